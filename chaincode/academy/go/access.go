@@ -0,0 +1,456 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/hyperledger/fabric/core/chaincode/lib/cid"
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	sc "github.com/hyperledger/fabric/protos/peer"
+)
+
+// A verifier presents its ID and the token the student got on grant in the
+// transient map; only the hash of the token is kept on the ledger.
+const (
+	TransientVerifierID    = "verifierId"
+	TransientVerifierToken = "verifierToken"
+	minVerifierTokenLength = 16
+)
+
+type CertificateGrant struct {
+	CertificateID   string
+	StudentUsername string
+	VerifierID      string
+	TokenHash       string
+	GrantedAt       string
+	Expiry          string
+	Revoked         bool
+}
+
+type CertificateAccess struct {
+	CertificateID string
+	VerifierID    string
+	Function      string
+	AccessedAt    string
+	TxID          string
+}
+
+func GrantCertificateAccess(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	if len(args) != 3 {
+		return shim.Error("Incorrect number of arguments. Expecting 3")
+	}
+
+	CertificateID := args[0]
+	VerifierID := args[1]
+	Expiry := args[2]
+
+	certificate, err := getOwnedCertificate(stub, CertificateID)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	GrantedAt, Expiry, TokenHash, err := getGrantTerms(stub, Expiry)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	grant := CertificateGrant{
+		CertificateID:   CertificateID,
+		StudentUsername: certificate.StudentUsername,
+		VerifierID:      VerifierID,
+		TokenHash:       TokenHash,
+		GrantedAt:       GrantedAt,
+		Expiry:          Expiry,
+	}
+
+	grantAsBytes, err := json.Marshal(grant)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	stub.PutState("CertificateGrant-"+" "+"Certificate-"+CertificateID+" "+"Verifier-"+VerifierID, grantAsBytes)
+
+	return shim.Success(nil)
+}
+
+func RevokeCertificateAccess(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	if len(args) != 2 {
+		return shim.Error("Incorrect number of arguments. Expecting 2")
+	}
+
+	CertificateID := args[0]
+	VerifierID := args[1]
+
+	_, err := getOwnedCertificate(stub, CertificateID)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	keyGrant := "CertificateGrant-" + " " + "Certificate-" + CertificateID + " " + "Verifier-" + VerifierID
+	grant, err := getCertificateGrant(stub, keyGrant)
+
+	if err != nil {
+		return shim.Error("Grant does not exist!")
+	}
+
+	if grant.Revoked {
+		return shim.Error("This grant was revoked!")
+	}
+
+	grant.Revoked = true
+
+	grantAsBytes, err := json.Marshal(grant)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	stub.PutState(keyGrant, grantAsBytes)
+
+	return shim.Success(nil)
+}
+
+func GetCertificateGrants(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	if len(args) != 1 {
+		return shim.Error("Incorrect number of arguments. Expecting 1")
+	}
+
+	CertificateID := args[0]
+
+	if err := checkCertificateOwnerOrAcademy(stub, CertificateID); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	startKey := "CertificateGrant-" + " " + "Certificate-" + CertificateID + " "
+	resultsIterator, err := stub.GetStateByRange(startKey, startKey+"zzzzzzzz")
+
+	if err != nil {
+		return shim.Error("Can not get grants of certificate - " + CertificateID)
+	}
+
+	defer resultsIterator.Close()
+
+	var tlist []CertificateGrant
+
+	for resultsIterator.HasNext() {
+		record, err := resultsIterator.Next()
+		if err != nil {
+			return shim.Error(err.Error())
+		}
+
+		grant := CertificateGrant{}
+		json.Unmarshal(record.Value, &grant)
+		tlist = append(tlist, grant)
+	}
+
+	jsonRow, err := json.Marshal(tlist)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	return shim.Success(jsonRow)
+}
+
+func GetCertificateAccessLog(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	if len(args) != 1 {
+		return shim.Error("Incorrect number of arguments. Expecting 1")
+	}
+
+	CertificateID := args[0]
+
+	if err := checkCertificateOwnerOrAcademy(stub, CertificateID); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	startKey := "CertificateAccess-" + " " + "Certificate-" + CertificateID + " "
+	resultsIterator, err := stub.GetStateByRange(startKey, startKey+"zzzzzzzz")
+
+	if err != nil {
+		return shim.Error("Can not get access log of certificate - " + CertificateID)
+	}
+
+	defer resultsIterator.Close()
+
+	var tlist []CertificateAccess
+
+	for resultsIterator.HasNext() {
+		record, err := resultsIterator.Next()
+		if err != nil {
+			return shim.Error(err.Error())
+		}
+
+		access := CertificateAccess{}
+		json.Unmarshal(record.Value, &access)
+		tlist = append(tlist, access)
+	}
+
+	jsonRow, err := json.Marshal(tlist)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	return shim.Success(jsonRow)
+}
+
+// guestFunctions are the only functions a guest may call. Reads of student
+// data among them check the verifier's grant themselves.
+var guestFunctions = map[string]bool{
+	"GetSubject":                           true,
+	"GetSubjectsOfCourse":                  true,
+	"GetAllSubjects":                       true,
+	"GetCourse":                            true,
+	"GetAchievement":                       true,
+	"GetCredentialIssuer":                  true,
+	"GetScoreCommitment":                   true,
+	"VerifyDisclosedScores":                true,
+	"GetStudent":                           true,
+	"GetCertificate":                       true,
+	"GetCertificatesOfStudent":             true,
+	"GetScoresOfStudent":                   true,
+	"GetHistoryOfCertificate":              true,
+	"GetCertificateAsVerifiableCredential": true,
+	"GetOpenBadgeCredential":               true,
+	"GetCertificateByVerificationCode":     true,
+	"VerifyCertificate":                    true,
+	"GetTranscript":                        true,
+	"GetMicroCredential":                   true,
+	"GetMicroCredentialsOfStudent":         true,
+	"VerifyMicroCredential":                true,
+}
+
+func checkGuestFunction(stub shim.ChaincodeStubInterface, function string) error {
+
+	_, isGuest, err := getGuestVerifier(stub)
+
+	if err != nil {
+		return err
+	}
+
+	if !isGuest {
+		return nil
+	}
+
+	if !guestFunctions[function] {
+		return errors.New("Permission Denied!")
+	}
+
+	return nil
+}
+
+// getGuestVerifier reports whether the caller is a guest identity: enrolled
+// under StudentMSP with the attribute role=guest (see
+// server/cli/registerGuest.js). All verifiers share that identity, so the
+// verifier ID is taken from the transient map.
+func getGuestVerifier(stub shim.ChaincodeStubInterface) (string, bool, error) {
+
+	MSPID, err := cid.GetMSPID(stub)
+
+	if err != nil {
+		return "", false, errors.New("Error - cid.GetMSPID()")
+	}
+
+	if MSPID != "StudentMSP" {
+		return "", false, nil
+	}
+
+	if cid.AssertAttributeValue(stub, "role", "guest") != nil {
+		return "", false, nil
+	}
+
+	transient, err := stub.GetTransient()
+
+	if err != nil {
+		return "", true, errors.New("Can not get transient map!")
+	}
+
+	return string(transient[TransientVerifierID]), true, nil
+}
+
+func getVerifierTokenHash(stub shim.ChaincodeStubInterface) (string, error) {
+
+	transient, err := stub.GetTransient()
+
+	if err != nil {
+		return "", errors.New("Can not get transient map!")
+	}
+
+	token := transient[TransientVerifierToken]
+
+	if len(token) < minVerifierTokenLength {
+		return "", errors.New("Verifier token is missing or too short!")
+	}
+
+	hash := sha256.Sum256(token)
+
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// checkGuestAccess lets non-guest callers through untouched. A guest needs an
+// active grant for its verifier ID and token on one of the given
+// certificates; the access is then written to the certificate's access log,
+// so guests have to submit rather than evaluate these reads for the log
+// entry to be committed.
+func checkGuestAccess(stub shim.ChaincodeStubInterface, certificateIDs []string, function string) error {
+
+	VerifierID, isGuest, err := getGuestVerifier(stub)
+
+	if err != nil {
+		return err
+	}
+
+	if !isGuest {
+		return nil
+	}
+
+	if VerifierID == "" {
+		return errors.New("Verifier ID is missing!")
+	}
+
+	TokenHash, err := getVerifierTokenHash(stub)
+
+	if err != nil {
+		return err
+	}
+
+	txTime, err := getTxTime(stub)
+
+	if err != nil {
+		return errors.New("Can not get transaction timestamp!")
+	}
+
+	for _, CertificateID := range certificateIDs {
+		grant, err := getCertificateGrant(stub, "CertificateGrant-"+" "+"Certificate-"+CertificateID+" "+"Verifier-"+VerifierID)
+		if err != nil || !isActiveGrant(grant.TokenHash, grant.Expiry, grant.Revoked, TokenHash, txTime) {
+			continue
+		}
+
+		access := CertificateAccess{
+			CertificateID: CertificateID,
+			VerifierID:    VerifierID,
+			Function:      function,
+			AccessedAt:    txTime.Format(time.RFC3339),
+			TxID:          stub.GetTxID(),
+		}
+
+		return logAccess(stub, "CertificateAccess-"+" "+"Certificate-"+CertificateID+" "+access.AccessedAt+" "+access.TxID, access)
+	}
+
+	return errors.New("Access denied - no active grant for verifier " + VerifierID)
+}
+
+func isActiveGrant(grantTokenHash string, grantExpiry string, revoked bool, TokenHash string, txTime time.Time) bool {
+
+	if revoked || grantTokenHash != TokenHash {
+		return false
+	}
+
+	expiry, err := time.Parse(time.RFC3339, grantExpiry)
+
+	return err == nil && expiry.After(txTime)
+}
+
+func logAccess(stub shim.ChaincodeStubInterface, key string, access interface{}) error {
+
+	accessAsBytes, err := json.Marshal(access)
+
+	if err != nil {
+		return errors.New("Can not convert data to bytes!")
+	}
+
+	return stub.PutState(key, accessAsBytes)
+}
+
+// getGrantTerms checks the expiry of a new grant and hashes the verifier
+// token the student hands out with it.
+func getGrantTerms(stub shim.ChaincodeStubInterface, Expiry string) (string, string, string, error) {
+
+	expiry, err := time.Parse(time.RFC3339, Expiry)
+
+	if err != nil {
+		return "", "", "", errors.New("Expiry must be an RFC 3339 timestamp!")
+	}
+
+	txTime, err := getTxTime(stub)
+
+	if err != nil {
+		return "", "", "", errors.New("Can not get transaction timestamp!")
+	}
+
+	if !expiry.After(txTime) {
+		return "", "", "", errors.New("Expiry must be in the future!")
+	}
+
+	TokenHash, err := getVerifierTokenHash(stub)
+
+	if err != nil {
+		return "", "", "", err
+	}
+
+	return txTime.Format(time.RFC3339), expiry.UTC().Format(time.RFC3339), TokenHash, nil
+}
+
+func getOwnedCertificate(stub shim.ChaincodeStubInterface, CertificateID string) (Certificate, error) {
+
+	MSPID, err := cid.GetMSPID(stub)
+
+	if err != nil {
+		return Certificate{}, errors.New("Error - cid.GetMSPID()")
+	}
+
+	if MSPID != "StudentMSP" {
+		return Certificate{}, errors.New("Permission Denied!")
+	}
+
+	Username, _, err := cid.GetAttributeValue(stub, "username")
+
+	if err != nil {
+		return Certificate{}, errors.New("Error - cid.GetAttributeValue()")
+	}
+
+	certificate, err := getCertificate(stub, "Certificate-"+CertificateID)
+
+	if err != nil {
+		return certificate, errors.New("Certificate does not exist!")
+	}
+
+	if certificate.StudentUsername != Username {
+		return certificate, errors.New("Permission Denied!")
+	}
+
+	return certificate, nil
+}
+
+func checkCertificateOwnerOrAcademy(stub shim.ChaincodeStubInterface, CertificateID string) error {
+
+	MSPID, err := cid.GetMSPID(stub)
+
+	if err != nil {
+		return errors.New("Error - cid.GetMSPID()")
+	}
+
+	if MSPID == "AcademyMSP" {
+		_, err := getCertificate(stub, "Certificate-"+CertificateID)
+		if err != nil {
+			return errors.New("Certificate does not exist!")
+		}
+
+		return nil
+	}
+
+	_, err = getOwnedCertificate(stub, CertificateID)
+
+	return err
+}
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+const (
+	testVerifierToken      = "token-of-verifier-acme-0123"
+	testOtherVerifierToken = "token-of-verifier-other-0123"
+)
+
+func asVerifier(stub *testStub, VerifierID string, token string) *testStub {
+	stub.withAttributes("StudentMSP", map[string]string{"username": "guest", "role": "guest"})
+	stub.Transient = map[string][]byte{
+		TransientVerifierID:    []byte(VerifierID),
+		TransientVerifierToken: []byte(token),
+	}
+
+	return stub
+}
+
+func grantTestAccess(stub *testStub, function string, ID string, VerifierID string, token string) {
+	stub.as("StudentMSP", "st1")
+	stub.Transient = map[string][]byte{TransientVerifierToken: []byte(token)}
+	stub.mustInvoke(function, ID, VerifierID, "2021-01-01T00:00:00Z")
+	stub.Transient = nil
+}
+
+func TestGuestCertificateAccess(test *testing.T) {
+	stub := newTestStub(test)
+	seedAcademy(stub)
+	issueTestCertificate(stub, "st1", "cert1")
+
+	asVerifier(stub, "acme", testVerifierToken)
+	stub.mustFail("GetCertificate", "cert1")
+	stub.mustFail("GetStudent", "st1")
+	stub.mustFail("GetCertificatesOfStudent", "st1")
+	stub.mustFail("GetAllStudents")
+	stub.mustFail("GetAllCertificates")
+
+	// khong doc duoc transient thi van bi chan, khong bo qua danh sach ham
+	stub.mustInvoke("GetSubject", "S1")
+	stub.TransientErr = errors.New("Transient map is not readable")
+	stub.mustFail("GetSubject", "S1")
+	stub.TransientErr = nil
+
+	// khach duoc nhan dien bang thuoc tinh role, khong phai bang ten
+	stub.as("AcademyMSP", "adminacademy").mustInvoke("CreateStudent", "guest", "Guest")
+	asVerifier(stub, "acme", testVerifierToken)
+	stub.mustFail("GetAllStudents")
+	stub.mustFail("GetCertificate", "cert1")
+
+	stub.as("StudentMSP", "st2").mustFail("GrantCertificateAccess", "cert1", "acme", "2021-01-01T00:00:00Z")
+
+	stub.as("StudentMSP", "st1")
+	stub.Transient = map[string][]byte{TransientVerifierToken: []byte("short")}
+	stub.mustFail("GrantCertificateAccess", "cert1", "acme", "2021-01-01T00:00:00Z")
+	stub.Transient = map[string][]byte{TransientVerifierToken: []byte(testVerifierToken)}
+	stub.mustFail("GrantCertificateAccess", "cert1", "acme", "2020-01-01T00:00:00Z")
+
+	grantTestAccess(stub, "GrantCertificateAccess", "cert1", "acme", testVerifierToken)
+
+	if strings.Contains(string(stub.mustInvoke("GetCertificateGrants", "cert1")), testVerifierToken) {
+		test.Fatal("Verifier token must only be stored as a hash")
+	}
+
+	// nguoi xac minh khac hoac token sai deu khong doc duoc
+	asVerifier(stub, "other", testVerifierToken).mustFail("GetCertificate", "cert1")
+	asVerifier(stub, "acme", testOtherVerifierToken).mustFail("GetCertificate", "cert1")
+
+	asVerifier(stub, "acme", testVerifierToken)
+	stub.mustInvoke("GetCertificate", "cert1")
+	stub.mustInvoke("GetStudent", "st1")
+	stub.mustInvoke("GetCertificatesOfStudent", "st1")
+	stub.mustInvoke("GetScoresOfStudent", "st1", "C1")
+
+	if strings.Contains(string(stub.mustInvoke("GetCourse", "C1")), "st1") {
+		test.Fatal("Students of the course must be hidden from guests")
+	}
+
+	stub.as("StudentMSP", "st1")
+
+	var accessLog []CertificateAccess
+	json.Unmarshal(stub.mustInvoke("GetCertificateAccessLog", "cert1"), &accessLog)
+
+	if len(accessLog) != 4 || accessLog[0].VerifierID != "acme" {
+		test.Fatalf("Unexpected access log %+v", accessLog)
+	}
+
+	stub.mustInvoke("RevokeCertificateAccess", "cert1", "acme")
+
+	asVerifier(stub, "acme", testVerifierToken)
+	stub.mustFail("GetCertificate", "cert1")
+	stub.mustFail("GetScoresOfStudent", "st1", "C1")
+}
+
+func TestGuestMicroCredentialAccess(test *testing.T) {
+	stub := newTestStub(test)
+	seedAcademy(stub)
+
+	stub.mustInvoke("CreateClass", "K1", "K1C", "R1", testClassSchedule, "S1", "30", "TM")
+	stub.mustInvoke("AssignTeacherToClass", "K1", "T1")
+	stub.at("2020-08-01 00:00").as("StudentMSP", "st1").mustInvoke("StudentRegisterClass", "st1", "K1")
+	stub.at("2020-09-01 00:00").as("AcademyMSP", "adminacademy").mustInvoke("StartClass", "K1")
+	stub.mustInvoke("PickScore", "T1", "K1", "st1", "8")
+	stub.mustInvoke("CompleteClass", "K1")
+
+	var microCredential MicroCredential
+	json.Unmarshal(stub.mustInvoke("IssueMicroCredential", "K1", "st1"), &microCredential)
+	ID := microCredential.MicroCredentialID
+
+	asVerifier(stub, "acme", testVerifierToken)
+	stub.mustFail("GetMicroCredential", ID)
+	stub.mustFail("GetMicroCredentialsOfStudent", "st1")
+
+	grantTestAccess(stub, "GrantMicroCredentialAccess", ID, "acme", testVerifierToken)
+
+	asVerifier(stub, "acme", testOtherVerifierToken).mustFail("VerifyMicroCredential", ID)
+
+	asVerifier(stub, "acme", testVerifierToken)
+	stub.mustInvoke("GetMicroCredential", ID)
+	stub.mustInvoke("VerifyMicroCredential", ID)
+	stub.mustInvoke("GetMicroCredentialsOfStudent", "st1")
+
+	stub.as("StudentMSP", "st1")
+
+	var accessLog []MicroCredentialAccess
+	json.Unmarshal(stub.mustInvoke("GetMicroCredentialAccessLog", ID), &accessLog)
+
+	if len(accessLog) != 3 {
+		test.Fatalf("Unexpected access log %+v", accessLog)
+	}
+
+	stub.mustInvoke("RevokeMicroCredentialAccess", ID, "acme")
+
+	asVerifier(stub, "acme", testVerifierToken)
+	stub.mustFail("GetMicroCredential", ID)
+	stub.mustFail("GetMicroCredentialsOfStudent", "st1")
+}
@@ -0,0 +1,453 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hyperledger/fabric/core/chaincode/lib/cid"
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	sc "github.com/hyperledger/fabric/protos/peer"
+)
+
+type AssessmentType string
+
+const (
+	Assignment AssessmentType = "Assignment"
+	Quiz       AssessmentType = "Quiz"
+	Exam       AssessmentType = "Exam"
+)
+
+// Assessment is an assignment, quiz or exam of a class. DueDate is RFC3339
+// so the deadline carries its own offset.
+type Assessment struct {
+	AssessmentID string
+	ClassID      string
+	Title        string
+	Type         AssessmentType
+	DueDate      string
+	MaxPoints    float64
+}
+
+// Submission anchors the SHA-256 hash of the work of a student. The work
+// itself stays off chain; SubmittedAt is the transaction timestamp, so the
+// record proves the work existed in this exact form before the deadline.
+type Submission struct {
+	AssessmentID    string
+	ClassID         string
+	StudentUsername string
+	Hash            string
+	SubmittedAt     string
+	TxID            string
+	Points          *float64
+	GradedBy        string
+	GradedAt        string
+}
+
+func CreateAssessment(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	MSPID, err := cid.GetMSPID(stub)
+
+	if err != nil {
+		return shim.Error("Error - cid.GetMSPID()")
+	}
+
+	if MSPID != "AcademyMSP" {
+		return shim.Error("Permission Denied!")
+	}
+
+	if len(args) != 7 {
+		return shim.Error("Incorrect number of arguments. Expecting 7")
+	}
+
+	Teacher := args[0]
+	ClassID := args[1]
+
+	assessment := Assessment{
+		AssessmentID: args[2],
+		ClassID:      ClassID,
+		Title:        strings.TrimSpace(args[3]),
+		Type:         AssessmentType(args[4]),
+		DueDate:      args[5],
+	}
+
+	MaxPoints, err := strconv.ParseFloat(args[6], 64)
+
+	if err != nil || MaxPoints <= 0 {
+		return shim.Error("Max points must be a positive number!")
+	}
+
+	assessment.MaxPoints = MaxPoints
+
+	if assessment.Title == "" {
+		return shim.Error("Title can not be empty!")
+	}
+
+	if assessment.Type != Assignment && assessment.Type != Quiz && assessment.Type != Exam {
+		return shim.Error("Assessment type must be Assignment, Quiz or Exam!")
+	}
+
+	if _, err := time.Parse(time.RFC3339, assessment.DueDate); err != nil {
+		return shim.Error("Due date must be RFC3339!")
+	}
+
+	class, err := getClass(stub, "Class-"+ClassID)
+
+	if err != nil {
+		return shim.Error("Class does not exist - " + ClassID)
+	}
+
+	err = checkStaffPermission(class, Teacher, CanManageAssessments)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	if class.Status == Completed {
+		return shim.Error("This class was completed!")
+	}
+
+	keyAssessment := "Assessment-" + " " + "Class-" + ClassID + " " + assessment.AssessmentID
+
+	if _, err := getAssessment(stub, keyAssessment); err == nil {
+		return shim.Error("Assessment already exists - " + assessment.AssessmentID)
+	}
+
+	assessmentAsBytes, err := json.Marshal(assessment)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	stub.PutState(keyAssessment, assessmentAsBytes)
+
+	return shim.Success(assessmentAsBytes)
+}
+
+// SubmitAssessment anchors the hash of the work of a student. A student may
+// submit again until the deadline; the latest hash replaces the earlier one,
+// which stays in the history of the key.
+func SubmitAssessment(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	MSPID, err := cid.GetMSPID(stub)
+
+	if err != nil {
+		return shim.Error("Error - cid.GetMSPID()")
+	}
+
+	if MSPID != "StudentMSP" {
+		return shim.Error("Permission Denied!")
+	}
+
+	if len(args) != 4 {
+		return shim.Error("Incorrect number of arguments. Expecting 4")
+	}
+
+	Student := args[0]
+	ClassID := args[1]
+	AssessmentID := args[2]
+	Hash := strings.ToLower(args[3])
+
+	if decoded, err := hex.DecodeString(Hash); err != nil || len(decoded) != 32 {
+		return shim.Error("Hash must be a hex encoded SHA-256 digest!")
+	}
+
+	class, err := getClass(stub, "Class-"+ClassID)
+
+	if err != nil {
+		return shim.Error("Class does not exist - " + ClassID)
+	}
+
+	if class.Status != InProgress {
+		return shim.Error("Can not submit now!")
+	}
+
+	if !containsString(class.Students, Student) {
+		return shim.Error("The student does not study in this class!")
+	}
+
+	assessment, err := getAssessment(stub, "Assessment-"+" "+"Class-"+ClassID+" "+AssessmentID)
+
+	if err != nil {
+		return shim.Error("Assessment does not exist - " + AssessmentID)
+	}
+
+	txTime, err := getTxTime(stub)
+
+	if err != nil {
+		return shim.Error("Can not get transaction timestamp!")
+	}
+
+	dueDate, _ := time.Parse(time.RFC3339, assessment.DueDate)
+
+	if txTime.After(dueDate) {
+		return shim.Error("Deadline of this assessment passed at " + assessment.DueDate + "!")
+	}
+
+	keySubmission := "Submission-" + " " + "Class-" + ClassID + " " + "Assessment-" + AssessmentID + " " + "Student-" + Student
+
+	if previous, err := getSubmission(stub, keySubmission); err == nil && previous.Points != nil {
+		return shim.Error("This submission was graded!")
+	}
+
+	submission := Submission{
+		AssessmentID:    AssessmentID,
+		ClassID:         ClassID,
+		StudentUsername: Student,
+		Hash:            Hash,
+		SubmittedAt:     txTime.Format(time.RFC3339),
+		TxID:            stub.GetTxID(),
+	}
+
+	submissionAsBytes, err := json.Marshal(submission)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	stub.PutState(keySubmission, submissionAsBytes)
+
+	return shim.Success(submissionAsBytes)
+}
+
+// GradeSubmission lets the staff of the class grade a submission. The
+// grader passes the hash of the work they graded, which must match the
+// anchored one, so a grade always refers to the work that was submitted.
+func GradeSubmission(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	MSPID, err := cid.GetMSPID(stub)
+
+	if err != nil {
+		return shim.Error("Error - cid.GetMSPID()")
+	}
+
+	if MSPID != "AcademyMSP" {
+		return shim.Error("Permission Denied!")
+	}
+
+	if len(args) != 6 {
+		return shim.Error("Incorrect number of arguments. Expecting 6")
+	}
+
+	Teacher := args[0]
+	ClassID := args[1]
+	AssessmentID := args[2]
+	Student := args[3]
+	Hash := strings.ToLower(args[4])
+
+	Points, err := strconv.ParseFloat(args[5], 64)
+
+	if err != nil {
+		return shim.Error("Failed convert string to float")
+	}
+
+	class, err := getClass(stub, "Class-"+ClassID)
+
+	if err != nil {
+		return shim.Error("Class does not exist - " + ClassID)
+	}
+
+	err = checkStaffPermission(class, Teacher, CanGradeSubmissions)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	if class.Status != InProgress {
+		return shim.Error("Can not entry score now!")
+	}
+
+	err = checkGradingWindow(stub, class)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	assessment, err := getAssessment(stub, "Assessment-"+" "+"Class-"+ClassID+" "+AssessmentID)
+
+	if err != nil {
+		return shim.Error("Assessment does not exist - " + AssessmentID)
+	}
+
+	if Points < 0 || Points > assessment.MaxPoints {
+		return shim.Error("Points must be between 0 and " + formatScore(assessment.MaxPoints) + "!")
+	}
+
+	keySubmission := "Submission-" + " " + "Class-" + ClassID + " " + "Assessment-" + AssessmentID + " " + "Student-" + Student
+	submission, err := getSubmission(stub, keySubmission)
+
+	if err != nil {
+		return shim.Error("The student did not submit this assessment - " + Student)
+	}
+
+	if submission.Hash != Hash {
+		return shim.Error("Hash does not match the submission!")
+	}
+
+	txTime, err := getTxTime(stub)
+
+	if err != nil {
+		return shim.Error("Can not get transaction timestamp!")
+	}
+
+	submission.Points = &Points
+	submission.GradedBy = Teacher
+	submission.GradedAt = txTime.Format(time.RFC3339)
+
+	submissionAsBytes, err := json.Marshal(submission)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	stub.PutState(keySubmission, submissionAsBytes)
+
+	return shim.Success(submissionAsBytes)
+}
+
+func GetAssessmentsOfClass(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	if len(args) != 1 {
+		return shim.Error("Incorrect number of arguments. Expecting 1")
+	}
+
+	ClassID := args[0]
+
+	_, err := getClass(stub, "Class-"+ClassID)
+
+	if err != nil {
+		return shim.Error("Class does not exist - " + ClassID)
+	}
+
+	assessments, err := getAssessmentsOfClass(stub, ClassID)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	assessmentsAsBytes, err := json.Marshal(assessments)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	return shim.Success(assessmentsAsBytes)
+}
+
+func GetSubmissionsOfAssessment(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	if len(args) != 2 {
+		return shim.Error("Incorrect number of arguments. Expecting 2")
+	}
+
+	ClassID := args[0]
+	AssessmentID := args[1]
+
+	_, err := getAssessment(stub, "Assessment-"+" "+"Class-"+ClassID+" "+AssessmentID)
+
+	if err != nil {
+		return shim.Error("Assessment does not exist - " + AssessmentID)
+	}
+
+	prefix := "Submission-" + " " + "Class-" + ClassID + " " + "Assessment-" + AssessmentID + " "
+	iterator, err := stub.GetStateByRange(prefix, prefix+"zzzzzzzz")
+
+	if err != nil {
+		return shim.Error("Failed to get data in the ledger")
+	}
+
+	defer iterator.Close()
+
+	submissions := []Submission{}
+	for iterator.HasNext() {
+		record, err := iterator.Next()
+
+		if err != nil {
+			return shim.Error("Failed to get data in the ledger")
+		}
+
+		submission := Submission{}
+		json.Unmarshal(record.Value, &submission)
+		submissions = append(submissions, submission)
+	}
+
+	submissionsAsBytes, err := json.Marshal(submissions)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	return shim.Success(submissionsAsBytes)
+}
+
+func GetSubmission(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	if len(args) != 3 {
+		return shim.Error("Incorrect number of arguments. Expecting 3")
+	}
+
+	ClassID := args[0]
+	AssessmentID := args[1]
+	Student := args[2]
+
+	submission, err := getSubmission(stub, "Submission-"+" "+"Class-"+ClassID+" "+"Assessment-"+AssessmentID+" "+"Student-"+Student)
+
+	if err != nil {
+		return shim.Error("Submission does not exist!")
+	}
+
+	submissionAsBytes, err := json.Marshal(submission)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	return shim.Success(submissionAsBytes)
+}
+
+func getAssessmentsOfClass(stub shim.ChaincodeStubInterface, ClassID string) ([]Assessment, error) {
+
+	prefix := "Assessment-" + " " + "Class-" + ClassID + " "
+	iterator, err := stub.GetStateByRange(prefix, prefix+"zzzzzzzz")
+
+	if err != nil {
+		return nil, errors.New("Failed to get data in the ledger")
+	}
+
+	defer iterator.Close()
+
+	assessments := []Assessment{}
+	for iterator.HasNext() {
+		record, err := iterator.Next()
+
+		if err != nil {
+			return nil, errors.New("Failed to get data in the ledger")
+		}
+
+		assessment := Assessment{}
+		json.Unmarshal(record.Value, &assessment)
+		assessments = append(assessments, assessment)
+	}
+
+	return assessments, nil
+}
+
+// deleteAssessmentsOfClass removes the assessments of a class being deleted.
+// Only Open classes can be deleted, so there are no submissions yet.
+func deleteAssessmentsOfClass(stub shim.ChaincodeStubInterface, ClassID string) error {
+
+	assessments, err := getAssessmentsOfClass(stub, ClassID)
+
+	if err != nil {
+		return err
+	}
+
+	for _, assessment := range assessments {
+		stub.DelState("Assessment-" + " " + "Class-" + ClassID + " " + assessment.AssessmentID)
+	}
+
+	return nil
+}
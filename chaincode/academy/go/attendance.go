@@ -0,0 +1,478 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"time"
+
+	"github.com/hyperledger/fabric/core/chaincode/lib/cid"
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	sc "github.com/hyperledger/fabric/protos/peer"
+)
+
+type AttendanceStatus string
+
+const (
+	Present AttendanceStatus = "Present"
+	Absent  AttendanceStatus = "Absent"
+	Late    AttendanceStatus = "Late"
+	Excused AttendanceStatus = "Excused"
+)
+
+// ClassSession is one meeting of a class, generated from its schedule. A
+// class meets at most once a day, so the local date identifies the session.
+type ClassSession struct {
+	ClassID    string
+	Date       string
+	Start      string
+	End        string
+	Attendance map[string]AttendanceStatus
+}
+
+// AttendanceSummary counts the recorded sessions of one student in a class.
+// Late counts as attended and Excused sessions are left out of Percentage.
+type AttendanceSummary struct {
+	ClassID         string
+	StudentUsername string
+	Sessions        int
+	Recorded        int
+	Present         int
+	Late            int
+	Absent          int
+	Excused         int
+	Percentage      float64
+}
+
+// RecordAttendance lets the staff of a class mark students of a session
+// that has already started. Attendance is a JSON object from student
+// username to Present, Absent, Late or Excused; students left out keep what
+// was recorded before. Classes scheduled before sessions existed get the
+// session of Date from their schedule the first time it is recorded.
+func RecordAttendance(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	MSPID, err := cid.GetMSPID(stub)
+
+	if err != nil {
+		return shim.Error("Error - cid.GetMSPID()")
+	}
+
+	if MSPID != "AcademyMSP" {
+		return shim.Error("Permission Denied!")
+	}
+
+	if len(args) != 4 {
+		return shim.Error("Incorrect number of arguments. Expecting 4")
+	}
+
+	Teacher := args[0]
+	ClassID := args[1]
+	Date := args[2]
+
+	var attendance map[string]AttendanceStatus
+
+	if err := json.Unmarshal([]byte(args[3]), &attendance); err != nil || len(attendance) == 0 {
+		return shim.Error("Attendance must be a JSON object of students!")
+	}
+
+	class, err := getClass(stub, "Class-"+ClassID)
+
+	if err != nil {
+		return shim.Error("Class does not exist - " + ClassID)
+	}
+
+	err = checkStaffPermission(class, Teacher, CanRecordAttendance)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	if class.Status != InProgress {
+		return shim.Error("Can not record attendance now!")
+	}
+
+	keySession := "ClassSession-" + " " + "Class-" + ClassID + " " + Date
+	session, err := getClassSession(stub, keySession)
+
+	if err != nil {
+		session, err = newClassSession(class, Date)
+	}
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	txTime, err := getTxTime(stub)
+
+	if err != nil {
+		return shim.Error("Can not get transaction timestamp!")
+	}
+
+	start, _ := time.Parse(time.RFC3339, session.Start)
+
+	if txTime.Before(start) {
+		return shim.Error("Session on " + Date + " has not started!")
+	}
+
+	if session.Attendance == nil {
+		session.Attendance = map[string]AttendanceStatus{}
+	}
+
+	for Username, status := range attendance {
+		if !containsString(class.Students, Username) {
+			return shim.Error("The student does not study in this class - " + Username)
+		}
+
+		if status != Present && status != Absent && status != Late && status != Excused {
+			return shim.Error("Attendance must be Present, Absent, Late or Excused - " + Username)
+		}
+
+		session.Attendance[Username] = status
+	}
+
+	sessionAsBytes, err := json.Marshal(session)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	stub.PutState(keySession, sessionAsBytes)
+
+	return shim.Success(sessionAsBytes)
+}
+
+func GetClassSessions(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	if len(args) != 1 {
+		return shim.Error("Incorrect number of arguments. Expecting 1")
+	}
+
+	ClassID := args[0]
+
+	_, err := getClass(stub, "Class-"+ClassID)
+
+	if err != nil {
+		return shim.Error("Class does not exist - " + ClassID)
+	}
+
+	sessions, err := getClassSessions(stub, ClassID)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	sessionsAsBytes, err := json.Marshal(sessions)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	return shim.Success(sessionsAsBytes)
+}
+
+// GetAttendanceOfClass summarises every student of the class.
+func GetAttendanceOfClass(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	if len(args) != 1 {
+		return shim.Error("Incorrect number of arguments. Expecting 1")
+	}
+
+	ClassID := args[0]
+
+	class, err := getClass(stub, "Class-"+ClassID)
+
+	if err != nil {
+		return shim.Error("Class does not exist - " + ClassID)
+	}
+
+	sessions, err := getClassSessions(stub, ClassID)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	summaries := []AttendanceSummary{}
+	for _, Username := range class.Students {
+		summaries = append(summaries, summarizeAttendance(ClassID, sessions, Username))
+	}
+
+	summariesAsBytes, err := json.Marshal(summaries)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	return shim.Success(summariesAsBytes)
+}
+
+func GetAttendanceOfStudent(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	if len(args) != 1 {
+		return shim.Error("Incorrect number of arguments. Expecting 1")
+	}
+
+	Username := args[0]
+
+	student, err := getStudent(stub, "Student-"+Username)
+
+	if err != nil {
+		return shim.Error("Student does not exist - " + Username)
+	}
+
+	summaries := []AttendanceSummary{}
+	for _, ClassID := range student.Classes {
+		sessions, err := getClassSessions(stub, ClassID)
+
+		if err != nil {
+			return shim.Error(err.Error())
+		}
+
+		summaries = append(summaries, summarizeAttendance(ClassID, sessions, Username))
+	}
+
+	summariesAsBytes, err := json.Marshal(summaries)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	return shim.Success(summariesAsBytes)
+}
+
+func getClassSessions(stub shim.ChaincodeStubInterface, ClassID string) ([]ClassSession, error) {
+
+	prefix := "ClassSession-" + " " + "Class-" + ClassID + " "
+	iterator, err := stub.GetStateByRange(prefix, prefix+"zzzzzzzz")
+
+	if err != nil {
+		return nil, errors.New("Failed to get data in the ledger")
+	}
+
+	defer iterator.Close()
+
+	sessions := []ClassSession{}
+	for iterator.HasNext() {
+		record, err := iterator.Next()
+
+		if err != nil {
+			return nil, errors.New("Failed to get data in the ledger")
+		}
+
+		session := ClassSession{}
+		json.Unmarshal(record.Value, &session)
+		sessions = append(sessions, session)
+	}
+
+	return sessions, nil
+}
+
+// syncClassSessions makes the sessions of class match its schedule. New
+// meetings get a session, and sessions the schedule no longer has are
+// removed unless attendance was already recorded for them.
+func syncClassSessions(stub shim.ChaincodeStubInterface, class Class) error {
+
+	meetings, err := class.Schedule.meetings()
+
+	if err != nil {
+		return err
+	}
+
+	existing, err := getClassSessions(stub, class.ClassID)
+
+	if err != nil {
+		return err
+	}
+
+	wanted := map[string]meeting{}
+	for _, m := range meetings {
+		wanted[m.Start.Format(scheduleDateLayout)] = m
+	}
+
+	for _, session := range existing {
+		m, ok := wanted[session.Date]
+
+		if !ok && len(session.Attendance) > 0 {
+			return errors.New("Session on " + session.Date + " already has attendance!")
+		}
+
+		if ok && session.Start == m.Start.Format(time.RFC3339) && session.End == m.End.Format(time.RFC3339) {
+			delete(wanted, session.Date)
+			continue
+		}
+
+		if !ok {
+			stub.DelState("ClassSession-" + " " + "Class-" + class.ClassID + " " + session.Date)
+		}
+	}
+
+	for _, m := range meetings {
+		Date := m.Start.Format(scheduleDateLayout)
+		if _, ok := wanted[Date]; !ok {
+			continue
+		}
+
+		session := ClassSession{
+			ClassID: class.ClassID,
+			Date:    Date,
+			Start:   m.Start.Format(time.RFC3339),
+			End:     m.End.Format(time.RFC3339),
+		}
+
+		// giu lai diem danh da ghi khi chi doi gio hoc
+		for _, previous := range existing {
+			if previous.Date == Date {
+				session.Attendance = previous.Attendance
+			}
+		}
+
+		sessionAsBytes, err := json.Marshal(session)
+
+		if err != nil {
+			return errors.New("Can not convert data to bytes!")
+		}
+
+		stub.PutState("ClassSession-"+" "+"Class-"+class.ClassID+" "+Date, sessionAsBytes)
+	}
+
+	return nil
+}
+
+// newClassSession builds the session of class on Date from its schedule.
+func newClassSession(class Class, Date string) (ClassSession, error) {
+
+	meetings, err := class.Schedule.meetings()
+
+	if err != nil {
+		return ClassSession{}, err
+	}
+
+	for _, m := range meetings {
+		if m.Start.Format(scheduleDateLayout) == Date {
+			return ClassSession{
+				ClassID: class.ClassID,
+				Date:    Date,
+				Start:   m.Start.Format(time.RFC3339),
+				End:     m.End.Format(time.RFC3339),
+			}, nil
+		}
+	}
+
+	return ClassSession{}, errors.New("Class has no session on " + Date)
+}
+
+func deleteClassSessions(stub shim.ChaincodeStubInterface, ClassID string) error {
+
+	sessions, err := getClassSessions(stub, ClassID)
+
+	if err != nil {
+		return err
+	}
+
+	for _, session := range sessions {
+		stub.DelState("ClassSession-" + " " + "Class-" + ClassID + " " + session.Date)
+	}
+
+	return nil
+}
+
+func summarizeAttendance(ClassID string, sessions []ClassSession, Username string) AttendanceSummary {
+
+	summary := AttendanceSummary{ClassID: ClassID, StudentUsername: Username, Sessions: len(sessions)}
+
+	for _, session := range sessions {
+		status, ok := session.Attendance[Username]
+		if !ok {
+			continue
+		}
+
+		summary.Recorded++
+
+		switch status {
+		case Present:
+			summary.Present++
+		case Late:
+			summary.Late++
+		case Absent:
+			summary.Absent++
+		case Excused:
+			summary.Excused++
+		}
+	}
+
+	counted := summary.Recorded - summary.Excused
+
+	if counted > 0 {
+		summary.Percentage = math.Round(float64(summary.Present+summary.Late)*10000/float64(counted)) / 100
+	} else if summary.Excused > 0 {
+		summary.Percentage = 100
+	}
+
+	return summary
+}
+
+// checkAttendance adds a failed condition for every required subject whose
+// counted attempt was taken in a class the student attended less than
+// MinAttendance percent of, or where no attendance was recorded for them.
+// Classes without a schedule, and classes that never had attendance taken
+// because they were scheduled before it was tracked, are not checked.
+func checkAttendance(stub shim.ChaincodeStubInterface, course Course, StudentUsername string, eligibility *CertificateEligibility) error {
+
+	MinAttendance := course.IssuancePolicy.MinAttendance
+
+	if MinAttendance <= 0 {
+		return nil
+	}
+
+	student, err := getStudent(stub, "Student-"+StudentUsername)
+
+	if err != nil {
+		return errors.New("Student does not exist - " + StudentUsername)
+	}
+
+	policy, err := getRetakePolicy(stub)
+
+	if err != nil {
+		return err
+	}
+
+	for _, SubjectID := range course.Subjects {
+		attempts := getAttempts(stub, student, SubjectID)
+
+		if len(attempts) == 0 {
+			continue
+		}
+
+		counted := attempts[len(attempts)-1]
+		for _, attempt := range attempts {
+			if policy.CountedAttempt == CountBestAttempt && attempt.ScoreValue > counted.ScoreValue {
+				counted = attempt
+			}
+		}
+
+		class, err := getClass(stub, "Class-"+counted.ClassID)
+
+		if err != nil || class.Schedule.StartDate == "" {
+			continue
+		}
+
+		sessions, err := getClassSessions(stub, class.ClassID)
+
+		if err != nil {
+			return err
+		}
+
+		if len(sessions) == 0 {
+			continue
+		}
+
+		summary := summarizeAttendance(class.ClassID, sessions, StudentUsername)
+
+		if summary.Recorded == 0 {
+			eligibility.FailedConditions = append(eligibility.FailedConditions, "No attendance recorded for subject "+SubjectID)
+		} else if summary.Percentage < MinAttendance {
+			eligibility.FailedConditions = append(eligibility.FailedConditions, "Attendance of subject "+SubjectID+" is "+formatScore(summary.Percentage)+"%, below "+formatScore(MinAttendance)+"%")
+		}
+	}
+
+	return nil
+}
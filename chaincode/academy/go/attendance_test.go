@@ -0,0 +1,72 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestAttendancePercentage(test *testing.T) {
+	stub := newTestStub(test)
+	seedAcademy(stub)
+
+	// thu Hai va thu Tu tu 2020-09-02 den 2020-09-30: 9 buoi
+	schedule := strings.Replace(testClassSchedule, `["Mon"]`, `["Mon","Wed"]`, 1)
+	stub.mustInvoke("CreateClass", "K1", "K1C", "R1", schedule, "S1", "30", "TM")
+	stub.mustInvoke("CreateClass", "K2", "K2C", "R2", strings.Replace(testClassSchedule, "Mon", "Tue", 1), "S2", "30", "TM")
+
+	var sessions []ClassSession
+	json.Unmarshal(stub.mustInvoke("GetClassSessions", "K1"), &sessions)
+
+	if len(sessions) != 9 || sessions[0].Start != "2020-09-02T08:00:00+07:00" {
+		test.Fatalf("Unexpected sessions %+v", sessions)
+	}
+
+	stub.mustInvoke("AssignTeacherToClass", "K1", "T1")
+	stub.mustInvoke("AssignTeacherToClass", "K2", "T1")
+	stub.mustInvoke("CreateStudent", "st2", "Student Two")
+
+	stub.at("2020-08-01 00:00")
+	stub.as("StudentMSP", "st1").mustInvoke("StudentRegisterClass", "st1", "K1")
+	stub.mustInvoke("StudentRegisterClass", "st1", "K2")
+	stub.mustInvoke("StudentRegisterCourse", "st1", "C1")
+	stub.as("StudentMSP", "st2").mustInvoke("StudentRegisterClass", "st2", "K1")
+
+	stub.as("AcademyMSP", "adminacademy").at("2020-09-01 00:00")
+	stub.mustInvoke("StartClass", "K1")
+	stub.mustInvoke("StartClass", "K2")
+
+	stub.at("2020-09-07 01:00")
+	stub.mustFail("RecordAttendance", "T1", "K1", "2020-09-08", `{"st1":"Present"}`)
+	stub.mustFail("RecordAttendance", "T1", "K1", "2020-09-07", `{"st1":"Sleeping"}`)
+	stub.mustFail("RecordAttendance", "T1", "K1", "2020-09-07", `{"nobody":"Present"}`)
+	stub.mustInvoke("RecordAttendance", "T1", "K1", "2020-09-07", `{"st1":"Present","st2":"Absent"}`)
+	stub.at("2020-09-09 01:00").mustInvoke("RecordAttendance", "T1", "K1", "2020-09-09", `{"st1":"Late","st2":"Excused"}`)
+	stub.at("2020-09-14 01:00").mustInvoke("RecordAttendance", "T1", "K1", "2020-09-14", `{"st1":"Absent","st2":"Present"}`)
+
+	// vang co phep khong tinh vao ti le, di muon van tinh la co mat
+	var summaries []AttendanceSummary
+	json.Unmarshal(stub.mustInvoke("GetAttendanceOfClass", "K1"), &summaries)
+
+	if summaries[0].Percentage != 66.67 || summaries[1].Percentage != 50 || summaries[1].Excused != 1 {
+		test.Fatalf("Unexpected summaries %+v", summaries)
+	}
+
+	stub.mustInvoke("SetCourseIssuancePolicy", "C1", `{"MinAttendance":75}`)
+	stub.mustInvoke("PickScore", "T1", "K1", "st1", "8")
+	stub.mustInvoke("PickScore", "T1", "K2", "st1", "8")
+	stub.at("2020-09-15 01:00").mustInvoke("RecordAttendance", "T1", "K2", "2020-09-15", `{"st1":"Present"}`)
+
+	eligibility := string(stub.mustInvoke("CheckCertificateEligibility", "C1", "st1"))
+
+	if !strings.Contains(eligibility, "Attendance of subject S1 is 66.67%, below 75%") {
+		test.Fatal(eligibility)
+	}
+
+	stub.mustInvoke("RecordAttendance", "T1", "K1", "2020-09-14", `{"st1":"Excused"}`)
+	eligibility = string(stub.mustInvoke("CheckCertificateEligibility", "C1", "st1"))
+
+	if strings.Contains(eligibility, "Attendance") {
+		test.Fatal(eligibility)
+	}
+}
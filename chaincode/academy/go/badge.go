@@ -0,0 +1,430 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/hyperledger/fabric/core/chaincode/lib/cid"
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	sc "github.com/hyperledger/fabric/protos/peer"
+)
+
+const (
+	PassScore         = 5.0
+	OpenBadgesContext = "https://purl.imsglobal.org/spec/ob/v3p0/context-3.0.3.json"
+	AchievementCourse = "Course"
+	AchievementSubj   = "Subject"
+	SubjectIDPrefix   = "urn:study-chain:subject:"
+)
+
+type BadgeProfile struct {
+	ID   string   `json:"id"`
+	Type []string `json:"type"`
+	Name string   `json:"name"`
+}
+
+type AchievementCriteria struct {
+	Narrative string `json:"narrative"`
+}
+
+type ResultDescription struct {
+	ID         string   `json:"id"`
+	Type       []string `json:"type"`
+	Name       string   `json:"name"`
+	ResultType string   `json:"resultType"`
+}
+
+type AchievementResult struct {
+	Type              []string `json:"type"`
+	ResultDescription string   `json:"resultDescription"`
+	Value             string   `json:"value"`
+}
+
+type Achievement struct {
+	ID                 string              `json:"id"`
+	Type               []string            `json:"type"`
+	AchievementType    string              `json:"achievementType"`
+	Name               string              `json:"name"`
+	Description        string              `json:"description"`
+	HumanCode          string              `json:"humanCode,omitempty"`
+	Criteria           AchievementCriteria `json:"criteria"`
+	ResultDescriptions []ResultDescription `json:"resultDescription,omitempty"`
+}
+
+type AchievementSubject struct {
+	ID          string              `json:"id"`
+	Type        []string            `json:"type"`
+	Name        string              `json:"name,omitempty"`
+	Achievement Achievement         `json:"achievement"`
+	Results     []AchievementResult `json:"result,omitempty"`
+}
+
+type OpenBadgeCredential struct {
+	Context           []string             `json:"@context"`
+	ID                string               `json:"id"`
+	Type              []string             `json:"type"`
+	Name              string               `json:"name"`
+	Issuer            BadgeProfile         `json:"issuer"`
+	ValidFrom         string               `json:"validFrom"`
+	CredentialSubject AchievementSubject   `json:"credentialSubject"`
+	CredentialStatus  *CredentialStatus    `json:"credentialStatus,omitempty"`
+	Evidence          []CredentialEvidence `json:"evidence,omitempty"`
+	Proof             *DataIntegrityProof  `json:"proof,omitempty"`
+}
+
+func GetAchievement(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	if len(args) != 2 {
+		return shim.Error("Incorrect number of arguments. Expecting 2")
+	}
+
+	AchievementType := args[0]
+	ID := args[1]
+
+	var achievement Achievement
+
+	if AchievementType == AchievementCourse {
+		course, err := getCourse(stub, "Course-"+ID)
+		if err != nil {
+			return shim.Error("Course does not exist - " + ID)
+		}
+
+		achievement, err = getCourseAchievement(stub, course)
+		if err != nil {
+			return shim.Error(err.Error())
+		}
+	} else if AchievementType == AchievementSubj {
+		subject, err := getSubject(stub, "Subject-"+ID)
+		if err != nil {
+			return shim.Error("Subject does not exist - " + ID)
+		}
+
+		achievement = getSubjectAchievement(subject)
+	} else {
+		return shim.Error("Achievement type must be Course or Subject!")
+	}
+
+	achievementAsBytes, err := canonicalJSON(achievement)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	return shim.Success(achievementAsBytes)
+}
+
+// GetOpenBadgeCredential returns the badge with the proof anchored by
+// AnchorOpenBadgeProof, left out when there is none or it no longer matches.
+func GetOpenBadgeCredential(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	if len(args) != 3 {
+		return shim.Error("Incorrect number of arguments. Expecting 3")
+	}
+
+	issuer, err := getCredentialIssuer(stub)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	badge, err := getOpenBadgeCredential(stub, issuer, args[0], args[1], args[2])
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	student, err := getStudent(stub, "Student-"+args[0])
+
+	if err != nil {
+		return shim.Error("Student does not exist - " + args[0])
+	}
+
+	err = checkGuestAccess(stub, student.Certificates, "GetOpenBadgeCredential")
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	badge.Proof = getAnchoredProof(stub, issuer, badge.ID, badge, badge.Context)
+
+	badgeAsBytes, err := canonicalJSON(badge)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	return shim.Success(badgeAsBytes)
+}
+
+func AnchorOpenBadgeProof(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	MSPID, err := cid.GetMSPID(stub)
+
+	if err != nil {
+		return shim.Error("Error - cid.GetMSPID()")
+	}
+
+	if MSPID != "AcademyMSP" {
+		return shim.Error("Permission Denied!")
+	}
+
+	if len(args) != 4 {
+		return shim.Error("Incorrect number of arguments. Expecting 4")
+	}
+
+	issuer, err := getCredentialIssuer(stub)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	badge, err := getOpenBadgeCredential(stub, issuer, args[0], args[1], args[2])
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	proofAsBytes, err := anchorProof(stub, issuer, badge.ID, badge, badge.Context, args[3])
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	return shim.Success(proofAsBytes)
+}
+
+func getOpenBadgeCredential(stub shim.ChaincodeStubInterface, issuer credentialIssuer, StudentUsername string, AchievementType string, ID string) (OpenBadgeCredential, error) {
+
+	var badge OpenBadgeCredential
+
+	student, err := getStudent(stub, "Student-"+StudentUsername)
+
+	if err != nil {
+		return badge, errors.New("Student does not exist - " + StudentUsername)
+	}
+
+	if AchievementType == AchievementCourse {
+		badge, err = getCourseBadge(stub, student, ID)
+	} else if AchievementType == AchievementSubj {
+		badge, err = getSubjectBadge(stub, student, ID)
+	} else {
+		return badge, errors.New("Achievement type must be Course or Subject!")
+	}
+
+	if err != nil {
+		return badge, err
+	}
+
+	badge.Context = []string{CredentialsContext, OpenBadgesContext}
+	badge.ID = CredentialIDPrefix + deriveUUID("OpenBadgeCredential", StudentUsername, AchievementType, ID)
+	badge.Type = []string{"VerifiableCredential", "OpenBadgeCredential"}
+	badge.Name = badge.CredentialSubject.Achievement.Name
+	badge.Issuer = BadgeProfile{ID: issuer.did, Type: []string{"Profile"}, Name: issuer.name}
+	badge.CredentialSubject.ID = StudentIDPrefix + student.Username
+	badge.CredentialSubject.Type = []string{"AchievementSubject"}
+	badge.CredentialSubject.Name = student.Fullname
+
+	return badge, nil
+}
+
+// getCourseBadge is only available once the course certificate was issued;
+// validity and revocation follow that certificate.
+func getCourseBadge(stub shim.ChaincodeStubInterface, student Student, CourseID string) (OpenBadgeCredential, error) {
+
+	var badge OpenBadgeCredential
+
+	course, err := getCourse(stub, "Course-"+CourseID)
+
+	if err != nil {
+		return badge, err
+	}
+
+	var certificate *Certificate
+
+	for _, certificateID := range student.Certificates {
+		cert, err := getCertificate(stub, "Certificate-"+certificateID)
+		if err != nil {
+			return badge, err
+		}
+
+		if cert.CourseID == CourseID && cert.Status != Superseded {
+			certificate = &cert
+			break
+		}
+	}
+
+	if certificate == nil {
+		return badge, errors.New("Student has no certificate of course - " + CourseID)
+	}
+
+	achievement, err := getCourseAchievement(stub, course)
+
+	if err != nil {
+		return badge, err
+	}
+
+	badge.CredentialSubject.Achievement = achievement
+
+	for _, SubjectID := range course.Subjects {
+		score, err := getScore(stub, "Score-"+" "+"Subject-"+SubjectID+" "+"Student-"+student.Username)
+		if err != nil {
+			continue
+		}
+
+		badge.CredentialSubject.Results = append(badge.CredentialSubject.Results, AchievementResult{
+			Type:              []string{"Result"},
+			ResultDescription: achievement.ID + "#" + SubjectID,
+			Value:             strconv.FormatFloat(score.ScoreValue, 'f', -1, 64),
+		})
+	}
+
+	badge.ValidFrom, err = toCredentialTime(certificate.IssueDate)
+
+	if err != nil {
+		return badge, errors.New("Invalid issue date of certificate - " + certificate.CertificateID)
+	}
+
+	status := getCertificateStatus(*certificate)
+	badge.CredentialStatus = &status
+
+	evidence, err := getIssuanceEvidence(stub, "Certificate-"+certificate.CertificateID)
+
+	if err != nil {
+		return badge, err
+	}
+
+	if evidence != nil {
+		badge.Evidence = []CredentialEvidence{*evidence}
+	}
+
+	return badge, nil
+}
+
+// getSubjectBadge is available for every subject the student passed, whether
+// or not the surrounding course was completed.
+func getSubjectBadge(stub shim.ChaincodeStubInterface, student Student, SubjectID string) (OpenBadgeCredential, error) {
+
+	var badge OpenBadgeCredential
+
+	subject, err := getSubject(stub, "Subject-"+SubjectID)
+
+	if err != nil {
+		return badge, err
+	}
+
+	keyScore := "Score-" + " " + "Subject-" + SubjectID + " " + "Student-" + student.Username
+	score, err := getScore(stub, keyScore)
+
+	if err != nil {
+		return badge, errors.New("Student has no score of subject - " + SubjectID)
+	}
+
+	if score.ScoreValue < PassScore {
+		return badge, errors.New("Student has not passed subject - " + SubjectID)
+	}
+
+	achievement := getSubjectAchievement(subject)
+
+	badge.CredentialSubject.Achievement = achievement
+	badge.CredentialSubject.Results = []AchievementResult{{
+		Type:              []string{"Result"},
+		ResultDescription: achievement.ID + "#score",
+		Value:             strconv.FormatFloat(score.ScoreValue, 'f', -1, 64),
+	}}
+
+	evidence, err := getIssuanceEvidence(stub, keyScore)
+
+	if err != nil {
+		return badge, err
+	}
+
+	if evidence != nil {
+		badge.ValidFrom = evidence.Timestamp
+		badge.Evidence = []CredentialEvidence{*evidence}
+	} else {
+		txTime, err := getTxTime(stub)
+		if err != nil {
+			return badge, err
+		}
+
+		badge.ValidFrom = txTime.Format(time.RFC3339)
+	}
+
+	return badge, nil
+}
+
+// A course maps to a Certificate achievement with one result per subject, a
+// subject maps to a Course achievement in Open Badges terms.
+func getCourseAchievement(stub shim.ChaincodeStubInterface, course Course) (Achievement, error) {
+
+	achievement := Achievement{
+		ID:              CourseIDPrefix + course.CourseID,
+		Type:            []string{"Achievement"},
+		AchievementType: "Certificate",
+		Name:            course.CourseName,
+		Description:     course.ShortDescription,
+		HumanCode:       course.CourseCode,
+		Criteria:        AchievementCriteria{Narrative: course.Description},
+	}
+
+	for _, SubjectID := range course.Subjects {
+		subject, err := getSubject(stub, "Subject-"+SubjectID)
+		if err != nil {
+			return achievement, err
+		}
+
+		achievement.ResultDescriptions = append(achievement.ResultDescriptions, ResultDescription{
+			ID:         achievement.ID + "#" + SubjectID,
+			Type:       []string{"ResultDescription"},
+			Name:       subject.SubjectName,
+			ResultType: "RawScore",
+		})
+	}
+
+	return achievement, nil
+}
+
+func getSubjectAchievement(subject Subject) Achievement {
+
+	achievement := Achievement{
+		ID:              SubjectIDPrefix + subject.SubjectID,
+		Type:            []string{"Achievement"},
+		AchievementType: "Course",
+		Name:            subject.SubjectName,
+		Description:     subject.ShortDescription,
+		HumanCode:       subject.SubjectCode,
+		Criteria:        AchievementCriteria{Narrative: subject.Description},
+	}
+
+	achievement.ResultDescriptions = []ResultDescription{{
+		ID:         achievement.ID + "#score",
+		Type:       []string{"ResultDescription"},
+		Name:       subject.SubjectName,
+		ResultType: "RawScore",
+	}}
+
+	return achievement
+}
+
+// deriveUUID builds a name-based UUID (RFC 4122 layout, SHA-256 digest) so
+// that identifiers minted by the chaincode are the same on every peer.
+func deriveUUID(parts ...string) string {
+
+	hash := sha256.New()
+
+	for _, part := range parts {
+		hash.Write([]byte(part))
+		hash.Write([]byte{0})
+	}
+
+	sum := hash.Sum(nil)[:16]
+	sum[6] = (sum[6] & 0x0f) | 0x50
+	sum[8] = (sum[8] & 0x3f) | 0x80
+
+	encoded := hex.EncodeToString(sum)
+
+	return encoded[0:8] + "-" + encoded[8:12] + "-" + encoded[12:16] + "-" + encoded[16:20] + "-" + encoded[20:32]
+}
@@ -0,0 +1,265 @@
+package main
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/hyperledger/fabric/core/chaincode/lib/cid"
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	sc "github.com/hyperledger/fabric/protos/peer"
+)
+
+const (
+	DefaultMaxWritesPerCall = 100
+	// certificate, student, verification code, score commitment and the
+	// private salt secret of the commitment
+	writesPerCertificate = 5
+	// progress record written at the end of every call
+	writesPerBatch = 1
+
+	Issued     = "Issued"
+	Skipped    = "Skipped"
+	Ineligible = "Ineligible"
+)
+
+type BatchIssuanceConfig struct {
+	MaxWritesPerCall uint64
+}
+
+type BatchIssuanceProgress struct {
+	CourseID  string
+	NextIndex int
+	Completed bool
+	UpdatedAt string
+	TxID      string
+}
+
+type BatchIssuanceResult struct {
+	StudentUsername string
+	Outcome         string
+	CertificateID   string
+	Reasons         []string
+}
+
+type BatchIssuanceReport struct {
+	CourseID   string
+	StartIndex int
+	NextIndex  int
+	Total      int
+	Completed  bool
+	Writes     uint64
+	Results    []BatchIssuanceResult
+}
+
+func SetBatchIssuanceLimit(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	MSPID, err := cid.GetMSPID(stub)
+
+	if err != nil {
+		return shim.Error("Error - cid.GetMSPID()")
+	}
+
+	if MSPID != "AcademyMSP" {
+		return shim.Error("Permission Denied!")
+	}
+
+	if len(args) != 1 {
+		return shim.Error("Incorrect number of arguments. Expecting 1")
+	}
+
+	MaxWritesPerCall, err := strconv.ParseUint(args[0], 10, 64)
+
+	if err != nil {
+		return shim.Error("Convert limit to integer failed")
+	}
+
+	if MaxWritesPerCall < writesPerCertificate+writesPerBatch {
+		return shim.Error("Limit must allow at least one certificate per call!")
+	}
+
+	configAsBytes, err := json.Marshal(BatchIssuanceConfig{MaxWritesPerCall: MaxWritesPerCall})
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	stub.PutState("Config-BatchIssuance", configAsBytes)
+
+	return shim.Success(nil)
+}
+
+// IssueCertificatesForCourse walks Course.Students in order and issues every
+// eligible student's certificate. When the write limit is reached it stores
+// the position it stopped at; calling it again for the same course resumes
+// from there. Pass "restart" as second argument to start over.
+func IssueCertificatesForCourse(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	MSPID, err := cid.GetMSPID(stub)
+
+	if err != nil {
+		return shim.Error("Error - cid.GetMSPID()")
+	}
+
+	if MSPID != "AcademyMSP" {
+		return shim.Error("Permission Denied!")
+	}
+
+	if len(args) != 1 && len(args) != 2 {
+		return shim.Error("Incorrect number of arguments. Expecting 1 or 2")
+	}
+
+	CourseID := args[0]
+
+	if len(args) == 2 && args[1] != "restart" {
+		return shim.Error("Second argument must be restart!")
+	}
+
+	course, err := getCourse(stub, "Course-"+CourseID)
+
+	if err != nil {
+		return shim.Error("Course does not exist!")
+	}
+
+	config, err := getBatchIssuanceConfig(stub)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	keyProgress := "BatchIssuance-" + CourseID
+	progress, err := getBatchIssuanceProgress(stub, keyProgress)
+
+	if err != nil || progress.Completed || len(args) == 2 {
+		progress = BatchIssuanceProgress{CourseID: CourseID}
+	}
+
+	txTime, err := getTxTime(stub)
+
+	if err != nil {
+		return shim.Error("Can not get transaction timestamp!")
+	}
+
+	report := BatchIssuanceReport{
+		CourseID:   CourseID,
+		StartIndex: progress.NextIndex,
+		Total:      len(course.Students),
+		Writes:     writesPerBatch,
+	}
+
+	IssueDate := txTime.Format("2006-01-02")
+	reserved := make(map[string]bool)
+
+	var i int
+	for i = progress.NextIndex; i < len(course.Students); i++ {
+		StudentUsername := course.Students[i]
+		result := BatchIssuanceResult{StudentUsername: StudentUsername}
+
+		student, err := getStudent(stub, "Student-"+StudentUsername)
+		if err != nil {
+			result.Outcome = Skipped
+			result.Reasons = []string{"Student does not exist"}
+			report.Results = append(report.Results, result)
+			continue
+		}
+
+		hasCertificate, err := hasCertificateOfCourse(stub, student, CourseID)
+		if err != nil {
+			return shim.Error(err.Error())
+		}
+
+		if hasCertificate {
+			result.Outcome = Skipped
+			result.Reasons = []string{"Certificate already exists"}
+			report.Results = append(report.Results, result)
+			continue
+		}
+
+		eligibility, err := evaluateIssuancePolicy(stub, course, StudentUsername, txTime)
+		if err != nil {
+			return shim.Error(err.Error())
+		}
+
+		if !eligibility.Eligible {
+			result.Outcome = Ineligible
+			result.Reasons = eligibility.FailedConditions
+			report.Results = append(report.Results, result)
+			continue
+		}
+
+		if report.Writes+writesPerCertificate > config.MaxWritesPerCall {
+			break
+		}
+
+		CertificateID := deriveUUID("Certificate", stub.GetTxID(), StudentUsername)
+
+		_, err = issueCertificate(stub, course, student, CertificateID, IssueDate, eligibility, reserved)
+		if err != nil {
+			return shim.Error(err.Error())
+		}
+
+		report.Writes += writesPerCertificate
+		result.Outcome = Issued
+		result.CertificateID = CertificateID
+		report.Results = append(report.Results, result)
+	}
+
+	report.NextIndex = i
+	report.Completed = i >= len(course.Students)
+
+	progress.NextIndex = report.NextIndex
+	progress.Completed = report.Completed
+	progress.UpdatedAt = txTime.Format(time.RFC3339)
+	progress.TxID = stub.GetTxID()
+
+	progressAsBytes, err := json.Marshal(progress)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	stub.PutState(keyProgress, progressAsBytes)
+
+	reportAsBytes, err := json.Marshal(report)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	return shim.Success(reportAsBytes)
+}
+
+func getBatchIssuanceConfig(stub shim.ChaincodeStubInterface) (BatchIssuanceConfig, error) {
+
+	config := BatchIssuanceConfig{MaxWritesPerCall: DefaultMaxWritesPerCall}
+
+	configAsBytes, err := stub.GetState("Config-BatchIssuance")
+
+	if err != nil {
+		return config, err
+	}
+
+	if configAsBytes != nil {
+		json.Unmarshal(configAsBytes, &config)
+	}
+
+	return config, nil
+}
+
+// hasCertificateOfCourse counts every version of a certificate, revoked ones
+// included, so a batch never issues a second certificate behind an admin's back.
+func hasCertificateOfCourse(stub shim.ChaincodeStubInterface, student Student, CourseID string) (bool, error) {
+
+	for _, CertificateID := range student.Certificates {
+		certificate, err := getCertificate(stub, "Certificate-"+CertificateID)
+		if err != nil {
+			return false, err
+		}
+
+		if certificate.CourseID == CourseID {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestIssueCertificatesForCourse(test *testing.T) {
+	stub := newTestStub(test)
+	seedAcademy(stub)
+	issueTestCertificate(stub, "st1", "cert1")
+
+	stub.as("AcademyMSP", "adminacademy")
+	stub.mustInvoke("CreateStudent", "st2", "Student Two")
+	stub.mustInvoke("CreateStudent", "st3", "Student Three")
+
+	for _, StudentUsername := range []string{"st2", "st3"} {
+		stub.as("StudentMSP", StudentUsername).mustInvoke("StudentRegisterCourse", StudentUsername, "C1")
+		stub.putState("Score-"+" "+"Subject-S1"+" "+"Student-"+StudentUsername, Score{"S1", StudentUsername, 8})
+		stub.putState("Score-"+" "+"Subject-S2"+" "+"Student-"+StudentUsername, Score{"S2", StudentUsername, 8})
+	}
+
+	stub.Transient = map[string][]byte{TransientSaltSecrets: []byte(testSaltSecrets)}
+
+	stub.as("StudentMSP", "st2").mustFail("IssueCertificatesForCourse", "C1")
+
+	// mot chung chi can 5 lan ghi, ke ca commitment va secret cua no
+	stub.as("AcademyMSP", "adminacademy")
+	stub.mustFail("SetBatchIssuanceLimit", "5")
+	stub.mustInvoke("SetBatchIssuanceLimit", "6")
+
+	var report BatchIssuanceReport
+	writes := stub.Writes
+	json.Unmarshal(stub.mustInvoke("IssueCertificatesForCourse", "C1"), &report)
+
+	if uint64(stub.Writes-writes) != report.Writes {
+		test.Fatalf("Report counted %d writes, the call made %d", report.Writes, stub.Writes-writes)
+	}
+
+	if report.Completed || report.NextIndex != 2 || report.Results[0].Outcome != Skipped || report.Results[1].Outcome != Issued {
+		test.Fatalf("Unexpected first batch %+v", report)
+	}
+
+	json.Unmarshal(stub.mustInvoke("IssueCertificatesForCourse", "C1"), &report)
+
+	if !report.Completed || len(report.Results) != 1 || report.Results[0].Outcome != Issued {
+		test.Fatalf("Unexpected second batch %+v", report)
+	}
+
+	var student Student
+	stub.getState("Student-st3", &student)
+
+	if len(student.Certificates) != 1 {
+		test.Fatal("Certificate of st3 was not issued")
+	}
+}
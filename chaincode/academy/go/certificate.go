@@ -3,9 +3,11 @@ package main
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hyperledger/fabric/core/chaincode/lib/cid"
@@ -23,6 +25,9 @@ const (
 	Closed     Status = "Closed"
 	InProgress Status = "InProgress"
 	Completed  Status = "Completed"
+	Valid      Status = "Valid"
+	Revoked    Status = "Revoked"
+	Superseded Status = "Superseded"
 )
 
 type Course struct {
@@ -32,8 +37,13 @@ type Course struct {
 	ShortDescription string
 	Description      string
 	Subjects         []string
+	Electives        []string
+	Prerequisites    []string
 	Students         []string
 	Status           Status
+	EnrollmentWindow *EnrollmentWindow
+	IssuancePolicy   IssuancePolicy
+	HonoursBands     []HonoursBand
 }
 
 type Subject struct {
@@ -43,37 +53,41 @@ type Subject struct {
 	ShortDescription string
 	Description      string
 	Classes          []string
+	Prerequisites    *PrerequisiteRule
 }
 
 type Class struct {
 	ClassID         string
 	SubjectID       string
+	TermID          string
 	ClassCode       string
 	Room            string
-	Time            string
+	Schedule        Schedule
 	Status          Status
-	StartDate       string
-	EndDate         string
-	Repeat          string
 	Students        []string
+	Waitlist        []string
 	Capacity        uint64
 	TeacherUsername string
+	Staff           []ClassStaff
 }
 
 type Teacher struct {
-	Username string
-	Fullname string
-	Info     Information
-	Classes  []string
+	Username       string
+	Fullname       string
+	Info           Information
+	Classes        []string
+	Qualifications []Qualification
 }
 
 type Student struct {
-	Username     string
-	Fullname     string
-	Info         Information
-	Courses      []string
-	Classes      []string
-	Certificates []string
+	Username         string
+	Fullname         string
+	Info             Information
+	Courses          []string
+	Classes          []string
+	Certificates     []string
+	MicroCredentials []string
+	Exemptions       []string
 }
 
 type Information struct {
@@ -93,10 +107,22 @@ type Score struct {
 }
 
 type Certificate struct {
-	CertificateID   string
-	CourseID        string
-	StudentUsername string
-	IssueDate       string
+	CertificateID    string
+	CourseID         string
+	StudentUsername  string
+	StudentFullname  string
+	IssueDate        string
+	Status           Status
+	RevokedDate      string
+	RevokeReason     string
+	VerificationCode string
+	AverageScore     float64
+	HonoursLevel     string
+	Supersedes       string
+	SupersededBy     string
+	ReissueReason    string
+	MicroCredentials []string
+	ExemptSubjects   []string
 }
 
 func (s *SmartContract) Init(stub shim.ChaincodeStubInterface) sc.Response {
@@ -115,6 +141,10 @@ func (s *SmartContract) Invoke(stub shim.ChaincodeStubInterface) sc.Response {
 
 	function, args := stub.GetFunctionAndParameters()
 
+	if err := checkGuestFunction(stub, function); err != nil {
+		return shim.Error(err.Error())
+	}
+
 	if function == "CreateStudent" {
 		return CreateStudent(stub, args)
 	} else if function == "CreateSubject" {
@@ -197,6 +227,10 @@ func (s *SmartContract) Invoke(stub shim.ChaincodeStubInterface) sc.Response {
 		return DeleteSubject(stub, args)
 	} else if function == "StartClass" {
 		return StartClass(stub, args)
+	} else if function == "AdvanceLifecycle" {
+		return AdvanceLifecycle(stub, args)
+	} else if function == "SetCourseEnrollmentWindow" {
+		return SetCourseEnrollmentWindow(stub, args)
 	} else if function == "GetClass" {
 		return GetClass(stub, args)
 	} else if function == "GetClassesOfStudent" {
@@ -215,6 +249,170 @@ func (s *SmartContract) Invoke(stub shim.ChaincodeStubInterface) sc.Response {
 		return GetHistoryOfCertificate(stub, args)
 	} else if function == "GetSubjectsNotInCourse" {
 		return GetSubjectsNotInCourse(stub, args)
+	} else if function == "RevokeCertificate" {
+		return RevokeCertificate(stub, args)
+	} else if function == "GetCertificateAsVerifiableCredential" {
+		return GetCertificateAsVerifiableCredential(stub, args)
+	} else if function == "SetCredentialIssuer" {
+		return SetCredentialIssuer(stub, args)
+	} else if function == "GetCredentialIssuer" {
+		return GetCredentialIssuer(stub, args)
+	} else if function == "AnchorCertificateProof" {
+		return AnchorCertificateProof(stub, args)
+	} else if function == "GetAchievement" {
+		return GetAchievement(stub, args)
+	} else if function == "GetOpenBadgeCredential" {
+		return GetOpenBadgeCredential(stub, args)
+	} else if function == "AnchorOpenBadgeProof" {
+		return AnchorOpenBadgeProof(stub, args)
+	} else if function == "IssueTranscriptCommitment" {
+		return IssueTranscriptCommitment(stub, args)
+	} else if function == "GetScoreCommitment" {
+		return GetScoreCommitment(stub, args)
+	} else if function == "GetDisclosureProof" {
+		return GetDisclosureProof(stub, args)
+	} else if function == "VerifyDisclosedScores" {
+		return VerifyDisclosedScores(stub, args)
+	} else if function == "GrantCertificateAccess" {
+		return GrantCertificateAccess(stub, args)
+	} else if function == "RevokeCertificateAccess" {
+		return RevokeCertificateAccess(stub, args)
+	} else if function == "GetCertificateGrants" {
+		return GetCertificateGrants(stub, args)
+	} else if function == "GetCertificateAccessLog" {
+		return GetCertificateAccessLog(stub, args)
+	} else if function == "GetCertificateByVerificationCode" {
+		return GetCertificateByVerificationCode(stub, args)
+	} else if function == "SetCourseIssuancePolicy" {
+		return SetCourseIssuancePolicy(stub, args)
+	} else if function == "AddElectiveToCourse" {
+		return AddElectiveToCourse(stub, args)
+	} else if function == "RemoveElectiveFromCourse" {
+		return RemoveElectiveFromCourse(stub, args)
+	} else if function == "CheckCertificateEligibility" {
+		return CheckCertificateEligibility(stub, args)
+	} else if function == "SetCourseHonoursBands" {
+		return SetCourseHonoursBands(stub, args)
+	} else if function == "GetCertificatesByHonoursLevel" {
+		return GetCertificatesByHonoursLevel(stub, args)
+	} else if function == "ReissueCertificate" {
+		return ReissueCertificate(stub, args)
+	} else if function == "VerifyCertificate" {
+		return VerifyCertificate(stub, args)
+	} else if function == "SetBatchIssuanceLimit" {
+		return SetBatchIssuanceLimit(stub, args)
+	} else if function == "IssueCertificatesForCourse" {
+		return IssueCertificatesForCourse(stub, args)
+	} else if function == "CompleteClass" {
+		return CompleteClass(stub, args)
+	} else if function == "IssueMicroCredential" {
+		return IssueMicroCredential(stub, args)
+	} else if function == "GetMicroCredential" {
+		return GetMicroCredential(stub, args)
+	} else if function == "GetMicroCredentialsOfStudent" {
+		return GetMicroCredentialsOfStudent(stub, args)
+	} else if function == "VerifyMicroCredential" {
+		return VerifyMicroCredential(stub, args)
+	} else if function == "RevokeMicroCredential" {
+		return RevokeMicroCredential(stub, args)
+	} else if function == "GrantMicroCredentialAccess" {
+		return GrantMicroCredentialAccess(stub, args)
+	} else if function == "RevokeMicroCredentialAccess" {
+		return RevokeMicroCredentialAccess(stub, args)
+	} else if function == "GetMicroCredentialGrants" {
+		return GetMicroCredentialGrants(stub, args)
+	} else if function == "GetMicroCredentialAccessLog" {
+		return GetMicroCredentialAccessLog(stub, args)
+	} else if function == "SetSubjectPrerequisites" {
+		return SetSubjectPrerequisites(stub, args)
+	} else if function == "AddPrerequisiteToCourse" {
+		return AddPrerequisiteToCourse(stub, args)
+	} else if function == "RemovePrerequisiteFromCourse" {
+		return RemovePrerequisiteFromCourse(stub, args)
+	} else if function == "SetRetakePolicy" {
+		return SetRetakePolicy(stub, args)
+	} else if function == "GetRetakePolicy" {
+		return GetRetakePolicy(stub, args)
+	} else if function == "SetGradingWindow" {
+		return SetGradingWindow(stub, args)
+	} else if function == "GetGradingWindow" {
+		return GetGradingWindow(stub, args)
+	} else if function == "OverrideScore" {
+		return OverrideScore(stub, args)
+	} else if function == "RecordAttendance" {
+		return RecordAttendance(stub, args)
+	} else if function == "GetClassSessions" {
+		return GetClassSessions(stub, args)
+	} else if function == "GetAttendanceOfClass" {
+		return GetAttendanceOfClass(stub, args)
+	} else if function == "GetAttendanceOfStudent" {
+		return GetAttendanceOfStudent(stub, args)
+	} else if function == "CreateAssessment" {
+		return CreateAssessment(stub, args)
+	} else if function == "SubmitAssessment" {
+		return SubmitAssessment(stub, args)
+	} else if function == "GradeSubmission" {
+		return GradeSubmission(stub, args)
+	} else if function == "GetAssessmentsOfClass" {
+		return GetAssessmentsOfClass(stub, args)
+	} else if function == "GetSubmissionsOfAssessment" {
+		return GetSubmissionsOfAssessment(stub, args)
+	} else if function == "GetSubmission" {
+		return GetSubmission(stub, args)
+	} else if function == "GrantTeacherQualification" {
+		return GrantTeacherQualification(stub, args)
+	} else if function == "RevokeTeacherQualification" {
+		return RevokeTeacherQualification(stub, args)
+	} else if function == "GetEligibleTeachersOfClass" {
+		return GetEligibleTeachersOfClass(stub, args)
+	} else if function == "AddClassStaff" {
+		return AddClassStaff(stub, args)
+	} else if function == "RemoveClassStaff" {
+		return RemoveClassStaff(stub, args)
+	} else if function == "GetStaffPermissions" {
+		return GetStaffPermissions(stub, args)
+	} else if function == "GetScoreOverridesOfClass" {
+		return GetScoreOverridesOfClass(stub, args)
+	} else if function == "GetTranscript" {
+		return GetTranscript(stub, args)
+	} else if function == "ExemptSubject" {
+		return ExemptSubject(stub, args)
+	} else if function == "GetExemption" {
+		return GetExemption(stub, args)
+	} else if function == "GetWaitlistPosition" {
+		return GetWaitlistPosition(stub, args)
+	} else if function == "LeaveClassWaitlist" {
+		return LeaveClassWaitlist(stub, args)
+	} else if function == "CreateRoom" {
+		return CreateRoom(stub, args)
+	} else if function == "UpdateRoomInfo" {
+		return UpdateRoomInfo(stub, args)
+	} else if function == "DeleteRoom" {
+		return DeleteRoom(stub, args)
+	} else if function == "GetRoom" {
+		return GetRoom(stub, args)
+	} else if function == "GetAllRooms" {
+		return GetAllRooms(stub)
+	} else if function == "GetRoomSchedule" {
+		return GetRoomSchedule(stub, args)
+	} else if function == "GetTimetableICS" {
+		return GetTimetableICS(stub, args)
+	} else if function == "CreateAcademicTerm" {
+		return CreateAcademicTerm(stub, args)
+	} else if function == "UpdateAcademicTerm" {
+		return UpdateAcademicTerm(stub, args)
+	} else if function == "SetAcademicTermStatus" {
+		return SetAcademicTermStatus(stub, args)
+	} else if function == "GetAcademicTerm" {
+		return GetAcademicTerm(stub, args)
+	} else if function == "GetAllAcademicTerms" {
+		return GetAllAcademicTerms(stub)
+	} else if function == "GetClassesOfTerm" {
+		return GetClassesOfTerm(stub, args)
+	} else if function == "GetEnrollmentsOfTerm" {
+		return GetEnrollmentsOfTerm(stub, args)
+	} else if function == "GetResultsOfTerm" {
+		return GetResultsOfTerm(stub, args)
 	}
 
 	return shim.Error("Invalid Smart Contract function name!")
@@ -267,18 +465,39 @@ func StudentRegisterClass(stub shim.ChaincodeStubInterface, args []string) sc.Re
 		if ClassID == student.Classes[i] {
 			return shim.Error("You registered this class!")
 		}
+	}
 
-		classInfo, _ := getClass(stub, "Class-"+student.Classes[i])
-		if classInfo.SubjectID == class.SubjectID {
-			return shim.Error("You studied this subject!")
-		}
+	err = checkTermPhase(stub, class, TermRegistration)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	err = checkClassRegistration(stub, student, class)
+
+	if err != nil {
+		return shim.Error(err.Error())
 	}
 
 	if uint64(len(class.Students)) >= class.Capacity {
-		return shim.Error("This class is full!")
+		if containsString(class.Waitlist, Student) {
+			return shim.Error("You are on the waitlist of this class!")
+		}
+
+		// lop da day, xep sinh vien vao danh sach cho
+		class.Waitlist = append(class.Waitlist, Student)
+
+		classAsBytes, _ := json.Marshal(class)
+
+		stub.PutState(keyClass, classAsBytes)
+
+		positionAsBytes, _ := json.Marshal(getWaitlistPosition(class, Student))
+
+		return shim.Success(positionAsBytes)
 	}
 
 	class.Students = append(class.Students, Student)
+	class.Waitlist = removeString(class.Waitlist, Student)
 	student.Classes = append(student.Classes, ClassID)
 
 	classAsBytes, _ := json.Marshal(class)
@@ -290,6 +509,46 @@ func StudentRegisterClass(stub shim.ChaincodeStubInterface, args []string) sc.Re
 	return shim.Success(nil)
 }
 
+// checkClassRegistration holds the rules a student must meet to take a seat
+// in a class, both when registering and when promoted from the waitlist.
+func checkClassRegistration(stub shim.ChaincodeStubInterface, student Student, class Class) error {
+
+	if containsString(student.Exemptions, class.SubjectID) {
+		return errors.New("You are exempt from this subject!")
+	}
+
+	err := checkRetake(stub, student, class.SubjectID)
+
+	if err != nil {
+		return err
+	}
+
+	err = checkScheduleConflicts(stub, student.Classes, class)
+
+	if err != nil {
+		return err
+	}
+
+	subject, err := getSubject(stub, "Subject-"+class.SubjectID)
+
+	if err != nil {
+		return errors.New("Subject does not exist!")
+	}
+
+	if subject.Prerequisites != nil {
+		unmet, err := evaluatePrerequisiteRule(stub, *subject.Prerequisites, student.Username)
+		if err != nil {
+			return err
+		}
+
+		if len(unmet) > 0 {
+			return errors.New("Prerequisites not met: " + strings.Join(unmet, "; "))
+		}
+	}
+
+	return nil
+}
+
 func StudentCancelRegisterClass(stub shim.ChaincodeStubInterface, args []string) sc.Response {
 
 	MSPID, err := cid.GetMSPID(stub)
@@ -357,6 +616,11 @@ func StudentCancelRegisterClass(stub shim.ChaincodeStubInterface, args []string)
 	student.Classes[lenClasses-1] = ""
 	student.Classes = student.Classes[:lenClasses-1]
 
+	_, err = promoteFromWaitlist(stub, &class)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
 	classAsBytes, err := json.Marshal(class)
 	if err != nil {
 		return shim.Error("Can not convert data to bytes!")
@@ -409,6 +673,16 @@ func StudentRegisterCourse(stub shim.ChaincodeStubInterface, args []string) sc.R
 		return shim.Error("This course was closed!")
 	}
 
+	txTime, err := getTxTime(stub)
+	if err != nil {
+		return shim.Error("Can not get transaction timestamp!")
+	}
+
+	err = checkEnrollmentWindow(course, txTime)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
 	var i int
 	for i = 0; i < len(student.Courses); i++ {
 		if CourseID == student.Courses[i] {
@@ -416,6 +690,15 @@ func StudentRegisterCourse(stub shim.ChaincodeStubInterface, args []string) sc.R
 		}
 	}
 
+	missing, err := getMissingCoursePrerequisites(stub, course, student)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	if len(missing) > 0 {
+		return shim.Error("Missing certificates of prerequisite courses: " + strings.Join(missing, ", "))
+	}
+
 	student.Courses = append(student.Courses, CourseID)
 	course.Students = append(course.Students, Username)
 
@@ -429,8 +712,16 @@ func StudentRegisterCourse(stub shim.ChaincodeStubInterface, args []string) sc.R
 		return shim.Error("Can not convert data to bytes")
 	}
 
+	enrollment := Enrollment{CourseID: CourseID, StudentUsername: Username, EnrolledAt: txTime.Format(time.RFC3339)}
+
+	enrollmentAsBytes, err := json.Marshal(enrollment)
+	if err != nil {
+		return shim.Error("Can not convert data to bytes")
+	}
+
 	stub.PutState(keyStudent, studentAsBytes)
 	stub.PutState(keyCourse, courseAsBytes)
+	stub.PutState("Enrollment-"+" "+"Course-"+CourseID+" "+"Student-"+Username, enrollmentAsBytes)
 
 	return shim.Success(nil)
 }
@@ -530,6 +821,24 @@ func AssignTeacherToClass(stub shim.ChaincodeStubInterface, args []string) sc.Re
 		return shim.Error("This class was started!")
 	}
 
+	txTime, err := getTxTime(stub)
+
+	if err != nil {
+		return shim.Error("Can not get transaction timestamp!")
+	}
+
+	err = checkQualification(user, class, txTime)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	err = checkScheduleConflicts(stub, user.Classes, class)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
 	user.Classes = append(user.Classes, ClassID)
 	class.TeacherUsername = Username
 
@@ -754,11 +1063,46 @@ func DeleteClass(stub shim.ChaincodeStubInterface, args []string) sc.Response {
 		stub.PutState(keyTeacher, teacherAsBytes)
 	}
 
+	for _, member := range class.Staff {
+		err = removeClassOfTeacher(stub, member.Username, ClassID)
+		if err != nil {
+			return shim.Error(err.Error())
+		}
+	}
+
 	subjectAsBytes, err := json.Marshal(subject)
 	if err != nil {
 		return shim.Error("Can not convert data to bytes!")
 	}
 
+	err = releaseRoom(stub, class.Room, ClassID)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	err = deleteClassSessions(stub, ClassID)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	err = deleteAssessmentsOfClass(stub, ClassID)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	if class.TermID != "" {
+		keyTerm := "Term-" + class.TermID
+		term, err := getAcademicTerm(stub, keyTerm)
+		if err == nil {
+			term.Classes = removeString(term.Classes, ClassID)
+			termAsBytes, err := json.Marshal(term)
+			if err != nil {
+				return shim.Error("Can not convert data to bytes!")
+			}
+			stub.PutState(keyTerm, termAsBytes)
+		}
+	}
+
 	stub.PutState(keySubject, subjectAsBytes)
 	stub.DelState(keyClass)
 
@@ -823,21 +1167,27 @@ func UpdateClassInfo(stub shim.ChaincodeStubInterface, args []string) sc.Respons
 		return shim.Error("Permission Denied!")
 	}
 
-	if len(args) != 8 {
-		return shim.Error("Incorrect number of arguments. Expecting 8")
+	if len(args) != 5 {
+		return shim.Error("Incorrect number of arguments. Expecting 5")
 	}
 
 	ClassID := args[0]
 	ClassCode := args[1]
 	Room := args[2]
-	Time := args[3]
-	StartDate := args[4]
-	EndDate := args[5]
-	Repeat := args[6]
-	Capacity := args[7]
+	Capacity := args[4]
+
+	schedule, err := parseSchedule(args[3])
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
 
 	CapacityInt, err := strconv.ParseUint(Capacity, 10, 64)
 
+	if err != nil {
+		return shim.Error("Convert Capacity To Integer Failed")
+	}
+
 	keyClass := "Class-" + ClassID
 	class, err := getClass(stub, keyClass)
 
@@ -846,19 +1196,83 @@ func UpdateClassInfo(stub shim.ChaincodeStubInterface, args []string) sc.Respons
 		return shim.Error("Class does not exist !")
 	}
 
+	PreviousRoom := class.Room
+
 	class.ClassCode = ClassCode
 
 	class.Room = Room
 
-	class.Time = Time
+	class.Schedule = schedule
 
-	class.StartDate = StartDate
+	class.Capacity = CapacityInt
 
-	class.EndDate = EndDate
+	if class.TermID != "" {
+		term, err := getAcademicTerm(stub, "Term-"+class.TermID)
+		if err != nil {
+			return shim.Error("Term does not exist - " + class.TermID)
+		}
 
-	class.Repeat = Repeat
+		err = checkScheduleInTerm(term, schedule)
+		if err != nil {
+			return shim.Error(err.Error())
+		}
+	}
 
-	class.Capacity = CapacityInt
+	if PreviousRoom != Room {
+		err = releaseRoom(stub, PreviousRoom, ClassID)
+		if err != nil {
+			return shim.Error(err.Error())
+		}
+	}
+
+	err = bookRoom(stub, Room, class)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	// lich moi khong duoc trung voi lich cua giao vien va sinh vien trong lop
+	teachers := []string{}
+	if class.TeacherUsername != "" {
+		teachers = append(teachers, class.TeacherUsername)
+	}
+	for _, member := range class.Staff {
+		teachers = append(teachers, member.Username)
+	}
+
+	for _, Username := range teachers {
+		teacher, err := getTeacher(stub, "Teacher-"+Username)
+		if err == nil {
+			err = checkScheduleConflicts(stub, teacher.Classes, class)
+			if err != nil {
+				return shim.Error("Teacher " + Username + ": " + err.Error())
+			}
+		}
+	}
+
+	for _, Username := range class.Students {
+		student, err := getStudent(stub, "Student-"+Username)
+		if err != nil {
+			continue
+		}
+
+		err = checkScheduleConflicts(stub, student.Classes, class)
+		if err != nil {
+			return shim.Error("Student " + Username + ": " + err.Error())
+		}
+	}
+
+	_, err = promoteFromWaitlist(stub, &class)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	err = syncClassSessions(stub, class)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
 
 	classAsBytes, _ := json.Marshal(class)
 
@@ -1157,6 +1571,17 @@ func OpenCourse(stub shim.ChaincodeStubInterface, args []string) sc.Response {
 		return shim.Error("This course is open!")
 	}
 
+	txTime, err := getTxTime(stub)
+
+	if err != nil {
+		return shim.Error("Can not get transaction timestamp!")
+	}
+
+	// mo lai khoa hoc da het han dang ky thi lan chay sau se dong lai
+	if enrollmentEnded(course, txTime) {
+		return shim.Error("Enrollment window of this course has ended!")
+	}
+
 	course.Status = Open
 
 	courseAsBytes, err := json.Marshal(course)
@@ -1199,6 +1624,16 @@ func DeleteSubject(stub shim.ChaincodeStubInterface, args []string) sc.Response
 		return shim.Error("Can not delete subject - " + SubjectID)
 	}
 
+	dependents, err := getDependentSubjects(stub, SubjectID)
+
+	if err != nil {
+		return shim.Error("Failed to get data in the ledger")
+	}
+
+	if len(dependents) > 0 {
+		return shim.Error("Subject is a prerequisite of " + strings.Join(dependents, ", "))
+	}
+
 	stub.DelState(keySubject)
 
 	return shim.Success(nil)
@@ -1232,6 +1667,12 @@ func StartClass(stub shim.ChaincodeStubInterface, args []string) sc.Response {
 		return shim.Error("Can not close register!")
 	}
 
+	err = checkTermPhase(stub, class, TermTeaching)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
 	class.Status = InProgress
 
 	classAsBytes, _ := json.Marshal(class)
@@ -1241,6 +1682,42 @@ func StartClass(stub shim.ChaincodeStubInterface, args []string) sc.Response {
 	return shim.Success(nil)
 }
 
+func CompleteClass(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+	MSPID, err := cid.GetMSPID(stub)
+
+	if err != nil {
+		return shim.Error("Error - cid.GetMSPID()!")
+	}
+
+	if MSPID != "AcademyMSP" {
+		return shim.Error("Permission Denied!")
+	}
+
+	if len(args) != 1 {
+		return shim.Error("Incorrect number of arguments. Expecting 1!")
+	}
+
+	ClassID := args[0]
+	keyClass := "Class-" + ClassID
+
+	class, err := getClass(stub, keyClass)
+	if err != nil {
+		return shim.Error("This class does not exist!")
+	}
+
+	if class.Status != InProgress {
+		return shim.Error("This class is not in progress!")
+	}
+
+	class.Status = Completed
+
+	classAsBytes, _ := json.Marshal(class)
+
+	stub.PutState(keyClass, classAsBytes)
+
+	return shim.Success(nil)
+}
+
 func GetSubject(stub shim.ChaincodeStubInterface, args []string) sc.Response {
 
 	var SubjectID string
@@ -1272,6 +1749,24 @@ func GetSubject(stub shim.ChaincodeStubInterface, args []string) sc.Response {
 		return shim.Error("Subject does not exist - " + args[0])
 	}
 
+	var subject Subject
+	json.Unmarshal(subjectAsBytes, &subject)
+
+	graph, err := getPrerequisiteGraph(stub, subject)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	// bo chinh mon hoc ra khoi do thi, chi giu cac mon tien quyet
+	delete(graph, SubjectID)
+
+	subjectAsBytes, err = json.Marshal(SubjectPrerequisiteGraph{Subject: subject, PrerequisiteGraph: graph})
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
 	return shim.Success(subjectAsBytes)
 }
 
@@ -1328,6 +1823,25 @@ func GetCourse(stub shim.ChaincodeStubInterface, args []string) sc.Response {
 		return shim.Error("Course does not exist - " + args[0])
 	}
 
+	_, isGuest, err := getGuestVerifier(stub)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	// khach khong duoc xem danh sach hoc vien
+	if isGuest {
+		course := Course{}
+		json.Unmarshal(courseAsBytes, &course)
+		course.Students = nil
+
+		courseAsBytes, err = json.Marshal(course)
+
+		if err != nil {
+			return shim.Error("Can not convert data to bytes!")
+		}
+	}
+
 	return shim.Success(courseAsBytes)
 }
 
@@ -1349,6 +1863,12 @@ func GetCertificate(stub shim.ChaincodeStubInterface, args []string) sc.Response
 		return shim.Error("Certificate does not exist - " + args[0])
 	}
 
+	err = checkGuestAccess(stub, []string{CertificateID}, "GetCertificate")
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
 	return shim.Success(certificateAsBytes)
 }
 
@@ -1445,11 +1965,22 @@ func GetCertificatesOfStudent(stub shim.ChaincodeStubInterface, args []string) s
 		return shim.Error("Student dose not exist - " + StudentUsername)
 	}
 
+	_, isGuest, err := getGuestVerifier(stub)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
 	var tlist []Certificate
 	var i int
 
 	for i = 0; i < len(student.Certificates); i++ {
 
+		// khach chi thay cac chung chi duoc cap quyen
+		if isGuest && checkGuestAccess(stub, []string{student.Certificates[i]}, "GetCertificatesOfStudent") != nil {
+			continue
+		}
+
 		certificate, err := getCertificate(stub, "Certificate-"+student.Certificates[i])
 		if err != nil {
 			return shim.Error("Certificate does not exist - " + student.Certificates[i])
@@ -1457,6 +1988,10 @@ func GetCertificatesOfStudent(stub shim.ChaincodeStubInterface, args []string) s
 		tlist = append(tlist, certificate)
 	}
 
+	if isGuest && len(tlist) == 0 {
+		return shim.Error("Access denied - no active grant on certificates of " + StudentUsername)
+	}
+
 	jsonRow, err := json.Marshal(tlist)
 
 	if err != nil {
@@ -1497,6 +2032,15 @@ func GetStudent(stub shim.ChaincodeStubInterface, args []string) sc.Response {
 
 	} else {
 
+		student := Student{}
+		json.Unmarshal(studentAsBytes, &student)
+
+		err = checkGuestAccess(stub, student.Certificates, "GetStudent")
+
+		if err != nil {
+			return shim.Error(err.Error())
+		}
+
 		// infoAsBytes, err := stub.GetState("Info- Student- " + Username)
 
 		// if err != nil {
@@ -2044,6 +2588,21 @@ func GetScoresOfStudent(stub shim.ChaincodeStubInterface, args []string) sc.Resp
 		return shim.Error("Course dose not exist - " + CourseID)
 	}
 
+	var certificateIDs []string
+
+	for _, CertificateID := range student.Certificates {
+		certificate, err := getCertificate(stub, "Certificate-"+CertificateID)
+		if err == nil && certificate.CourseID == CourseID {
+			certificateIDs = append(certificateIDs, CertificateID)
+		}
+	}
+
+	err = checkGuestAccess(stub, certificateIDs, "GetScoresOfStudent")
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
 	var tlist []Score
 	var i int
 
@@ -2140,7 +2699,7 @@ func GetClassesByTeacher(stub shim.ChaincodeStubInterface, args []string) sc.Res
 
 		class := Class{}
 		json.Unmarshal(record.Value, &class)
-		if class.TeacherUsername == TeacherUsername {
+		if staffRole(class, TeacherUsername) != "" {
 			tlist = append(tlist, class)
 		}
 	}
@@ -2162,6 +2721,12 @@ func GetHistoryOfCertificate(stub shim.ChaincodeStubInterface, args []string) sc
 
 	CertificateID := args[0]
 
+	err := checkGuestAccess(stub, []string{CertificateID}, "GetHistoryOfCertificate")
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
 	keyCertificate := "Certificate-" + CertificateID
 	resultsIterator, err := stub.GetHistoryForKey(keyCertificate)
 	if err != nil {
@@ -0,0 +1,710 @@
+package main
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/hyperledger/fabric/core/chaincode/lib/cid"
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	sc "github.com/hyperledger/fabric/protos/peer"
+)
+
+// Credentials are signed off-chain with the academy issuer key. Peers only
+// know its public key, registered with SetCredentialIssuer, check submitted
+// proofs against it and anchor them next to the credential they sign.
+const (
+	DefaultIssuerName  = "Study Chain Academy"
+	CredentialsContext = "https://www.w3.org/ns/credentials/v2"
+	CredentialIDPrefix = "urn:uuid:"
+	StudentIDPrefix    = "urn:study-chain:student:"
+	CourseIDPrefix     = "urn:study-chain:course:"
+	ledgerStatusType   = "StudyChainLedgerStatus"
+	dataIntegrityType  = "DataIntegrityProof"
+	dataIntegritySuite = "ecdsa-jcs-2019"
+	proofPurposeAssert = "assertionMethod"
+	p256MulticodecHi   = 0x80
+	p256MulticodecLo   = 0x24
+	base58Alphabet     = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+)
+
+type CredentialIssuer struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+	Name string `json:"name"`
+}
+
+type CredentialCourse struct {
+	ID               string   `json:"id"`
+	Type             string   `json:"type"`
+	CourseCode       string   `json:"courseCode"`
+	Name             string   `json:"name"`
+	ShortDescription string   `json:"shortDescription,omitempty"`
+	Description      string   `json:"description,omitempty"`
+	Subjects         []string `json:"subjects,omitempty"`
+}
+
+type CertificateSubject struct {
+	ID       string           `json:"id"`
+	Type     string           `json:"type"`
+	Username string           `json:"username"`
+	Name     string           `json:"name"`
+	Course   CredentialCourse `json:"course"`
+}
+
+type CredentialStatus struct {
+	ID            string `json:"id"`
+	Type          string `json:"type"`
+	StatusPurpose string `json:"statusPurpose"`
+	Status        Status `json:"status"`
+	RevokedDate   string `json:"revokedDate,omitempty"`
+	RevokeReason  string `json:"revokeReason,omitempty"`
+	SupersededBy  string `json:"supersededBy,omitempty"`
+}
+
+type CredentialEvidence struct {
+	ID        string `json:"id"`
+	Type      string `json:"type"`
+	Timestamp string `json:"timestamp"`
+}
+
+type DataIntegrityProof struct {
+	Context            []string `json:"@context,omitempty"`
+	Type               string   `json:"type"`
+	Cryptosuite        string   `json:"cryptosuite"`
+	Created            string   `json:"created"`
+	VerificationMethod string   `json:"verificationMethod"`
+	ProofPurpose       string   `json:"proofPurpose"`
+	ProofValue         string   `json:"proofValue,omitempty"`
+}
+
+type VerifiableCredential struct {
+	Context           []string             `json:"@context"`
+	ID                string               `json:"id"`
+	Type              []string             `json:"type"`
+	Issuer            CredentialIssuer     `json:"issuer"`
+	ValidFrom         string               `json:"validFrom"`
+	CredentialSubject CertificateSubject   `json:"credentialSubject"`
+	CredentialStatus  CredentialStatus     `json:"credentialStatus"`
+	Evidence          []CredentialEvidence `json:"evidence,omitempty"`
+	Proof             *DataIntegrityProof  `json:"proof,omitempty"`
+}
+
+// IssuerConfig is the registered issuer. The did:key is derived from the
+// public key so that verifiers can check proofs without contacting the
+// network.
+type IssuerConfig struct {
+	PublicKey          string
+	DID                string
+	VerificationMethod string
+	Name               string
+}
+
+type credentialIssuer struct {
+	key                *ecdsa.PublicKey
+	did                string
+	verificationMethod string
+	name               string
+}
+
+func RevokeCertificate(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	MSPID, err := cid.GetMSPID(stub)
+
+	if err != nil {
+		return shim.Error("Error - cid.GetMSPID()")
+	}
+
+	if MSPID != "AcademyMSP" {
+		return shim.Error("Permission Denied!")
+	}
+
+	if len(args) != 2 {
+		return shim.Error("Incorrect number of arguments. Expecting 2")
+	}
+
+	CertificateID := args[0]
+	Reason := args[1]
+
+	keyCertificate := "Certificate-" + CertificateID
+	certificate, err := getCertificate(stub, keyCertificate)
+
+	if err != nil {
+		return shim.Error("Certificate does not exist!")
+	}
+
+	if certificate.Status == Revoked {
+		return shim.Error("This certificate was revoked!")
+	}
+
+	txTime, err := getTxTime(stub)
+
+	if err != nil {
+		return shim.Error("Can not get transaction timestamp!")
+	}
+
+	certificate.Status = Revoked
+	certificate.RevokedDate = txTime.Format("2006-01-02")
+	certificate.RevokeReason = Reason
+
+	certificateAsBytes, err := json.Marshal(certificate)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	stub.PutState(keyCertificate, certificateAsBytes)
+
+	return shim.Success(nil)
+}
+
+func SetCredentialIssuer(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	MSPID, err := cid.GetMSPID(stub)
+
+	if err != nil {
+		return shim.Error("Error - cid.GetMSPID()")
+	}
+
+	if MSPID != "AcademyMSP" {
+		return shim.Error("Permission Denied!")
+	}
+
+	if len(args) != 2 {
+		return shim.Error("Incorrect number of arguments. Expecting 2")
+	}
+
+	PublicKey := args[0]
+	Name := args[1]
+
+	key, err := parseIssuerKey(PublicKey)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	if Name == "" {
+		Name = DefaultIssuerName
+	}
+
+	multikey := "z" + base58Encode(append([]byte{p256MulticodecHi, p256MulticodecLo}, compressPoint(*key)...))
+
+	config := IssuerConfig{
+		PublicKey:          PublicKey,
+		DID:                "did:key:" + multikey,
+		VerificationMethod: "did:key:" + multikey + "#" + multikey,
+		Name:               Name,
+	}
+
+	configAsBytes, err := json.Marshal(config)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	stub.PutState("Config-CredentialIssuer", configAsBytes)
+
+	return shim.Success(configAsBytes)
+}
+
+func GetCredentialIssuer(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	if len(args) != 0 {
+		return shim.Error("Incorrect number of arguments. Expecting 0")
+	}
+
+	configAsBytes, err := stub.GetState("Config-CredentialIssuer")
+
+	if err != nil {
+		return shim.Error("Failed to get data in the ledger")
+	}
+
+	if configAsBytes == nil {
+		return shim.Error("Credential issuer is not set!")
+	}
+
+	return shim.Success(configAsBytes)
+}
+
+// GetCertificateAsVerifiableCredential returns the credential with the proof
+// anchored by AnchorCertificateProof. The proof is left out when there is
+// none yet or it no longer matches, e.g. after the certificate was revoked or
+// the issuer key changed, and the credential has to be signed again.
+func GetCertificateAsVerifiableCredential(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	if len(args) != 1 {
+		return shim.Error("Incorrect number of arguments. Expecting 1")
+	}
+
+	CertificateID := args[0]
+
+	issuer, err := getCredentialIssuer(stub)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	credential, err := getVerifiableCredential(stub, issuer, CertificateID)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	err = checkGuestAccess(stub, []string{CertificateID}, "GetCertificateAsVerifiableCredential")
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	credential.Proof = getAnchoredProof(stub, issuer, credential.ID, credential, credential.Context)
+
+	credentialAsBytes, err := canonicalJSON(credential)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	return shim.Success(credentialAsBytes)
+}
+
+// AnchorCertificateProof stores the proof the issuer made off-chain over the
+// credential returned by GetCertificateAsVerifiableCredential.
+func AnchorCertificateProof(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	MSPID, err := cid.GetMSPID(stub)
+
+	if err != nil {
+		return shim.Error("Error - cid.GetMSPID()")
+	}
+
+	if MSPID != "AcademyMSP" {
+		return shim.Error("Permission Denied!")
+	}
+
+	if len(args) != 2 {
+		return shim.Error("Incorrect number of arguments. Expecting 2")
+	}
+
+	CertificateID := args[0]
+
+	issuer, err := getCredentialIssuer(stub)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	credential, err := getVerifiableCredential(stub, issuer, CertificateID)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	proofAsBytes, err := anchorProof(stub, issuer, credential.ID, credential, credential.Context, args[1])
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	return shim.Success(proofAsBytes)
+}
+
+func getVerifiableCredential(stub shim.ChaincodeStubInterface, issuer credentialIssuer, CertificateID string) (VerifiableCredential, error) {
+
+	var credential VerifiableCredential
+
+	certificate, err := getCertificate(stub, "Certificate-"+CertificateID)
+
+	if err != nil {
+		return credential, errors.New("Certificate does not exist - " + CertificateID)
+	}
+
+	student, err := getStudent(stub, "Student-"+certificate.StudentUsername)
+
+	if err != nil {
+		return credential, errors.New("Student does not exist - " + certificate.StudentUsername)
+	}
+
+	course, err := getCourse(stub, "Course-"+certificate.CourseID)
+
+	if err != nil {
+		return credential, errors.New("Course does not exist - " + certificate.CourseID)
+	}
+
+	// ten ghi tren chung chi tai thoi diem cap, co the khac ten hien tai
+	if certificate.StudentFullname != "" {
+		student.Fullname = certificate.StudentFullname
+	}
+
+	validFrom, err := toCredentialTime(certificate.IssueDate)
+
+	if err != nil {
+		return credential, errors.New("Invalid issue date of certificate - " + CertificateID)
+	}
+
+	credential = VerifiableCredential{
+		Context: []string{CredentialsContext},
+		ID:      CredentialIDPrefix + certificate.CertificateID,
+		Type:    []string{"VerifiableCredential", "CourseCertificateCredential"},
+		Issuer:  issuer.issuer(),
+		CredentialSubject: CertificateSubject{
+			ID:       StudentIDPrefix + student.Username,
+			Type:     "Student",
+			Username: student.Username,
+			Name:     student.Fullname,
+			Course: CredentialCourse{
+				ID:               CourseIDPrefix + course.CourseID,
+				Type:             "Course",
+				CourseCode:       course.CourseCode,
+				Name:             course.CourseName,
+				ShortDescription: course.ShortDescription,
+				Description:      course.Description,
+				Subjects:         course.Subjects,
+			},
+		},
+		CredentialStatus: getCertificateStatus(certificate),
+		ValidFrom:        validFrom,
+	}
+
+	evidence, err := getIssuanceEvidence(stub, "Certificate-"+CertificateID)
+
+	if err != nil {
+		return credential, errors.New("Can not get history of certificate - " + CertificateID)
+	}
+
+	if evidence != nil {
+		credential.Evidence = []CredentialEvidence{*evidence}
+	}
+
+	return credential, nil
+}
+
+func getCredentialIssuer(stub shim.ChaincodeStubInterface) (credentialIssuer, error) {
+
+	var issuer credentialIssuer
+
+	configAsBytes, err := stub.GetState("Config-CredentialIssuer")
+
+	if err != nil {
+		return issuer, errors.New("Failed to get data in the ledger")
+	}
+
+	if configAsBytes == nil {
+		return issuer, errors.New("Credential issuer is not set!")
+	}
+
+	config := IssuerConfig{}
+	json.Unmarshal(configAsBytes, &config)
+
+	issuer.key, err = parseIssuerKey(config.PublicKey)
+
+	if err != nil {
+		return issuer, err
+	}
+
+	issuer.did = config.DID
+	issuer.verificationMethod = config.VerificationMethod
+	issuer.name = config.Name
+
+	return issuer, nil
+}
+
+// parseIssuerKey accepts a PEM encoded P-256 public key (PKIX).
+func parseIssuerKey(PublicKey string) (*ecdsa.PublicKey, error) {
+
+	block, _ := pem.Decode([]byte(PublicKey))
+
+	if block == nil {
+		return nil, errors.New("Issuer key must be a PEM encoded public key!")
+	}
+
+	parsedKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+
+	if err != nil {
+		return nil, errors.New("Issuer key is invalid!")
+	}
+
+	key, ok := parsedKey.(*ecdsa.PublicKey)
+
+	if !ok || key.Curve != elliptic.P256() {
+		return nil, errors.New("Issuer key must be a P-256 key!")
+	}
+
+	return key, nil
+}
+
+func (issuer credentialIssuer) issuer() CredentialIssuer {
+	return CredentialIssuer{ID: issuer.did, Type: "Profile", Name: issuer.name}
+}
+
+// verify checks an ecdsa-jcs-2019 Data Integrity proof over the unsecured
+// document.
+func (issuer credentialIssuer) verify(document interface{}, context []string, proof DataIntegrityProof) error {
+
+	if proof.Type != dataIntegrityType || proof.Cryptosuite != dataIntegritySuite || proof.ProofPurpose != proofPurposeAssert {
+		return errors.New("Proof must be an ecdsa-jcs-2019 assertion proof!")
+	}
+
+	if proof.VerificationMethod != issuer.verificationMethod {
+		return errors.New("Proof was not made with the issuer key!")
+	}
+
+	if _, err := time.Parse(time.RFC3339, proof.Created); err != nil {
+		return errors.New("Proof created must be an RFC 3339 time!")
+	}
+
+	signature, err := base58Decode(strings.TrimPrefix(proof.ProofValue, "z"))
+
+	if err != nil || !strings.HasPrefix(proof.ProofValue, "z") || len(signature) != 64 {
+		return errors.New("Proof value is invalid!")
+	}
+
+	proof.Context = context
+	proof.ProofValue = ""
+
+	canonicalProof, err := canonicalJSON(proof)
+
+	if err != nil {
+		return err
+	}
+
+	canonicalDocument, err := canonicalJSON(document)
+
+	if err != nil {
+		return err
+	}
+
+	proofHash := sha256.Sum256(canonicalProof)
+	documentHash := sha256.Sum256(canonicalDocument)
+
+	digest := sha256.Sum256(append(proofHash[:], documentHash[:]...))
+
+	r := new(big.Int).SetBytes(signature[:32])
+	s := new(big.Int).SetBytes(signature[32:])
+
+	if !ecdsa.Verify(issuer.key, digest[:], r, s) {
+		return errors.New("Proof does not match the credential!")
+	}
+
+	return nil
+}
+
+// anchorProof checks proofJSON against document before storing it under the
+// credential ID.
+func anchorProof(stub shim.ChaincodeStubInterface, issuer credentialIssuer, CredentialID string, document interface{}, context []string, proofJSON string) ([]byte, error) {
+
+	proof := DataIntegrityProof{}
+
+	if err := json.Unmarshal([]byte(proofJSON), &proof); err != nil {
+		return nil, errors.New("Proof must be a JSON object!")
+	}
+
+	if err := issuer.verify(document, context, proof); err != nil {
+		return nil, err
+	}
+
+	proof.Context = nil
+
+	proofAsBytes, err := json.Marshal(proof)
+
+	if err != nil {
+		return nil, errors.New("Can not convert data to bytes!")
+	}
+
+	stub.PutState("CredentialProof-"+" "+CredentialID, proofAsBytes)
+
+	return proofAsBytes, nil
+}
+
+// getAnchoredProof returns the anchored proof of the credential when it still
+// verifies against document.
+func getAnchoredProof(stub shim.ChaincodeStubInterface, issuer credentialIssuer, CredentialID string, document interface{}, context []string) *DataIntegrityProof {
+
+	proofAsBytes, err := stub.GetState("CredentialProof-" + " " + CredentialID)
+
+	if err != nil || proofAsBytes == nil {
+		return nil
+	}
+
+	proof := DataIntegrityProof{}
+
+	if err := json.Unmarshal(proofAsBytes, &proof); err != nil {
+		return nil
+	}
+
+	if issuer.verify(document, context, proof) != nil {
+		return nil
+	}
+
+	return &proof
+}
+
+func getCertificateStatus(certificate Certificate) CredentialStatus {
+
+	status := certificate.Status
+	if status == "" {
+		status = Valid
+	}
+
+	return CredentialStatus{
+		ID:            CredentialIDPrefix + certificate.CertificateID + "#status",
+		Type:          ledgerStatusType,
+		StatusPurpose: "revocation",
+		Status:        status,
+		RevokedDate:   certificate.RevokedDate,
+		RevokeReason:  certificate.RevokeReason,
+		SupersededBy:  certificate.SupersededBy,
+	}
+}
+
+// getIssuanceEvidence points at the transaction that first wrote the record.
+func getIssuanceEvidence(stub shim.ChaincodeStubInterface, key string) (*CredentialEvidence, error) {
+
+	resultsIterator, err := stub.GetHistoryForKey(key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer resultsIterator.Close()
+
+	var evidence *CredentialEvidence
+
+	for resultsIterator.HasNext() {
+		response, err := resultsIterator.Next()
+		if err != nil {
+			return nil, err
+		}
+
+		timestamp := time.Unix(response.Timestamp.Seconds, int64(response.Timestamp.Nanos)).UTC()
+
+		if evidence == nil || timestamp.Format(time.RFC3339) < evidence.Timestamp {
+			evidence = &CredentialEvidence{
+				ID:        "urn:study-chain:tx:" + response.TxId,
+				Type:      "LedgerTransaction",
+				Timestamp: timestamp.Format(time.RFC3339),
+			}
+		}
+	}
+
+	return evidence, nil
+}
+
+// toCredentialTime turns a ledger date (YYYY-MM-DD or RFC 3339) into an XML
+// Schema dateTimeStamp as required by the credentials data model.
+func toCredentialTime(date string) (string, error) {
+
+	if t, err := time.Parse(time.RFC3339, date); err == nil {
+		return t.UTC().Format(time.RFC3339), nil
+	}
+
+	t, err := time.Parse("2006-01-02", date)
+
+	if err != nil {
+		return "", err
+	}
+
+	return t.UTC().Format(time.RFC3339), nil
+}
+
+// canonicalJSON serializes v following the JSON Canonicalization Scheme
+// (RFC 8785) for the data produced by this chaincode: keys sorted, no
+// insignificant whitespace and no HTML escaping.
+func canonicalJSON(v interface{}) ([]byte, error) {
+
+	raw, err := json.Marshal(v)
+
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+
+	var generic interface{}
+
+	if err := decoder.Decode(&generic); err != nil {
+		return nil, err
+	}
+
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(generic); err != nil {
+		return nil, err
+	}
+
+	return bytes.TrimRight(buffer.Bytes(), "\n"), nil
+}
+
+func compressPoint(publicKey ecdsa.PublicKey) []byte {
+
+	compressed := make([]byte, 33)
+	compressed[0] = byte(2 + publicKey.Y.Bit(0))
+
+	x := publicKey.X.Bytes()
+	copy(compressed[33-len(x):], x)
+
+	return compressed
+}
+
+func base58Encode(input []byte) string {
+
+	number := new(big.Int).SetBytes(input)
+	radix := big.NewInt(58)
+	modulo := new(big.Int)
+
+	var encoded []byte
+
+	for number.Sign() > 0 {
+		number.DivMod(number, radix, modulo)
+		encoded = append(encoded, base58Alphabet[modulo.Int64()])
+	}
+
+	for _, b := range input {
+		if b != 0 {
+			break
+		}
+		encoded = append(encoded, base58Alphabet[0])
+	}
+
+	for i, j := 0, len(encoded)-1; i < j; i, j = i+1, j-1 {
+		encoded[i], encoded[j] = encoded[j], encoded[i]
+	}
+
+	return string(encoded)
+}
+
+func base58Decode(input string) ([]byte, error) {
+
+	number := new(big.Int)
+	radix := big.NewInt(58)
+
+	for _, c := range input {
+		digit := strings.IndexRune(base58Alphabet, c)
+		if digit < 0 {
+			return nil, errors.New("invalid base58 character")
+		}
+		number.Mul(number, radix)
+		number.Add(number, big.NewInt(int64(digit)))
+	}
+
+	decoded := number.Bytes()
+
+	for _, c := range input {
+		if c != rune(base58Alphabet[0]) {
+			break
+		}
+		decoded = append([]byte{0}, decoded...)
+	}
+
+	return decoded, nil
+}
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"testing"
+)
+
+// signCredential makes the ecdsa-jcs-2019 proof the server signs off-chain
+// (server/fabric/credential.js).
+func signCredential(test *testing.T, key *ecdsa.PrivateKey, credentialAsBytes []byte, VerificationMethod string) string {
+	var document map[string]interface{}
+	json.Unmarshal(credentialAsBytes, &document)
+	delete(document, "proof")
+
+	proof := map[string]interface{}{
+		"@context":           document["@context"],
+		"type":               "DataIntegrityProof",
+		"cryptosuite":        "ecdsa-jcs-2019",
+		"created":            "2020-09-13T00:00:00Z",
+		"verificationMethod": VerificationMethod,
+		"proofPurpose":       "assertionMethod",
+	}
+
+	canonicalProof, _ := canonicalJSON(proof)
+	canonicalDocument, _ := canonicalJSON(document)
+	proofHash := sha256.Sum256(canonicalProof)
+	documentHash := sha256.Sum256(canonicalDocument)
+	digest := sha256.Sum256(append(proofHash[:], documentHash[:]...))
+
+	r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	signature := make([]byte, 64)
+	r.FillBytes(signature[:32])
+	s.FillBytes(signature[32:])
+
+	delete(proof, "@context")
+	proof["proofValue"] = "z" + base58Encode(signature)
+	proofAsBytes, _ := json.Marshal(proof)
+
+	return string(proofAsBytes)
+}
+
+func setTestIssuer(stub *testStub) (*ecdsa.PrivateKey, IssuerConfig) {
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	der, _ := x509.MarshalPKIXPublicKey(&key.PublicKey)
+
+	stub.as("AcademyMSP", "adminacademy")
+	stub.mustInvoke("SetCredentialIssuer", string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})), "Study Chain")
+
+	var issuer IssuerConfig
+	json.Unmarshal(stub.mustInvoke("GetCredentialIssuer"), &issuer)
+
+	return key, issuer
+}
+
+func TestAnchorCertificateProof(test *testing.T) {
+	stub := newTestStub(test)
+	seedAcademy(stub)
+	issueTestCertificate(stub, "st1", "cert1")
+
+	stub.as("AcademyMSP", "adminacademy")
+	stub.mustFail("GetCertificateAsVerifiableCredential", "cert1")
+
+	key, issuer := setTestIssuer(stub)
+	credential := stub.mustInvoke("GetCertificateAsVerifiableCredential", "cert1")
+
+	var document map[string]interface{}
+	json.Unmarshal(credential, &document)
+
+	if document["proof"] != nil {
+		test.Fatal("Credential should not have a proof before it is anchored")
+	}
+
+	otherKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	stub.mustFail("AnchorCertificateProof", "cert1", signCredential(test, otherKey, credential, issuer.VerificationMethod))
+	stub.mustFail("AnchorCertificateProof", "cert1", signCredential(test, key, credential, "did:key:zOther#zOther"))
+
+	proof := signCredential(test, key, credential, issuer.VerificationMethod)
+	stub.as("StudentMSP", "st1").mustFail("AnchorCertificateProof", "cert1", proof)
+	stub.as("AcademyMSP", "adminacademy").mustInvoke("AnchorCertificateProof", "cert1", proof)
+
+	json.Unmarshal(stub.mustInvoke("GetCertificateAsVerifiableCredential", "cert1"), &document)
+
+	if document["proof"] == nil {
+		test.Fatal("Anchored proof is missing")
+	}
+
+	// chung chi bi thu hoi thi chu ky cu khong con khop
+	stub.mustInvoke("RevokeCertificate", "cert1", "Fraud")
+	document = nil
+	json.Unmarshal(stub.mustInvoke("GetCertificateAsVerifiableCredential", "cert1"), &document)
+
+	if document["proof"] != nil {
+		test.Fatal("Stale proof of a revoked certificate was returned")
+	}
+}
+
+func TestAnchorOpenBadgeProof(test *testing.T) {
+	stub := newTestStub(test)
+	seedAcademy(stub)
+	key, issuer := setTestIssuer(stub)
+
+	stub.mustFail("GetOpenBadgeCredential", "st1", "Course", "C1")
+
+	issueTestCertificate(stub, "st1", "cert1")
+	badge := stub.mustInvoke("GetOpenBadgeCredential", "st1", "Course", "C1")
+
+	stub.as("AcademyMSP", "adminacademy")
+	stub.mustFail("AnchorOpenBadgeProof", "st1", "Course", "C1", "{}")
+	stub.mustInvoke("AnchorOpenBadgeProof", "st1", "Course", "C1", signCredential(test, key, badge, issuer.VerificationMethod))
+
+	var document map[string]interface{}
+	json.Unmarshal(stub.mustInvoke("GetOpenBadgeCredential", "st1", "Course", "C1"), &document)
+
+	if document["proof"] == nil {
+		test.Fatal("Anchored proof is missing")
+	}
+
+	stub.mustFail("GetOpenBadgeCredential", "st1", "Diploma", "C1")
+}
@@ -0,0 +1,556 @@
+package main
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strconv"
+
+	"github.com/hyperledger/fabric/core/chaincode/lib/cid"
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	sc "github.com/hyperledger/fabric/protos/peer"
+)
+
+// Salts are derived from a secret of the commitment. The client picks a fresh
+// secret for every student and sends them in the transient map as a JSON
+// object from username to secret; the chaincode keeps each one, with the score
+// values it committed, in a private data collection that only the student can
+// read back through GetDisclosureProof. Only the salted leaf hashes and their
+// Merkle root are anchored on the public ledger.
+const (
+	TransientSaltSecrets = "saltSecrets"
+	ScoreSaltCollection  = "collectionScoreSalts"
+	minSaltSecretLength  = 16
+	merkleLeafPrefix     = 0x00
+	merkleNodePrefix     = 0x01
+)
+
+type ScoreCommitment struct {
+	RootID          string
+	CertificateID   string
+	CourseID        string
+	StudentUsername string
+	Root            string
+	Subjects        []string
+	LeafHashes      []string
+}
+
+// ScoreSalt is the private half of a commitment. ScoreValues follow the order
+// of Subjects in the commitment, so a proof still opens after the score is
+// changed by a retake or an override.
+type ScoreSalt struct {
+	Secret      string
+	ScoreValues []float64
+}
+
+type DisclosedScore struct {
+	SubjectID  string
+	ScoreValue float64
+	Salt       string
+}
+
+type MerkleProofStep struct {
+	Hash string
+	Left bool
+}
+
+type DisclosureProof struct {
+	RootID string
+	Leaf   DisclosedScore
+	Proof  []MerkleProofStep
+}
+
+type DisclosedScoreResult struct {
+	SubjectID  string
+	ScoreValue float64
+	Valid      bool
+}
+
+type DisclosureVerification struct {
+	RootID            string
+	CertificateID     string
+	CourseID          string
+	StudentUsername   string
+	CertificateStatus Status
+	Valid             bool
+	Scores            []DisclosedScoreResult
+}
+
+func IssueTranscriptCommitment(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	MSPID, err := cid.GetMSPID(stub)
+
+	if err != nil {
+		return shim.Error("Error - cid.GetMSPID()")
+	}
+
+	if MSPID != "StudentMSP" {
+		return shim.Error("Permission Denied!")
+	}
+
+	if len(args) != 2 {
+		return shim.Error("Incorrect number of arguments. Expecting 2")
+	}
+
+	StudentUsername := args[0]
+	CourseID := args[1]
+
+	Username, _, err := cid.GetAttributeValue(stub, "username")
+
+	if err != nil {
+		return shim.Error("Error - cid.GetAttributeValue()")
+	}
+
+	if Username != StudentUsername {
+		return shim.Error("Permission Denied!")
+	}
+
+	student, err := getStudent(stub, "Student-"+StudentUsername)
+
+	if err != nil {
+		return shim.Error("Student does not exist!")
+	}
+
+	course, err := getCourse(stub, "Course-"+CourseID)
+
+	if err != nil {
+		return shim.Error("Course does not exist!")
+	}
+
+	var checkExist = false
+	for _, id := range student.Courses {
+		if id == CourseID {
+			checkExist = true
+			break
+		}
+	}
+
+	if !checkExist {
+		return shim.Error("Student does not in course!")
+	}
+
+	secret, err := getSaltSecret(stub, StudentUsername)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	RootID := deriveUUID("Transcript", stub.GetTxID())
+
+	commitment, err := putScoreCommitment(stub, RootID, "", course, StudentUsername, secret)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	commitmentAsBytes, err := json.Marshal(commitment)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	return shim.Success(commitmentAsBytes)
+}
+
+func GetScoreCommitment(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	if len(args) != 1 {
+		return shim.Error("Incorrect number of arguments. Expecting 1")
+	}
+
+	RootID := args[0]
+
+	commitmentAsBytes, err := stub.GetState("ScoreCommitment-" + RootID)
+
+	if err != nil {
+		return shim.Error("Failed to get data in the ledger")
+	}
+
+	if commitmentAsBytes == nil {
+		return shim.Error("Score commitment does not exist - " + RootID)
+	}
+
+	return shim.Success(commitmentAsBytes)
+}
+
+// GetDisclosureProof is meant to be evaluated by the student on a peer of
+// their organization: it rebuilds the salt from the private secret of the
+// commitment and hands it back together with the Merkle path of one subject.
+func GetDisclosureProof(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	MSPID, err := cid.GetMSPID(stub)
+
+	if err != nil {
+		return shim.Error("Error - cid.GetMSPID()")
+	}
+
+	if MSPID != "StudentMSP" {
+		return shim.Error("Permission Denied!")
+	}
+
+	if len(args) != 2 {
+		return shim.Error("Incorrect number of arguments. Expecting 2")
+	}
+
+	RootID := args[0]
+	SubjectID := args[1]
+
+	commitment, err := getScoreCommitment(stub, "ScoreCommitment-"+RootID)
+
+	if err != nil {
+		return shim.Error("Score commitment does not exist - " + RootID)
+	}
+
+	Username, _, err := cid.GetAttributeValue(stub, "username")
+
+	if err != nil {
+		return shim.Error("Error - cid.GetAttributeValue()")
+	}
+
+	if Username != commitment.StudentUsername {
+		return shim.Error("Permission Denied!")
+	}
+
+	saltAsBytes, err := stub.GetPrivateData(ScoreSaltCollection, "ScoreSalt-"+RootID)
+
+	if err != nil || saltAsBytes == nil {
+		return shim.Error("Salt secret of commitment is not available - " + RootID)
+	}
+
+	var index = -1
+	for i, id := range commitment.Subjects {
+		if id == SubjectID {
+			index = i
+			break
+		}
+	}
+
+	if index < 0 {
+		return shim.Error("Subject is not committed - " + SubjectID)
+	}
+
+	var scoreSalt ScoreSalt
+	var ScoreValue float64
+
+	// commitment cu chi luu secret, gia tri diem lay tu diem hien tai
+	if json.Unmarshal(saltAsBytes, &scoreSalt) == nil && len(scoreSalt.ScoreValues) == len(commitment.Subjects) {
+		ScoreValue = scoreSalt.ScoreValues[index]
+	} else {
+		scoreSalt.Secret = string(saltAsBytes)
+
+		score, err := getScore(stub, "Score-"+" "+"Subject-"+SubjectID+" "+"Student-"+commitment.StudentUsername)
+
+		if err != nil {
+			return shim.Error("Score does not exist - " + SubjectID)
+		}
+
+		ScoreValue = score.ScoreValue
+	}
+
+	salt := deriveSalt([]byte(scoreSalt.Secret), RootID, SubjectID)
+	leaf := DisclosedScore{SubjectID: SubjectID, ScoreValue: ScoreValue, Salt: hex.EncodeToString(salt)}
+
+	if hex.EncodeToString(hashScoreLeaf(salt, SubjectID, ScoreValue)) != commitment.LeafHashes[index] {
+		return shim.Error("Score changed since commitment!")
+	}
+
+	var levels [][][]byte
+	var level [][]byte
+
+	for _, leafHash := range commitment.LeafHashes {
+		hash, _ := hex.DecodeString(leafHash)
+		level = append(level, hash)
+	}
+
+	levels = append(levels, level)
+	for len(level) > 1 {
+		level = nextMerkleLevel(level)
+		levels = append(levels, level)
+	}
+
+	var proof []MerkleProofStep
+	position := index
+
+	for _, nodes := range levels[:len(levels)-1] {
+		sibling := position ^ 1
+		if sibling < len(nodes) {
+			proof = append(proof, MerkleProofStep{Hash: hex.EncodeToString(nodes[sibling]), Left: sibling < position})
+		}
+		position /= 2
+	}
+
+	proofAsBytes, err := json.Marshal(DisclosureProof{RootID: RootID, Leaf: leaf, Proof: proof})
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	return shim.Success(proofAsBytes)
+}
+
+func VerifyDisclosedScores(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	if len(args) != 3 {
+		return shim.Error("Incorrect number of arguments. Expecting 3")
+	}
+
+	RootID := args[0]
+
+	var leaves []DisclosedScore
+	var proofs [][]MerkleProofStep
+
+	if err := json.Unmarshal([]byte(args[1]), &leaves); err != nil {
+		return shim.Error("Disclosed leaves must be a JSON array!")
+	}
+
+	if err := json.Unmarshal([]byte(args[2]), &proofs); err != nil {
+		return shim.Error("Proofs must be a JSON array!")
+	}
+
+	if len(leaves) == 0 || len(leaves) != len(proofs) {
+		return shim.Error("Each disclosed leaf needs exactly one proof!")
+	}
+
+	commitment, err := getScoreCommitment(stub, "ScoreCommitment-"+RootID)
+
+	if err != nil {
+		return shim.Error("Score commitment does not exist - " + RootID)
+	}
+
+	root, _ := hex.DecodeString(commitment.Root)
+
+	result := DisclosureVerification{
+		RootID:          RootID,
+		CertificateID:   commitment.CertificateID,
+		CourseID:        commitment.CourseID,
+		StudentUsername: commitment.StudentUsername,
+		Valid:           true,
+	}
+
+	if commitment.CertificateID != "" {
+		certificate, err := getCertificate(stub, "Certificate-"+commitment.CertificateID)
+		if err != nil {
+			return shim.Error("Certificate does not exist - " + commitment.CertificateID)
+		}
+
+		result.CertificateStatus = getCertificateStatus(certificate).Status
+	}
+
+	for i, leaf := range leaves {
+		valid := verifyMerkleProof(root, leaf, proofs[i])
+
+		result.Valid = result.Valid && valid
+		result.Scores = append(result.Scores, DisclosedScoreResult{SubjectID: leaf.SubjectID, ScoreValue: leaf.ScoreValue, Valid: valid})
+	}
+
+	resultAsBytes, err := json.Marshal(result)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	return shim.Success(resultAsBytes)
+}
+
+// putScoreCommitment anchors one salted leaf per scored subject of the course,
+// in the order the subjects appear in the course, and keeps the secret and the
+// committed values in the private collection.
+func putScoreCommitment(stub shim.ChaincodeStubInterface, RootID string, CertificateID string, course Course, StudentUsername string, secret []byte) (ScoreCommitment, error) {
+
+	commitment := ScoreCommitment{
+		RootID:          RootID,
+		CertificateID:   CertificateID,
+		CourseID:        course.CourseID,
+		StudentUsername: StudentUsername,
+	}
+
+	var level [][]byte
+	scoreSalt := ScoreSalt{Secret: string(secret)}
+
+	for _, SubjectID := range course.Subjects {
+		score, err := getScore(stub, "Score-"+" "+"Subject-"+SubjectID+" "+"Student-"+StudentUsername)
+		if err != nil {
+			continue
+		}
+
+		leafHash := hashScoreLeaf(deriveSalt(secret, RootID, SubjectID), SubjectID, score.ScoreValue)
+
+		commitment.Subjects = append(commitment.Subjects, SubjectID)
+		commitment.LeafHashes = append(commitment.LeafHashes, hex.EncodeToString(leafHash))
+		scoreSalt.ScoreValues = append(scoreSalt.ScoreValues, score.ScoreValue)
+		level = append(level, leafHash)
+	}
+
+	if len(level) == 0 {
+		return commitment, errors.New("There is no score to commit!")
+	}
+
+	for len(level) > 1 {
+		level = nextMerkleLevel(level)
+	}
+
+	commitment.Root = hex.EncodeToString(level[0])
+
+	commitmentAsBytes, err := json.Marshal(commitment)
+
+	if err != nil {
+		return commitment, errors.New("Can not convert data to bytes!")
+	}
+
+	saltAsBytes, err := json.Marshal(scoreSalt)
+
+	if err != nil {
+		return commitment, errors.New("Can not convert data to bytes!")
+	}
+
+	err = stub.PutPrivateData(ScoreSaltCollection, "ScoreSalt-"+RootID, saltAsBytes)
+
+	if err != nil {
+		return commitment, errors.New("Can not put private data!")
+	}
+
+	stub.PutState("ScoreCommitment-"+RootID, commitmentAsBytes)
+
+	return commitment, nil
+}
+
+// commitCertificateScores anchors the scores behind a new certificate. A
+// student exempt from every subject of the course has no score to commit, so
+// the certificate is issued without a commitment.
+func commitCertificateScores(stub shim.ChaincodeStubInterface, CertificateID string, course Course, StudentUsername string) error {
+
+	var hasScore = false
+	for _, SubjectID := range course.Subjects {
+		if _, err := getScore(stub, "Score-"+" "+"Subject-"+SubjectID+" "+"Student-"+StudentUsername); err == nil {
+			hasScore = true
+			break
+		}
+	}
+
+	if !hasScore {
+		return nil
+	}
+
+	secret, err := getSaltSecret(stub, StudentUsername)
+	if err != nil {
+		return err
+	}
+
+	_, err = putScoreCommitment(stub, CertificateID, CertificateID, course, StudentUsername, secret)
+
+	return err
+}
+
+// getSaltSecret returns the secret the client picked for StudentUsername.
+// Secrets shared between students are refused so that knowing one
+// student's salts reveals nothing about another's.
+func getSaltSecret(stub shim.ChaincodeStubInterface, StudentUsername string) ([]byte, error) {
+
+	transient, err := stub.GetTransient()
+
+	if err != nil {
+		return nil, errors.New("Can not get transient data!")
+	}
+
+	secrets := map[string]string{}
+
+	if err := json.Unmarshal(transient[TransientSaltSecrets], &secrets); err != nil {
+		return nil, errors.New("Salt secrets are missing in transient data!")
+	}
+
+	secret, ok := secrets[StudentUsername]
+
+	if !ok {
+		return nil, errors.New("Salt secret is missing in transient data - " + StudentUsername)
+	}
+
+	if len(secret) < minSaltSecretLength {
+		return nil, errors.New("Salt secret must be at least 16 bytes!")
+	}
+
+	for Username, other := range secrets {
+		if Username != StudentUsername && other == secret {
+			return nil, errors.New("Every student needs a different salt secret!")
+		}
+	}
+
+	return []byte(secret), nil
+}
+
+func deriveSalt(secret []byte, RootID string, SubjectID string) []byte {
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(RootID))
+	mac.Write([]byte{0})
+	mac.Write([]byte(SubjectID))
+
+	return mac.Sum(nil)
+}
+
+func hashScoreLeaf(salt []byte, SubjectID string, ScoreValue float64) []byte {
+
+	hash := sha256.New()
+	hash.Write([]byte{merkleLeafPrefix})
+	hash.Write(salt)
+	hash.Write([]byte(SubjectID))
+	hash.Write([]byte{0})
+	hash.Write([]byte(strconv.FormatFloat(ScoreValue, 'f', -1, 64)))
+
+	return hash.Sum(nil)
+}
+
+func hashMerkleNode(left []byte, right []byte) []byte {
+
+	hash := sha256.New()
+	hash.Write([]byte{merkleNodePrefix})
+	hash.Write(left)
+	hash.Write(right)
+
+	return hash.Sum(nil)
+}
+
+// nextMerkleLevel pairs nodes left to right; an odd node out is carried up
+// unchanged.
+func nextMerkleLevel(level [][]byte) [][]byte {
+
+	var next [][]byte
+
+	for i := 0; i < len(level); i += 2 {
+		if i+1 < len(level) {
+			next = append(next, hashMerkleNode(level[i], level[i+1]))
+		} else {
+			next = append(next, level[i])
+		}
+	}
+
+	return next
+}
+
+func verifyMerkleProof(root []byte, leaf DisclosedScore, proof []MerkleProofStep) bool {
+
+	salt, err := hex.DecodeString(leaf.Salt)
+
+	if err != nil || len(salt) != sha256.Size {
+		return false
+	}
+
+	hash := hashScoreLeaf(salt, leaf.SubjectID, leaf.ScoreValue)
+
+	for _, step := range proof {
+		sibling, err := hex.DecodeString(step.Hash)
+		if err != nil || len(sibling) != sha256.Size {
+			return false
+		}
+
+		if step.Left {
+			hash = hashMerkleNode(sibling, hash)
+		} else {
+			hash = hashMerkleNode(hash, sibling)
+		}
+	}
+
+	return bytes.Equal(hash, root)
+}
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestScoreCommitmentSecret(test *testing.T) {
+	stub := newTestStub(test)
+	seedAcademy(stub)
+
+	stub.as("StudentMSP", "st1")
+	stub.mustInvoke("StudentRegisterCourse", "st1", "C1")
+	stub.putState("Score-"+" "+"Subject-S1"+" "+"Student-st1", Score{"S1", "st1", 9})
+	stub.putState("Score-"+" "+"Subject-S2"+" "+"Student-st1", Score{"S2", "st1", 7})
+
+	// khong co secret, secret qua ngan hoac dung chung secret deu bi tu choi
+	stub.mustFail("CreateCertificate", "cert1", "C1", "st1", "2020-09-13")
+	stub.Transient = map[string][]byte{TransientSaltSecrets: []byte(`{"st1":"short"}`)}
+	stub.mustFail("CreateCertificate", "cert1", "C1", "st1", "2020-09-13")
+	stub.Transient = map[string][]byte{TransientSaltSecrets: []byte(`{"st1":"0123456789abcdef","st2":"0123456789abcdef"}`)}
+	stub.mustFail("CreateCertificate", "cert1", "C1", "st1", "2020-09-13")
+
+	stub.Transient = map[string][]byte{TransientSaltSecrets: []byte(`{"st1":"0123456789abcdef0123"}`)}
+	stub.mustInvoke("CreateCertificate", "cert1", "C1", "st1", "2020-09-13")
+	stub.Transient = nil
+
+	var scoreSalt ScoreSalt
+	saltAsBytes, _ := stub.GetPrivateData(ScoreSaltCollection, "ScoreSalt-cert1")
+	json.Unmarshal(saltAsBytes, &scoreSalt)
+
+	if scoreSalt.Secret != "0123456789abcdef0123" || len(scoreSalt.ScoreValues) != 2 || scoreSalt.ScoreValues[1] != 7 {
+		test.Fatalf("Salt secret is not kept in the private collection %+v", scoreSalt)
+	}
+
+	var commitment ScoreCommitment
+	json.Unmarshal(stub.mustInvoke("GetScoreCommitment", "cert1"), &commitment)
+
+	if commitment.Root == "" || len(commitment.LeafHashes) != 2 {
+		test.Fatalf("Unexpected commitment %+v", commitment)
+	}
+}
+
+func TestVerifyDisclosedScores(test *testing.T) {
+	stub := newTestStub(test)
+	seedAcademy(stub)
+	issueTestCertificate(stub, "st1", "cert1")
+
+	stub.as("AcademyMSP", "adminacademy").mustFail("GetDisclosureProof", "cert1", "S1")
+	stub.as("StudentMSP", "st2").mustFail("GetDisclosureProof", "cert1", "S1")
+
+	// diem doi sau khi cap (hoc lai, sua diem) khong lam mat kha nang tiet lo
+	stub.putState("Score-"+" "+"Subject-S2"+" "+"Student-st1", Score{"S2", "st1", 5})
+	stub.as("StudentMSP", "st1")
+
+	var proof DisclosureProof
+	json.Unmarshal(stub.mustInvoke("GetDisclosureProof", "cert1", "S2"), &proof)
+
+	if proof.Leaf.ScoreValue != 7 {
+		test.Fatalf("Proof should open the committed score, got %v", proof.Leaf.ScoreValue)
+	}
+
+	leaves, _ := json.Marshal([]DisclosedScore{proof.Leaf})
+	proofs, _ := json.Marshal([][]MerkleProofStep{proof.Proof})
+
+	var verification DisclosureVerification
+	asVerifier(stub, "acme", testVerifierToken)
+	json.Unmarshal(stub.mustInvoke("VerifyDisclosedScores", "cert1", string(leaves), string(proofs)), &verification)
+
+	if !verification.Valid {
+		test.Fatal("Disclosed score should match the commitment")
+	}
+
+	proof.Leaf.ScoreValue = 10
+	leaves, _ = json.Marshal([]DisclosedScore{proof.Leaf})
+	json.Unmarshal(stub.mustInvoke("VerifyDisclosedScores", "cert1", string(leaves), string(proofs)), &verification)
+
+	if verification.Valid {
+		test.Fatal("Changed score should not match the commitment")
+	}
+
+	// bang diem cung duoc cam ket voi secret rieng, chi boi chinh sinh vien
+	stub.as("AcademyMSP", "adminacademy").mustInvoke("CreateStudent", "st2", "Student Two")
+	stub.Transient = map[string][]byte{TransientSaltSecrets: []byte(`{"st1":"another-secret-of-st1"}`)}
+	stub.as("StudentMSP", "st2").mustFail("IssueTranscriptCommitment", "st1", "C1")
+	stub.as("StudentMSP", "st1")
+
+	var commitment ScoreCommitment
+	json.Unmarshal(stub.mustInvoke("IssueTranscriptCommitment", "st1", "C1"), &commitment)
+	stub.Transient = nil
+
+	stub.mustInvoke("GetDisclosureProof", commitment.RootID, "S1")
+}
+
+func TestCertificateWithoutScores(test *testing.T) {
+	stub := newTestStub(test)
+	seedAcademy(stub)
+
+	stub.mustInvoke("CreateStudent", "st2", "Student Two")
+
+	for _, StudentUsername := range []string{"st1", "st2"} {
+		stub.as("StudentMSP", StudentUsername).mustInvoke("StudentRegisterCourse", StudentUsername, "C1")
+
+		stub.as("AcademyMSP", "adminacademy")
+		stub.mustInvoke("ExemptSubject", StudentUsername, "S1", strings.Repeat("ab", 32), "Transfer credit")
+		stub.mustInvoke("ExemptSubject", StudentUsername, "S2", strings.Repeat("cd", 32), "Transfer credit")
+	}
+
+	var eligibility CertificateEligibility
+	json.Unmarshal(stub.mustInvoke("CheckCertificateEligibility", "C1", "st1"), &eligibility)
+
+	if !eligibility.Eligible {
+		test.Fatalf("Student exempt from every subject should be eligible %+v", eligibility)
+	}
+
+	// khong co diem nao thi chung chi duoc cap ma khong can commitment
+	stub.as("StudentMSP", "st1").mustInvoke("CreateCertificate", "cert1", "C1", "st1", "2020-09-13")
+	stub.mustFail("GetScoreCommitment", "cert1")
+
+	stub.as("AcademyMSP", "adminacademy")
+
+	var certificate Certificate
+	json.Unmarshal(stub.mustInvoke("ReissueCertificate", "cert1", "Name corrected"), &certificate)
+	stub.mustFail("GetScoreCommitment", certificate.CertificateID)
+
+	var report BatchIssuanceReport
+	json.Unmarshal(stub.mustInvoke("IssueCertificatesForCourse", "C1"), &report)
+
+	if !report.Completed || report.Results[1].Outcome != Issued {
+		test.Fatalf("Unexpected batch %+v", report)
+	}
+}
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/hyperledger/fabric/core/chaincode/lib/cid"
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	sc "github.com/hyperledger/fabric/protos/peer"
+)
+
+// Exemption credits a subject studied elsewhere. It satisfies the subject for
+// course completion, electives and prerequisites but carries no score, so it
+// is left out of averages. The evidence itself stays off-chain; only its
+// SHA-256 hash is recorded.
+type Exemption struct {
+	SubjectID       string
+	StudentUsername string
+	EvidenceHash    string
+	Reason          string
+	ExemptedAt      string
+	TxID            string
+}
+
+func ExemptSubject(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	MSPID, err := cid.GetMSPID(stub)
+
+	if err != nil {
+		return shim.Error("Error - cid.GetMSPID()")
+	}
+
+	if MSPID != "AcademyMSP" {
+		return shim.Error("Permission Denied!")
+	}
+
+	if len(args) != 4 {
+		return shim.Error("Incorrect number of arguments. Expecting 4")
+	}
+
+	StudentUsername := args[0]
+	SubjectID := args[1]
+	EvidenceHash := strings.ToLower(args[2])
+	Reason := args[3]
+
+	if hash, err := hex.DecodeString(EvidenceHash); err != nil || len(hash) != 32 {
+		return shim.Error("Evidence hash must be a hex encoded SHA-256 digest!")
+	}
+
+	if Reason == "" {
+		return shim.Error("Reason of exemption can not be empty!")
+	}
+
+	keyStudent := "Student-" + StudentUsername
+	student, err := getStudent(stub, keyStudent)
+
+	if err != nil {
+		return shim.Error("Student does not exist - " + StudentUsername)
+	}
+
+	subject, err := getSubject(stub, "Subject-"+SubjectID)
+
+	if err != nil {
+		return shim.Error("Subject does not exist - " + SubjectID)
+	}
+
+	if containsString(student.Exemptions, SubjectID) {
+		return shim.Error("Student is already exempt from subject " + SubjectID)
+	}
+
+	_, err = getScore(stub, "Score-"+" "+"Subject-"+SubjectID+" "+"Student-"+StudentUsername)
+
+	if err == nil {
+		return shim.Error("Student already has a score of subject " + SubjectID)
+	}
+
+	// lop chua ket thuc van co the nhap diem, sinh vien phai huy dang ky truoc
+	for _, ClassID := range subject.Classes {
+		class, err := getClass(stub, "Class-"+ClassID)
+		if err != nil || class.Status == Completed {
+			continue
+		}
+
+		if containsString(class.Students, StudentUsername) || containsString(class.Waitlist, StudentUsername) {
+			return shim.Error("Student is registered or waiting in class " + ClassID + " of subject " + SubjectID)
+		}
+	}
+
+	txTime, err := getTxTime(stub)
+
+	if err != nil {
+		return shim.Error("Can not get transaction timestamp!")
+	}
+
+	exemption := Exemption{
+		SubjectID:       SubjectID,
+		StudentUsername: StudentUsername,
+		EvidenceHash:    EvidenceHash,
+		Reason:          Reason,
+		ExemptedAt:      txTime.Format(time.RFC3339),
+		TxID:            stub.GetTxID(),
+	}
+
+	student.Exemptions = append(student.Exemptions, SubjectID)
+
+	exemptionAsBytes, err := json.Marshal(exemption)
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	studentAsBytes, err := json.Marshal(student)
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	stub.PutState("Exemption-"+" "+"Subject-"+SubjectID+" "+"Student-"+StudentUsername, exemptionAsBytes)
+	stub.PutState(keyStudent, studentAsBytes)
+
+	return shim.Success(exemptionAsBytes)
+}
+
+func GetExemption(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	if len(args) != 2 {
+		return shim.Error("Incorrect number of arguments. Expecting 2")
+	}
+
+	StudentUsername := args[0]
+	SubjectID := args[1]
+
+	exemptionAsBytes, err := stub.GetState("Exemption-" + " " + "Subject-" + SubjectID + " " + "Student-" + StudentUsername)
+
+	if err != nil {
+		return shim.Error("Failed to get data in the ledger")
+	}
+
+	if exemptionAsBytes == nil {
+		return shim.Error("Exemption does not exist!")
+	}
+
+	return shim.Success(exemptionAsBytes)
+}
+
+func isExempt(stub shim.ChaincodeStubInterface, SubjectID string, StudentUsername string) bool {
+
+	_, err := getExemption(stub, "Exemption-"+" "+"Subject-"+SubjectID+" "+"Student-"+StudentUsername)
+
+	return err == nil
+}
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestExemptionOfStudiedSubject(test *testing.T) {
+	stub := newTestStub(test)
+	seedAcademy(stub)
+
+	stub.mustInvoke("CreateStudent", "st2", "Student Two")
+	stub.mustInvoke("CreateClass", "K1", "K1C", "R1", testClassSchedule, "S1", "1", "TM")
+
+	stub.at("2020-08-01 00:00")
+	stub.as("StudentMSP", "st1").mustInvoke("StudentRegisterClass", "st1", "K1")
+	stub.as("StudentMSP", "st2").mustInvoke("StudentRegisterClass", "st2", "K1")
+
+	// dang hoc hoac dang cho trong lop chua ket thuc thi khong duoc mien
+	stub.as("AcademyMSP", "adminacademy")
+	stub.mustFail("ExemptSubject", "st1", "S1", strings.Repeat("ab", 32), "Transfer credit")
+	stub.mustFail("ExemptSubject", "st2", "S1", strings.Repeat("ab", 32), "Transfer credit")
+
+	stub.as("StudentMSP", "st2").mustInvoke("LeaveClassWaitlist", "st2", "K1")
+	stub.as("AcademyMSP", "adminacademy").mustInvoke("ExemptSubject", "st2", "S1", strings.Repeat("ab", 32), "Transfer credit")
+
+	stub.as("StudentMSP", "st2").mustFail("StudentRegisterClass", "st2", "K1")
+}
@@ -0,0 +1,331 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hyperledger/fabric/core/chaincode/lib/cid"
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	sc "github.com/hyperledger/fabric/protos/peer"
+)
+
+// GradingWindow keeps score entry open until DaysAfterEnd days after the
+// EndDate of the class schedule, inclusive and local to its timezone.
+// Without a stored window scores may be entered at any time, as before.
+type GradingWindow struct {
+	DaysAfterEnd uint64
+}
+
+// ScoreOverride records a score an admin entered after the grading window
+// closed, and why.
+type ScoreOverride struct {
+	ClassID         string
+	StudentUsername string
+	ScoreValue      float64
+	PreviousScore   *float64
+	Justification   string
+	OverriddenBy    string
+	RecordedAt      string
+	TxID            string
+}
+
+func SetGradingWindow(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	MSPID, err := cid.GetMSPID(stub)
+
+	if err != nil {
+		return shim.Error("Error - cid.GetMSPID()")
+	}
+
+	if MSPID != "AcademyMSP" {
+		return shim.Error("Permission Denied!")
+	}
+
+	if len(args) != 1 {
+		return shim.Error("Incorrect number of arguments. Expecting 1")
+	}
+
+	DaysAfterEnd, err := strconv.ParseUint(args[0], 10, 64)
+
+	if err != nil {
+		return shim.Error("Days after end must be a non-negative integer!")
+	}
+
+	windowAsBytes, err := json.Marshal(GradingWindow{DaysAfterEnd: DaysAfterEnd})
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	stub.PutState("Config-GradingWindow", windowAsBytes)
+
+	return shim.Success(windowAsBytes)
+}
+
+func GetGradingWindow(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	if len(args) != 0 {
+		return shim.Error("Incorrect number of arguments. Expecting 0")
+	}
+
+	window, err := getGradingWindow(stub)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	if window == nil {
+		return shim.Success(nil)
+	}
+
+	windowAsBytes, err := json.Marshal(window)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	return shim.Success(windowAsBytes)
+}
+
+// OverrideScore lets an admin enter a score after the grading window has
+// closed. The term and grading deadlines are not checked, but a
+// justification is required and kept in a ScoreOverride record.
+func OverrideScore(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	MSPID, err := cid.GetMSPID(stub)
+
+	if err != nil {
+		return shim.Error("Error - cid.GetMSPID()")
+	}
+
+	if MSPID != "AcademyMSP" {
+		return shim.Error("Permission Denied!")
+	}
+
+	if len(args) != 4 {
+		return shim.Error("Incorrect number of arguments. Expecting 4")
+	}
+
+	ClassID := args[0]
+	Student := args[1]
+	Justification := strings.TrimSpace(args[3])
+
+	ScoreValue, err := strconv.ParseFloat(args[2], 64)
+
+	if err != nil {
+		return shim.Error("Failed convert string to float")
+	}
+
+	// nguoi ghi de la chinh nguoi goi, khong lay tu tham so
+	Admin, _, err := cid.GetAttributeValue(stub, "username")
+
+	if err != nil {
+		return shim.Error("Error - cid.GetAttributeValue()")
+	}
+
+	if Admin == "" {
+		return shim.Error("Admin username can not be empty!")
+	}
+
+	if Justification == "" {
+		return shim.Error("Justification is required to override a score!")
+	}
+
+	student, err := getStudent(stub, "Student-"+Student)
+
+	if err != nil {
+		return shim.Error("Student does not exist - " + Student)
+	}
+
+	class, err := getClass(stub, "Class-"+ClassID)
+
+	if err != nil {
+		return shim.Error("Class does not exist - " + ClassID)
+	}
+
+	if class.Status != InProgress && class.Status != Completed {
+		return shim.Error("Can not entry score now!")
+	}
+
+	if !containsString(class.Students, Student) {
+		return shim.Error("The student does not study in this class!")
+	}
+
+	txTime, err := getTxTime(stub)
+
+	if err != nil {
+		return shim.Error("Can not get transaction timestamp!")
+	}
+
+	override := ScoreOverride{
+		ClassID:         ClassID,
+		StudentUsername: Student,
+		ScoreValue:      ScoreValue,
+		Justification:   Justification,
+		OverriddenBy:    Admin,
+		RecordedAt:      txTime.Format(time.RFC3339),
+		TxID:            stub.GetTxID(),
+	}
+
+	attempt, err := getAttempt(stub, "Attempt-"+" "+"Subject-"+class.SubjectID+" "+"Student-"+Student+" "+"Class-"+ClassID)
+
+	if err == nil {
+		PreviousScore := attempt.ScoreValue
+		override.PreviousScore = &PreviousScore
+	}
+
+	err = recordScore(stub, student, class, ScoreValue)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	overrideAsBytes, err := json.Marshal(override)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	stub.PutState("ScoreOverride-"+" "+"Class-"+ClassID+" "+override.TxID, overrideAsBytes)
+
+	return shim.Success(overrideAsBytes)
+}
+
+func GetScoreOverridesOfClass(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	if len(args) != 1 {
+		return shim.Error("Incorrect number of arguments. Expecting 1")
+	}
+
+	ClassID := args[0]
+
+	_, err := getClass(stub, "Class-"+ClassID)
+
+	if err != nil {
+		return shim.Error("Class does not exist - " + ClassID)
+	}
+
+	prefix := "ScoreOverride-" + " " + "Class-" + ClassID + " "
+	iterator, err := stub.GetStateByRange(prefix, prefix+"zzzzzzzz")
+
+	if err != nil {
+		return shim.Error("Failed to get data in the ledger")
+	}
+
+	defer iterator.Close()
+
+	overrides := []ScoreOverride{}
+	for iterator.HasNext() {
+		record, err := iterator.Next()
+
+		if err != nil {
+			return shim.Error("Failed to get data in the ledger")
+		}
+
+		override := ScoreOverride{}
+		json.Unmarshal(record.Value, &override)
+		overrides = append(overrides, override)
+	}
+
+	overridesAsBytes, err := json.Marshal(overrides)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	return shim.Success(overridesAsBytes)
+}
+
+func getGradingWindow(stub shim.ChaincodeStubInterface) (*GradingWindow, error) {
+
+	windowAsBytes, err := stub.GetState("Config-GradingWindow")
+
+	if err != nil {
+		return nil, errors.New("Failed to get grading window")
+	}
+
+	if windowAsBytes == nil {
+		return nil, nil
+	}
+
+	window := GradingWindow{}
+	json.Unmarshal(windowAsBytes, &window)
+
+	return &window, nil
+}
+
+// checkGradingWindow fails once the grading window of class has closed.
+// Classes without a structured schedule have no EndDate and are not gated.
+func checkGradingWindow(stub shim.ChaincodeStubInterface, class Class) error {
+
+	window, err := getGradingWindow(stub)
+
+	if err != nil {
+		return err
+	}
+
+	if window == nil || class.Schedule.EndDate == "" {
+		return nil
+	}
+
+	endDate, err := time.Parse(scheduleDateLayout, class.Schedule.EndDate)
+
+	if err != nil {
+		return errors.New("End date must be YYYY-MM-DD!")
+	}
+
+	txTime, err := getTxTime(stub)
+
+	if err != nil {
+		return errors.New("Can not get transaction timestamp!")
+	}
+
+	today, err := localDate(txTime, class.Schedule.Timezone)
+
+	if err != nil {
+		return err
+	}
+
+	deadline := endDate.AddDate(0, 0, int(window.DaysAfterEnd)).Format(scheduleDateLayout)
+
+	if today > deadline {
+		return errors.New("Grading window of this class closed on " + deadline + ", an admin override is required!")
+	}
+
+	return nil
+}
+
+// recordScore stores the attempt of the class and updates the Score of the
+// subject with the attempt that counts.
+func recordScore(stub shim.ChaincodeStubInterface, student Student, class Class, ScoreValue float64) error {
+
+	// moi lop la mot lan thi, diem cua mon hoc la lan thi duoc tinh theo chinh sach thi lai
+	ScoreValue, err := putAttempt(stub, student, class, ScoreValue)
+
+	if err != nil {
+		return err
+	}
+
+	keyScore := "Score-" + " " + "Subject-" + class.SubjectID + " " + "Student-" + student.Username
+
+	score, err := getScore(stub, keyScore)
+
+	if err != nil {
+		score = Score{SubjectID: class.SubjectID, StudentUsername: student.Username}
+	}
+
+	score.ScoreValue = ScoreValue
+
+	scoreAsBytes, err := json.Marshal(score)
+
+	if err != nil {
+		return errors.New("Can not convert data to bytes!")
+	}
+
+	stub.PutState(keyScore, scoreAsBytes)
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestGradingWindow(test *testing.T) {
+	stub := newTestStub(test)
+	seedAcademy(stub)
+
+	stub.mustInvoke("CreateClass", "K1", "K1C", "R1", testClassSchedule, "S1", "30", "TM")
+	stub.mustInvoke("AssignTeacherToClass", "K1", "T1")
+	stub.mustInvoke("CreateStudent", "st2", "Student Two")
+
+	stub.at("2020-08-01 00:00")
+	stub.as("StudentMSP", "st1").mustInvoke("StudentRegisterClass", "st1", "K1")
+	stub.as("StudentMSP", "st2").mustInvoke("StudentRegisterClass", "st2", "K1")
+	stub.at("2020-09-01 00:00").as("AcademyMSP", "adminacademy").mustInvoke("StartClass", "K1")
+
+	// chua dat thoi han thi giang vien van cham diem duoc
+	stub.at("2021-01-01 00:00").mustInvoke("PickScore", "T1", "K1", "st1", "4")
+
+	stub.mustFail("SetGradingWindow", "-1")
+	stub.mustInvoke("SetGradingWindow", "14")
+
+	// lop ket thuc 2020-10-01 gio Viet Nam, han cham la het ngay 2020-10-15
+	stub.at("2020-10-15 16:59").mustInvoke("PickScore", "T1", "K1", "st2", "7")
+	stub.at("2020-10-15 17:00").mustFail("PickScore", "T1", "K1", "st1", "6")
+
+	stub.mustFail("OverrideScore", "K1", "st1", "6", "   ")
+	stub.mustFail("OverrideScore", "K1", "nobody", "6", "Regrade")
+
+	var override ScoreOverride
+	json.Unmarshal(stub.mustInvoke("OverrideScore", "K1", "st1", "6", "Regrade after appeal"), &override)
+
+	if override.PreviousScore == nil || *override.PreviousScore != 4 || override.OverriddenBy != "adminacademy" {
+		test.Fatalf("Unexpected override %+v", override)
+	}
+
+	var score Score
+	stub.getState("Score-"+" "+"Subject-S1"+" "+"Student-st1", &score)
+
+	if score.ScoreValue != 6 {
+		test.Fatalf("Score was not overridden %+v", score)
+	}
+
+	// nguoi ghi de luon la nguoi goi giao dich
+	stub.as("AcademyMSP", "admin2")
+	json.Unmarshal(stub.mustInvoke("OverrideScore", "K1", "st2", "8", "Regrade after appeal"), &override)
+
+	if override.OverriddenBy != "admin2" {
+		test.Fatalf("Unexpected override %+v", override)
+	}
+
+	var overrides []ScoreOverride
+	json.Unmarshal(stub.mustInvoke("GetScoreOverridesOfClass", "K1"), &overrides)
+
+	if len(overrides) != 2 {
+		test.Fatalf("Unexpected overrides %+v", overrides)
+	}
+}
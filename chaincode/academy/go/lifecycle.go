@@ -0,0 +1,400 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hyperledger/fabric/core/chaincode/lib/cid"
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	sc "github.com/hyperledger/fabric/protos/peer"
+)
+
+const (
+	lifecycleEvent           = "LifecycleAdvanced"
+	DefaultLifecyclePageSize = 100
+)
+
+// EnrollmentWindow limits when students may register a course. Dates are
+// YYYY-MM-DD, inclusive, local to Timezone.
+type EnrollmentWindow struct {
+	Start    string
+	End      string
+	Timezone string
+}
+
+type MissingScores struct {
+	ClassID   string
+	ClassCode string
+	EndDate   string
+	Students  []string
+}
+
+type SkippedClass struct {
+	ClassID string
+	Reason  string
+}
+
+// LifecycleReport lists what one AdvanceLifecycle run changed. Classes with
+// missing scores stay InProgress and are reported again on the next run.
+// Bookmark is empty once every class and course has been looked at.
+type LifecycleReport struct {
+	RunAt            string
+	StartedClasses   []string
+	CompletedClasses []string
+	MissingScores    []MissingScores
+	SkippedClasses   []SkippedClass
+	ClosedCourses    []string
+	Bookmark         string
+}
+
+// SetCourseEnrollmentWindow sets the dates students may register a course.
+// Once the window has ended AdvanceLifecycle closes the course.
+func SetCourseEnrollmentWindow(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	MSPID, err := cid.GetMSPID(stub)
+
+	if err != nil {
+		return shim.Error("Error - cid.GetMSPID()")
+	}
+
+	if MSPID != "AcademyMSP" {
+		return shim.Error("Permission Denied!")
+	}
+
+	if len(args) != 4 {
+		return shim.Error("Incorrect number of arguments. Expecting 4")
+	}
+
+	CourseID := args[0]
+	window := EnrollmentWindow{Start: args[1], End: args[2], Timezone: args[3]}
+
+	keyCourse := "Course-" + CourseID
+	course, err := getCourse(stub, keyCourse)
+
+	if err != nil {
+		return shim.Error("Course does not exist !")
+	}
+
+	if _, err := time.Parse(scheduleDateLayout, window.Start); err != nil {
+		return shim.Error("Enrollment start must be YYYY-MM-DD!")
+	}
+
+	if _, err := time.Parse(scheduleDateLayout, window.End); err != nil {
+		return shim.Error("Enrollment end must be YYYY-MM-DD!")
+	}
+
+	if window.End < window.Start {
+		return shim.Error("Enrollment start must occur before enrollment end!")
+	}
+
+	if _, err := loadLocation(window.Timezone); err != nil {
+		return shim.Error("Unknown timezone - " + window.Timezone)
+	}
+
+	course.EnrollmentWindow = &window
+
+	courseAsBytes, err := json.Marshal(course)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	stub.PutState(keyCourse, courseAsBytes)
+
+	return shim.Success(courseAsBytes)
+}
+
+// AdvanceLifecycle is called periodically by a scheduler. Using the
+// transaction timestamp it starts Open classes whose StartDate has come,
+// completes InProgress classes past EndDate once every student is scored and
+// flags the ones still missing scores, then closes Open courses whose
+// enrollment window has ended. The report is returned and also emitted as a
+// chaincode event.
+//
+// Each call looks at no more than pageSize classes and courses, 100 by
+// default. When it stops early the report carries a bookmark; pass it back
+// with the same page size to continue from there.
+func AdvanceLifecycle(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	MSPID, err := cid.GetMSPID(stub)
+
+	if err != nil {
+		return shim.Error("Error - cid.GetMSPID()")
+	}
+
+	if MSPID != "AcademyMSP" {
+		return shim.Error("Permission Denied!")
+	}
+
+	if len(args) != 0 && len(args) != 2 {
+		return shim.Error("Incorrect number of arguments. Expecting 0 or 2")
+	}
+
+	var PageSize uint64 = DefaultLifecyclePageSize
+	var Bookmark string
+
+	if len(args) == 2 {
+		PageSize, err = strconv.ParseUint(args[0], 10, 64)
+
+		if err != nil || PageSize == 0 {
+			return shim.Error("Page size must be a positive integer!")
+		}
+
+		Bookmark = args[1]
+	}
+
+	if Bookmark != "" && !strings.HasPrefix(Bookmark, "Class-") && !strings.HasPrefix(Bookmark, "Course-") {
+		return shim.Error("Invalid bookmark - " + Bookmark)
+	}
+
+	txTime, err := getTxTime(stub)
+
+	if err != nil {
+		return shim.Error("Can not get transaction timestamp!")
+	}
+
+	report := LifecycleReport{
+		RunAt:            txTime.Format(time.RFC3339),
+		StartedClasses:   []string{},
+		CompletedClasses: []string{},
+		MissingScores:    []MissingScores{},
+		SkippedClasses:   []SkippedClass{},
+		ClosedCourses:    []string{},
+	}
+
+	// bookmark la khoa cuoi cung da xet, lop dung truoc khoa hoc theo thu tu khoa
+	classStart, courseStart := "Class-", "Course-"
+
+	if strings.HasPrefix(Bookmark, "Class-") {
+		classStart = Bookmark
+	}
+
+	if strings.HasPrefix(Bookmark, "Course-") {
+		classStart = "Class-zzzzzzzz"
+		courseStart = Bookmark
+	}
+
+	var visited uint64
+
+	allClasses, err := stub.GetStateByRange(classStart, "Class-zzzzzzzz")
+
+	if err != nil {
+		return shim.Error("Failed to get data in the ledger")
+	}
+
+	defer allClasses.Close()
+
+	for allClasses.HasNext() && report.Bookmark == "" {
+		record, err := allClasses.Next()
+
+		if err != nil {
+			return shim.Error("Failed to get data in the ledger")
+		}
+
+		if record.Key == Bookmark {
+			continue
+		}
+
+		visited++
+		if visited == PageSize {
+			report.Bookmark = record.Key
+		}
+
+		class := Class{}
+		json.Unmarshal(record.Value, &class)
+
+		// lop tao truoc khi co lich co cau truc thi van quan ly bang tay
+		if class.Schedule.StartDate == "" {
+			continue
+		}
+
+		today, err := localDate(txTime, class.Schedule.Timezone)
+
+		if err != nil {
+			report.SkippedClasses = append(report.SkippedClasses, SkippedClass{ClassID: class.ClassID, Reason: err.Error()})
+			continue
+		}
+
+		changed := false
+
+		if class.Status == Open && today >= class.Schedule.StartDate {
+			err = checkTermPhase(stub, class, TermTeaching)
+
+			if err != nil {
+				report.SkippedClasses = append(report.SkippedClasses, SkippedClass{ClassID: class.ClassID, Reason: err.Error()})
+				continue
+			}
+
+			class.Status = InProgress
+			changed = true
+			report.StartedClasses = append(report.StartedClasses, class.ClassID)
+		}
+
+		if class.Status == InProgress && today > class.Schedule.EndDate {
+			missing := getUnscoredStudents(stub, class)
+
+			if len(missing) == 0 {
+				class.Status = Completed
+				changed = true
+				report.CompletedClasses = append(report.CompletedClasses, class.ClassID)
+			} else {
+				report.MissingScores = append(report.MissingScores, MissingScores{
+					ClassID:   class.ClassID,
+					ClassCode: class.ClassCode,
+					EndDate:   class.Schedule.EndDate,
+					Students:  missing,
+				})
+			}
+		}
+
+		if !changed {
+			continue
+		}
+
+		classAsBytes, err := json.Marshal(class)
+
+		if err != nil {
+			return shim.Error("Can not convert data to bytes!")
+		}
+
+		stub.PutState("Class-"+class.ClassID, classAsBytes)
+	}
+
+	allCourses, err := stub.GetStateByRange(courseStart, "Course-zzzzzzzz")
+
+	if err != nil {
+		return shim.Error("Failed to get data in the ledger")
+	}
+
+	defer allCourses.Close()
+
+	for allCourses.HasNext() && report.Bookmark == "" {
+		record, err := allCourses.Next()
+
+		if err != nil {
+			return shim.Error("Failed to get data in the ledger")
+		}
+
+		if record.Key == Bookmark {
+			continue
+		}
+
+		visited++
+		if visited == PageSize {
+			report.Bookmark = record.Key
+		}
+
+		course := Course{}
+		json.Unmarshal(record.Value, &course)
+
+		if course.Status != Open || !enrollmentEnded(course, txTime) {
+			continue
+		}
+
+		course.Status = Closed
+
+		courseAsBytes, err := json.Marshal(course)
+
+		if err != nil {
+			return shim.Error("Can not convert data to bytes!")
+		}
+
+		stub.PutState("Course-"+course.CourseID, courseAsBytes)
+		report.ClosedCourses = append(report.ClosedCourses, course.CourseID)
+	}
+
+	reportAsBytes, err := json.Marshal(report)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	err = stub.SetEvent(lifecycleEvent, reportAsBytes)
+
+	if err != nil {
+		return shim.Error("Can not set event!")
+	}
+
+	return shim.Success(reportAsBytes)
+}
+
+// getUnscoredStudents lists the students of class without a score recorded
+// for this class. Scores entered before retakes existed count for the first
+// class the student took of the subject.
+func getUnscoredStudents(stub shim.ChaincodeStubInterface, class Class) []string {
+
+	missing := []string{}
+	for _, Username := range class.Students {
+		_, err := getAttempt(stub, "Attempt-"+" "+"Subject-"+class.SubjectID+" "+"Student-"+Username+" "+"Class-"+class.ClassID)
+		if err == nil {
+			continue
+		}
+
+		scored := false
+		student, err := getStudent(stub, "Student-"+Username)
+		if err == nil {
+			for _, attempt := range getAttempts(stub, student, class.SubjectID) {
+				if attempt.ClassID == class.ClassID {
+					scored = true
+				}
+			}
+		}
+
+		if !scored {
+			missing = append(missing, Username)
+		}
+	}
+
+	return missing
+}
+
+// checkEnrollmentWindow fails when the course has a window and the
+// transaction date is outside it.
+func checkEnrollmentWindow(course Course, txTime time.Time) error {
+
+	window := course.EnrollmentWindow
+
+	if window == nil {
+		return nil
+	}
+
+	today, err := localDate(txTime, window.Timezone)
+
+	if err != nil {
+		return err
+	}
+
+	if today < window.Start || today > window.End {
+		return errors.New("Enrollment of this course is open from " + window.Start + " to " + window.End + "!")
+	}
+
+	return nil
+}
+
+func enrollmentEnded(course Course, txTime time.Time) bool {
+
+	window := course.EnrollmentWindow
+
+	if window == nil {
+		return false
+	}
+
+	today, err := localDate(txTime, window.Timezone)
+
+	return err == nil && today > window.End
+}
+
+// localDate is the calendar date of t in timezone, as YYYY-MM-DD.
+func localDate(t time.Time, timezone string) (string, error) {
+
+	location, err := loadLocation(timezone)
+
+	if err != nil {
+		return "", errors.New("Unknown timezone - " + timezone)
+	}
+
+	return t.In(location).Format(scheduleDateLayout), nil
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func advanceTestLifecycle(stub *testStub, args ...string) LifecycleReport {
+	var report LifecycleReport
+	json.Unmarshal(stub.mustInvoke("AdvanceLifecycle", args...), &report)
+
+	return report
+}
+
+func TestAdvanceLifecyclePaging(test *testing.T) {
+	stub := newTestStub(test)
+	seedAcademy(stub)
+
+	for i, ClassID := range []string{"K1", "K2", "K3"} {
+		RoomID := []string{"R1", "R2", "R3"}[i]
+		stub.mustInvoke("CreateClass", ClassID, ClassID+"C", RoomID, testClassSchedule, "S1", "30", "TM")
+	}
+
+	stub.mustInvoke("SetCourseEnrollmentWindow", "C1", "2020-08-01", "2020-08-31", "Asia/Ho_Chi_Minh")
+	stub.mustFail("AdvanceLifecycle", "0", "")
+	stub.mustFail("AdvanceLifecycle", "2", "Student-st1")
+
+	stub.at("2020-09-01 10:00")
+
+	report := advanceTestLifecycle(stub, "2", "")
+
+	if !reflect.DeepEqual(report.StartedClasses, []string{"K1", "K2"}) || len(report.ClosedCourses) != 0 || report.Bookmark != "Class-K2" {
+		test.Fatalf("Unexpected first page %+v", report)
+	}
+
+	// trang thu hai di qua ranh gioi giua lop va khoa hoc
+	report = advanceTestLifecycle(stub, "2", report.Bookmark)
+
+	if !reflect.DeepEqual(report.StartedClasses, []string{"K3"}) || !reflect.DeepEqual(report.ClosedCourses, []string{"C1"}) || report.Bookmark != "Course-C1" {
+		test.Fatalf("Unexpected second page %+v", report)
+	}
+
+	report = advanceTestLifecycle(stub, "2", report.Bookmark)
+
+	if len(report.StartedClasses) != 0 || len(report.ClosedCourses) != 0 || report.Bookmark != "" {
+		test.Fatalf("Unexpected last page %+v", report)
+	}
+
+	var course Course
+	stub.getState("Course-C1", &course)
+
+	if course.Status != Closed {
+		test.Fatalf("Course was not closed %+v", course)
+	}
+}
+
+func TestAdvanceLifecyclePageEndsOnLastClass(test *testing.T) {
+	stub := newTestStub(test)
+	seedAcademy(stub)
+
+	stub.mustInvoke("CreateClass", "K1", "K1C", "R1", testClassSchedule, "S1", "30", "TM")
+	stub.mustInvoke("SetCourseEnrollmentWindow", "C1", "2020-08-01", "2020-08-31", "Asia/Ho_Chi_Minh")
+
+	stub.at("2020-09-01 10:00")
+
+	report := advanceTestLifecycle(stub, "1", "")
+
+	if !reflect.DeepEqual(report.StartedClasses, []string{"K1"}) || len(report.ClosedCourses) != 0 || report.Bookmark != "Class-K1" {
+		test.Fatalf("Unexpected first page %+v", report)
+	}
+
+	// bookmark la lop cuoi cung thi trang sau bat dau tu khoa hoc dau tien
+	report = advanceTestLifecycle(stub, "1", report.Bookmark)
+
+	if len(report.StartedClasses) != 0 || !reflect.DeepEqual(report.ClosedCourses, []string{"C1"}) || report.Bookmark != "Course-C1" {
+		test.Fatalf("Unexpected second page %+v", report)
+	}
+
+	report = advanceTestLifecycle(stub, "1", report.Bookmark)
+
+	if len(report.ClosedCourses) != 0 || report.Bookmark != "" {
+		test.Fatalf("Unexpected last page %+v", report)
+	}
+}
+
+func TestAdvanceLifecycleLegacyScores(test *testing.T) {
+	stub := newTestStub(test)
+	seedAcademy(stub)
+	stub.mustInvoke("CreateStudent", "st2", "Student Two")
+	stub.mustInvoke("SetRetakePolicy", "2", "false", CountBestAttempt)
+
+	stub.mustInvoke("CreateClass", "K1", "K1C", "R1", testClassSchedule, "S1", "30", "TM")
+	stub.mustInvoke("AssignTeacherToClass", "K1", "T1")
+	stub.at("2020-08-01 00:00").as("StudentMSP", "st1").mustInvoke("StudentRegisterClass", "st1", "K1")
+	stub.as("StudentMSP", "st2").mustInvoke("StudentRegisterClass", "st2", "K1")
+	stub.at("2020-09-01 00:00").as("AcademyMSP", "adminacademy").mustInvoke("StartClass", "K1")
+
+	// diem cua st1 nhap truoc khi co thi lai nen chua co ban ghi Attempt
+	stub.putState("Score-"+" "+"Subject-S1"+" "+"Student-st1", Score{"S1", "st1", 9})
+
+	report := advanceTestLifecycle(stub.at("2020-10-02 10:00"))
+
+	if len(report.CompletedClasses) != 0 || len(report.MissingScores) != 1 || !reflect.DeepEqual(report.MissingScores[0].Students, []string{"st2"}) {
+		test.Fatalf("Legacy score should count for K1 %+v", report)
+	}
+
+	stub.mustInvoke("PickScore", "T1", "K1", "st2", "7")
+
+	report = advanceTestLifecycle(stub)
+
+	if !reflect.DeepEqual(report.CompletedClasses, []string{"K1"}) || len(report.MissingScores) != 0 {
+		test.Fatalf("K1 should be completed %+v", report)
+	}
+
+	// diem cu thuoc ve lop dau tien, lop thi lai van phai co diem rieng
+	stub.mustInvoke("CreateClass", "K2", "K2C", "R1", retakeTestSchedule, "S1", "30", "TM")
+	stub.mustInvoke("AssignTeacherToClass", "K2", "T1")
+	stub.at("2020-10-03 00:00").as("StudentMSP", "st1").mustInvoke("StudentRegisterClass", "st1", "K2")
+	stub.at("2020-10-05 00:00").as("AcademyMSP", "adminacademy").mustInvoke("StartClass", "K2")
+
+	report = advanceTestLifecycle(stub.at("2020-11-06 10:00"))
+
+	if len(report.MissingScores) != 1 || report.MissingScores[0].ClassID != "K2" || !reflect.DeepEqual(report.MissingScores[0].Students, []string{"st1"}) {
+		test.Fatalf("Retake of st1 should be missing a score %+v", report)
+	}
+}
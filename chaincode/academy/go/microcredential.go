@@ -0,0 +1,633 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/hyperledger/fabric/core/chaincode/lib/cid"
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	sc "github.com/hyperledger/fabric/protos/peer"
+)
+
+// MicroCredential records that a student passed a single subject in a
+// completed class. Course certificates reference the micro-credentials they
+// stack on through Certificate.MicroCredentials.
+type MicroCredential struct {
+	MicroCredentialID string
+	SubjectID         string
+	ClassID           string
+	StudentUsername   string
+	StudentFullname   string
+	ScoreValue        float64
+	IssueDate         string
+	Status            Status
+	RevokedDate       string
+	RevokeReason      string
+}
+
+// MicroCredentialGrant lets a verifier read one micro-credential without a
+// grant on a certificate that stacks it.
+type MicroCredentialGrant struct {
+	MicroCredentialID string
+	StudentUsername   string
+	VerifierID        string
+	TokenHash         string
+	GrantedAt         string
+	Expiry            string
+	Revoked           bool
+}
+
+type MicroCredentialAccess struct {
+	MicroCredentialID string
+	VerifierID        string
+	Function          string
+	AccessedAt        string
+	TxID              string
+}
+
+type MicroCredentialVerification struct {
+	MicroCredentialID string
+	Valid             bool
+	Status            Status
+	MicroCredential   MicroCredential
+}
+
+func IssueMicroCredential(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	MSPID, err := cid.GetMSPID(stub)
+
+	if err != nil {
+		return shim.Error("Error - cid.GetMSPID()")
+	}
+
+	if MSPID != "AcademyMSP" {
+		return shim.Error("Permission Denied!")
+	}
+
+	if len(args) != 2 {
+		return shim.Error("Incorrect number of arguments. Expecting 2")
+	}
+
+	ClassID := args[0]
+	StudentUsername := args[1]
+
+	class, err := getClass(stub, "Class-"+ClassID)
+
+	if err != nil {
+		return shim.Error("Class does not exist - " + ClassID)
+	}
+
+	if class.Status != Completed {
+		return shim.Error("This class is not completed yet!")
+	}
+
+	if !containsString(class.Students, StudentUsername) {
+		return shim.Error("The student does not study in this class!")
+	}
+
+	keyStudent := "Student-" + StudentUsername
+	student, err := getStudent(stub, keyStudent)
+
+	if err != nil {
+		return shim.Error("Student does not exist - " + StudentUsername)
+	}
+
+	// diem cua mon la lan thi duoc tinh, co the cua lop khac; dung diem cua chinh lop nay
+	var attempt *Attempt
+	attempts := getAttempts(stub, student, class.SubjectID)
+	for i := range attempts {
+		if attempts[i].ClassID == ClassID {
+			attempt = &attempts[i]
+			break
+		}
+	}
+
+	if attempt == nil {
+		return shim.Error("Student has no score in class " + ClassID)
+	}
+
+	if attempt.ScoreValue < PassScore {
+		return shim.Error("Student did not pass class " + ClassID)
+	}
+
+	for _, ID := range student.MicroCredentials {
+		existing, err := getMicroCredential(stub, "MicroCredential-"+ID)
+		if err != nil {
+			return shim.Error(err.Error())
+		}
+
+		if existing.SubjectID == class.SubjectID && existing.Status == Valid {
+			return shim.Error("Micro-credential already exist - " + ID)
+		}
+	}
+
+	txTime, err := getTxTime(stub)
+
+	if err != nil {
+		return shim.Error("Can not get transaction timestamp!")
+	}
+
+	MicroCredentialID := deriveUUID("MicroCredential", stub.GetTxID(), ClassID, StudentUsername)
+
+	microCredential := MicroCredential{
+		MicroCredentialID: MicroCredentialID,
+		SubjectID:         class.SubjectID,
+		ClassID:           ClassID,
+		StudentUsername:   StudentUsername,
+		StudentFullname:   student.Fullname,
+		ScoreValue:        attempt.ScoreValue,
+		IssueDate:         txTime.Format("2006-01-02"),
+		Status:            Valid,
+	}
+
+	student.MicroCredentials = append(student.MicroCredentials, MicroCredentialID)
+
+	microCredentialAsBytes, err := json.Marshal(microCredential)
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	studentAsBytes, err := json.Marshal(student)
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	stub.PutState("MicroCredential-"+MicroCredentialID, microCredentialAsBytes)
+	stub.PutState(keyStudent, studentAsBytes)
+
+	return shim.Success(microCredentialAsBytes)
+}
+
+func GetMicroCredential(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	if len(args) != 1 {
+		return shim.Error("Incorrect number of arguments. Expecting 1")
+	}
+
+	MicroCredentialID := args[0]
+
+	microCredential, err := getMicroCredential(stub, "MicroCredential-"+MicroCredentialID)
+
+	if err != nil {
+		return shim.Error("Micro-credential does not exist - " + MicroCredentialID)
+	}
+
+	err = checkMicroCredentialGuestAccess(stub, microCredential, "GetMicroCredential")
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	microCredentialAsBytes, err := json.Marshal(microCredential)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	return shim.Success(microCredentialAsBytes)
+}
+
+func GetMicroCredentialsOfStudent(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	if len(args) != 1 {
+		return shim.Error("Incorrect number of arguments. Expecting 1")
+	}
+
+	StudentUsername := args[0]
+
+	student, err := getStudent(stub, "Student-"+StudentUsername)
+
+	if err != nil {
+		return shim.Error("Student does not exist - " + StudentUsername)
+	}
+
+	_, isGuest, err := getGuestVerifier(stub)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	var tlist []MicroCredential
+
+	for _, ID := range student.MicroCredentials {
+		microCredential, err := getMicroCredential(stub, "MicroCredential-"+ID)
+		if err != nil {
+			return shim.Error("Micro-credential does not exist - " + ID)
+		}
+
+		// khach chi thay cac chung nhan duoc cap quyen
+		if isGuest && checkMicroCredentialGuestAccess(stub, microCredential, "GetMicroCredentialsOfStudent") != nil {
+			continue
+		}
+
+		tlist = append(tlist, microCredential)
+	}
+
+	if isGuest && len(tlist) == 0 {
+		return shim.Error("Access denied - no active grant on micro-credentials of " + StudentUsername)
+	}
+
+	jsonRow, err := json.Marshal(tlist)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	return shim.Success(jsonRow)
+}
+
+func VerifyMicroCredential(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	if len(args) != 1 {
+		return shim.Error("Incorrect number of arguments. Expecting 1")
+	}
+
+	MicroCredentialID := args[0]
+
+	microCredential, err := getMicroCredential(stub, "MicroCredential-"+MicroCredentialID)
+
+	if err != nil {
+		return shim.Error("Micro-credential does not exist - " + MicroCredentialID)
+	}
+
+	err = checkMicroCredentialGuestAccess(stub, microCredential, "VerifyMicroCredential")
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	verification := MicroCredentialVerification{
+		MicroCredentialID: MicroCredentialID,
+		Valid:             microCredential.Status == Valid,
+		Status:            microCredential.Status,
+		MicroCredential:   microCredential,
+	}
+
+	verificationAsBytes, err := json.Marshal(verification)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	return shim.Success(verificationAsBytes)
+}
+
+func RevokeMicroCredential(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	MSPID, err := cid.GetMSPID(stub)
+
+	if err != nil {
+		return shim.Error("Error - cid.GetMSPID()")
+	}
+
+	if MSPID != "AcademyMSP" {
+		return shim.Error("Permission Denied!")
+	}
+
+	if len(args) != 2 {
+		return shim.Error("Incorrect number of arguments. Expecting 2")
+	}
+
+	MicroCredentialID := args[0]
+	Reason := args[1]
+
+	keyMicroCredential := "MicroCredential-" + MicroCredentialID
+	microCredential, err := getMicroCredential(stub, keyMicroCredential)
+
+	if err != nil {
+		return shim.Error("Micro-credential does not exist!")
+	}
+
+	if microCredential.Status == Revoked {
+		return shim.Error("This micro-credential was revoked!")
+	}
+
+	txTime, err := getTxTime(stub)
+
+	if err != nil {
+		return shim.Error("Can not get transaction timestamp!")
+	}
+
+	microCredential.Status = Revoked
+	microCredential.RevokedDate = txTime.Format("2006-01-02")
+	microCredential.RevokeReason = Reason
+
+	microCredentialAsBytes, err := json.Marshal(microCredential)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	stub.PutState(keyMicroCredential, microCredentialAsBytes)
+
+	return shim.Success(nil)
+}
+
+// getStackedMicroCredentials returns the student's valid micro-credentials
+// for the subjects and electives of a course, in the order they were issued.
+func getStackedMicroCredentials(stub shim.ChaincodeStubInterface, course Course, student Student) ([]string, error) {
+
+	var stacked []string
+
+	for _, ID := range student.MicroCredentials {
+		microCredential, err := getMicroCredential(stub, "MicroCredential-"+ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if microCredential.Status != Valid {
+			continue
+		}
+
+		if containsString(course.Subjects, microCredential.SubjectID) || containsString(course.Electives, microCredential.SubjectID) {
+			stacked = append(stacked, ID)
+		}
+	}
+
+	return stacked, nil
+}
+
+func GrantMicroCredentialAccess(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	if len(args) != 3 {
+		return shim.Error("Incorrect number of arguments. Expecting 3")
+	}
+
+	MicroCredentialID := args[0]
+	VerifierID := args[1]
+	Expiry := args[2]
+
+	microCredential, err := getOwnedMicroCredential(stub, MicroCredentialID)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	GrantedAt, Expiry, TokenHash, err := getGrantTerms(stub, Expiry)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	grant := MicroCredentialGrant{
+		MicroCredentialID: MicroCredentialID,
+		StudentUsername:   microCredential.StudentUsername,
+		VerifierID:        VerifierID,
+		TokenHash:         TokenHash,
+		GrantedAt:         GrantedAt,
+		Expiry:            Expiry,
+	}
+
+	grantAsBytes, err := json.Marshal(grant)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	stub.PutState("MicroCredentialGrant-"+" "+"MicroCredential-"+MicroCredentialID+" "+"Verifier-"+VerifierID, grantAsBytes)
+
+	return shim.Success(nil)
+}
+
+func RevokeMicroCredentialAccess(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	if len(args) != 2 {
+		return shim.Error("Incorrect number of arguments. Expecting 2")
+	}
+
+	MicroCredentialID := args[0]
+	VerifierID := args[1]
+
+	_, err := getOwnedMicroCredential(stub, MicroCredentialID)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	keyGrant := "MicroCredentialGrant-" + " " + "MicroCredential-" + MicroCredentialID + " " + "Verifier-" + VerifierID
+	grant, err := getMicroCredentialGrant(stub, keyGrant)
+
+	if err != nil {
+		return shim.Error("Grant does not exist!")
+	}
+
+	if grant.Revoked {
+		return shim.Error("This grant was revoked!")
+	}
+
+	grant.Revoked = true
+
+	grantAsBytes, err := json.Marshal(grant)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	stub.PutState(keyGrant, grantAsBytes)
+
+	return shim.Success(nil)
+}
+
+func GetMicroCredentialGrants(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	if len(args) != 1 {
+		return shim.Error("Incorrect number of arguments. Expecting 1")
+	}
+
+	MicroCredentialID := args[0]
+
+	if err := checkMicroCredentialOwnerOrAcademy(stub, MicroCredentialID); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	startKey := "MicroCredentialGrant-" + " " + "MicroCredential-" + MicroCredentialID + " "
+	resultsIterator, err := stub.GetStateByRange(startKey, startKey+"zzzzzzzz")
+
+	if err != nil {
+		return shim.Error("Can not get grants of micro-credential - " + MicroCredentialID)
+	}
+
+	defer resultsIterator.Close()
+
+	var tlist []MicroCredentialGrant
+
+	for resultsIterator.HasNext() {
+		record, err := resultsIterator.Next()
+		if err != nil {
+			return shim.Error(err.Error())
+		}
+
+		grant := MicroCredentialGrant{}
+		json.Unmarshal(record.Value, &grant)
+		tlist = append(tlist, grant)
+	}
+
+	jsonRow, err := json.Marshal(tlist)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	return shim.Success(jsonRow)
+}
+
+func GetMicroCredentialAccessLog(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	if len(args) != 1 {
+		return shim.Error("Incorrect number of arguments. Expecting 1")
+	}
+
+	MicroCredentialID := args[0]
+
+	if err := checkMicroCredentialOwnerOrAcademy(stub, MicroCredentialID); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	startKey := "MicroCredentialAccess-" + " " + "MicroCredential-" + MicroCredentialID + " "
+	resultsIterator, err := stub.GetStateByRange(startKey, startKey+"zzzzzzzz")
+
+	if err != nil {
+		return shim.Error("Can not get access log of micro-credential - " + MicroCredentialID)
+	}
+
+	defer resultsIterator.Close()
+
+	var tlist []MicroCredentialAccess
+
+	for resultsIterator.HasNext() {
+		record, err := resultsIterator.Next()
+		if err != nil {
+			return shim.Error(err.Error())
+		}
+
+		access := MicroCredentialAccess{}
+		json.Unmarshal(record.Value, &access)
+		tlist = append(tlist, access)
+	}
+
+	jsonRow, err := json.Marshal(tlist)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	return shim.Success(jsonRow)
+}
+
+// checkMicroCredentialGuestAccess lets a guest read a micro-credential when
+// they hold a grant on the micro-credential itself, or on one of the
+// student's certificates that stacks it.
+func checkMicroCredentialGuestAccess(stub shim.ChaincodeStubInterface, microCredential MicroCredential, function string) error {
+
+	VerifierID, isGuest, err := getGuestVerifier(stub)
+
+	if err != nil || !isGuest {
+		return err
+	}
+
+	if VerifierID == "" {
+		return errors.New("Verifier ID is missing!")
+	}
+
+	TokenHash, err := getVerifierTokenHash(stub)
+
+	if err != nil {
+		return err
+	}
+
+	txTime, err := getTxTime(stub)
+
+	if err != nil {
+		return errors.New("Can not get transaction timestamp!")
+	}
+
+	MicroCredentialID := microCredential.MicroCredentialID
+	grant, err := getMicroCredentialGrant(stub, "MicroCredentialGrant-"+" "+"MicroCredential-"+MicroCredentialID+" "+"Verifier-"+VerifierID)
+
+	if err == nil && isActiveGrant(grant.TokenHash, grant.Expiry, grant.Revoked, TokenHash, txTime) {
+		access := MicroCredentialAccess{
+			MicroCredentialID: MicroCredentialID,
+			VerifierID:        VerifierID,
+			Function:          function,
+			AccessedAt:        txTime.Format(time.RFC3339),
+			TxID:              stub.GetTxID(),
+		}
+
+		return logAccess(stub, "MicroCredentialAccess-"+" "+"MicroCredential-"+MicroCredentialID+" "+access.AccessedAt+" "+access.TxID, access)
+	}
+
+	student, err := getStudent(stub, "Student-"+microCredential.StudentUsername)
+
+	if err != nil {
+		return err
+	}
+
+	var certificateIDs []string
+
+	for _, CertificateID := range student.Certificates {
+		certificate, err := getCertificate(stub, "Certificate-"+CertificateID)
+		if err != nil {
+			return err
+		}
+
+		if containsString(certificate.MicroCredentials, MicroCredentialID) {
+			certificateIDs = append(certificateIDs, CertificateID)
+		}
+	}
+
+	return checkGuestAccess(stub, certificateIDs, function)
+}
+
+func getOwnedMicroCredential(stub shim.ChaincodeStubInterface, MicroCredentialID string) (MicroCredential, error) {
+
+	MSPID, err := cid.GetMSPID(stub)
+
+	if err != nil {
+		return MicroCredential{}, errors.New("Error - cid.GetMSPID()")
+	}
+
+	if MSPID != "StudentMSP" {
+		return MicroCredential{}, errors.New("Permission Denied!")
+	}
+
+	Username, _, err := cid.GetAttributeValue(stub, "username")
+
+	if err != nil {
+		return MicroCredential{}, errors.New("Error - cid.GetAttributeValue()")
+	}
+
+	microCredential, err := getMicroCredential(stub, "MicroCredential-"+MicroCredentialID)
+
+	if err != nil {
+		return microCredential, errors.New("Micro-credential does not exist!")
+	}
+
+	if microCredential.StudentUsername != Username {
+		return microCredential, errors.New("Permission Denied!")
+	}
+
+	return microCredential, nil
+}
+
+func checkMicroCredentialOwnerOrAcademy(stub shim.ChaincodeStubInterface, MicroCredentialID string) error {
+
+	MSPID, err := cid.GetMSPID(stub)
+
+	if err != nil {
+		return errors.New("Error - cid.GetMSPID()")
+	}
+
+	if MSPID == "AcademyMSP" {
+		_, err := getMicroCredential(stub, "MicroCredential-"+MicroCredentialID)
+		if err != nil {
+			return errors.New("Micro-credential does not exist!")
+		}
+
+		return nil
+	}
+
+	_, err = getOwnedMicroCredential(stub, MicroCredentialID)
+
+	return err
+}
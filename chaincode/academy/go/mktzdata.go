@@ -0,0 +1,110 @@
+//go:build ignore
+// +build ignore
+
+// mktzdata writes tzdata.go, the zone data the chaincode resolves timezones
+// with. Point it at a zoneinfo directory compiled in the "fat" format, which
+// older Go toolchains can read without the POSIX TZ footer:
+//
+//	go run mktzdata.go /usr/share/zoneinfo
+package main
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+
+	dir := "/usr/share/zoneinfo"
+	if len(os.Args) > 1 {
+		dir = os.Args[1]
+	}
+
+	var archive bytes.Buffer
+	writer := zip.NewWriter(&archive)
+
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		name, _ := filepath.Rel(dir, path)
+		name = filepath.ToSlash(name)
+
+		if info.IsDir() {
+			if name == "posix" || name == "right" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		if !bytes.HasPrefix(data, []byte("TZif")) || name == "localtime" {
+			return nil
+		}
+
+		file, err := writer.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Store})
+		if err != nil {
+			return err
+		}
+
+		_, err = file.Write(data)
+		return err
+	})
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := writer.Close(); err != nil {
+		log.Fatal(err)
+	}
+
+	var compressed bytes.Buffer
+	gz, _ := gzip.NewWriterLevel(&compressed, gzip.BestCompression)
+	gz.Write(archive.Bytes())
+	gz.Close()
+
+	encoded := base64.StdEncoding.EncodeToString(compressed.Bytes())
+
+	version := "unknown"
+	if zi, err := os.Open(filepath.Join(dir, "tzdata.zi")); err == nil {
+		scanner := bufio.NewScanner(zi)
+		if scanner.Scan() && strings.HasPrefix(scanner.Text(), "# version ") {
+			version = strings.TrimPrefix(scanner.Text(), "# version ")
+		}
+		zi.Close()
+	}
+
+	var out bytes.Buffer
+	out.WriteString("// Code generated by mktzdata.go; DO NOT EDIT.\n\npackage main\n\n")
+	out.WriteString("// tzdataVersion is the release of the IANA Time Zone Database in tzdataZip.\n")
+	out.WriteString("const tzdataVersion = \"" + version + "\"\n\n")
+	out.WriteString("// tzdataZip is a zip of the zone files, gzip compressed and base64 encoded.\n")
+	out.WriteString("const tzdataZip = `\n")
+	for len(encoded) > 0 {
+		n := 76
+		if len(encoded) < n {
+			n = len(encoded)
+		}
+		out.WriteString(encoded[:n] + "\n")
+		encoded = encoded[n:]
+	}
+	out.WriteString("`\n")
+
+	if err := ioutil.WriteFile("tzdata.go", out.Bytes(), 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,293 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	"github.com/hyperledger/fabric/protos/ledger/queryresult"
+	"github.com/hyperledger/fabric/protos/msp"
+	sc "github.com/hyperledger/fabric/protos/peer"
+)
+
+// attributes cua Fabric CA duoc ghi trong extension nay cua chung chi
+var attributesOID = asn1.ObjectIdentifier{1, 2, 3, 4, 5, 6, 7, 8, 1}
+
+// testStub fills in what shim.MockStub leaves out: the creator identity read
+// by cid, the transient map, the transaction time and the history of keys.
+type testStub struct {
+	*shim.MockStub
+	test      *testing.T
+	args      [][]byte
+	creator   []byte
+	Transient map[string][]byte
+	// TransientErr makes GetTransient fail, like a peer that can not read
+	// the proposal.
+	TransientErr error
+	Now          time.Time
+	Writes       int
+	history      map[string][]*queryresult.KeyModification
+	pvtState     map[string]map[string][]byte
+	txCount      int
+}
+
+func newTestStub(test *testing.T) *testStub {
+	return &testStub{
+		MockStub: shim.NewMockStub("testingStub", new(SmartContract)),
+		test:     test,
+		Now:      time.Date(2020, 9, 13, 0, 0, 0, 0, time.UTC),
+		history:  map[string][]*queryresult.KeyModification{},
+		pvtState: map[string]map[string][]byte{},
+	}
+}
+
+// as makes the next calls come from an enrollment certificate of the MSP
+// carrying the username attribute, like the ones server/cli registers.
+func (stub *testStub) as(MSPID string, Username string) *testStub {
+	return stub.withAttributes(MSPID, map[string]string{"username": Username})
+}
+
+func (stub *testStub) withAttributes(MSPID string, attributes map[string]string) *testStub {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+	if err != nil {
+		stub.test.Fatal(err)
+	}
+
+	attrs, _ := json.Marshal(map[string]map[string]string{"attrs": attributes})
+	template := x509.Certificate{
+		SerialNumber:    big.NewInt(int64(stub.txCount + 1)),
+		Subject:         pkix.Name{CommonName: attributes["username"]},
+		NotBefore:       time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC),
+		NotAfter:        time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+		ExtraExtensions: []pkix.Extension{{Id: attributesOID, Value: attrs}},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+
+	if err != nil {
+		stub.test.Fatal(err)
+	}
+
+	stub.creator, err = proto.Marshal(&msp.SerializedIdentity{
+		Mspid:   MSPID,
+		IdBytes: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	})
+
+	if err != nil {
+		stub.test.Fatal(err)
+	}
+
+	return stub
+}
+
+// at sets the transaction time, in UTC, of the next calls.
+func (stub *testStub) at(date string) *testStub {
+	now, err := time.Parse("2006-01-02 15:04", date)
+
+	if err != nil {
+		stub.test.Fatal(err)
+	}
+
+	stub.Now = now
+
+	return stub
+}
+
+func (stub *testStub) invoke(function string, args ...string) sc.Response {
+	stub.args = [][]byte{[]byte(function)}
+
+	for _, arg := range args {
+		stub.args = append(stub.args, []byte(arg))
+	}
+
+	stub.txCount++
+	txID := "tx" + strconv.Itoa(stub.txCount)
+
+	stub.MockTransactionStart(txID)
+	stub.TxTimestamp = &timestamp.Timestamp{Seconds: stub.Now.Unix()}
+	response := new(SmartContract).Invoke(stub)
+	stub.MockTransactionEnd(txID)
+
+	return response
+}
+
+func (stub *testStub) mustInvoke(function string, args ...string) []byte {
+	stub.test.Helper()
+
+	response := stub.invoke(function, args...)
+
+	if response.Status != shim.OK {
+		stub.test.Fatalf("%s(%v) failed: %s", function, args, response.Message)
+	}
+
+	return response.Payload
+}
+
+func (stub *testStub) mustFail(function string, args ...string) string {
+	stub.test.Helper()
+
+	response := stub.invoke(function, args...)
+
+	if response.Status == shim.OK {
+		stub.test.Fatalf("%s(%v) should fail, got %s", function, args, response.Payload)
+	}
+
+	return response.Message
+}
+
+// putState writes a record directly, e.g. a score without running a class.
+func (stub *testStub) putState(key string, value interface{}) {
+	valueAsBytes, _ := json.Marshal(value)
+
+	stub.MockTransactionStart("seed")
+	stub.PutState(key, valueAsBytes)
+	stub.MockTransactionEnd("seed")
+}
+
+func (stub *testStub) getState(key string, value interface{}) {
+	valueAsBytes, _ := stub.GetState(key)
+
+	json.Unmarshal(valueAsBytes, value)
+}
+
+func (stub *testStub) GetArgs() [][]byte {
+	return stub.args
+}
+
+func (stub *testStub) GetStringArgs() []string {
+	var args []string
+
+	for _, arg := range stub.args {
+		args = append(args, string(arg))
+	}
+
+	return args
+}
+
+func (stub *testStub) GetFunctionAndParameters() (string, []string) {
+	args := stub.GetStringArgs()
+
+	if len(args) == 0 {
+		return "", nil
+	}
+
+	return args[0], args[1:]
+}
+
+func (stub *testStub) GetCreator() ([]byte, error) {
+	return stub.creator, nil
+}
+
+func (stub *testStub) GetTransient() (map[string][]byte, error) {
+	if stub.TransientErr != nil {
+		return nil, stub.TransientErr
+	}
+
+	return stub.Transient, nil
+}
+
+func (stub *testStub) PutState(key string, value []byte) error {
+	stub.Writes++
+	stub.history[key] = append(stub.history[key], &queryresult.KeyModification{
+		TxId:      stub.TxID,
+		Value:     value,
+		Timestamp: stub.TxTimestamp,
+	})
+
+	return stub.MockStub.PutState(key, value)
+}
+
+func (stub *testStub) GetPrivateData(collection string, key string) ([]byte, error) {
+	return stub.pvtState[collection][key], nil
+}
+
+func (stub *testStub) PutPrivateData(collection string, key string, value []byte) error {
+	if stub.pvtState[collection] == nil {
+		stub.pvtState[collection] = map[string][]byte{}
+	}
+
+	stub.Writes++
+	stub.pvtState[collection][key] = value
+
+	return nil
+}
+
+func (stub *testStub) GetHistoryForKey(key string) (shim.HistoryQueryIteratorInterface, error) {
+	return &historyIterator{modifications: stub.history[key]}, nil
+}
+
+type historyIterator struct {
+	modifications []*queryresult.KeyModification
+	next          int
+}
+
+func (iter *historyIterator) HasNext() bool {
+	return iter.next < len(iter.modifications)
+}
+
+func (iter *historyIterator) Next() (*queryresult.KeyModification, error) {
+	if !iter.HasNext() {
+		return nil, errors.New("No more history!")
+	}
+
+	iter.next++
+
+	return iter.modifications[iter.next-1], nil
+}
+
+func (iter *historyIterator) Close() error {
+	return nil
+}
+
+const (
+	testClassSchedule = `{"DaysOfWeek":["Mon"],"StartTime":"08:00","EndTime":"10:00","StartDate":"2020-09-01","EndDate":"2020-10-01","Timezone":"Asia/Ho_Chi_Minh"}`
+	testSaltSecrets   = `{"st1":"secret-of-student-one","st2":"secret-of-student-two","st3":"secret-of-student-three"}`
+)
+
+// seedAcademy creates course C1 with subjects S1 and S2 in an active term,
+// teacher T1 qualified for both and student st1.
+func seedAcademy(stub *testStub) {
+	stub.as("AcademyMSP", "adminacademy")
+	stub.mustInvoke("CreateTeacher", "T1", "Teacher One")
+	stub.mustInvoke("CreateStudent", "st1", "Student One")
+	stub.mustInvoke("CreateSubject", "S1", "S1C", "Subject One", "short", "desc")
+	stub.mustInvoke("CreateSubject", "S2", "S2C", "Subject Two", "short", "desc")
+	stub.mustInvoke("CreateCourse", "C1", "C1C", "Course One", "short", "desc")
+	stub.mustInvoke("AddSubjectToCourse", "C1", "S1")
+	stub.mustInvoke("AddSubjectToCourse", "C1", "S2")
+	stub.mustInvoke("CreateAcademicTerm", "TM", "Term", "2019-01-01", "2021-12-01", "2019-01-01", "2021-12-31", "2022-01-31", "Asia/Ho_Chi_Minh")
+	stub.mustInvoke("SetAcademicTermStatus", "TM", "Active")
+	stub.mustInvoke("GrantTeacherQualification", "T1", "S1", "2019-01-01", "")
+	stub.mustInvoke("GrantTeacherQualification", "T1", "S2", "2019-01-01", "")
+
+	for _, RoomID := range []string{"R1", "R2", "R3"} {
+		stub.mustInvoke("CreateRoom", RoomID, "B1", "100", "")
+	}
+}
+
+// issueTestCertificate gives the student passing scores in C1 and issues
+// certificate CertificateID for it.
+func issueTestCertificate(stub *testStub, StudentUsername string, CertificateID string) {
+	stub.as("StudentMSP", StudentUsername)
+	stub.mustInvoke("StudentRegisterCourse", StudentUsername, "C1")
+	stub.putState("Score-"+" "+"Subject-S1"+" "+"Student-"+StudentUsername, Score{"S1", StudentUsername, 9})
+	stub.putState("Score-"+" "+"Subject-S2"+" "+"Student-"+StudentUsername, Score{"S2", StudentUsername, 7})
+
+	stub.Transient = map[string][]byte{TransientSaltSecrets: []byte(testSaltSecrets)}
+	stub.mustInvoke("CreateCertificate", CertificateID, "C1", StudentUsername, "2020-09-13")
+	stub.Transient = nil
+}
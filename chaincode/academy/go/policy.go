@@ -0,0 +1,579 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/hyperledger/fabric/core/chaincode/lib/cid"
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	sc "github.com/hyperledger/fabric/protos/peer"
+)
+
+// IssuancePolicy is evaluated by CreateCertificate on top of the base rule
+// that every subject of the course has a score. Zero values disable a
+// condition; SubjectWeights defaults to 1 for subjects that are not listed.
+// MaxCompletionDays runs from enrollment to the completion of the last
+// subject, not to the request for the certificate.
+type IssuancePolicy struct {
+	MinSubjectScore   float64
+	MinAverageScore   float64
+	MinAttendance     float64
+	MaxCompletionDays uint64
+	MinElectives      uint64
+	SubjectWeights    map[string]float64
+}
+
+// HonoursBand awards Level to certificates whose weighted average reaches
+// MinAverage. Bands are kept sorted from the highest threshold down.
+type HonoursBand struct {
+	Level      string
+	MinAverage float64
+}
+
+type Enrollment struct {
+	CourseID        string
+	StudentUsername string
+	EnrolledAt      string
+}
+
+type CertificateEligibility struct {
+	CourseID         string
+	StudentUsername  string
+	Eligible         bool
+	AverageScore     float64
+	PassedElectives  uint64
+	ExemptSubjects   []string
+	FailedConditions []string
+}
+
+func SetCourseIssuancePolicy(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	MSPID, err := cid.GetMSPID(stub)
+
+	if err != nil {
+		return shim.Error("Error - cid.GetMSPID()")
+	}
+
+	if MSPID != "AcademyMSP" {
+		return shim.Error("Permission Denied!")
+	}
+
+	if len(args) != 2 {
+		return shim.Error("Incorrect number of arguments. Expecting 2")
+	}
+
+	CourseID := args[0]
+
+	keyCourse := "Course-" + CourseID
+	course, err := getCourse(stub, keyCourse)
+
+	if err != nil {
+		return shim.Error("Course does not exist!")
+	}
+
+	var policy IssuancePolicy
+
+	if err := json.Unmarshal([]byte(args[1]), &policy); err != nil {
+		return shim.Error("Issuance policy must be a JSON object!")
+	}
+
+	if policy.MinSubjectScore < 0 || policy.MinAverageScore < 0 {
+		return shim.Error("Minimum scores can not be negative!")
+	}
+
+	if policy.MinAttendance < 0 || policy.MinAttendance > 100 {
+		return shim.Error("Minimum attendance must be a percentage!")
+	}
+
+	if policy.MinElectives > uint64(len(course.Electives)) {
+		return shim.Error("Course does not have enough electives!")
+	}
+
+	for SubjectID, weight := range policy.SubjectWeights {
+		if !containsString(course.Subjects, SubjectID) {
+			return shim.Error("Subject is not in course - " + SubjectID)
+		}
+
+		if weight <= 0 {
+			return shim.Error("Subject weight must be positive - " + SubjectID)
+		}
+	}
+
+	course.IssuancePolicy = policy
+
+	courseAsBytes, err := json.Marshal(course)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	stub.PutState(keyCourse, courseAsBytes)
+
+	return shim.Success(nil)
+}
+
+func AddElectiveToCourse(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	MSPID, err := cid.GetMSPID(stub)
+
+	if err != nil {
+		return shim.Error("Error - cid.GetMSPID()")
+	}
+
+	if MSPID != "AcademyMSP" {
+		return shim.Error("Permission Denied!")
+	}
+
+	if len(args) != 2 {
+		return shim.Error("Incorrect number of arguments. Expecting 2")
+	}
+
+	CourseID := args[0]
+	SubjectID := args[1]
+
+	keyCourse := "Course-" + CourseID
+	course, err := getCourse(stub, keyCourse)
+
+	if err != nil {
+		return shim.Error("Course does not exist!")
+	}
+
+	if course.Status == Closed {
+		return shim.Error("This course was closed!")
+	}
+
+	_, err = getSubject(stub, "Subject-"+SubjectID)
+
+	if err != nil {
+		return shim.Error("Subject does not exist!")
+	}
+
+	if containsString(course.Subjects, SubjectID) || containsString(course.Electives, SubjectID) {
+		return shim.Error("Subject is already in course!")
+	}
+
+	course.Electives = append(course.Electives, SubjectID)
+
+	courseAsBytes, err := json.Marshal(course)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	stub.PutState(keyCourse, courseAsBytes)
+
+	return shim.Success(nil)
+}
+
+func RemoveElectiveFromCourse(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	MSPID, err := cid.GetMSPID(stub)
+
+	if err != nil {
+		return shim.Error("Error - cid.GetMSPID()")
+	}
+
+	if MSPID != "AcademyMSP" {
+		return shim.Error("Permission Denied!")
+	}
+
+	if len(args) != 2 {
+		return shim.Error("Incorrect number of arguments. Expecting 2")
+	}
+
+	CourseID := args[0]
+	SubjectID := args[1]
+
+	keyCourse := "Course-" + CourseID
+	course, err := getCourse(stub, keyCourse)
+
+	if err != nil {
+		return shim.Error("Course does not exist!")
+	}
+
+	if !containsString(course.Electives, SubjectID) {
+		return shim.Error("Subject is not an elective of course!")
+	}
+
+	if uint64(len(course.Electives)-1) < course.IssuancePolicy.MinElectives {
+		return shim.Error("Issuance policy requires more electives!")
+	}
+
+	course.Electives = removeString(course.Electives, SubjectID)
+
+	courseAsBytes, err := json.Marshal(course)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	stub.PutState(keyCourse, courseAsBytes)
+
+	return shim.Success(nil)
+}
+
+func SetCourseHonoursBands(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	MSPID, err := cid.GetMSPID(stub)
+
+	if err != nil {
+		return shim.Error("Error - cid.GetMSPID()")
+	}
+
+	if MSPID != "AcademyMSP" {
+		return shim.Error("Permission Denied!")
+	}
+
+	if len(args) != 2 {
+		return shim.Error("Incorrect number of arguments. Expecting 2")
+	}
+
+	CourseID := args[0]
+
+	keyCourse := "Course-" + CourseID
+	course, err := getCourse(stub, keyCourse)
+
+	if err != nil {
+		return shim.Error("Course does not exist!")
+	}
+
+	var bands []HonoursBand
+
+	if err := json.Unmarshal([]byte(args[1]), &bands); err != nil {
+		return shim.Error("Honours bands must be a JSON array!")
+	}
+
+	var levels []string
+	for _, band := range bands {
+		if band.Level == "" {
+			return shim.Error("Honours level can not be empty!")
+		}
+
+		if band.MinAverage < 0 {
+			return shim.Error("Minimum average can not be negative - " + band.Level)
+		}
+
+		if containsString(levels, band.Level) {
+			return shim.Error("Duplicate honours level - " + band.Level)
+		}
+
+		levels = append(levels, band.Level)
+	}
+
+	sort.SliceStable(bands, func(i, j int) bool {
+		return bands[i].MinAverage > bands[j].MinAverage
+	})
+
+	course.HonoursBands = bands
+
+	courseAsBytes, err := json.Marshal(course)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	stub.PutState(keyCourse, courseAsBytes)
+
+	return shim.Success(nil)
+}
+
+func GetCertificatesByHonoursLevel(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	MSPID, err := cid.GetMSPID(stub)
+
+	if err != nil {
+		return shim.Error("Error - cid.GetMSPID()")
+	}
+
+	if MSPID != "AcademyMSP" {
+		return shim.Error("Permission Denied!")
+	}
+
+	if len(args) != 2 {
+		return shim.Error("Incorrect number of arguments. Expecting 2")
+	}
+
+	CourseID := args[0]
+	Level := args[1]
+
+	allCertificates, err := getListCertificates(stub)
+
+	if err != nil {
+		return shim.Error("Can not get certificates list!")
+	}
+
+	defer allCertificates.Close()
+
+	var tlist []Certificate
+
+	for allCertificates.HasNext() {
+		record, err := allCertificates.Next()
+		if err != nil {
+			return shim.Error(err.Error())
+		}
+
+		certificate := Certificate{}
+		json.Unmarshal(record.Value, &certificate)
+
+		if CourseID != "" && certificate.CourseID != CourseID {
+			continue
+		}
+
+		if certificate.HonoursLevel == Level {
+			tlist = append(tlist, certificate)
+		}
+	}
+
+	jsonRow, err := json.Marshal(tlist)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	return shim.Success(jsonRow)
+}
+
+func CheckCertificateEligibility(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	if len(args) != 2 {
+		return shim.Error("Incorrect number of arguments. Expecting 2")
+	}
+
+	CourseID := args[0]
+	StudentUsername := args[1]
+
+	course, err := getCourse(stub, "Course-"+CourseID)
+
+	if err != nil {
+		return shim.Error("Course does not exist - " + CourseID)
+	}
+
+	txTime, err := getTxTime(stub)
+
+	if err != nil {
+		return shim.Error("Can not get transaction timestamp!")
+	}
+
+	eligibility, err := evaluateIssuancePolicy(stub, course, StudentUsername, txTime)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	eligibilityAsBytes, err := json.Marshal(eligibility)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	return shim.Success(eligibilityAsBytes)
+}
+
+// evaluateIssuancePolicy checks every condition instead of stopping at the
+// first failure, so the caller can explain everything that is missing.
+func evaluateIssuancePolicy(stub shim.ChaincodeStubInterface, course Course, StudentUsername string, now time.Time) (CertificateEligibility, error) {
+
+	policy := course.IssuancePolicy
+
+	eligibility := CertificateEligibility{CourseID: course.CourseID, StudentUsername: StudentUsername}
+
+	var weightedSum, totalWeight float64
+
+	for _, SubjectID := range course.Subjects {
+		score, err := getScore(stub, "Score-"+" "+"Subject-"+SubjectID+" "+"Student-"+StudentUsername)
+		if err != nil {
+			if isExempt(stub, SubjectID, StudentUsername) {
+				eligibility.ExemptSubjects = append(eligibility.ExemptSubjects, SubjectID)
+			} else {
+				eligibility.FailedConditions = append(eligibility.FailedConditions, "Missing score of subject "+SubjectID)
+			}
+			continue
+		}
+
+		if score.ScoreValue < policy.MinSubjectScore {
+			eligibility.FailedConditions = append(eligibility.FailedConditions, "Score of subject "+SubjectID+" is below "+formatScore(policy.MinSubjectScore))
+		}
+
+		weight := 1.0
+		if w, ok := policy.SubjectWeights[SubjectID]; ok {
+			weight = w
+		}
+
+		weightedSum += weight * score.ScoreValue
+		totalWeight += weight
+	}
+
+	if totalWeight > 0 {
+		eligibility.AverageScore = weightedSum / totalWeight
+	}
+
+	if policy.MinAverageScore > 0 && eligibility.AverageScore < policy.MinAverageScore {
+		eligibility.FailedConditions = append(eligibility.FailedConditions, "Weighted average "+formatScore(eligibility.AverageScore)+" is below "+formatScore(policy.MinAverageScore))
+	}
+
+	for _, SubjectID := range course.Electives {
+		score, err := getScore(stub, "Score-"+" "+"Subject-"+SubjectID+" "+"Student-"+StudentUsername)
+		if err == nil && score.ScoreValue >= policy.MinSubjectScore {
+			eligibility.PassedElectives++
+		} else if err != nil && isExempt(stub, SubjectID, StudentUsername) {
+			eligibility.PassedElectives++
+			eligibility.ExemptSubjects = append(eligibility.ExemptSubjects, SubjectID)
+		}
+	}
+
+	if eligibility.PassedElectives < policy.MinElectives {
+		eligibility.FailedConditions = append(eligibility.FailedConditions, "Passed "+strconv.FormatUint(eligibility.PassedElectives, 10)+" of "+strconv.FormatUint(policy.MinElectives, 10)+" required electives")
+	}
+
+	if policy.MaxCompletionDays > 0 {
+		enrollment, err := getEnrollment(stub, "Enrollment-"+" "+"Course-"+course.CourseID+" "+"Student-"+StudentUsername)
+
+		// dang ky truoc khi co ban ghi Enrollment thi khong biet ngay bat dau, bo qua dieu kien
+		if err == nil {
+			enrolledAt, err := time.Parse(time.RFC3339, enrollment.EnrolledAt)
+			if err != nil {
+				return eligibility, errors.New("Invalid enrollment date - " + enrollment.EnrolledAt)
+			}
+
+			completedAt, completed, err := getCompletionTime(stub, course, StudentUsername)
+			if err != nil {
+				return eligibility, err
+			}
+
+			if !completed {
+				completedAt = now
+			}
+
+			if completedAt.Sub(enrolledAt) > time.Duration(policy.MaxCompletionDays)*24*time.Hour {
+				eligibility.FailedConditions = append(eligibility.FailedConditions, "Course was not completed within "+strconv.FormatUint(policy.MaxCompletionDays, 10)+" days")
+			}
+		}
+	}
+
+	err := checkAttendance(stub, course, StudentUsername, &eligibility)
+
+	if err != nil {
+		return eligibility, err
+	}
+
+	eligibility.Eligible = len(eligibility.FailedConditions) == 0
+
+	return eligibility, nil
+}
+
+// getCompletionTime returns when the student completed the last subject of
+// the course they have a score or an exemption for: the end of the class of
+// the counted attempt, or the time of the exemption. Attempts in classes
+// without a schedule end fall back to the time the score was recorded.
+func getCompletionTime(stub shim.ChaincodeStubInterface, course Course, StudentUsername string) (time.Time, bool, error) {
+
+	var completedAt time.Time
+	var completed = false
+
+	student, err := getStudent(stub, "Student-"+StudentUsername)
+
+	if err != nil {
+		return completedAt, false, errors.New("Student does not exist - " + StudentUsername)
+	}
+
+	for _, SubjectID := range append(append([]string{}, course.Subjects...), course.Electives...) {
+		var end time.Time
+
+		exemption, err := getExemption(stub, "Exemption-"+" "+"Subject-"+SubjectID+" "+"Student-"+StudentUsername)
+		if err == nil {
+			end, err = time.Parse(time.RFC3339, exemption.ExemptedAt)
+			if err != nil {
+				return completedAt, false, errors.New("Invalid exemption date - " + exemption.ExemptedAt)
+			}
+		} else {
+			score, err := getScore(stub, "Score-"+" "+"Subject-"+SubjectID+" "+"Student-"+StudentUsername)
+			if err != nil {
+				continue
+			}
+
+			var counted *Attempt
+			attempts := getAttempts(stub, student, SubjectID)
+			for i := range attempts {
+				if attempts[i].ScoreValue == score.ScoreValue {
+					counted = &attempts[i]
+				}
+			}
+
+			if counted == nil {
+				continue
+			}
+
+			class, err := getClass(stub, "Class-"+counted.ClassID)
+			if err == nil && class.Schedule.EndDate != "" {
+				location, err := loadLocation(class.Schedule.Timezone)
+				if err != nil {
+					return completedAt, false, errors.New("Unknown timezone - " + class.Schedule.Timezone)
+				}
+
+				end, err = time.ParseInLocation("2006-01-02", class.Schedule.EndDate, location)
+				if err != nil {
+					return completedAt, false, errors.New("Invalid end date of class - " + class.ClassID)
+				}
+
+				end = end.AddDate(0, 0, 1)
+			} else if counted.RecordedAt != "" {
+				end, err = time.Parse(time.RFC3339, counted.RecordedAt)
+				if err != nil {
+					return completedAt, false, errors.New("Invalid attempt date - " + counted.RecordedAt)
+				}
+			} else {
+				continue
+			}
+		}
+
+		if !completed || end.After(completedAt) {
+			completedAt = end
+			completed = true
+		}
+	}
+
+	return completedAt, completed, nil
+}
+
+// getHonoursLevel returns an empty level when no band is reached.
+func getHonoursLevel(course Course, average float64) string {
+
+	for _, band := range course.HonoursBands {
+		if average >= band.MinAverage {
+			return band.Level
+		}
+	}
+
+	return ""
+}
+
+func formatScore(score float64) string {
+	return strconv.FormatFloat(score, 'g', 4, 64)
+}
+
+func containsString(list []string, value string) bool {
+
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func removeString(list []string, value string) []string {
+
+	var result []string
+
+	for _, item := range list {
+		if item != value {
+			result = append(result, item)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestMaxCompletionDays(test *testing.T) {
+	stub := newTestStub(test)
+	seedAcademy(stub)
+
+	stub.at("2020-08-10 00:00").as("StudentMSP", "st1").mustInvoke("StudentRegisterCourse", "st1", "C1")
+
+	stub.as("AcademyMSP", "adminacademy")
+	stub.mustInvoke("CreateClass", "K1", "K1C", "R1", testClassSchedule, "S1", "30", "TM")
+	stub.mustInvoke("CreateClass", "K2", "K2C", "R2", strings.Replace(testClassSchedule, "Mon", "Tue", 1), "S2", "30", "TM")
+
+	for _, ClassID := range []string{"K1", "K2"} {
+		stub.as("AcademyMSP", "adminacademy").mustInvoke("AssignTeacherToClass", ClassID, "T1")
+		stub.at("2020-08-15 00:00").as("StudentMSP", "st1").mustInvoke("StudentRegisterClass", "st1", ClassID)
+		stub.at("2020-09-01 00:00").as("AcademyMSP", "adminacademy").mustInvoke("StartClass", ClassID)
+		stub.mustInvoke("PickScore", "T1", ClassID, "st1", "8")
+	}
+
+	check := func(MaxCompletionDays string) CertificateEligibility {
+		var eligibility CertificateEligibility
+		stub.as("AcademyMSP", "adminacademy")
+		stub.mustInvoke("SetCourseIssuancePolicy", "C1", `{"MaxCompletionDays":`+MaxCompletionDays+`}`)
+		json.Unmarshal(stub.mustInvoke("CheckCertificateEligibility", "C1", "st1"), &eligibility)
+		return eligibility
+	}
+
+	// lop cuoi ket thuc 2020-10-01, yeu cau cap chung chi muon van hop le
+	stub.at("2021-06-01 00:00")
+
+	if eligibility := check("60"); !eligibility.Eligible {
+		test.Fatalf("Course completed 53 days after enrollment should be eligible %+v", eligibility)
+	}
+
+	if eligibility := check("30"); eligibility.Eligible {
+		test.Fatal("Course completed 53 days after enrollment should miss a 30 day limit")
+	}
+
+	// sinh vien dang ky truoc khi co ban ghi Enrollment
+	stub.MockTransactionStart("legacy")
+	stub.DelState("Enrollment-" + " " + "Course-C1" + " " + "Student-st1")
+	stub.MockTransactionEnd("legacy")
+
+	if eligibility := check("30"); !eligibility.Eligible {
+		test.Fatalf("Unknown enrollment date should not fail the policy %+v", eligibility)
+	}
+}
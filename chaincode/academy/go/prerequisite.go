@@ -0,0 +1,496 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/hyperledger/fabric/core/chaincode/lib/cid"
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	sc "github.com/hyperledger/fabric/protos/peer"
+)
+
+const (
+	PrerequisiteSubject = "SUBJECT"
+	PrerequisiteAnd     = "AND"
+	PrerequisiteOr      = "OR"
+
+	maxPrerequisiteDepth = 16
+)
+
+// PrerequisiteRule is either a leaf requiring a score of at least MinScore in
+// SubjectID, or an AND/OR over nested Rules. A MinScore of 0 only requires
+// that the subject has been scored.
+type PrerequisiteRule struct {
+	Operator  string
+	SubjectID string             `json:",omitempty"`
+	MinScore  float64            `json:",omitempty"`
+	Rules     []PrerequisiteRule `json:",omitempty"`
+}
+
+type SubjectPrerequisiteGraph struct {
+	Subject
+	PrerequisiteGraph map[string]*PrerequisiteRule
+}
+
+// SetSubjectPrerequisites replaces the prerequisite rule of a subject. The
+// rule is either a rule object or a list of {SubjectID, MinScore} that must
+// all be met; an empty string removes the prerequisites.
+func SetSubjectPrerequisites(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	MSPID, err := cid.GetMSPID(stub)
+
+	if err != nil {
+		return shim.Error("Error - cid.GetMSPID()")
+	}
+
+	if MSPID != "AcademyMSP" {
+		return shim.Error("Permission Denied!")
+	}
+
+	if len(args) != 2 {
+		return shim.Error("Incorrect number of arguments. Expecting 2")
+	}
+
+	SubjectID := args[0]
+	keySubject := "Subject-" + SubjectID
+
+	subject, err := getSubject(stub, keySubject)
+
+	if err != nil {
+		return shim.Error("Subject does not exist !")
+	}
+
+	rule, err := parsePrerequisiteRule(args[1])
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	if rule != nil {
+		err = validatePrerequisiteRule(stub, SubjectID, *rule, 0)
+		if err != nil {
+			return shim.Error(err.Error())
+		}
+	}
+
+	subject.Prerequisites = rule
+
+	_, err = getPrerequisiteGraph(stub, subject)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	subjectAsBytes, err := json.Marshal(subject)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	stub.PutState(keySubject, subjectAsBytes)
+
+	return shim.Success(subjectAsBytes)
+}
+
+func parsePrerequisiteRule(input string) (*PrerequisiteRule, error) {
+
+	input = strings.TrimSpace(input)
+
+	if input == "" || input == "null" {
+		return nil, nil
+	}
+
+	var rule PrerequisiteRule
+
+	if strings.HasPrefix(input, "[") {
+		var list []PrerequisiteRule
+
+		err := json.Unmarshal([]byte(input), &list)
+		if err != nil {
+			return nil, errors.New("Prerequisite list is invalid!")
+		}
+
+		if len(list) == 0 {
+			return nil, nil
+		}
+
+		rule.Operator = PrerequisiteAnd
+		for _, item := range list {
+			item.Operator = PrerequisiteSubject
+			rule.Rules = append(rule.Rules, item)
+		}
+
+		return &rule, nil
+	}
+
+	err := json.Unmarshal([]byte(input), &rule)
+	if err != nil {
+		return nil, errors.New("Prerequisite rule is invalid!")
+	}
+
+	return &rule, nil
+}
+
+func validatePrerequisiteRule(stub shim.ChaincodeStubInterface, SubjectID string, rule PrerequisiteRule, depth int) error {
+
+	if depth > maxPrerequisiteDepth {
+		return errors.New("Prerequisite rule is nested too deep!")
+	}
+
+	switch rule.Operator {
+	case PrerequisiteSubject:
+		if len(rule.Rules) != 0 {
+			return errors.New("SUBJECT rule can not have nested rules!")
+		}
+
+		if rule.SubjectID == SubjectID {
+			return errors.New("Subject can not be a prerequisite of itself!")
+		}
+
+		if rule.MinScore < 0 {
+			return errors.New("Minimum score can not be negative!")
+		}
+
+		if _, err := getSubject(stub, "Subject-"+rule.SubjectID); err != nil {
+			return errors.New("Subject does not exist - " + rule.SubjectID)
+		}
+
+	case PrerequisiteAnd, PrerequisiteOr:
+		if rule.SubjectID != "" || len(rule.Rules) == 0 {
+			return errors.New(rule.Operator + " rule must have nested rules only!")
+		}
+
+		for _, nested := range rule.Rules {
+			err := validatePrerequisiteRule(stub, SubjectID, nested, depth+1)
+			if err != nil {
+				return err
+			}
+		}
+
+	default:
+		return errors.New("Unknown prerequisite operator - " + rule.Operator)
+	}
+
+	return nil
+}
+
+// getPrerequisiteGraph collects the rules of every subject reachable from
+// subject through its prerequisites and fails when a cycle leads back to a
+// subject still being visited.
+func getPrerequisiteGraph(stub shim.ChaincodeStubInterface, subject Subject) (map[string]*PrerequisiteRule, error) {
+
+	graph := make(map[string]*PrerequisiteRule)
+	visiting := make(map[string]bool)
+
+	var visit func(subject Subject) error
+	visit = func(subject Subject) error {
+		if visiting[subject.SubjectID] {
+			return errors.New("Prerequisites form a cycle through subject " + subject.SubjectID)
+		}
+
+		if _, done := graph[subject.SubjectID]; done {
+			return nil
+		}
+
+		visiting[subject.SubjectID] = true
+		graph[subject.SubjectID] = subject.Prerequisites
+
+		for _, SubjectID := range getPrerequisiteSubjects(subject.Prerequisites) {
+			next, err := getSubject(stub, "Subject-"+SubjectID)
+			if err != nil {
+				return errors.New("Subject does not exist - " + SubjectID)
+			}
+
+			err = visit(next)
+			if err != nil {
+				return err
+			}
+		}
+
+		visiting[subject.SubjectID] = false
+
+		return nil
+	}
+
+	err := visit(subject)
+
+	return graph, err
+}
+
+func getPrerequisiteSubjects(rule *PrerequisiteRule) []string {
+
+	if rule == nil {
+		return nil
+	}
+
+	if rule.Operator == PrerequisiteSubject {
+		return []string{rule.SubjectID}
+	}
+
+	var subjects []string
+	for i := range rule.Rules {
+		for _, SubjectID := range getPrerequisiteSubjects(&rule.Rules[i]) {
+			if !containsString(subjects, SubjectID) {
+				subjects = append(subjects, SubjectID)
+			}
+		}
+	}
+
+	sort.Strings(subjects)
+
+	return subjects
+}
+
+// evaluatePrerequisiteRule returns a description of every unmet part of the
+// rule; an empty result means the student may register.
+func evaluatePrerequisiteRule(stub shim.ChaincodeStubInterface, rule PrerequisiteRule, StudentUsername string) ([]string, error) {
+
+	switch rule.Operator {
+	case PrerequisiteSubject:
+		description := rule.SubjectID
+		if rule.MinScore > 0 {
+			description += " with score at least " + formatScore(rule.MinScore)
+		}
+
+		if isExempt(stub, rule.SubjectID, StudentUsername) {
+			return nil, nil
+		}
+
+		score, err := getScore(stub, "Score-"+" "+"Subject-"+rule.SubjectID+" "+"Student-"+StudentUsername)
+		if err != nil || score.ScoreValue < rule.MinScore {
+			return []string{description}, nil
+		}
+
+		return nil, nil
+
+	case PrerequisiteAnd:
+		var unmet []string
+		for _, nested := range rule.Rules {
+			failed, err := evaluatePrerequisiteRule(stub, nested, StudentUsername)
+			if err != nil {
+				return nil, err
+			}
+			unmet = append(unmet, failed...)
+		}
+
+		return unmet, nil
+
+	case PrerequisiteOr:
+		var options []string
+		for _, nested := range rule.Rules {
+			failed, err := evaluatePrerequisiteRule(stub, nested, StudentUsername)
+			if err != nil {
+				return nil, err
+			}
+
+			if len(failed) == 0 {
+				return nil, nil
+			}
+			options = append(options, strings.Join(failed, " and "))
+		}
+
+		return []string{"one of (" + strings.Join(options, " | ") + ")"}, nil
+	}
+
+	return nil, errors.New("Unknown prerequisite operator - " + rule.Operator)
+}
+
+// getDependentSubjects lists the subjects whose rules mention SubjectID.
+func getDependentSubjects(stub shim.ChaincodeStubInterface, SubjectID string) ([]string, error) {
+
+	resultsIterator, err := getListSubjects(stub)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer resultsIterator.Close()
+
+	var dependents []string
+
+	for resultsIterator.HasNext() {
+		queryResponse, err := resultsIterator.Next()
+		if err != nil {
+			return nil, err
+		}
+
+		var subject Subject
+		json.Unmarshal(queryResponse.Value, &subject)
+
+		if containsString(getPrerequisiteSubjects(subject.Prerequisites), SubjectID) {
+			dependents = append(dependents, subject.SubjectID)
+		}
+	}
+
+	return dependents, nil
+}
+
+func AddPrerequisiteToCourse(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	MSPID, err := cid.GetMSPID(stub)
+
+	if err != nil {
+		return shim.Error("Error - cid.GetMSPID()")
+	}
+
+	if MSPID != "AcademyMSP" {
+		return shim.Error("Permission Denied!")
+	}
+
+	if len(args) != 2 {
+		return shim.Error("Incorrect number of arguments. Expecting 2")
+	}
+
+	CourseID := args[0]
+	RequiredCourseID := args[1]
+
+	keyCourse := "Course-" + CourseID
+	course, err := getCourse(stub, keyCourse)
+
+	if err != nil {
+		return shim.Error("Course does not exist!")
+	}
+
+	if course.Status == Closed {
+		return shim.Error("This course was closed!")
+	}
+
+	if CourseID == RequiredCourseID {
+		return shim.Error("Course can not be a prerequisite of itself!")
+	}
+
+	if containsString(course.Prerequisites, RequiredCourseID) {
+		return shim.Error("Course is already a prerequisite!")
+	}
+
+	required, err := getCourse(stub, "Course-"+RequiredCourseID)
+
+	if err != nil {
+		return shim.Error("Prerequisite course does not exist!")
+	}
+
+	cycle, err := courseRequires(stub, required, CourseID, 0)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	if cycle {
+		return shim.Error("Prerequisites form a cycle through course " + RequiredCourseID)
+	}
+
+	course.Prerequisites = append(course.Prerequisites, RequiredCourseID)
+
+	courseAsBytes, err := json.Marshal(course)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	stub.PutState(keyCourse, courseAsBytes)
+
+	return shim.Success(nil)
+}
+
+func RemovePrerequisiteFromCourse(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	MSPID, err := cid.GetMSPID(stub)
+
+	if err != nil {
+		return shim.Error("Error - cid.GetMSPID()")
+	}
+
+	if MSPID != "AcademyMSP" {
+		return shim.Error("Permission Denied!")
+	}
+
+	if len(args) != 2 {
+		return shim.Error("Incorrect number of arguments. Expecting 2")
+	}
+
+	CourseID := args[0]
+	RequiredCourseID := args[1]
+
+	keyCourse := "Course-" + CourseID
+	course, err := getCourse(stub, keyCourse)
+
+	if err != nil {
+		return shim.Error("Course does not exist!")
+	}
+
+	if !containsString(course.Prerequisites, RequiredCourseID) {
+		return shim.Error("Course is not a prerequisite!")
+	}
+
+	course.Prerequisites = removeString(course.Prerequisites, RequiredCourseID)
+
+	courseAsBytes, err := json.Marshal(course)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	stub.PutState(keyCourse, courseAsBytes)
+
+	return shim.Success(nil)
+}
+
+// courseRequires reports whether course needs CourseID, directly or through
+// the prerequisites of its prerequisites.
+func courseRequires(stub shim.ChaincodeStubInterface, course Course, CourseID string, depth int) (bool, error) {
+
+	if depth > maxPrerequisiteDepth {
+		return false, errors.New("Course prerequisites are nested too deep!")
+	}
+
+	for _, RequiredCourseID := range course.Prerequisites {
+		if RequiredCourseID == CourseID {
+			return true, nil
+		}
+
+		required, err := getCourse(stub, "Course-"+RequiredCourseID)
+		if err != nil {
+			continue
+		}
+
+		found, err := courseRequires(stub, required, CourseID, depth+1)
+		if err != nil || found {
+			return found, err
+		}
+	}
+
+	return false, nil
+}
+
+// getMissingCoursePrerequisites lists the prerequisite courses for which the
+// student holds no valid certificate. Revoked and superseded versions do not
+// count; a reissued certificate counts through its current version.
+// Certificates issued before statuses existed have none and are valid.
+func getMissingCoursePrerequisites(stub shim.ChaincodeStubInterface, course Course, student Student) ([]string, error) {
+
+	var missing []string
+
+	for _, RequiredCourseID := range course.Prerequisites {
+		found := false
+
+		for _, CertificateID := range student.Certificates {
+			certificate, err := getCertificate(stub, "Certificate-"+CertificateID)
+			if err != nil {
+				return nil, err
+			}
+
+			if certificate.CourseID == RequiredCourseID && getCertificateStatus(certificate).Status == Valid {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			missing = append(missing, RequiredCourseID)
+		}
+	}
+
+	return missing, nil
+}
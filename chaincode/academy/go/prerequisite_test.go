@@ -0,0 +1,51 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestSubjectPrerequisiteCycles(test *testing.T) {
+	stub := newTestStub(test)
+	seedAcademy(stub)
+	stub.mustInvoke("CreateSubject", "S3", "S3C", "Subject Three", "short", "desc")
+
+	if !strings.Contains(stub.mustFail("SetSubjectPrerequisites", "S1", `[{"SubjectID":"S1","MinScore":5}]`), "itself") {
+		test.Fatal("Subject must not be a prerequisite of itself")
+	}
+
+	stub.mustInvoke("SetSubjectPrerequisites", "S2", `[{"SubjectID":"S1","MinScore":5}]`)
+
+	if !strings.Contains(stub.mustFail("SetSubjectPrerequisites", "S1", `[{"SubjectID":"S2"}]`), "cycle") {
+		test.Fatal("Cycle of two subjects must be rejected")
+	}
+
+	// S3 can toi S1 qua hai duong, khong phai la chu trinh
+	stub.mustInvoke("SetSubjectPrerequisites", "S3", `{"Operator":"OR","Rules":[{"Operator":"SUBJECT","SubjectID":"S1"},{"Operator":"AND","Rules":[{"Operator":"SUBJECT","SubjectID":"S2"},{"Operator":"SUBJECT","SubjectID":"S1"}]}]}`)
+
+	// chu trinh qua luat long nhau S1 -> S3 -> S2 -> S1
+	if !strings.Contains(stub.mustFail("SetSubjectPrerequisites", "S1", `{"Operator":"AND","Rules":[{"Operator":"OR","Rules":[{"Operator":"SUBJECT","SubjectID":"S3"}]}]}`), "cycle") {
+		test.Fatal("Cycle through nested rules must be rejected")
+	}
+
+	var subject SubjectPrerequisiteGraph
+	json.Unmarshal(stub.mustInvoke("GetSubject", "S1"), &subject)
+
+	if subject.Prerequisites != nil {
+		test.Fatalf("Rejected rule must not be stored %+v", subject.Prerequisites)
+	}
+
+	var graph SubjectPrerequisiteGraph
+	json.Unmarshal(stub.mustInvoke("GetSubject", "S3"), &graph)
+
+	if _, ok := graph.PrerequisiteGraph["S1"]; !ok || len(graph.PrerequisiteGraph) != 2 || graph.PrerequisiteGraph["S2"] == nil {
+		test.Fatalf("Unexpected prerequisite graph %+v", graph.PrerequisiteGraph)
+	}
+
+	// S3 chi can S2 va S2 khong con can S1 thi S1 duoc phep can S3
+	stub.mustInvoke("SetSubjectPrerequisites", "S3", `[{"SubjectID":"S2"}]`)
+	stub.mustFail("SetSubjectPrerequisites", "S1", `[{"SubjectID":"S3"}]`)
+	stub.mustInvoke("SetSubjectPrerequisites", "S2", "")
+	stub.mustInvoke("SetSubjectPrerequisites", "S1", `[{"SubjectID":"S3"}]`)
+}
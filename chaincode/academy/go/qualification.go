@@ -0,0 +1,229 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/hyperledger/fabric/core/chaincode/lib/cid"
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	sc "github.com/hyperledger/fabric/protos/peer"
+)
+
+// Qualification allows a teacher to teach a subject from EffectiveFrom to
+// EffectiveUntil, both YYYY-MM-DD and inclusive. An empty EffectiveUntil
+// never expires.
+type Qualification struct {
+	SubjectID      string
+	EffectiveFrom  string
+	EffectiveUntil string
+}
+
+// GrantTeacherQualification adds a subject to the qualifications of a
+// teacher, replacing the dates of an earlier grant of the same subject.
+func GrantTeacherQualification(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	MSPID, err := cid.GetMSPID(stub)
+
+	if err != nil {
+		return shim.Error("Error - cid.GetMSPID()")
+	}
+
+	if MSPID != "AcademyMSP" {
+		return shim.Error("Permission Denied!")
+	}
+
+	if len(args) != 4 {
+		return shim.Error("Incorrect number of arguments. Expecting 4")
+	}
+
+	Username := args[0]
+	qualification := Qualification{SubjectID: args[1], EffectiveFrom: args[2], EffectiveUntil: args[3]}
+
+	keyTeacher := "Teacher-" + Username
+	teacher, err := getTeacher(stub, keyTeacher)
+
+	if err != nil {
+		return shim.Error("Teacher does not exist!")
+	}
+
+	_, err = getSubject(stub, "Subject-"+qualification.SubjectID)
+
+	if err != nil {
+		return shim.Error("Subject does not exist !")
+	}
+
+	if _, err := time.Parse(scheduleDateLayout, qualification.EffectiveFrom); err != nil {
+		return shim.Error("Effective from must be YYYY-MM-DD!")
+	}
+
+	if qualification.EffectiveUntil != "" {
+		if _, err := time.Parse(scheduleDateLayout, qualification.EffectiveUntil); err != nil {
+			return shim.Error("Effective until must be YYYY-MM-DD!")
+		}
+
+		if qualification.EffectiveUntil < qualification.EffectiveFrom {
+			return shim.Error("Effective from must occur before effective until!")
+		}
+	}
+
+	qualifications := []Qualification{}
+	for _, granted := range teacher.Qualifications {
+		if granted.SubjectID != qualification.SubjectID {
+			qualifications = append(qualifications, granted)
+		}
+	}
+
+	teacher.Qualifications = append(qualifications, qualification)
+
+	teacherAsBytes, err := json.Marshal(teacher)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	stub.PutState(keyTeacher, teacherAsBytes)
+
+	return shim.Success(teacherAsBytes)
+}
+
+// RevokeTeacherQualification removes a subject from the qualifications of a
+// teacher. Classes already assigned are kept.
+func RevokeTeacherQualification(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	MSPID, err := cid.GetMSPID(stub)
+
+	if err != nil {
+		return shim.Error("Error - cid.GetMSPID()")
+	}
+
+	if MSPID != "AcademyMSP" {
+		return shim.Error("Permission Denied!")
+	}
+
+	if len(args) != 2 {
+		return shim.Error("Incorrect number of arguments. Expecting 2")
+	}
+
+	Username := args[0]
+	SubjectID := args[1]
+
+	keyTeacher := "Teacher-" + Username
+	teacher, err := getTeacher(stub, keyTeacher)
+
+	if err != nil {
+		return shim.Error("Teacher does not exist!")
+	}
+
+	qualifications := []Qualification{}
+	for _, granted := range teacher.Qualifications {
+		if granted.SubjectID != SubjectID {
+			qualifications = append(qualifications, granted)
+		}
+	}
+
+	if len(qualifications) == len(teacher.Qualifications) {
+		return shim.Error("The teacher is not qualified for this subject!")
+	}
+
+	teacher.Qualifications = qualifications
+
+	teacherAsBytes, err := json.Marshal(teacher)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	stub.PutState(keyTeacher, teacherAsBytes)
+
+	return shim.Success(teacherAsBytes)
+}
+
+// GetEligibleTeachersOfClass lists the teachers AssignTeacherToClass would
+// accept for the class: qualified for its subject and free at its times.
+func GetEligibleTeachersOfClass(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	if len(args) != 1 {
+		return shim.Error("Incorrect number of arguments. Expecting 1")
+	}
+
+	ClassID := args[0]
+
+	class, err := getClass(stub, "Class-"+ClassID)
+
+	if err != nil {
+		return shim.Error("Class does not exist - " + ClassID)
+	}
+
+	txTime, err := getTxTime(stub)
+
+	if err != nil {
+		return shim.Error("Can not get transaction timestamp!")
+	}
+
+	allTeachers, err := getListTeachers(stub)
+
+	if err != nil {
+		return shim.Error("Failed to get data in the ledger")
+	}
+
+	defer allTeachers.Close()
+
+	teachers := []Teacher{}
+	for allTeachers.HasNext() {
+		record, err := allTeachers.Next()
+
+		if err != nil {
+			return shim.Error("Failed to get data in the ledger")
+		}
+
+		teacher := Teacher{}
+		json.Unmarshal(record.Value, &teacher)
+
+		if checkQualification(teacher, class, txTime) != nil {
+			continue
+		}
+
+		if checkScheduleConflicts(stub, teacher.Classes, class) != nil {
+			continue
+		}
+
+		teachers = append(teachers, teacher)
+	}
+
+	teachersAsBytes, err := json.Marshal(teachers)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	return shim.Success(teachersAsBytes)
+}
+
+// checkQualification fails unless teacher holds a qualification for the
+// subject of class that is effective for the whole schedule of the class.
+// Classes without a schedule are checked against the transaction date.
+func checkQualification(teacher Teacher, class Class, txTime time.Time) error {
+
+	from := class.Schedule.StartDate
+	until := class.Schedule.EndDate
+
+	if from == "" {
+		from = txTime.UTC().Format(scheduleDateLayout)
+		until = from
+	}
+
+	for _, qualification := range teacher.Qualifications {
+		if qualification.SubjectID != class.SubjectID {
+			continue
+		}
+
+		if qualification.EffectiveFrom <= from && (qualification.EffectiveUntil == "" || qualification.EffectiveUntil >= until) {
+			return nil
+		}
+
+		return errors.New("Qualification of teacher " + teacher.Username + " for subject " + class.SubjectID + " does not cover " + from + " to " + until + "!")
+	}
+
+	return errors.New("Teacher " + teacher.Username + " is not qualified for subject " + class.SubjectID + "!")
+}
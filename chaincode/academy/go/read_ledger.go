@@ -3,6 +3,7 @@ package main
 import (
 	"encoding/json"
 	"errors"
+	"time"
 
 	"github.com/hyperledger/fabric/core/chaincode/shim"
 )
@@ -138,6 +139,283 @@ func getCertificate(stub shim.ChaincodeStubInterface, compoundKey string) (Certi
 	return certificate, nil
 }
 
+func getEnrollment(stub shim.ChaincodeStubInterface, compoundKey string) (Enrollment, error) {
+
+	var enrollment Enrollment
+
+	enrollmentAsBytes, err := stub.GetState(compoundKey)
+
+	if err != nil {
+		return enrollment, errors.New("Failed to get enrollment - " + compoundKey)
+	}
+
+	if enrollmentAsBytes == nil {
+		return enrollment, errors.New("Enrollment does not exist - " + compoundKey)
+	}
+
+	json.Unmarshal(enrollmentAsBytes, &enrollment)
+
+	return enrollment, nil
+}
+
+func getVerificationCode(stub shim.ChaincodeStubInterface, compoundKey string) (VerificationCode, error) {
+
+	var verificationCode VerificationCode
+
+	verificationCodeAsBytes, err := stub.GetState(compoundKey)
+
+	if err != nil {
+		return verificationCode, errors.New("Failed to get verification code - " + compoundKey)
+	}
+
+	if verificationCodeAsBytes == nil {
+		return verificationCode, errors.New("Verification code does not exist - " + compoundKey)
+	}
+
+	json.Unmarshal(verificationCodeAsBytes, &verificationCode)
+
+	return verificationCode, nil
+}
+
+func getCertificateGrant(stub shim.ChaincodeStubInterface, compoundKey string) (CertificateGrant, error) {
+
+	var grant CertificateGrant
+
+	grantAsBytes, err := stub.GetState(compoundKey)
+
+	if err != nil {
+		return grant, errors.New("Failed to get grant - " + compoundKey)
+	}
+
+	if grantAsBytes == nil {
+		return grant, errors.New("Grant does not exist - " + compoundKey)
+	}
+
+	json.Unmarshal(grantAsBytes, &grant)
+
+	return grant, nil
+}
+
+func getScoreCommitment(stub shim.ChaincodeStubInterface, compoundKey string) (ScoreCommitment, error) {
+
+	var commitment ScoreCommitment
+
+	commitmentAsBytes, err := stub.GetState(compoundKey)
+
+	if err != nil {
+		return commitment, errors.New("Failed to get score commitment - " + compoundKey)
+	}
+
+	if commitmentAsBytes == nil {
+		return commitment, errors.New("Score commitment does not exist - " + compoundKey)
+	}
+
+	json.Unmarshal(commitmentAsBytes, &commitment)
+
+	return commitment, nil
+}
+
+func getBatchIssuanceProgress(stub shim.ChaincodeStubInterface, compoundKey string) (BatchIssuanceProgress, error) {
+
+	var progress BatchIssuanceProgress
+
+	progressAsBytes, err := stub.GetState(compoundKey)
+
+	if err != nil {
+		return progress, errors.New("Failed to get batch issuance progress - " + compoundKey)
+	}
+
+	if progressAsBytes == nil {
+		return progress, errors.New("Batch issuance progress does not exist - " + compoundKey)
+	}
+
+	json.Unmarshal(progressAsBytes, &progress)
+
+	return progress, nil
+}
+
+func getMicroCredential(stub shim.ChaincodeStubInterface, compoundKey string) (MicroCredential, error) {
+
+	var microCredential MicroCredential
+
+	microCredentialAsBytes, err := stub.GetState(compoundKey)
+
+	if err != nil {
+		return microCredential, errors.New("Failed to get micro-credential - " + compoundKey)
+	}
+
+	if microCredentialAsBytes == nil {
+		return microCredential, errors.New("Micro-credential does not exist - " + compoundKey)
+	}
+
+	json.Unmarshal(microCredentialAsBytes, &microCredential)
+
+	return microCredential, nil
+}
+
+func getMicroCredentialGrant(stub shim.ChaincodeStubInterface, compoundKey string) (MicroCredentialGrant, error) {
+
+	var grant MicroCredentialGrant
+
+	grantAsBytes, err := stub.GetState(compoundKey)
+
+	if err != nil {
+		return grant, errors.New("Failed to get grant - " + compoundKey)
+	}
+
+	if grantAsBytes == nil {
+		return grant, errors.New("Grant does not exist - " + compoundKey)
+	}
+
+	json.Unmarshal(grantAsBytes, &grant)
+
+	return grant, nil
+}
+
+func getAttempt(stub shim.ChaincodeStubInterface, compoundKey string) (Attempt, error) {
+
+	var attempt Attempt
+
+	attemptAsBytes, err := stub.GetState(compoundKey)
+
+	if err != nil {
+		return attempt, errors.New("Failed to get attempt - " + compoundKey)
+	}
+
+	if attemptAsBytes == nil {
+		return attempt, errors.New("Attempt does not exist - " + compoundKey)
+	}
+
+	json.Unmarshal(attemptAsBytes, &attempt)
+
+	return attempt, nil
+}
+
+func getExemption(stub shim.ChaincodeStubInterface, compoundKey string) (Exemption, error) {
+
+	var exemption Exemption
+
+	exemptionAsBytes, err := stub.GetState(compoundKey)
+
+	if err != nil {
+		return exemption, errors.New("Failed to get exemption - " + compoundKey)
+	}
+
+	if exemptionAsBytes == nil {
+		return exemption, errors.New("Exemption does not exist - " + compoundKey)
+	}
+
+	json.Unmarshal(exemptionAsBytes, &exemption)
+
+	return exemption, nil
+}
+
+func getRoom(stub shim.ChaincodeStubInterface, compoundKey string) (Room, error) {
+
+	var room Room
+
+	roomAsBytes, err := stub.GetState(compoundKey)
+
+	if err != nil {
+		return room, errors.New("Failed to get room - " + compoundKey)
+	}
+
+	if roomAsBytes == nil {
+		return room, errors.New("Room does not exist - " + compoundKey)
+	}
+
+	json.Unmarshal(roomAsBytes, &room)
+
+	return room, nil
+}
+
+func getAcademicTerm(stub shim.ChaincodeStubInterface, compoundKey string) (AcademicTerm, error) {
+
+	var term AcademicTerm
+
+	termAsBytes, err := stub.GetState(compoundKey)
+
+	if err != nil {
+		return term, errors.New("Failed to get term - " + compoundKey)
+	}
+
+	if termAsBytes == nil {
+		return term, errors.New("Term does not exist - " + compoundKey)
+	}
+
+	json.Unmarshal(termAsBytes, &term)
+
+	return term, nil
+}
+
+func getClassSession(stub shim.ChaincodeStubInterface, compoundKey string) (ClassSession, error) {
+
+	var session ClassSession
+
+	sessionAsBytes, err := stub.GetState(compoundKey)
+
+	if err != nil {
+		return session, errors.New("Failed to get class session - " + compoundKey)
+	}
+
+	if sessionAsBytes == nil {
+		return session, errors.New("Class session does not exist - " + compoundKey)
+	}
+
+	json.Unmarshal(sessionAsBytes, &session)
+
+	return session, nil
+}
+
+func getAssessment(stub shim.ChaincodeStubInterface, compoundKey string) (Assessment, error) {
+
+	var assessment Assessment
+
+	assessmentAsBytes, err := stub.GetState(compoundKey)
+
+	if err != nil {
+		return assessment, errors.New("Failed to get assessment - " + compoundKey)
+	}
+
+	if assessmentAsBytes == nil {
+		return assessment, errors.New("Assessment does not exist - " + compoundKey)
+	}
+
+	json.Unmarshal(assessmentAsBytes, &assessment)
+
+	return assessment, nil
+}
+
+func getSubmission(stub shim.ChaincodeStubInterface, compoundKey string) (Submission, error) {
+
+	var submission Submission
+
+	submissionAsBytes, err := stub.GetState(compoundKey)
+
+	if err != nil {
+		return submission, errors.New("Failed to get submission - " + compoundKey)
+	}
+
+	if submissionAsBytes == nil {
+		return submission, errors.New("Submission does not exist - " + compoundKey)
+	}
+
+	json.Unmarshal(submissionAsBytes, &submission)
+
+	return submission, nil
+}
+
+func getTxTime(stub shim.ChaincodeStubInterface) (time.Time, error) {
+
+	txTimestamp, err := stub.GetTxTimestamp()
+
+	if err != nil {
+		return time.Time{}, errors.New("Failed to get transaction timestamp")
+	}
+
+	return time.Unix(txTimestamp.Seconds, int64(txTimestamp.Nanos)).UTC(), nil
+}
+
 func getListSubjects(stub shim.ChaincodeStubInterface) (shim.StateQueryIteratorInterface, error) {
 
 	startKey := "Subject-"
@@ -228,3 +506,29 @@ func getListCertificates(stub shim.ChaincodeStubInterface) (shim.StateQueryItera
 
 	return resultIter, nil
 }
+
+func getListRooms(stub shim.ChaincodeStubInterface) (shim.StateQueryIteratorInterface, error) {
+
+	startKey := "Room-"
+	endKey := "Room-zzzzzzzz"
+
+	resultIter, err := stub.GetStateByRange(startKey, endKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return resultIter, nil
+}
+
+func getListAcademicTerms(stub shim.ChaincodeStubInterface) (shim.StateQueryIteratorInterface, error) {
+
+	startKey := "Term-"
+	endKey := "Term-zzzzzzzz"
+
+	resultIter, err := stub.GetStateByRange(startKey, endKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return resultIter, nil
+}
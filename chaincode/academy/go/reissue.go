@@ -0,0 +1,201 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/hyperledger/fabric/core/chaincode/lib/cid"
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	sc "github.com/hyperledger/fabric/protos/peer"
+)
+
+const maxReissueChain = 32
+
+type CertificateVerification struct {
+	CertificateID        string
+	Status               Status
+	CurrentCertificateID string
+	Chain                []string
+	Certificate          Certificate
+}
+
+func ReissueCertificate(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	MSPID, err := cid.GetMSPID(stub)
+
+	if err != nil {
+		return shim.Error("Error - cid.GetMSPID()")
+	}
+
+	if MSPID != "AcademyMSP" {
+		return shim.Error("Permission Denied!")
+	}
+
+	if len(args) != 2 {
+		return shim.Error("Incorrect number of arguments. Expecting 2")
+	}
+
+	OldCertificateID := args[0]
+	Reason := args[1]
+
+	if Reason == "" {
+		return shim.Error("Reason of reissue can not be empty!")
+	}
+
+	keyOldCertificate := "Certificate-" + OldCertificateID
+	oldCertificate, err := getCertificate(stub, keyOldCertificate)
+
+	if err != nil {
+		return shim.Error("Certificate does not exist!")
+	}
+
+	if oldCertificate.Status == Revoked {
+		return shim.Error("This certificate was revoked!")
+	}
+
+	if oldCertificate.Status == Superseded {
+		return shim.Error("This certificate was reissued as " + oldCertificate.SupersededBy)
+	}
+
+	keyStudent := "Student-" + oldCertificate.StudentUsername
+	student, err := getStudent(stub, keyStudent)
+
+	if err != nil {
+		return shim.Error("Student does not exist!")
+	}
+
+	txTime, err := getTxTime(stub)
+
+	if err != nil {
+		return shim.Error("Can not get transaction timestamp!")
+	}
+
+	CertificateID := deriveUUID("Certificate", stub.GetTxID(), OldCertificateID)
+	keyCertificate := "Certificate-" + CertificateID
+
+	Code, err := newVerificationCode(stub, CertificateID, nil)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	certificate := oldCertificate
+	certificate.CertificateID = CertificateID
+	certificate.IssueDate = txTime.Format("2006-01-02")
+	certificate.Status = Valid
+	certificate.StudentFullname = student.Fullname
+	certificate.VerificationCode = Code
+	certificate.Supersedes = OldCertificateID
+	certificate.SupersededBy = ""
+	certificate.ReissueReason = Reason
+
+	oldCertificate.Status = Superseded
+	oldCertificate.SupersededBy = CertificateID
+
+	student.Certificates = append(student.Certificates, CertificateID)
+
+	certificateAsBytes, err := json.Marshal(certificate)
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	oldCertificateAsBytes, err := json.Marshal(oldCertificate)
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	studentAsBytes, err := json.Marshal(student)
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	course, err := getCourse(stub, "Course-"+certificate.CourseID)
+	if err != nil {
+		return shim.Error("Course does not exist!")
+	}
+
+	err = commitCertificateScores(stub, CertificateID, course, student.Username)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	err = putVerificationCode(stub, VerificationCode{Code: Code, CertificateID: CertificateID})
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	stub.PutState(keyCertificate, certificateAsBytes)
+	stub.PutState(keyOldCertificate, oldCertificateAsBytes)
+	stub.PutState(keyStudent, studentAsBytes)
+
+	return shim.Success(certificateAsBytes)
+}
+
+// VerifyCertificate follows the reissue chain from any version of a
+// certificate to the current one.
+func VerifyCertificate(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	if len(args) != 1 {
+		return shim.Error("Incorrect number of arguments. Expecting 1")
+	}
+
+	CertificateID := args[0]
+
+	certificate, chain, err := getCurrentCertificate(stub, CertificateID)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	err = checkGuestAccess(stub, chain, "VerifyCertificate")
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	verification := CertificateVerification{
+		CertificateID:        CertificateID,
+		Status:               getCertificateStatus(certificate).Status,
+		CurrentCertificateID: certificate.CertificateID,
+		Chain:                chain,
+		Certificate:          certificate,
+	}
+
+	verificationAsBytes, err := json.Marshal(verification)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	return shim.Success(verificationAsBytes)
+}
+
+// getCurrentCertificate returns the latest version of a certificate together
+// with the IDs visited on the way, starting with CertificateID.
+func getCurrentCertificate(stub shim.ChaincodeStubInterface, CertificateID string) (Certificate, []string, error) {
+
+	certificate, err := getCertificate(stub, "Certificate-"+CertificateID)
+
+	if err != nil {
+		return certificate, nil, errors.New("Certificate does not exist - " + CertificateID)
+	}
+
+	chain := []string{CertificateID}
+
+	for certificate.SupersededBy != "" {
+		if len(chain) >= maxReissueChain {
+			return certificate, chain, errors.New("Reissue chain is too long - " + CertificateID)
+		}
+
+		next := certificate.SupersededBy
+
+		certificate, err = getCertificate(stub, "Certificate-"+next)
+		if err != nil {
+			return certificate, chain, errors.New("Certificate does not exist - " + next)
+		}
+
+		chain = append(chain, next)
+	}
+
+	return certificate, chain, nil
+}
@@ -0,0 +1,388 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/hyperledger/fabric/core/chaincode/lib/cid"
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	sc "github.com/hyperledger/fabric/protos/peer"
+)
+
+const (
+	CountBestAttempt   = "Best"
+	CountLatestAttempt = "Latest"
+)
+
+// RetakePolicy decides how often a subject may be taken and which attempt
+// ends up in the Score record. Without a stored policy every subject may be
+// taken once, as before retakes existed.
+type RetakePolicy struct {
+	MaxAttempts         uint64
+	RetakeOnlyAfterFail bool
+	CountedAttempt      string
+}
+
+// Attempt is the score of one class a student took for a subject. The Score
+// record of the subject keeps the attempt that counts under the policy.
+type Attempt struct {
+	SubjectID       string
+	StudentUsername string
+	ClassID         string
+	AttemptNumber   int
+	ScoreValue      float64
+	RecordedAt      string
+}
+
+type TranscriptEntry struct {
+	SubjectID   string
+	SubjectName string
+	Scored      bool
+	ScoreValue  float64
+	Passed      bool
+	Exempt      bool
+	Exemption   *Exemption
+	Attempts    []Attempt
+}
+
+type Transcript struct {
+	StudentUsername string
+	StudentFullname string
+	CountedAttempt  string
+	Subjects        []TranscriptEntry
+}
+
+func SetRetakePolicy(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	MSPID, err := cid.GetMSPID(stub)
+
+	if err != nil {
+		return shim.Error("Error - cid.GetMSPID()")
+	}
+
+	if MSPID != "AcademyMSP" {
+		return shim.Error("Permission Denied!")
+	}
+
+	if len(args) != 3 {
+		return shim.Error("Incorrect number of arguments. Expecting 3")
+	}
+
+	MaxAttempts, err := strconv.ParseUint(args[0], 10, 64)
+
+	if err != nil || MaxAttempts == 0 {
+		return shim.Error("Maximum attempts must be a positive integer!")
+	}
+
+	RetakeOnlyAfterFail, err := strconv.ParseBool(args[1])
+
+	if err != nil {
+		return shim.Error("Convert retake only after fail to boolean failed")
+	}
+
+	CountedAttempt := args[2]
+
+	if CountedAttempt != CountBestAttempt && CountedAttempt != CountLatestAttempt {
+		return shim.Error("Counted attempt must be Best or Latest!")
+	}
+
+	policy := RetakePolicy{MaxAttempts: MaxAttempts, RetakeOnlyAfterFail: RetakeOnlyAfterFail, CountedAttempt: CountedAttempt}
+
+	policyAsBytes, err := json.Marshal(policy)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	stub.PutState("Config-RetakePolicy", policyAsBytes)
+
+	return shim.Success(policyAsBytes)
+}
+
+func GetRetakePolicy(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	if len(args) != 0 {
+		return shim.Error("Incorrect number of arguments. Expecting 0")
+	}
+
+	policy, err := getRetakePolicy(stub)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	policyAsBytes, err := json.Marshal(policy)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	return shim.Success(policyAsBytes)
+}
+
+func GetTranscript(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	if len(args) != 1 {
+		return shim.Error("Incorrect number of arguments. Expecting 1")
+	}
+
+	StudentUsername := args[0]
+
+	student, err := getStudent(stub, "Student-"+StudentUsername)
+
+	if err != nil {
+		return shim.Error("Student does not exist - " + StudentUsername)
+	}
+
+	err = checkGuestAccess(stub, student.Certificates, "GetTranscript")
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	policy, err := getRetakePolicy(stub)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	transcript := Transcript{StudentUsername: student.Username, StudentFullname: student.Fullname, CountedAttempt: policy.CountedAttempt}
+
+	var SubjectIDs []string
+	for _, ClassID := range student.Classes {
+		class, err := getClass(stub, "Class-"+ClassID)
+		if err != nil {
+			return shim.Error("Class does not exist - " + ClassID)
+		}
+
+		if !containsString(SubjectIDs, class.SubjectID) {
+			SubjectIDs = append(SubjectIDs, class.SubjectID)
+		}
+	}
+
+	for _, SubjectID := range student.Exemptions {
+		if !containsString(SubjectIDs, SubjectID) {
+			SubjectIDs = append(SubjectIDs, SubjectID)
+		}
+	}
+
+	for _, SubjectID := range SubjectIDs {
+		entry := TranscriptEntry{SubjectID: SubjectID}
+
+		subject, err := getSubject(stub, "Subject-"+SubjectID)
+		if err == nil {
+			entry.SubjectName = subject.SubjectName
+		}
+
+		score, err := getScore(stub, "Score-"+" "+"Subject-"+SubjectID+" "+"Student-"+StudentUsername)
+		if err == nil {
+			entry.Scored = true
+			entry.ScoreValue = score.ScoreValue
+			entry.Passed = score.ScoreValue >= PassScore
+		}
+
+		entry.Attempts = getAttempts(stub, student, SubjectID)
+
+		exemption, err := getExemption(stub, "Exemption-"+" "+"Subject-"+SubjectID+" "+"Student-"+StudentUsername)
+		if err == nil {
+			entry.Exempt = true
+			entry.Passed = true
+			entry.Exemption = &exemption
+		}
+
+		transcript.Subjects = append(transcript.Subjects, entry)
+	}
+
+	transcriptAsBytes, err := json.Marshal(transcript)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	return shim.Success(transcriptAsBytes)
+}
+
+func getRetakePolicy(stub shim.ChaincodeStubInterface) (RetakePolicy, error) {
+
+	policy := RetakePolicy{MaxAttempts: 1, CountedAttempt: CountLatestAttempt}
+
+	policyAsBytes, err := stub.GetState("Config-RetakePolicy")
+
+	if err != nil {
+		return policy, errors.New("Failed to get retake policy")
+	}
+
+	if policyAsBytes != nil {
+		json.Unmarshal(policyAsBytes, &policy)
+	}
+
+	return policy, nil
+}
+
+// checkRetake decides whether the student may register a class of SubjectID
+// given the classes of that subject they already took.
+func checkRetake(stub shim.ChaincodeStubInterface, student Student, SubjectID string) error {
+
+	policy, err := getRetakePolicy(stub)
+
+	if err != nil {
+		return err
+	}
+
+	var taken uint64
+	for _, ClassID := range student.Classes {
+		class, err := getClass(stub, "Class-"+ClassID)
+		if err != nil || class.SubjectID != SubjectID {
+			continue
+		}
+
+		if class.Status != Completed {
+			return errors.New("You are studying this subject!")
+		}
+		taken++
+	}
+
+	if taken == 0 {
+		return nil
+	}
+
+	if policy.MaxAttempts <= 1 {
+		return errors.New("You studied this subject!")
+	}
+
+	if taken >= policy.MaxAttempts {
+		return errors.New("You have used all " + strconv.FormatUint(policy.MaxAttempts, 10) + " attempts of this subject!")
+	}
+
+	if policy.RetakeOnlyAfterFail {
+		score, err := getScore(stub, "Score-"+" "+"Subject-"+SubjectID+" "+"Student-"+student.Username)
+		if err == nil && score.ScoreValue >= PassScore {
+			return errors.New("You passed this subject!")
+		}
+	}
+
+	return nil
+}
+
+// putAttempt records the score of one class and returns the score that
+// counts for the subject once it is taken into account.
+func putAttempt(stub shim.ChaincodeStubInterface, student Student, class Class, ScoreValue float64) (float64, error) {
+
+	txTime, err := getTxTime(stub)
+
+	if err != nil {
+		return 0, errors.New("Can not get transaction timestamp!")
+	}
+
+	AttemptNumber := 0
+	for _, ClassID := range student.Classes {
+		taken, err := getClass(stub, "Class-"+ClassID)
+		if err != nil || taken.SubjectID != class.SubjectID {
+			continue
+		}
+
+		AttemptNumber++
+		if ClassID == class.ClassID {
+			break
+		}
+	}
+
+	attempt := Attempt{
+		SubjectID:       class.SubjectID,
+		StudentUsername: student.Username,
+		ClassID:         class.ClassID,
+		AttemptNumber:   AttemptNumber,
+		ScoreValue:      ScoreValue,
+		RecordedAt:      txTime.Format(time.RFC3339),
+	}
+
+	attemptAsBytes, err := json.Marshal(attempt)
+
+	if err != nil {
+		return 0, errors.New("Can not convert data to bytes!")
+	}
+
+	policy, err := getRetakePolicy(stub)
+
+	if err != nil {
+		return 0, err
+	}
+
+	attempts := getAttempts(stub, student, class.SubjectID)
+
+	stub.PutState("Attempt-"+" "+"Subject-"+class.SubjectID+" "+"Student-"+student.Username+" "+"Class-"+class.ClassID, attemptAsBytes)
+
+	// diem nhap truoc khi co thi lai chua co lan thi, luu lai de chinh sach Best so sanh duoc
+	if len(attempts) == 1 && attempts[0].RecordedAt == "" && attempts[0].ClassID != class.ClassID {
+		legacyAsBytes, err := json.Marshal(attempts[0])
+
+		if err != nil {
+			return 0, errors.New("Can not convert data to bytes!")
+		}
+
+		stub.PutState("Attempt-"+" "+"Subject-"+class.SubjectID+" "+"Student-"+student.Username+" "+"Class-"+attempts[0].ClassID, legacyAsBytes)
+	}
+
+	// ghi de lan thi hien tai vi PutState chua doc lai duoc trong cung giao dich
+	counted := attempt
+	for _, previous := range attempts {
+		if previous.ClassID == class.ClassID {
+			continue
+		}
+
+		if policy.CountedAttempt == CountBestAttempt && previous.ScoreValue > counted.ScoreValue {
+			counted = previous
+		}
+
+		if policy.CountedAttempt == CountLatestAttempt && previous.AttemptNumber > counted.AttemptNumber {
+			counted = previous
+		}
+	}
+
+	return counted.ScoreValue, nil
+}
+
+// getAttempts returns the scored attempts of a subject in the order the
+// classes were registered. Scores entered before retakes existed have no
+// attempt; they are returned as the first attempt, taken in the first class of
+// the subject, with an empty RecordedAt.
+func getAttempts(stub shim.ChaincodeStubInterface, student Student, SubjectID string) []Attempt {
+
+	var attempts []Attempt
+	var FirstClassID string
+
+	for _, ClassID := range student.Classes {
+		attempt, err := getAttempt(stub, "Attempt-"+" "+"Subject-"+SubjectID+" "+"Student-"+student.Username+" "+"Class-"+ClassID)
+		if err == nil {
+			attempts = append(attempts, attempt)
+			continue
+		}
+
+		if FirstClassID == "" {
+			class, err := getClass(stub, "Class-"+ClassID)
+			if err == nil && class.SubjectID == SubjectID {
+				FirstClassID = ClassID
+			}
+		}
+	}
+
+	if len(attempts) > 0 || FirstClassID == "" {
+		return attempts
+	}
+
+	score, err := getScore(stub, "Score-"+" "+"Subject-"+SubjectID+" "+"Student-"+student.Username)
+
+	if err != nil {
+		return attempts
+	}
+
+	return []Attempt{{
+		SubjectID:       SubjectID,
+		StudentUsername: student.Username,
+		ClassID:         FirstClassID,
+		AttemptNumber:   1,
+		ScoreValue:      score.ScoreValue,
+	}}
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+// retakeTestSchedule runs after testClassSchedule, for the class that retakes
+// a subject.
+var retakeTestSchedule = strings.NewReplacer("2020-09-01", "2020-10-05", "2020-10-01", "2020-11-05").Replace(testClassSchedule)
+
+// takeTestClass creates ClassID for SubjectID, registers the student at
+// registeredAt, runs the class and completes it with ScoreValue.
+func takeTestClass(stub *testStub, ClassID string, SubjectID string, schedule string, registeredAt string, StudentUsername string, ScoreValue string) {
+	stub.as("AcademyMSP", "adminacademy")
+	stub.mustInvoke("CreateClass", ClassID, ClassID+"C", "R1", schedule, SubjectID, "30", "TM")
+	stub.mustInvoke("AssignTeacherToClass", ClassID, "T1")
+	stub.at(registeredAt).as("StudentMSP", StudentUsername).mustInvoke("StudentRegisterClass", StudentUsername, ClassID)
+
+	var class Class
+	stub.getState("Class-"+ClassID, &class)
+
+	stub.at(class.Schedule.StartDate+" 00:00").as("AcademyMSP", "adminacademy").mustInvoke("StartClass", ClassID)
+
+	if ScoreValue != "" {
+		stub.mustInvoke("PickScore", "T1", ClassID, StudentUsername, ScoreValue)
+	}
+
+	stub.at(class.Schedule.EndDate+" 12:00").mustInvoke("CompleteClass", ClassID)
+}
+
+func TestMicroCredentialOfRetakenSubject(test *testing.T) {
+	stub := newTestStub(test)
+	seedAcademy(stub)
+	stub.mustInvoke("SetRetakePolicy", "2", "true", CountLatestAttempt)
+
+	takeTestClass(stub, "K1", "S1", testClassSchedule, "2020-08-01 00:00", "st1", "3")
+	takeTestClass(stub, "K2", "S1", retakeTestSchedule, "2020-10-02 00:00", "st1", "8")
+
+	var score Score
+	stub.getState("Score-"+" "+"Subject-S1"+" "+"Student-st1", &score)
+
+	if score.ScoreValue != 8 {
+		test.Fatalf("Retake should be the counted score, got %v", score.ScoreValue)
+	}
+
+	// lop K1 truot, chi lop K2 duoc cap micro-credential
+	stub.as("AcademyMSP", "adminacademy")
+	stub.mustFail("IssueMicroCredential", "K1", "st1")
+
+	var microCredential MicroCredential
+	json.Unmarshal(stub.mustInvoke("IssueMicroCredential", "K2", "st1"), &microCredential)
+
+	if microCredential.ClassID != "K2" || microCredential.ScoreValue != 8 {
+		test.Fatalf("Unexpected micro-credential %+v", microCredential)
+	}
+}
+
+func TestLegacyAttemptSeeding(test *testing.T) {
+	stub := newTestStub(test)
+	seedAcademy(stub)
+
+	// diem cua K1 nhap truoc khi co thi lai nen chua co ban ghi Attempt
+	takeTestClass(stub, "K1", "S1", testClassSchedule, "2020-08-01 00:00", "st1", "")
+	stub.putState("Score-"+" "+"Subject-S1"+" "+"Student-st1", Score{"S1", "st1", 9})
+
+	stub.as("AcademyMSP", "adminacademy")
+	stub.mustInvoke("SetRetakePolicy", "2", "false", CountBestAttempt)
+
+	var microCredential MicroCredential
+	json.Unmarshal(stub.mustInvoke("IssueMicroCredential", "K1", "st1"), &microCredential)
+
+	if microCredential.ScoreValue != 9 {
+		test.Fatalf("Legacy score should count as the attempt of K1, got %v", microCredential.ScoreValue)
+	}
+
+	takeTestClass(stub, "K2", "S1", retakeTestSchedule, "2020-10-02 00:00", "st1", "6")
+
+	var legacy Attempt
+	stub.getState("Attempt-"+" "+"Subject-S1"+" "+"Student-st1"+" "+"Class-K1", &legacy)
+
+	if legacy.ClassID != "K1" || legacy.AttemptNumber != 1 || legacy.ScoreValue != 9 {
+		test.Fatalf("Legacy score was not seeded as the first attempt %+v", legacy)
+	}
+
+	var score Score
+	stub.getState("Score-"+" "+"Subject-S1"+" "+"Student-st1", &score)
+
+	if score.ScoreValue != 9 {
+		test.Fatalf("Best attempt should keep the legacy score, got %v", score.ScoreValue)
+	}
+
+	var transcript Transcript
+	json.Unmarshal(stub.mustInvoke("GetTranscript", "st1"), &transcript)
+
+	if len(transcript.Subjects) != 1 || len(transcript.Subjects[0].Attempts) != 2 || transcript.Subjects[0].Attempts[1].ScoreValue != 6 {
+		test.Fatalf("Unexpected transcript %+v", transcript)
+	}
+}
@@ -0,0 +1,453 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hyperledger/fabric/core/chaincode/lib/cid"
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	sc "github.com/hyperledger/fabric/protos/peer"
+)
+
+// Room is a bookable classroom. Classes lists every class held in the room,
+// completed ones included, so the room schedule keeps its history; only the
+// classes that are not completed keep the room from being deleted.
+type Room struct {
+	RoomID     string
+	Building   string
+	Capacity   uint64
+	Facilities []string
+	Classes    []string
+}
+
+type RoomBooking struct {
+	ClassID   string
+	ClassCode string
+	SubjectID string
+	Start     string
+	End       string
+}
+
+type RoomSchedule struct {
+	RoomID    string
+	StartDate string
+	EndDate   string
+	Bookings  []RoomBooking
+}
+
+func CreateRoom(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	MSPID, err := cid.GetMSPID(stub)
+
+	if err != nil {
+		return shim.Error("Error - cid.GetMSPID()")
+	}
+
+	if MSPID != "AcademyMSP" {
+		return shim.Error("Permission Denied!")
+	}
+
+	if len(args) != 4 {
+		return shim.Error("Incorrect number of arguments. Expecting 4")
+	}
+
+	RoomID := args[0]
+	Building := args[1]
+
+	if RoomID == "" {
+		return shim.Error("Room ID can not be empty!")
+	}
+
+	Capacity, err := strconv.ParseUint(args[2], 10, 64)
+
+	if err != nil || Capacity == 0 {
+		return shim.Error("Room capacity must be a positive integer!")
+	}
+
+	Facilities, err := parseFacilities(args[3])
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	keyRoom := "Room-" + RoomID
+
+	_, err = getRoom(stub, keyRoom)
+
+	if err == nil {
+		return shim.Error("This room already exists - " + RoomID)
+	}
+
+	room := Room{RoomID: RoomID, Building: Building, Capacity: Capacity, Facilities: Facilities}
+
+	roomAsBytes, err := json.Marshal(room)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	stub.PutState(keyRoom, roomAsBytes)
+
+	return shim.Success(roomAsBytes)
+}
+
+func UpdateRoomInfo(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	MSPID, err := cid.GetMSPID(stub)
+
+	if err != nil {
+		return shim.Error("Error - cid.GetMSPID()")
+	}
+
+	if MSPID != "AcademyMSP" {
+		return shim.Error("Permission Denied!")
+	}
+
+	if len(args) != 4 {
+		return shim.Error("Incorrect number of arguments. Expecting 4")
+	}
+
+	RoomID := args[0]
+	Building := args[1]
+
+	Capacity, err := strconv.ParseUint(args[2], 10, 64)
+
+	if err != nil || Capacity == 0 {
+		return shim.Error("Room capacity must be a positive integer!")
+	}
+
+	Facilities, err := parseFacilities(args[3])
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	keyRoom := "Room-" + RoomID
+	room, err := getRoom(stub, keyRoom)
+
+	if err != nil {
+		return shim.Error("Room does not exist - " + RoomID)
+	}
+
+	// phong nho lai khong duoc nho hon suc chua cua cac lop chua ket thuc
+	for _, ClassID := range room.Classes {
+		class, err := getClass(stub, "Class-"+ClassID)
+		if err != nil || class.Status == Completed {
+			continue
+		}
+
+		if class.Capacity > Capacity {
+			return shim.Error("Class " + class.ClassCode + " needs " + strconv.FormatUint(class.Capacity, 10) + " seats!")
+		}
+	}
+
+	room.Building = Building
+	room.Capacity = Capacity
+	room.Facilities = Facilities
+
+	roomAsBytes, err := json.Marshal(room)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	stub.PutState(keyRoom, roomAsBytes)
+
+	return shim.Success(roomAsBytes)
+}
+
+func DeleteRoom(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	MSPID, err := cid.GetMSPID(stub)
+
+	if err != nil {
+		return shim.Error("Error - cid.GetMSPID()")
+	}
+
+	if MSPID != "AcademyMSP" {
+		return shim.Error("Permission Denied!")
+	}
+
+	if len(args) != 1 {
+		return shim.Error("Incorrect number of arguments. Expecting 1")
+	}
+
+	RoomID := args[0]
+
+	keyRoom := "Room-" + RoomID
+	room, err := getRoom(stub, keyRoom)
+
+	if err != nil {
+		return shim.Error("Room does not exist - " + RoomID)
+	}
+
+	// lop da ket thuc chi la lich su, lop van giu RoomID cua no
+	var booked int
+	for _, ClassID := range room.Classes {
+		class, err := getClass(stub, "Class-"+ClassID)
+		if err == nil && class.Status != Completed {
+			booked++
+		}
+	}
+
+	if booked > 0 {
+		return shim.Error("Room is booked by " + strconv.Itoa(booked) + " classes!")
+	}
+
+	stub.DelState(keyRoom)
+
+	return shim.Success(nil)
+}
+
+func GetRoom(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	if len(args) != 1 {
+		return shim.Error("Incorrect number of arguments. Expecting 1")
+	}
+
+	roomAsBytes, err := stub.GetState("Room-" + args[0])
+
+	if err != nil {
+		return shim.Error("Failed to get data in the ledger")
+	}
+
+	if roomAsBytes == nil {
+		return shim.Error("Room does not exist - " + args[0])
+	}
+
+	return shim.Success(roomAsBytes)
+}
+
+func GetAllRooms(stub shim.ChaincodeStubInterface) sc.Response {
+
+	allRooms, err := getListRooms(stub)
+
+	if err != nil {
+		return shim.Error("Failed to get data in the ledger")
+	}
+
+	defer allRooms.Close()
+
+	var tlist []Room
+
+	for allRooms.HasNext() {
+
+		record, err := allRooms.Next()
+
+		if err != nil {
+			return shim.Success(nil)
+		}
+
+		room := Room{}
+		json.Unmarshal(record.Value, &room)
+		tlist = append(tlist, room)
+	}
+
+	jsonRow, err := json.Marshal(tlist)
+
+	if err != nil {
+		return shim.Error("Failed")
+	}
+
+	return shim.Success(jsonRow)
+}
+
+// GetRoomSchedule lists the meetings held in a room between two dates
+// (YYYY-MM-DD, inclusive), in order.
+func GetRoomSchedule(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	if len(args) != 3 {
+		return shim.Error("Incorrect number of arguments. Expecting 3")
+	}
+
+	RoomID := args[0]
+
+	startDate, err := time.Parse(scheduleDateLayout, args[1])
+
+	if err != nil {
+		return shim.Error("Start date must be YYYY-MM-DD!")
+	}
+
+	endDate, err := time.Parse(scheduleDateLayout, args[2])
+
+	if err != nil {
+		return shim.Error("End date must be YYYY-MM-DD!")
+	}
+
+	if endDate.Before(startDate) {
+		return shim.Error("Start date must be before end date!")
+	}
+
+	if endDate.Sub(startDate) > maxScheduleDays*24*time.Hour {
+		return shim.Error("Date range can not be longer than two years!")
+	}
+
+	room, err := getRoom(stub, "Room-"+RoomID)
+
+	if err != nil {
+		return shim.Error("Room does not exist - " + RoomID)
+	}
+
+	roomSchedule := RoomSchedule{RoomID: RoomID, StartDate: args[1], EndDate: args[2], Bookings: []RoomBooking{}}
+
+	var bookings []meeting
+	for _, ClassID := range room.Classes {
+		class, err := getClass(stub, "Class-"+ClassID)
+		if err != nil {
+			continue
+		}
+
+		meetings, err := class.Schedule.meetings()
+		if err != nil {
+			return shim.Error(err.Error())
+		}
+
+		for _, m := range meetings {
+			date := m.Start.Format(scheduleDateLayout)
+			if date < args[1] || date > args[2] {
+				continue
+			}
+
+			bookings = append(bookings, m)
+			roomSchedule.Bookings = append(roomSchedule.Bookings, RoomBooking{
+				ClassID:   class.ClassID,
+				ClassCode: class.ClassCode,
+				SubjectID: class.SubjectID,
+				Start:     m.Start.Format(time.RFC3339),
+				End:       m.End.Format(time.RFC3339),
+			})
+		}
+	}
+
+	sort.Sort(roomBookingsByStart{roomSchedule.Bookings, bookings})
+
+	roomScheduleAsBytes, err := json.Marshal(roomSchedule)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	return shim.Success(roomScheduleAsBytes)
+}
+
+// roomBookingsByStart sorts bookings by the absolute start of their meetings,
+// since bookings of classes in different timezones do not sort as strings.
+type roomBookingsByStart struct {
+	bookings []RoomBooking
+	meetings []meeting
+}
+
+func (b roomBookingsByStart) Len() int { return len(b.bookings) }
+
+func (b roomBookingsByStart) Less(i, j int) bool {
+	return b.meetings[i].Start.Before(b.meetings[j].Start)
+}
+
+func (b roomBookingsByStart) Swap(i, j int) {
+	b.bookings[i], b.bookings[j] = b.bookings[j], b.bookings[i]
+	b.meetings[i], b.meetings[j] = b.meetings[j], b.meetings[i]
+}
+
+func parseFacilities(input string) ([]string, error) {
+
+	var facilities []string
+
+	if input == "" {
+		return facilities, nil
+	}
+
+	err := json.Unmarshal([]byte(input), &facilities)
+
+	if err != nil {
+		return nil, errors.New("Facilities must be a JSON list!")
+	}
+
+	var result []string
+	for _, facility := range facilities {
+		facility = strings.TrimSpace(facility)
+		if facility != "" && !containsString(result, facility) {
+			result = append(result, facility)
+		}
+	}
+
+	return result, nil
+}
+
+// checkRoomBooking fails when the class does not fit in the room or meets
+// while another class of the room is in session.
+func checkRoomBooking(stub shim.ChaincodeStubInterface, room Room, class Class) error {
+
+	if class.Capacity > room.Capacity {
+		return errors.New("Class capacity is larger than room " + room.RoomID + " (" + strconv.FormatUint(room.Capacity, 10) + " seats)!")
+	}
+
+	err := checkScheduleConflicts(stub, room.Classes, class)
+
+	if err != nil {
+		return errors.New("Room " + room.RoomID + " is booked: " + err.Error())
+	}
+
+	return nil
+}
+
+// bookRoom records the class in the room after checking the booking.
+func bookRoom(stub shim.ChaincodeStubInterface, RoomID string, class Class) error {
+
+	keyRoom := "Room-" + RoomID
+	room, err := getRoom(stub, keyRoom)
+
+	if err != nil {
+		return errors.New("Room does not exist - " + RoomID)
+	}
+
+	err = checkRoomBooking(stub, room, class)
+
+	if err != nil {
+		return err
+	}
+
+	if containsString(room.Classes, class.ClassID) {
+		return nil
+	}
+
+	room.Classes = append(room.Classes, class.ClassID)
+
+	roomAsBytes, err := json.Marshal(room)
+
+	if err != nil {
+		return errors.New("Can not convert data to bytes!")
+	}
+
+	stub.PutState(keyRoom, roomAsBytes)
+
+	return nil
+}
+
+// releaseRoom removes the class from the room. Classes created before rooms
+// were registered may name a room that does not exist; there is nothing to
+// release then.
+func releaseRoom(stub shim.ChaincodeStubInterface, RoomID string, ClassID string) error {
+
+	keyRoom := "Room-" + RoomID
+	room, err := getRoom(stub, keyRoom)
+
+	if err != nil || !containsString(room.Classes, ClassID) {
+		return nil
+	}
+
+	room.Classes = removeString(room.Classes, ClassID)
+
+	roomAsBytes, err := json.Marshal(room)
+
+	if err != nil {
+		return errors.New("Can not convert data to bytes!")
+	}
+
+	stub.PutState(keyRoom, roomAsBytes)
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package main
+
+import "testing"
+
+func TestDeleteRoom(test *testing.T) {
+	stub := newTestStub(test)
+	seedAcademy(stub)
+
+	stub.mustInvoke("CreateClass", "K1", "K1C", "R1", testClassSchedule, "S1", "30", "TM")
+	stub.mustInvoke("AssignTeacherToClass", "K1", "T1")
+	stub.mustFail("DeleteRoom", "R1")
+
+	stub.at("2020-09-01 00:00").mustInvoke("StartClass", "K1")
+	stub.mustFail("DeleteRoom", "R1")
+
+	// phong chi con lop da ket thuc thi xoa duoc, lop van giu phong cua no
+	stub.at("2020-10-01 12:00").mustInvoke("CompleteClass", "K1")
+	stub.mustInvoke("DeleteRoom", "R1")
+	stub.mustFail("GetRoom", "R1")
+
+	var class Class
+	stub.getState("Class-K1", &class)
+
+	if class.Room != "R1" {
+		test.Fatalf("Completed class lost its room, got %q", class.Room)
+	}
+}
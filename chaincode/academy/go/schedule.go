@@ -0,0 +1,243 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+)
+
+const (
+	scheduleDateLayout = "2006-01-02"
+	scheduleTimeLayout = "15:04"
+	// mot lop hoc khong keo dai qua hai nam
+	maxScheduleDays = 731
+)
+
+// Schedule is a weekly recurrence: the class meets on DaysOfWeek from
+// StartTime to EndTime, local to Timezone, on every date between StartDate
+// and EndDate inclusive.
+type Schedule struct {
+	DaysOfWeek []string
+	StartTime  string
+	EndTime    string
+	StartDate  string
+	EndDate    string
+	Timezone   string
+}
+
+type meeting struct {
+	Start time.Time
+	End   time.Time
+}
+
+func parseSchedule(input string) (Schedule, error) {
+
+	var schedule Schedule
+
+	err := json.Unmarshal([]byte(input), &schedule)
+	if err != nil {
+		return schedule, errors.New("Schedule is invalid!")
+	}
+
+	err = validateSchedule(&schedule)
+
+	return schedule, err
+}
+
+// validateSchedule checks every field and normalizes DaysOfWeek to the
+// canonical weekday names in calendar order.
+func validateSchedule(schedule *Schedule) error {
+
+	if len(schedule.DaysOfWeek) == 0 {
+		return errors.New("Schedule must have at least one day of week!")
+	}
+
+	var weekdays []int
+	for _, day := range schedule.DaysOfWeek {
+		weekday, ok := parseWeekday(day)
+		if !ok {
+			return errors.New("Invalid day of week - " + day)
+		}
+
+		for _, existing := range weekdays {
+			if existing == int(weekday) {
+				return errors.New("Day of week is repeated - " + day)
+			}
+		}
+		weekdays = append(weekdays, int(weekday))
+	}
+
+	sort.Ints(weekdays)
+	schedule.DaysOfWeek = nil
+	for _, weekday := range weekdays {
+		schedule.DaysOfWeek = append(schedule.DaysOfWeek, time.Weekday(weekday).String())
+	}
+
+	startTime, err := time.Parse(scheduleTimeLayout, schedule.StartTime)
+	if err != nil {
+		return errors.New("Start time must be HH:MM!")
+	}
+
+	endTime, err := time.Parse(scheduleTimeLayout, schedule.EndTime)
+	if err != nil {
+		return errors.New("End time must be HH:MM!")
+	}
+
+	if !startTime.Before(endTime) {
+		return errors.New("Start time must be before end time!")
+	}
+
+	if schedule.Timezone == "" {
+		return errors.New("Timezone can not be empty!")
+	}
+
+	if _, err := loadLocation(schedule.Timezone); err != nil {
+		return errors.New("Unknown timezone - " + schedule.Timezone)
+	}
+
+	startDate, err := time.Parse(scheduleDateLayout, schedule.StartDate)
+	if err != nil {
+		return errors.New("Start date must be YYYY-MM-DD!")
+	}
+
+	endDate, err := time.Parse(scheduleDateLayout, schedule.EndDate)
+	if err != nil {
+		return errors.New("End date must be YYYY-MM-DD!")
+	}
+
+	if endDate.Before(startDate) {
+		return errors.New("Start date must be before end date!")
+	}
+
+	if endDate.Sub(startDate) > maxScheduleDays*24*time.Hour {
+		return errors.New("Schedule can not be longer than two years!")
+	}
+
+	return nil
+}
+
+func parseWeekday(day string) (time.Weekday, bool) {
+
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		name := weekday.String()
+		if strings.EqualFold(day, name) || strings.EqualFold(day, name[:3]) {
+			return weekday, true
+		}
+	}
+
+	return time.Sunday, false
+}
+
+// meetings expands the schedule into absolute time intervals, in order.
+// A schedule that was never set (classes created before schedules were
+// structured) has no meetings.
+func (schedule Schedule) meetings() ([]meeting, error) {
+
+	if len(schedule.DaysOfWeek) == 0 {
+		return nil, nil
+	}
+
+	location, err := loadLocation(schedule.Timezone)
+	if err != nil {
+		return nil, errors.New("Unknown timezone - " + schedule.Timezone)
+	}
+
+	startDate, err := time.ParseInLocation(scheduleDateLayout, schedule.StartDate, location)
+	if err != nil {
+		return nil, errors.New("Start date must be YYYY-MM-DD!")
+	}
+
+	endDate, err := time.ParseInLocation(scheduleDateLayout, schedule.EndDate, location)
+	if err != nil {
+		return nil, errors.New("End date must be YYYY-MM-DD!")
+	}
+
+	startTime, _ := time.Parse(scheduleTimeLayout, schedule.StartTime)
+	endTime, _ := time.Parse(scheduleTimeLayout, schedule.EndTime)
+
+	var days [7]bool
+	for _, day := range schedule.DaysOfWeek {
+		if weekday, ok := parseWeekday(day); ok {
+			days[weekday] = true
+		}
+	}
+
+	var meetings []meeting
+	for date := startDate; !date.After(endDate); date = date.AddDate(0, 0, 1) {
+		if !days[date.Weekday()] {
+			continue
+		}
+
+		year, month, day := date.Date()
+		meetings = append(meetings, meeting{
+			Start: time.Date(year, month, day, startTime.Hour(), startTime.Minute(), 0, 0, location),
+			End:   time.Date(year, month, day, endTime.Hour(), endTime.Minute(), 0, 0, location),
+		})
+	}
+
+	return meetings, nil
+}
+
+// schedulesOverlap reports the first time both schedules meet at once.
+func schedulesOverlap(a Schedule, b Schedule) (bool, time.Time, error) {
+
+	meetingsA, err := a.meetings()
+	if err != nil {
+		return false, time.Time{}, err
+	}
+
+	meetingsB, err := b.meetings()
+	if err != nil {
+		return false, time.Time{}, err
+	}
+
+	i, j := 0, 0
+	for i < len(meetingsA) && j < len(meetingsB) {
+		if meetingsA[i].Start.Before(meetingsB[j].End) && meetingsB[j].Start.Before(meetingsA[i].End) {
+			start := meetingsA[i].Start
+			if meetingsB[j].Start.After(start) {
+				start = meetingsB[j].Start
+			}
+			return true, start, nil
+		}
+
+		if meetingsA[i].End.Before(meetingsB[j].End) {
+			i++
+		} else {
+			j++
+		}
+	}
+
+	return false, time.Time{}, nil
+}
+
+// checkScheduleConflicts fails when class meets at the same time as one of
+// the classes in ClassIDs that is not completed yet.
+func checkScheduleConflicts(stub shim.ChaincodeStubInterface, ClassIDs []string, class Class) error {
+
+	for _, ClassID := range ClassIDs {
+		if ClassID == class.ClassID {
+			continue
+		}
+
+		other, err := getClass(stub, "Class-"+ClassID)
+		if err != nil || other.Status == Completed {
+			continue
+		}
+
+		overlap, at, err := schedulesOverlap(class.Schedule, other.Schedule)
+		if err != nil {
+			return err
+		}
+
+		if overlap {
+			return errors.New("Schedule conflicts with class " + other.ClassCode + " on " + at.Format("2006-01-02 15:04 MST"))
+		}
+	}
+
+	return nil
+}
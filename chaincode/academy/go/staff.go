@@ -0,0 +1,275 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/hyperledger/fabric/core/chaincode/lib/cid"
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	sc "github.com/hyperledger/fabric/protos/peer"
+)
+
+type StaffRole string
+
+const (
+	Lead              StaffRole = "Lead"
+	CoTeacher         StaffRole = "CoTeacher"
+	TeachingAssistant StaffRole = "TA"
+)
+
+type StaffPermission string
+
+const (
+	CanEnterFinalScore   StaffPermission = "FinalScore"
+	CanRecordAttendance  StaffPermission = "Attendance"
+	CanManageAssessments StaffPermission = "Assessment"
+	CanGradeSubmissions  StaffPermission = "GradeSubmission"
+)
+
+// staffPermissions lists what each role may do in a class. The lead is
+// Class.TeacherUsername; co-teachers and TAs are kept in Class.Staff.
+var staffPermissions = map[StaffRole][]StaffPermission{
+	Lead:              {CanEnterFinalScore, CanRecordAttendance, CanManageAssessments, CanGradeSubmissions},
+	CoTeacher:         {CanEnterFinalScore, CanRecordAttendance, CanManageAssessments, CanGradeSubmissions},
+	TeachingAssistant: {CanRecordAttendance, CanGradeSubmissions},
+}
+
+type ClassStaff struct {
+	Username string
+	Role     StaffRole
+}
+
+// AddClassStaff adds a co-teacher or a TA to a class that is not completed.
+// Co-teachers need a qualification for the subject like the lead does; both
+// must be free at the times of the class.
+func AddClassStaff(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	MSPID, err := cid.GetMSPID(stub)
+
+	if err != nil {
+		return shim.Error("Error - cid.GetMSPID()")
+	}
+
+	if MSPID != "AcademyMSP" {
+		return shim.Error("Permission Denied!")
+	}
+
+	if len(args) != 3 {
+		return shim.Error("Incorrect number of arguments. Expecting 3")
+	}
+
+	ClassID := args[0]
+	Username := args[1]
+	Role := StaffRole(args[2])
+
+	if Role != CoTeacher && Role != TeachingAssistant {
+		return shim.Error("Staff role must be CoTeacher or TA!")
+	}
+
+	keyTeacher := "Teacher-" + Username
+	teacher, err := getTeacher(stub, keyTeacher)
+
+	if err != nil {
+		return shim.Error("Teacher does not exist!")
+	}
+
+	keyClass := "Class-" + ClassID
+	class, err := getClass(stub, keyClass)
+
+	if err != nil {
+		return shim.Error("Class does not exist!")
+	}
+
+	if class.Status == Completed {
+		return shim.Error("This class was completed!")
+	}
+
+	if containsString(teacher.Classes, ClassID) {
+		return shim.Error("The class has been added!")
+	}
+
+	if Role == CoTeacher {
+		txTime, err := getTxTime(stub)
+
+		if err != nil {
+			return shim.Error("Can not get transaction timestamp!")
+		}
+
+		err = checkQualification(teacher, class, txTime)
+
+		if err != nil {
+			return shim.Error(err.Error())
+		}
+	}
+
+	err = checkScheduleConflicts(stub, teacher.Classes, class)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	teacher.Classes = append(teacher.Classes, ClassID)
+	class.Staff = append(class.Staff, ClassStaff{Username: Username, Role: Role})
+
+	teacherAsBytes, errTeacher := json.Marshal(teacher)
+	classAsBytes, errClass := json.Marshal(class)
+	if errTeacher != nil || errClass != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	stub.PutState(keyTeacher, teacherAsBytes)
+	stub.PutState(keyClass, classAsBytes)
+
+	return shim.Success(classAsBytes)
+}
+
+func RemoveClassStaff(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	MSPID, err := cid.GetMSPID(stub)
+
+	if err != nil {
+		return shim.Error("Error - cid.GetMSPID()")
+	}
+
+	if MSPID != "AcademyMSP" {
+		return shim.Error("Permission Denied!")
+	}
+
+	if len(args) != 2 {
+		return shim.Error("Incorrect number of arguments. Expecting 2")
+	}
+
+	ClassID := args[0]
+	Username := args[1]
+
+	keyClass := "Class-" + ClassID
+	class, err := getClass(stub, keyClass)
+
+	if err != nil {
+		return shim.Error("Class does not exist!")
+	}
+
+	if class.Status == Completed {
+		return shim.Error("This class was completed!")
+	}
+
+	staff := []ClassStaff{}
+	for _, member := range class.Staff {
+		if member.Username != Username {
+			staff = append(staff, member)
+		}
+	}
+
+	if len(staff) == len(class.Staff) {
+		return shim.Error("The teacher is not a staff of this class!")
+	}
+
+	class.Staff = staff
+
+	err = removeClassOfTeacher(stub, Username, ClassID)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	classAsBytes, err := json.Marshal(class)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	stub.PutState(keyClass, classAsBytes)
+
+	return shim.Success(classAsBytes)
+}
+
+// GetStaffPermissions lists what Username may do in a class, nothing when
+// they do not teach it.
+func GetStaffPermissions(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	if len(args) != 2 {
+		return shim.Error("Incorrect number of arguments. Expecting 2")
+	}
+
+	ClassID := args[0]
+	Username := args[1]
+
+	class, err := getClass(stub, "Class-"+ClassID)
+
+	if err != nil {
+		return shim.Error("Class does not exist - " + ClassID)
+	}
+
+	permissions := staffPermissions[staffRole(class, Username)]
+
+	if permissions == nil {
+		permissions = []StaffPermission{}
+	}
+
+	permissionsAsBytes, err := json.Marshal(permissions)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	return shim.Success(permissionsAsBytes)
+}
+
+// staffRole is the role of Username in class, empty when they do not teach
+// it.
+func staffRole(class Class, Username string) StaffRole {
+
+	if Username != "" && class.TeacherUsername == Username {
+		return Lead
+	}
+
+	for _, member := range class.Staff {
+		if member.Username == Username {
+			return member.Role
+		}
+	}
+
+	return ""
+}
+
+// checkStaffPermission fails unless Username teaches class in a role that
+// has permission.
+func checkStaffPermission(class Class, Username string, permission StaffPermission) error {
+
+	for _, granted := range staffPermissions[staffRole(class, Username)] {
+		if granted == permission {
+			return nil
+		}
+	}
+
+	return errors.New("Permission Denied!")
+}
+
+func removeClassOfTeacher(stub shim.ChaincodeStubInterface, Username string, ClassID string) error {
+
+	keyTeacher := "Teacher-" + Username
+	teacher, err := getTeacher(stub, keyTeacher)
+
+	if err != nil {
+		return errors.New("Teacher does not exist!")
+	}
+
+	classes := []string{}
+	for _, id := range teacher.Classes {
+		if id != ClassID {
+			classes = append(classes, id)
+		}
+	}
+
+	teacher.Classes = classes
+
+	teacherAsBytes, err := json.Marshal(teacher)
+
+	if err != nil {
+		return errors.New("Can not convert data to bytes!")
+	}
+
+	stub.PutState(keyTeacher, teacherAsBytes)
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestClassStaffPermissions(test *testing.T) {
+	stub := newTestStub(test)
+	seedAcademy(stub)
+
+	stub.mustInvoke("CreateTeacher", "CO", "Co Teacher")
+	stub.mustInvoke("CreateTeacher", "TA", "Assistant")
+	stub.mustInvoke("CreateClass", "K1", "K1C", "R1", testClassSchedule, "S1", "30", "TM")
+	stub.mustInvoke("AssignTeacherToClass", "K1", "T1")
+
+	stub.mustFail("AddClassStaff", "K1", "TA", "Lead")
+	stub.mustFail("AddClassStaff", "K1", "T1", "TA")
+	stub.mustFail("AddClassStaff", "K1", "CO", "CoTeacher")
+	stub.mustInvoke("GrantTeacherQualification", "CO", "S1", "2019-01-01", "")
+	stub.mustInvoke("AddClassStaff", "K1", "CO", "CoTeacher")
+	stub.mustInvoke("AddClassStaff", "K1", "TA", "TA")
+
+	if permissions := string(stub.mustInvoke("GetStaffPermissions", "K1", "TA")); permissions != `["Attendance","GradeSubmission"]` {
+		test.Fatal(permissions)
+	}
+
+	if permissions := string(stub.mustInvoke("GetStaffPermissions", "K1", "T1")); permissions != `["FinalScore","Attendance","Assessment","GradeSubmission"]` {
+		test.Fatal(permissions)
+	}
+
+	stub.at("2020-08-01 00:00").as("StudentMSP", "st1").mustInvoke("StudentRegisterClass", "st1", "K1")
+	stub.at("2020-09-01 00:00").as("AcademyMSP", "adminacademy").mustInvoke("StartClass", "K1")
+
+	// tro giang diem danh va cham bai, khong cho diem tong ket
+	stub.at("2020-09-07 01:00")
+	stub.mustInvoke("RecordAttendance", "TA", "K1", "2020-09-07", `{"st1":"Present"}`)
+	stub.mustFail("PickScore", "TA", "K1", "st1", "9")
+	stub.mustFail("CreateAssessment", "TA", "K1", "A1", "HW", "Quiz", "2020-09-20T00:00:00Z", "10")
+	stub.mustInvoke("CreateAssessment", "CO", "K1", "A1", "HW", "Quiz", "2020-09-20T00:00:00Z", "10")
+
+	hash := strings.Repeat("ab", 32)
+	stub.as("StudentMSP", "st1").mustInvoke("SubmitAssessment", "st1", "K1", "A1", hash)
+	stub.as("AcademyMSP", "adminacademy").mustInvoke("GradeSubmission", "TA", "K1", "A1", "st1", hash, "7")
+	stub.mustInvoke("PickScore", "CO", "K1", "st1", "9")
+
+	stub.mustInvoke("RemoveClassStaff", "K1", "TA")
+	stub.mustFail("RecordAttendance", "TA", "K1", "2020-09-07", `{"st1":"Present"}`)
+
+	var classes []Class
+	json.Unmarshal(stub.mustInvoke("GetClassesByTeacher", "TA"), &classes)
+
+	if len(classes) != 0 {
+		test.Fatalf("Removed staff still has classes %+v", classes)
+	}
+}
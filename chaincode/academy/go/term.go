@@ -0,0 +1,509 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/hyperledger/fabric/core/chaincode/lib/cid"
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	sc "github.com/hyperledger/fabric/protos/peer"
+)
+
+type TermStatus string
+
+const (
+	TermPlanned TermStatus = "Planned"
+	TermActive  TermStatus = "Active"
+	TermClosed  TermStatus = "Closed"
+)
+
+// Cac giai doan cua hoc ky duoc kiem tra theo thoi diem giao dich
+const (
+	TermRegistration = "registration"
+	TermTeaching     = "teaching"
+	TermGrading      = "grading"
+)
+
+// AcademicTerm groups the classes taught in one semester. All dates are
+// YYYY-MM-DD, inclusive, local to Timezone. Registration, class start and
+// score entry are only allowed while the term is Active and the transaction
+// timestamp falls inside the matching window.
+type AcademicTerm struct {
+	TermID            string
+	TermName          string
+	RegistrationStart string
+	RegistrationEnd   string
+	TeachingStart     string
+	TeachingEnd       string
+	GradingDeadline   string
+	Timezone          string
+	Status            TermStatus
+	Classes           []string
+}
+
+type TermEnrollment struct {
+	ClassID         string
+	ClassCode       string
+	SubjectID       string
+	StudentUsername string
+}
+
+type TermResult struct {
+	ClassID         string
+	ClassCode       string
+	SubjectID       string
+	StudentUsername string
+	Scored          bool
+	ScoreValue      float64
+	Passed          bool
+}
+
+func CreateAcademicTerm(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	MSPID, err := cid.GetMSPID(stub)
+
+	if err != nil {
+		return shim.Error("Error - cid.GetMSPID()")
+	}
+
+	if MSPID != "AcademyMSP" {
+		return shim.Error("Permission Denied!")
+	}
+
+	if len(args) != 8 {
+		return shim.Error("Incorrect number of arguments. Expecting 8")
+	}
+
+	term := AcademicTerm{
+		TermID:            args[0],
+		TermName:          args[1],
+		RegistrationStart: args[2],
+		RegistrationEnd:   args[3],
+		TeachingStart:     args[4],
+		TeachingEnd:       args[5],
+		GradingDeadline:   args[6],
+		Timezone:          args[7],
+		Status:            TermPlanned,
+	}
+
+	if term.TermID == "" || term.TermName == "" {
+		return shim.Error("Term ID and term name can not be empty!")
+	}
+
+	err = validateAcademicTerm(term)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	keyTerm := "Term-" + term.TermID
+
+	_, err = getAcademicTerm(stub, keyTerm)
+
+	if err == nil {
+		return shim.Error("This term already exists - " + term.TermID)
+	}
+
+	termAsBytes, err := json.Marshal(term)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	stub.PutState(keyTerm, termAsBytes)
+
+	return shim.Success(termAsBytes)
+}
+
+func UpdateAcademicTerm(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	MSPID, err := cid.GetMSPID(stub)
+
+	if err != nil {
+		return shim.Error("Error - cid.GetMSPID()")
+	}
+
+	if MSPID != "AcademyMSP" {
+		return shim.Error("Permission Denied!")
+	}
+
+	if len(args) != 8 {
+		return shim.Error("Incorrect number of arguments. Expecting 8")
+	}
+
+	keyTerm := "Term-" + args[0]
+	term, err := getAcademicTerm(stub, keyTerm)
+
+	if err != nil {
+		return shim.Error("Term does not exist - " + args[0])
+	}
+
+	if term.Status == TermClosed {
+		return shim.Error("Term " + term.TermID + " is closed!")
+	}
+
+	term.TermName = args[1]
+	term.RegistrationStart = args[2]
+	term.RegistrationEnd = args[3]
+	term.TeachingStart = args[4]
+	term.TeachingEnd = args[5]
+	term.GradingDeadline = args[6]
+	term.Timezone = args[7]
+
+	if term.TermName == "" {
+		return shim.Error("Term name can not be empty!")
+	}
+
+	err = validateAcademicTerm(term)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	// cac lop da co trong hoc ky van phai nam trong thoi gian giang day moi
+	for _, ClassID := range term.Classes {
+		class, err := getClass(stub, "Class-"+ClassID)
+		if err != nil {
+			continue
+		}
+
+		err = checkScheduleInTerm(term, class.Schedule)
+		if err != nil {
+			return shim.Error("Class " + class.ClassCode + ": " + err.Error())
+		}
+	}
+
+	termAsBytes, err := json.Marshal(term)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	stub.PutState(keyTerm, termAsBytes)
+
+	return shim.Success(termAsBytes)
+}
+
+// SetAcademicTermStatus moves a term forward: Planned, Active, then Closed.
+func SetAcademicTermStatus(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	MSPID, err := cid.GetMSPID(stub)
+
+	if err != nil {
+		return shim.Error("Error - cid.GetMSPID()")
+	}
+
+	if MSPID != "AcademyMSP" {
+		return shim.Error("Permission Denied!")
+	}
+
+	if len(args) != 2 {
+		return shim.Error("Incorrect number of arguments. Expecting 2")
+	}
+
+	keyTerm := "Term-" + args[0]
+	Status := TermStatus(args[1])
+
+	term, err := getAcademicTerm(stub, keyTerm)
+
+	if err != nil {
+		return shim.Error("Term does not exist - " + args[0])
+	}
+
+	if !(term.Status == TermPlanned && Status == TermActive) && !(term.Status != TermClosed && Status == TermClosed) {
+		return shim.Error("Can not change term status from " + string(term.Status) + " to " + string(Status) + "!")
+	}
+
+	term.Status = Status
+
+	termAsBytes, err := json.Marshal(term)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	stub.PutState(keyTerm, termAsBytes)
+
+	return shim.Success(termAsBytes)
+}
+
+func GetAcademicTerm(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	if len(args) != 1 {
+		return shim.Error("Incorrect number of arguments. Expecting 1")
+	}
+
+	termAsBytes, err := stub.GetState("Term-" + args[0])
+
+	if err != nil {
+		return shim.Error("Failed to get data in the ledger")
+	}
+
+	if termAsBytes == nil {
+		return shim.Error("Term does not exist - " + args[0])
+	}
+
+	return shim.Success(termAsBytes)
+}
+
+func GetAllAcademicTerms(stub shim.ChaincodeStubInterface) sc.Response {
+
+	allTerms, err := getListAcademicTerms(stub)
+
+	if err != nil {
+		return shim.Error("Failed to get data in the ledger")
+	}
+
+	defer allTerms.Close()
+
+	var tlist []AcademicTerm
+
+	for allTerms.HasNext() {
+
+		record, err := allTerms.Next()
+
+		if err != nil {
+			return shim.Success(nil)
+		}
+
+		term := AcademicTerm{}
+		json.Unmarshal(record.Value, &term)
+		tlist = append(tlist, term)
+	}
+
+	jsonRow, err := json.Marshal(tlist)
+
+	if err != nil {
+		return shim.Error("Failed")
+	}
+
+	return shim.Success(jsonRow)
+}
+
+func GetClassesOfTerm(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	if len(args) != 1 {
+		return shim.Error("Incorrect number of arguments. Expecting 1")
+	}
+
+	classes, err := getClassesOfTerm(stub, args[0])
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	classesAsBytes, err := json.Marshal(classes)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	return shim.Success(classesAsBytes)
+}
+
+func GetEnrollmentsOfTerm(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	if len(args) != 1 {
+		return shim.Error("Incorrect number of arguments. Expecting 1")
+	}
+
+	classes, err := getClassesOfTerm(stub, args[0])
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	enrollments := []TermEnrollment{}
+	for _, class := range classes {
+		for _, Username := range class.Students {
+			enrollments = append(enrollments, TermEnrollment{
+				ClassID:         class.ClassID,
+				ClassCode:       class.ClassCode,
+				SubjectID:       class.SubjectID,
+				StudentUsername: Username,
+			})
+		}
+	}
+
+	enrollmentsAsBytes, err := json.Marshal(enrollments)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	return shim.Success(enrollmentsAsBytes)
+}
+
+// GetResultsOfTerm lists the score of every student in every class of the
+// term. The score is the attempt recorded for that class, not the counted
+// score of the subject, so retakes in later terms do not change it.
+func GetResultsOfTerm(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	if len(args) != 1 {
+		return shim.Error("Incorrect number of arguments. Expecting 1")
+	}
+
+	classes, err := getClassesOfTerm(stub, args[0])
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	results := []TermResult{}
+	for _, class := range classes {
+		for _, Username := range class.Students {
+			result := TermResult{
+				ClassID:         class.ClassID,
+				ClassCode:       class.ClassCode,
+				SubjectID:       class.SubjectID,
+				StudentUsername: Username,
+			}
+
+			attempt, err := getAttempt(stub, "Attempt-"+" "+"Subject-"+class.SubjectID+" "+"Student-"+Username+" "+"Class-"+class.ClassID)
+			if err == nil {
+				result.Scored = true
+				result.ScoreValue = attempt.ScoreValue
+				result.Passed = attempt.ScoreValue >= PassScore
+			}
+
+			results = append(results, result)
+		}
+	}
+
+	resultsAsBytes, err := json.Marshal(results)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	return shim.Success(resultsAsBytes)
+}
+
+func getClassesOfTerm(stub shim.ChaincodeStubInterface, TermID string) ([]Class, error) {
+
+	term, err := getAcademicTerm(stub, "Term-"+TermID)
+
+	if err != nil {
+		return nil, errors.New("Term does not exist - " + TermID)
+	}
+
+	classes := []Class{}
+	for _, ClassID := range term.Classes {
+		class, err := getClass(stub, "Class-"+ClassID)
+		if err != nil {
+			return nil, errors.New("Class does not exist - " + ClassID)
+		}
+
+		classes = append(classes, class)
+	}
+
+	return classes, nil
+}
+
+func validateAcademicTerm(term AcademicTerm) error {
+
+	if _, err := loadLocation(term.Timezone); term.Timezone == "" || err != nil {
+		return errors.New("Unknown timezone - " + term.Timezone)
+	}
+
+	dates := []struct {
+		name  string
+		value string
+	}{
+		{"Registration start", term.RegistrationStart},
+		{"Registration end", term.RegistrationEnd},
+		{"Teaching start", term.TeachingStart},
+		{"Teaching end", term.TeachingEnd},
+		{"Grading deadline", term.GradingDeadline},
+	}
+
+	for _, date := range dates {
+		if _, err := time.Parse(scheduleDateLayout, date.value); err != nil {
+			return errors.New(date.name + " must be YYYY-MM-DD!")
+		}
+	}
+
+	// ngay dang YYYY-MM-DD nen so sanh chuoi cung la so sanh ngay
+	if term.RegistrationEnd < term.RegistrationStart {
+		return errors.New("Registration start must be before registration end!")
+	}
+
+	if term.TeachingEnd < term.TeachingStart {
+		return errors.New("Teaching start must be before teaching end!")
+	}
+
+	if term.TeachingStart < term.RegistrationStart || term.TeachingEnd < term.RegistrationEnd {
+		return errors.New("Registration must open before teaching starts and close before teaching ends!")
+	}
+
+	if term.GradingDeadline < term.TeachingEnd {
+		return errors.New("Grading deadline can not be before teaching end!")
+	}
+
+	return nil
+}
+
+// checkScheduleInTerm fails when the class meets outside the teaching period.
+func checkScheduleInTerm(term AcademicTerm, schedule Schedule) error {
+
+	if schedule.StartDate < term.TeachingStart || schedule.EndDate > term.TeachingEnd {
+		return errors.New("Schedule must be within the teaching period of term " + term.TermID + " (" + term.TeachingStart + " - " + term.TeachingEnd + ")!")
+	}
+
+	return nil
+}
+
+// checkTermPhase fails unless the term of the class is Active and the
+// transaction timestamp is inside the window of phase. Classes created before
+// terms existed have no term and are not gated.
+func checkTermPhase(stub shim.ChaincodeStubInterface, class Class, phase string) error {
+
+	if class.TermID == "" {
+		return nil
+	}
+
+	term, err := getAcademicTerm(stub, "Term-"+class.TermID)
+
+	if err != nil {
+		return errors.New("Term does not exist - " + class.TermID)
+	}
+
+	if term.Status != TermActive {
+		return errors.New("Term " + term.TermID + " is " + string(term.Status) + "!")
+	}
+
+	txTime, err := getTxTime(stub)
+
+	if err != nil {
+		return errors.New("Can not get transaction timestamp!")
+	}
+
+	location, err := loadLocation(term.Timezone)
+
+	if err != nil {
+		return errors.New("Unknown timezone - " + term.Timezone)
+	}
+
+	today := txTime.In(location).Format(scheduleDateLayout)
+
+	switch phase {
+	case TermRegistration:
+		if today < term.RegistrationStart || today > term.RegistrationEnd {
+			return errors.New("Registration of term " + term.TermID + " is open from " + term.RegistrationStart + " to " + term.RegistrationEnd + "!")
+		}
+	case TermTeaching:
+		if today < term.TeachingStart || today > term.TeachingEnd {
+			return errors.New("Teaching period of term " + term.TermID + " is from " + term.TeachingStart + " to " + term.TeachingEnd + "!")
+		}
+	case TermGrading:
+		if today < term.TeachingStart {
+			return errors.New("Teaching period of term " + term.TermID + " has not started!")
+		}
+		if today > term.GradingDeadline {
+			return errors.New("Grading deadline of term " + term.TermID + " has passed!")
+		}
+	}
+
+	return nil
+}
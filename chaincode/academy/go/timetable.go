@@ -0,0 +1,308 @@
+package main
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	sc "github.com/hyperledger/fabric/protos/peer"
+)
+
+const (
+	icsDateTimeLayout = "20060102T150405"
+	// RFC 5545 3.1: dong dai hon 75 octet phai duoc gap lai
+	icsMaxLineOctets = 75
+)
+
+// GetTimetableICS exports the classes of a student or teacher as an RFC 5545
+// calendar, one weekly recurring event per class. The calendar only depends
+// on ledger state and the transaction timestamp, so every endorser builds the
+// same bytes.
+func GetTimetableICS(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	if len(args) != 1 {
+		return shim.Error("Incorrect number of arguments. Expecting 1")
+	}
+
+	Username := args[0]
+
+	var ClassIDs []string
+
+	student, err := getStudent(stub, "Student-"+Username)
+
+	if err == nil {
+		ClassIDs = student.Classes
+	} else {
+		teacher, err := getTeacher(stub, "Teacher-"+Username)
+		if err != nil {
+			return shim.Error("User does not exist - " + Username)
+		}
+		ClassIDs = teacher.Classes
+	}
+
+	txTime, err := getTxTime(stub)
+
+	if err != nil {
+		return shim.Error("Can not get transaction timestamp!")
+	}
+
+	var classes []Class
+	for _, ClassID := range ClassIDs {
+		class, err := getClass(stub, "Class-"+ClassID)
+		if err != nil {
+			return shim.Error("Class does not exist - " + ClassID)
+		}
+
+		classes = append(classes, class)
+	}
+
+	calendar, err := buildTimetableICS(stub, classes, txTime)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	return shim.Success([]byte(calendar))
+}
+
+func buildTimetableICS(stub shim.ChaincodeStubInterface, classes []Class, stamp time.Time) (string, error) {
+
+	var lines []string
+	var events []string
+
+	// khoang thoi gian can mo ta cho moi mui gio, de sinh VTIMEZONE
+	zoneStart := map[string]time.Time{}
+	zoneEnd := map[string]time.Time{}
+
+	for _, class := range classes {
+		meetings, err := class.Schedule.meetings()
+		if err != nil {
+			return "", err
+		}
+
+		// lop tao truoc khi co lich co cau truc thi khong co buoi hoc nao
+		if len(meetings) == 0 {
+			continue
+		}
+
+		first := meetings[0]
+		last := meetings[len(meetings)-1]
+		TZID := class.Schedule.Timezone
+
+		if start, ok := zoneStart[TZID]; !ok || first.Start.Before(start) {
+			zoneStart[TZID] = first.Start
+		}
+		if end, ok := zoneEnd[TZID]; !ok || last.End.After(end) {
+			zoneEnd[TZID] = last.End
+		}
+
+		var byDay []string
+		for _, day := range class.Schedule.DaysOfWeek {
+			if weekday, ok := parseWeekday(day); ok {
+				byDay = append(byDay, strings.ToUpper(weekday.String()[:2]))
+			}
+		}
+
+		SubjectName := class.SubjectID
+		subject, err := getSubject(stub, "Subject-"+class.SubjectID)
+		if err == nil {
+			SubjectName = subject.SubjectName
+		}
+
+		Location := class.Room
+		room, err := getRoom(stub, "Room-"+class.Room)
+		if err == nil && room.Building != "" {
+			Location = room.RoomID + ", " + room.Building
+		}
+
+		Description := "Subject: " + SubjectName + "\nClass: " + class.ClassCode
+		if class.TeacherUsername != "" {
+			TeacherName := class.TeacherUsername
+			teacher, err := getTeacher(stub, "Teacher-"+class.TeacherUsername)
+			if err == nil && teacher.Fullname != "" {
+				TeacherName = teacher.Fullname
+			}
+			Description += "\nTeacher: " + TeacherName
+		}
+
+		events = append(events,
+			"BEGIN:VEVENT",
+			"UID:"+class.ClassID+"@academy",
+			"DTSTAMP:"+stamp.UTC().Format(icsDateTimeLayout)+"Z",
+			"DTSTART;TZID="+TZID+":"+first.Start.Format(icsDateTimeLayout),
+			"DTEND;TZID="+TZID+":"+first.End.Format(icsDateTimeLayout),
+			"RRULE:FREQ=WEEKLY;BYDAY="+strings.Join(byDay, ",")+";UNTIL="+last.Start.UTC().Format(icsDateTimeLayout)+"Z",
+			"SUMMARY:"+escapeICSText(SubjectName+" ("+class.ClassCode+")"),
+			"LOCATION:"+escapeICSText(Location),
+			"DESCRIPTION:"+escapeICSText(Description),
+			"END:VEVENT",
+		)
+	}
+
+	lines = append(lines,
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//Academy Certificate//Timetable//EN",
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+	)
+
+	var TZIDs []string
+	for TZID := range zoneStart {
+		TZIDs = append(TZIDs, TZID)
+	}
+	sort.Strings(TZIDs)
+
+	for _, TZID := range TZIDs {
+		timezone, err := buildVTimezone(TZID, zoneStart[TZID], zoneEnd[TZID])
+		if err != nil {
+			return "", err
+		}
+		lines = append(lines, timezone...)
+	}
+
+	lines = append(lines, events...)
+	lines = append(lines, "END:VCALENDAR")
+
+	var calendar strings.Builder
+	for _, line := range lines {
+		calendar.WriteString(foldICSLine(line))
+		calendar.WriteString("\r\n")
+	}
+
+	return calendar.String(), nil
+}
+
+type zoneTransition struct {
+	At         time.Time
+	OffsetFrom int
+	OffsetTo   int
+	Name       string
+}
+
+// buildVTimezone describes the offsets a timezone uses between start and end.
+// Each transition becomes its own STANDARD or DAYLIGHT block; a block is
+// DAYLIGHT when its offset is ahead of the smallest offset seen.
+func buildVTimezone(TZID string, start time.Time, end time.Time) ([]string, error) {
+
+	location, err := loadLocation(TZID)
+
+	if err != nil {
+		return nil, errors.New("Unknown timezone - " + TZID)
+	}
+
+	start = start.In(location)
+	end = end.In(location)
+
+	name, offset := start.Zone()
+	transitions := []zoneTransition{{At: start, OffsetFrom: offset, OffsetTo: offset, Name: name}}
+
+	// quet tung gio roi tung phut de tim thoi diem doi mui gio
+	for at := start.Add(time.Hour); !at.After(end.Add(time.Hour)); at = at.Add(time.Hour) {
+		_, current := at.Zone()
+		if current == offset {
+			continue
+		}
+
+		onset := at.Add(-time.Hour)
+		for onset.Add(time.Minute).Before(at) {
+			if _, o := onset.Add(time.Minute).Zone(); o != offset {
+				break
+			}
+			onset = onset.Add(time.Minute)
+		}
+		onset = onset.Add(time.Minute).Truncate(time.Minute)
+
+		name, current = onset.Zone()
+		transitions = append(transitions, zoneTransition{At: onset, OffsetFrom: offset, OffsetTo: current, Name: name})
+		offset = current
+	}
+
+	standard := transitions[0].OffsetTo
+	for _, transition := range transitions {
+		if transition.OffsetTo < standard {
+			standard = transition.OffsetTo
+		}
+	}
+
+	lines := []string{"BEGIN:VTIMEZONE", "TZID:" + TZID}
+	for _, transition := range transitions {
+		kind := "STANDARD"
+		if transition.OffsetTo > standard {
+			kind = "DAYLIGHT"
+		}
+
+		// thoi diem bat dau tinh theo gio dia phuong truoc khi doi
+		onset := transition.At.In(time.FixedZone("", transition.OffsetFrom))
+
+		lines = append(lines,
+			"BEGIN:"+kind,
+			"DTSTART:"+onset.Format(icsDateTimeLayout),
+			"TZOFFSETFROM:"+formatICSOffset(transition.OffsetFrom),
+			"TZOFFSETTO:"+formatICSOffset(transition.OffsetTo),
+			"TZNAME:"+escapeICSText(transition.Name),
+			"END:"+kind,
+		)
+	}
+	lines = append(lines, "END:VTIMEZONE")
+
+	return lines, nil
+}
+
+func formatICSOffset(offset int) string {
+
+	sign := "+"
+	if offset < 0 {
+		sign = "-"
+		offset = -offset
+	}
+
+	hours := strconv.Itoa(offset / 3600)
+	minutes := strconv.Itoa(offset % 3600 / 60)
+
+	if len(hours) < 2 {
+		hours = "0" + hours
+	}
+	if len(minutes) < 2 {
+		minutes = "0" + minutes
+	}
+
+	return sign + hours + minutes
+}
+
+func escapeICSText(text string) string {
+
+	replacer := strings.NewReplacer("\\", "\\\\", ";", "\\;", ",", "\\,", "\r\n", "\\n", "\n", "\\n")
+
+	return replacer.Replace(text)
+}
+
+// foldICSLine splits a content line into 75-octet pieces without breaking a
+// UTF-8 sequence; continuation lines start with a single space.
+func foldICSLine(line string) string {
+
+	if len(line) <= icsMaxLineOctets {
+		return line
+	}
+
+	var folded strings.Builder
+	limit := icsMaxLineOctets
+	size := 0
+
+	for _, r := range line {
+		width := utf8.RuneLen(r)
+		if size+width > limit {
+			folded.WriteString("\r\n ")
+			limit = icsMaxLineOctets - 1
+			size = 0
+		}
+		folded.WriteRune(r)
+		size += width
+	}
+
+	return folded.String()
+}
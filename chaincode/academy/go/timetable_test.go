@@ -0,0 +1,118 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+func TestFoldICSLine(test *testing.T) {
+	short := "SUMMARY:" + strings.Repeat("a", icsMaxLineOctets-len("SUMMARY:"))
+
+	if foldICSLine(short) != short {
+		test.Fatalf("Line of %v octets must not be folded", len(short))
+	}
+
+	for _, line := range []string{
+		"DESCRIPTION:" + strings.Repeat("a", 200),
+		"DESCRIPTION:" + strings.Repeat("Lập trình hướng đối tượng ", 10),
+	} {
+		folded := foldICSLine(line)
+
+		for i, piece := range strings.Split(folded, "\r\n") {
+			if len(piece) > icsMaxLineOctets || !utf8.ValidString(piece) {
+				test.Fatalf("Piece %v of %v octets is not a valid folded line %q", i, len(piece), piece)
+			}
+			if i > 0 && !strings.HasPrefix(piece, " ") {
+				test.Fatalf("Continuation line must start with a space %q", piece)
+			}
+		}
+
+		if strings.Replace(folded, "\r\n ", "", -1) != line {
+			test.Fatalf("Unfolded line differs from %q", line)
+		}
+	}
+}
+
+func TestBuildVTimezone(test *testing.T) {
+	newYork, _ := loadLocation("America/New_York")
+
+	// gio mua he ket thuc ngay 2020-11-01 luc 02:00
+	lines, err := buildVTimezone("America/New_York", time.Date(2020, 9, 7, 8, 0, 0, 0, newYork), time.Date(2020, 11, 30, 10, 0, 0, 0, newYork))
+
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	expected := []string{
+		"BEGIN:VTIMEZONE",
+		"TZID:America/New_York",
+		"BEGIN:DAYLIGHT",
+		"DTSTART:20200907T080000",
+		"TZOFFSETFROM:-0400",
+		"TZOFFSETTO:-0400",
+		"TZNAME:EDT",
+		"END:DAYLIGHT",
+		"BEGIN:STANDARD",
+		"DTSTART:20201101T020000",
+		"TZOFFSETFROM:-0400",
+		"TZOFFSETTO:-0500",
+		"TZNAME:EST",
+		"END:STANDARD",
+		"END:VTIMEZONE",
+	}
+
+	if !reflect.DeepEqual(lines, expected) {
+		test.Fatalf("Unexpected VTIMEZONE\n%v", strings.Join(lines, "\n"))
+	}
+
+	saigon, _ := loadLocation("Asia/Ho_Chi_Minh")
+	lines, err = buildVTimezone("Asia/Ho_Chi_Minh", time.Date(2020, 9, 7, 8, 0, 0, 0, saigon), time.Date(2020, 9, 28, 10, 0, 0, 0, saigon))
+
+	if err != nil || len(lines) != 9 || lines[2] != "BEGIN:STANDARD" || lines[5] != "TZOFFSETTO:+0700" {
+		test.Fatalf("Unexpected VTIMEZONE %v %v", lines, err)
+	}
+
+	if _, err := buildVTimezone("Mars/Olympus_Mons", time.Now(), time.Now()); err == nil {
+		test.Fatal("Unknown timezone must fail")
+	}
+}
+
+func TestGetTimetableICS(test *testing.T) {
+	stub := newTestStub(test)
+	seedAcademy(stub)
+
+	stub.mustInvoke("CreateClass", "K1", "K1C", "R1", testClassSchedule, "S1", "30", "TM")
+	stub.mustInvoke("AssignTeacherToClass", "K1", "T1")
+	stub.at("2020-08-01 00:00").as("StudentMSP", "st1").mustInvoke("StudentRegisterClass", "st1", "K1")
+
+	stub.mustFail("GetTimetableICS", "nobody")
+
+	calendar := string(stub.mustInvoke("GetTimetableICS", "st1"))
+
+	if calendar != string(stub.mustInvoke("GetTimetableICS", "T1")) {
+		test.Fatal("Student and teacher of the only class should get the same calendar")
+	}
+
+	if !strings.HasSuffix(calendar, "END:VCALENDAR\r\n") {
+		test.Fatalf("Calendar lines must end with CRLF %q", calendar)
+	}
+
+	// buoi dau la thu Hai 2020-09-07, buoi cuoi la thu Hai 2020-09-28 luc 08:00 gio Viet Nam
+	for _, line := range []string{
+		"UID:K1@academy",
+		"DTSTAMP:20200801T000000Z",
+		"DTSTART;TZID=Asia/Ho_Chi_Minh:20200907T080000",
+		"DTEND;TZID=Asia/Ho_Chi_Minh:20200907T100000",
+		"RRULE:FREQ=WEEKLY;BYDAY=MO;UNTIL=20200928T010000Z",
+		"SUMMARY:Subject One (K1C)",
+		"LOCATION:R1\\, B1",
+		"DESCRIPTION:Subject: Subject One\\nClass: K1C\\nTeacher: Teacher One",
+	} {
+		if !strings.Contains(calendar, "\r\n"+line+"\r\n") {
+			test.Fatalf("Calendar is missing %q\n%v", line, calendar)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"errors"
+	"io/ioutil"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Timezones are resolved from the zone data embedded in tzdata.go rather
+// than from the zoneinfo of each peer's chaincode container, which may be
+// missing or of another release, so that every endorser computes the same
+// local dates and phases. Regenerate it with mktzdata.go.
+var (
+	tzdataOnce  sync.Once
+	tzdataFiles map[string][]byte
+	tzdataErr   error
+)
+
+// loadLocation works like time.LoadLocation, including "" and "UTC" for UTC,
+// but only knows the embedded zones.
+func loadLocation(name string) (*time.Location, error) {
+
+	if name == "" || name == "UTC" {
+		return time.UTC, nil
+	}
+
+	tzdataOnce.Do(unpackTZData)
+
+	if tzdataErr != nil {
+		return nil, tzdataErr
+	}
+
+	data, ok := tzdataFiles[name]
+
+	if !ok {
+		return nil, errors.New("Unknown timezone - " + name)
+	}
+
+	return time.LoadLocationFromTZData(name, data)
+}
+
+func unpackTZData() {
+
+	compressed, err := base64.StdEncoding.DecodeString(strings.Replace(tzdataZip, "\n", "", -1))
+	if err != nil {
+		tzdataErr = errors.New("Can not decode embedded zone data!")
+		return
+	}
+
+	gz, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		tzdataErr = errors.New("Can not decompress embedded zone data!")
+		return
+	}
+
+	archive, err := ioutil.ReadAll(gz)
+	if err != nil {
+		tzdataErr = errors.New("Can not decompress embedded zone data!")
+		return
+	}
+
+	reader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		tzdataErr = errors.New("Can not read embedded zone data!")
+		return
+	}
+
+	files := map[string][]byte{}
+
+	for _, file := range reader.File {
+		content, err := file.Open()
+		if err != nil {
+			tzdataErr = errors.New("Can not read embedded zone data!")
+			return
+		}
+
+		files[file.Name], err = ioutil.ReadAll(content)
+		content.Close()
+
+		if err != nil {
+			tzdataErr = errors.New("Can not read embedded zone data!")
+			return
+		}
+	}
+
+	tzdataFiles = files
+}
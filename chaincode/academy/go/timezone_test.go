@@ -0,0 +1,43 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLoadLocation(test *testing.T) {
+
+	offsets := []struct {
+		Timezone string
+		Date     time.Time
+		Offset   int
+	}{
+		{"Asia/Ho_Chi_Minh", time.Date(2020, 9, 1, 0, 0, 0, 0, time.UTC), 7 * 3600},
+		{"America/New_York", time.Date(2020, 1, 15, 0, 0, 0, 0, time.UTC), -5 * 3600},
+		{"America/New_York", time.Date(2020, 7, 15, 0, 0, 0, 0, time.UTC), -4 * 3600},
+		{"UTC", time.Date(2020, 7, 15, 0, 0, 0, 0, time.UTC), 0},
+		{"", time.Date(2020, 7, 15, 0, 0, 0, 0, time.UTC), 0},
+	}
+
+	for _, expected := range offsets {
+		location, err := loadLocation(expected.Timezone)
+		if err != nil {
+			test.Fatalf("Can not load %q: %s", expected.Timezone, err)
+		}
+
+		if _, offset := expected.Date.In(location).Zone(); offset != expected.Offset {
+			test.Fatalf("Offset of %q on %s is %d, expected %d", expected.Timezone, expected.Date, offset, expected.Offset)
+		}
+	}
+
+	if _, err := loadLocation("Mars/Olympus_Mons"); err == nil {
+		test.Fatal("Unknown timezone should be refused")
+	}
+
+	// thoi diem cuoi cung cua du lieu "fat" van co chuyen doi gio mua he
+	location, _ := loadLocation("Europe/Berlin")
+
+	if _, offset := time.Date(2036, 7, 1, 0, 0, 0, 0, time.UTC).In(location).Zone(); offset != 2*3600 {
+		test.Fatalf("Summer time of 2036 is missing, offset %d", offset)
+	}
+}
@@ -0,0 +1,3442 @@
+// Code generated by mktzdata.go; DO NOT EDIT.
+
+package main
+
+// tzdataVersion is the release of the IANA Time Zone Database in tzdataZip.
+const tzdataVersion = "2025b"
+
+// tzdataZip is a zip of the zone files, gzip compressed and base64 encoded.
+const tzdataZip = `
+H4sIAAAAAAAC/+xdCXxM1/f/ZpFN8OxbMNbYwiCxL2NNgjCIiKVMSESIZEQSaxmtUv1pPUvRUp6i
+pS2epZZqGbtah9RWxKCWKjpoUUvm/zn3BJP+LZNW95gmZ85MOu/Nved87/fce+65+nZu7oXghf//
+Ly+A5v2T4vpF1WzeNy56YFRCeI+4/rXx3H8uAFwBeE27OC3ExW5/UB8AALi3DwtHcFg4svMhdrvd
+/swP8gkOC9f66Nt5eg1eXuzNGQDo59nfx9fh+/TrlxTlxI00AeABoND7pWYELjCvMS24+Z1tYeAs
+ZeGRONviwns1i9d+YPzodoby8SyLbcnx2pqlI9yNn3yxTvNpz0nGz+ZcUZY1/tK2/NXSmhWlbEa1
+dydFtZe3rdIdVVadXWJbU85Hs2brKeNatybK2kX5bOu+G6JZ/0ZL44adtzVfxO42blxSRfky5L7t
+q0mvaDZVrWncPFBWzLn72raEFVa2XLtk21YjVLPtYEnjjnyjlR1qB9tOm6rZNfU14+5avsrXyZ7G
+PQV0mr1dG9r2TmxsOnw2bfjJBSc1p1p8Wuz063mV9PLps8/ETFesHv1mn22zS3P20qxi5/3vKed3
+75l9wbuG5sLSjGKuLtl6uLm6Ozzsdvs+AHBXXdwBAD6Apxfy0m+XvPwKdWQ1rba2VnRnNa22jhbZ
+6RSyDuoYktQ5Qt78zkaSOknII3FCp84Scu0HRpLUaSSp40hS55GkDiRJnUiSOpIkdSZJ6lCS1Kkk
+qWNJUucKaS8v3qdOFvLsEqFTZwu59ZT4e+p0IRflE+9T55MkAyBJRkCSDIEkGQNJMgiSZBQkyTBI
+knGQJAMhSUYi5LVLQidjEfJgSfH3ZDRCqh3E+2Q8JMmASJIRkSRDIknGRJIMSsiJjUV7k2GRJOMi
+SQZGkoyMJBkaSTI2kmRwJMnohLw0S/w9GZ+Qu/eI98kIhfyrDPEJutSZ+k5GBXeggvvz0KWAA7pE
+R8cN7dO8b1RfZzDGFYAbgDwmAOYVN2e7uAIVQgCgwgC4A1W18KY7bN4qLBytm4cjOx9KbdjycvH1
+wjac+XCf1s3DA+qILz5ux1J1A4ANz4VVx2EiPjYuJmnoc+7v0QeUy3xewgRgqkHfcU7wcNucY9WN
+c7+cavzAHGKbNynDOF+palMMi20LTL62D2sUsC0c2d62KGicbdF6D+NOu4dxV+QXpl3feisHpwVK
+lreAQ0lFpMMdKhi/aedpvHAgDbd6qTbX4Vtsbi132/IcPIa8y1rZ8g3vAWlAd6lAaktjwf4tTIX6
+jJYKd+wDF3dXN4eHu0cuj1we7h7urm6e9MTT3QNwPQUArrXgDuSVXLwAAHke/fbTuOSn11GE36VW
+1YeFo1vrLvQrHC3pScvW4QBcXAAgO+1E/Tfo6zkVSFJ7kaQ2E/JYdeGj1HYkqf1IUhuSpHYkSW0p
+MNLkKyS1qcDIke2FpLYVcr2H+P922llSOwv5rbfwUWpvktTmJKndBRZ0qCD+ntpf+O6BNPE+9QMA
+UF8AAPUHAFCfABD9AgDUNwBE/wAA9REAUD8BAPUVAPxV/eXTsnV4QC3hGuMn/vL+GVfgjOvzXCOP
+g2sMHRyV9NvhIPn3wYEbAHcA+TPhYDLJuAnrfJ/AgtvjqyTDXVzLS1wrj7jWMxAipZC3vA/APjjf
+DDFONUOuzJ9C/7MferDy+reRa3ZZLVuuLYvcFz/yGxdXFzdXoNx4AChfObNVfIBKnyEvP2dIr11H
+K265mrZ2YBCyc1lqGLo0Sbq8GLt3WS08pi4T+u+4FYc29NlRqri3C+Dt4mQbtogaHDUoMRu97jvt
+4kx8OW296Xr+iZKLq4vdftcEAADc7fYbNnhlJdYB2lrZMSpfZukzha/TZUhm41JPRtqe9caFWgBY
+4HRTJMSmxGUvLHm1kAuQPx4Qzi/upFvz3xKWPOODfLo1f4QSD30qFXwXwLvZ+D4DU+Kz0fbStIvz
+/L5Ij527d4wkubi62e22aED8dmxvX7q5Fpnt7WQQ9vgS/G3n+Qk6mh47V9C/7F3uSRef6fm/SccB
+HHe6SeKGDo1Kecb9umX+OAKn77SLc2Xv2CjJxdVuvyFu74YN7gAAr/YONwUX+i87n8wtMVcGAOev
+8OTLH+0asnwrgK3P/fL5HL58fFRC8oikmGw5e4Hq2lmzhl75LNSWLGBdYwGAskXgDpT1pd9+Gr7P
+HmHhaJm9EYTboEB1LUm6jAiOnL+UT8vm4QG1RWu8W3DwoEMADsFJXt0iKWrkyKjUuPj4mOy5vKm6
+C5A3/Pe7/DM+yMHlw48dbPFil5ccvlTKwJTBfVOSorJzNyZWSr4JiCZ2b5/ZvNn9SnETNt996gc5
+9NOra2r7vfgrOcyutIyKS3re+OQOAAA+yHwuqM7u6Wusu++Nsnz94Efrnl2XLXv3LjfsO1PFsv+z
+s9YDO3+yHJzS32pZOsJyaLzBevitZfrLdfsZLv8UoL+Se4PhyhGD5Yer92xXPx+MawfH2q7Puokf
+1dVG24iKphtTL9pu9uiOW0NLGH9qNNn0c9f5xtul85vu1D1su2NvjV+KeRh/ObsH935pYLu/9aHp
+wclNtoeLXzNlfHnTaJ+wFphX0eYS+73JdWx3o1toKbhHpRtzVfsQHi3z2zx9j5i8KrQ2el33hk+u
+4Tafg41NuS89tPmu3GLK83UtY95pPyPfJ9E2KbmyKf//ZhsLdO2FggmljIXqnUXhjnpbkeKFTEWV
+6bai90JQ3LjQVvzkKFOJnxrbSm6Cye+I0VhqfiBKr11oK2OKNWlmHTOWjZqDciN7Gcu30qBCX39j
+xYpdTJUabzP6e0xE5VK3bZUvfWGqilG2qnuamaqdXWGs/ulQBGw9Z6sx+SNTTaWgVTvI11Jr7M/W
+2m2vW+r0OWYNrGqxBDXbYK3rs8pSr/JRQ71bB/UNvNYbGqSt1De8/L6h0erp+sa7RxuaTE/RN13S
+29BsaDe9bupIa/NeQy0thvSytmzc1dKqU1Nr6wIP9W1qaaxtrAP1IfldrSHr8ltCbd9Z2769Qd/u
+WxdD+6ivLO0nRVrbL7qn75JS39rls0mWLt9+aQiv8Jo+uq3NENMwTd+/6mFDbPkfLQN81hji3A5Z
+4q7MNAw8v9oyKG2VNX7bu5bBq2dYExYOsyROT7UaX+9uGTI00poUn6of2qWhNbl9pD6lcTdDavWG
++mF+DQzDff30wx+UNIz4IUM/8uRDw6i9Vv3ojWcMr655YBkzZ4th7Ix0SzYnFbIz/fD8hzOfAZTq
+ChB9dGEM9ebn5J2tKSxpLcISuGTHy8jfX/0q7FMxvTN9jVXIe6MEpSWvE9M8uy4LnbxPUNwzVYRO
+XkiSPFGEgFP6C508UoSA4w1CJ88kSd4p5E8BQicvFfKIQfw9eStJ8liS5LWCNs66KXTyXpLkwSTJ
+i0mSJ4uQcmgJ8T55NEnyapLk2STJu4W0txZ/T14u5Nk9QidvJ0keT5K8niR5Pknh/fRvwlpuxXkV
+bQBASAAAhAYAQIgAQKACABAyABDoAACEEABAKCHkdW8AEGgh5MHG4n1CDQAg5AAAQg8AIAQBIFAE
+AAhJAIDQBAAIUQAIVAEAQhYOkfXi7wlhAIBQRsh7IQAg0EbIk6PE+4Q6AEDIAwCEPgBACARAoBAA
+EBIBAKERABAiARCoBACETAAEOgEAIRQAEEoBACEVAIFWQl76QrxPqCXknmZCJ/QCAEIwAALFAICQ
+DAAIzQCAEA0ACNUAgJANAAjdAIAQDgAI5QCAkA4ACO2EvHVQDwCEekKmrRQ6oR8AEAICAKEgABAS
+AgChIQAQIgIAoSIAEDICAKEjABBCAgChJAAQUgIAoaWQ1oFCJ9QUcl1+8feEngBACAoAhKIAQEgq
+5KRI8T4hKgAQqgr52STxPqErABDCAgChLAAQ0gIAoS0AEOICAKEuABDyCnllptAJgQGAUBgACIkB
+gNAYAAiRAYBQGQAImQGA0BkACKEBgFAaAAipAYDQGgAIsQGAUBsACLmFfFBS6ITgAEAoDgCE5ABA
+aA4AhOgAQKgOAP8eZPdp3To8oDa9VD0ssEZQjaCa2uphtbQ1gmoE1qwdKIjZjQn9WvXxBvp4P4+Y
+5XckZkOj+sZHJfRzhmz2AZALgO/MTr/M3WkvZNoV+Zlx14lrpsMLattOBi3Cyev+tvsVk3T3t/5o
+9Bo03uS1c7At92Vf+EYF2/J83cyU952bxnxpnUyS/0JjiXUfoXSxRFtI84umkI3ljG3Ll0Dbt48Y
+250ym9q/csEYNmu9qUPgZFvHuYqi99qo6Gu9r9HHfqx0Gv2a0un4XqVzrnlK5/ihSpd6I5Uuy8sq
+XU610YS376p0LdpL6Traqun6qbsSUb2eEnGnqdKty0Sl27jPNJGFwzWRxzRKd81PSvfILkr3n+tq
+eqxzVXrcmavpWdGs9DxSTNPrQEul14MBmt5zVKV3Wj7FkBSpMSijlb7Ndmj6DqugiS4Sr0S3mqqJ
+uVZI079kfSX2i3Ga2BtWTdzUykrc5jDNoFiLZtC7PymDg5poBsfP1STm/k5JbOitMZ7opAyRVCVp
+xS+apPRSSvIERUles0OTGp5HSf1fvDK86uea4ZEnlBF3y2lG1hynGXXga2W0R2Xl1cVDNK+mvauM
+TUrXjP2kieYpNuiElbm5O/mw2+9+x8GMizsAwAsisAEAnhGtpq1FCyLOBBY7HhkMjZtkNDyHXIjn
+jiM/47nkE9d43WhBbTHgkiEJed2fB+SKSToemH/kAXPQeB5Adw62AQAZGABhZDxQ8oBBxgYAZHAA
+QEYHAGR4AITxAQAZoJAby4n3yRAZaI8InQwSAMgoAYAMEwDIOAGADBQAyEiFrPW+RsjYj4VOBivk
+8b1CkuEKGT9USDJgIZeXZXmqjQYAyJgBgAxayNFW8ToZNgCQcQt5p6mQZORCjvtMAwBk7EIe04jX
+yeiFjOzC8ue6GgAgBxDyzlyhkyMIeaSY0MkhhHwwQOjkGEKm5ROSHERIZbTQyVGEHFZBSHIYIVtN
+FTo5DgCQ8wAAOZCQN/j7kSMJuTlM6ORQQr7L90+OJWQ83y85mJANvYVOjgYA5GwAQA4nZHopoZPj
+CbmG75McUMj/8X2SIwoZeULo5JAAQE4JAOSYAEDOCQDkoEKmvSt0clQhP+H7HBf6hnh93LBPhP66
+JoBlB77P8dfYXt6owPc5YXtz8fqEm/2F/ubU5UJ/82u+z7cGRIjX35rP9zm56UPx+uTBfJ/v+A4U
++jst+T6nnC8gXpfz1xX61A0bWF7i+5w+sZLQp2/i+3z3lQPi9Xf/d1Pos6o1Evqsvnyf77mcE6+/
+14Dv8/3jb4nX53jwfc5dfEfoc0/zfc4bzfY+75NtQle6sH0r4/g+P9SsFvqHkXyfC+/0EK8vqjhW
+6Iu37xL64gd8nx/PSRSvf3yY73NpUhHx+lKF7/PTZq8L/dNUvs9lRaqJ15e16ij05VcPC7miBN+n
++kUzoas3+D5XyZfE66s25xb6mt7sR2ve5ftcG3hfvL42nu9zfe6F4vX1Dfg+N5yQhP6FxPe5ccU6
+8frG06eE/tWEPizX8H1u7rJP6Jv/x/e5pSrjwpbI2ULfescq9G01+T63H3hTvL4jF9/nzsU1xes7
+0/g+dyfNFfrupXyfe0KZge8ZNkDo+8r8wLID3+f+a5FCP1CB7/PgdojXD96sIPRDUwcL/dBuvs+0
+AYXE62kzGwj9SNMvWcbzfR7zrSL0Yy35Po+fs4jXT0g/C/3bDcNZXuL7PDXhgtBPbWr1Rw9uf87D
++SHUp3E1ba2mmfOE37QLKPSqN/Cqt7OTajEpyc+ibV6ZPwAwJvN5EROAeUdTjPMXJBg+yfWj8ZNL
+CVj29ce25R/Xxgqlo1GdWAgrJ43EKqPWlC0aN1mWyoweJ2nik6SyvfpJ5dqHS+Ubt5YqVK8rVfTz
+lyr5FpP8c7WU/X8OlCtfqChXOVJErrrDU6625q5cfdH3csCMb+Ua4/fKNZO/lLXRy+Rar5yQaiet
+keo02SgFdp4pBZX6QKpbe7hU9+FYqX7JGXL901FSg/upcsOvWkqNTkbKjT/qIzfZ2FBuOqG53GyO
+n6zrX15uPr6B1KJNLrlldEmpVcVLcuuWD6U2ub6Wg8ufkYKvX5RC3bZIoQd2S23PL5TaLf9Ear9v
+sxz2zmSpw2cL5I4JCZJ+8utyp25vyZ3jY+Qu9eLl8PbBcteiHeSI6lXkiDtaObJwGynyWAG5+8+V
+pR5rf5J7HvGRein5pVfWXJV6j70l9ZlxQDL0OSJFvXpF7ttsndTvlX1ydJn3pJgmy+X+Xp/LsaVk
+OfbyLHnAwyQ5bvcIeeDpcHnQkp5y/M4h0uA3m8gJH3WREgeUkY0TgqQhnRpLSf2LSkNrlZaSW/8i
+peSHlFqzsJxqOycNz3tHHm7ZLo24fkIeqX4kjTqwUR793lb51eUfyGNGLJLHvjNWdkyAeRyb5PLw
+/HMedvt9dwAAsqy6uz95Jcvau1hd7tY6c9W95aNVd7jQfy4ucAEAF5fs2D2xxzF1PxeskuxfZEAs
+SBDTT+QHQl5KEO+TP5AknxDZXEpH8T75hlj5nTRSSPKRP5SdTpYlACC/AgDyLQAg/wIA8jEAID8D
+API1ACB/AwDyOQAgvxPy50Ahyf8AgHwQAMgPAYB8EQDIHwGAfBIAyC8BgHwTAMg/AYB8FADITwGA
+fBUAyF8BgHwWAMhvhXw4VkjyXyFPRwmd/BgAyJcBgPwZAMinAYD8GgDItwGA/BsAyMcBgPwcAMjX
+AYD8HQDI5wGA/B4AyPcBgPxfyOsXhSQcEPLAbiEJDwCAMAEACBcAgLABAAgfAIAwAgAIJwCAsIJZ
+fYzMrD5eZhYfLDOL7yAza6/C8o5WZpbeRmKWXkBmVl5Z6IQpzMJ9hE7YAgCELwBAGAMAhDMAQFgD
+AIQ3zMLXCZ1wBwAIewCA8AcACIMAgHBIyMuzhCQ8AgDCJAAgXAIAwiYAIHwCAMIoACCcAgDCKgAg
+vAIAwiwAINwCAMIuACD8AgDCMAAgHBPSdk7ohGdCWrYLnXANAAjbmH1vFDphHAAQzgEAYR0A/Fvx
+jjONWorJnzo1gmo8mvrR1uScEZeMkN7uXoC71/PYg0NmXsvEhKhBSSOylzQypxdlc1zTFpFFJset
+CgDwcpNG5vRyTBrJxqWeLKq3uhNy68VJIw5MqlXUoKikbGRi+U67OO/4nkaSTMv9Py559nJ/dj4z
+M6niuFh0ceKzn3zftNNptdYCWPvc71vI8fsm9YkZ2qdLVHxU1OBsdFHetQvTPjv6wVjLjwX6U54T
+UOEbnrqEu8hv4gQxnrrMTpLV4wuIBOiFaZ+RpAuJlZ/sXMwhjaqn9er+IwCOOJtb0WpgXN/ElORs
+ZQ9NvXXY1wWonAxkvZ3srrs/84McvtGRHZd/yFb2UKvElKj4qOylRrwxwAXwLobfnRrxjA9ySI2Y
+FjTv5Iu/j0MPtY7v0zwqLsWZbSfhADwASF+G2Ky5D6nysyKYnAno7E1Au7i5OvvI5e7kw26/pTxB
+usdjpi8AuPjSK0+wLxvzz188sgHeJ2GzAgDZwm9h5jnzxDnzxDnzxDnzxDnzxH/9PPEfMQb9OY/f
+MtJlmSbOV9Fr3x0v4I6Xk5ypTVJMTHLiMGc4U0EAuR7NFL+7bnP8F02t8hfpUYaNS0rKX26eYf1q
+Ukdpk7LbsHnga7L59TDDljBPeWu0ybqtRiNpe+s1hh35EuSdw7oYdto+lHb+fN6wN7Cx9P/7yt1u
+vzUDEL/d7fbbiouXaJC8AIAC9PXbiCbQBmqzE0o9vn0a7nuMXHKVJH0NkvRVhEyPMvD2yZJCp6/F
+2yc7CppAX4+3T74m3qevydsnPYVOX5e3TzYSf09fm7dPJoj36esLaftQvE/NQPIlNsWTyG++dsle
+iwtgcXHSGIKj+iYmJSY4ky3tnvlTwATgtTYHBxx4J8F0cEwnI02vAMXaA0DhYnBMWhGTGV2ad+GM
+4Zb0JDtXonYylDGK7bt0RZJ0VZLZv7JDwvKFSZfcfgDwg7OBU0hUUlRStnLKX2uzK9oFKOWH35mA
+/cwPcvg+tS7MPvXiwKngk+/TNnFAVEJCzNC+KUmxTtyQBwB3AN6P+n7f8smm/fMSjY9tQFgvUEL3
+pC8eJzC5P+6L7FyKvvvgUc11jp1PlxXJpvMSjf/PGJy7BR/6ndlsK2drV90GcPu5zZbbodlSnNqw
+Wx5ALgD51y789hTmFbW6jAzUu776i9WtZ6zF3TDGmqvJVYuHzmD1LKPRe2laWL3RxeKDClafsxP1
+ua0eVt/tvvo8my9b8y5uZsm3NJdBmjBUn//NS4YCsR9ZCg742lCobTVL4ZBPDUWq9dEXrfy2oZjv
+NEtxr0RD8Ws79SVu/M9a0tJO73dosLXUyjGW+qbWGkPREnonkteAUovw/1LPeHqStxS2fORRTk5p
+PG4Jnsj59hQAUIsAALUKAFDLAAC1DgBQCwEAtRIAUEsBALUWAFCLAQC1GgBQywl5dqIeAKgFAYBa
+EQCoJQGAWhMAqEUBgFoVAKhlAYBaFwCohQGAWhkAqKUBgFobAKjFAYBaHQCo5YW8tlMPANQDAEC9
+AADUEwBAvQEA1CMA8Mf0igMsbLxQzOVjV+BjVycngdtFDTY6N0Hk/mgb5MrrZ/zWbH3fcvSDCccv
+TQ4Od3F1cwFKRz+ZmitfGV4Oux0dJ+jqaJ2fE3R33HhJV+ViCe9beG5wgpgo/e134DCtNqfnO4Xu
+A7jv7DRUuwFRScmJKYOzCQ34O0BD97vfOWeEpU1/LDQgBxqeQMMf0ysO0DB2X8SqF0ODAwNqFxcb
+FZ+tqfBNN/LqXQA/7e9lQM/8IIfv82Xlgj9ka+q4XVzC0AFRQ3/Lprq8kb93Mnx4WPUlT/0gh8nw
+alOHb8rWprr2UbGJQ7NDryd8ajw0WW/AzE9aHlrQ6cfhLnB1A9xqPvk7T6/MZKW8j1bWuPSJk985
+C5Gnq5GkK4r8309aCj37V3ZopOH7osa9mMg7JLi3j+ubFPMbNoi+scgF8DqF378K8vQPcvhOAZUW
+nXlxxzuw0vaJg2N+gxm7PF5C/a2FtxKr7ez11A96EpGG7xh2/cWFtxxwpn1KVEJ0VDb2XIuvM+2i
+yejiCvgOAADfwF83L3/h7HyqCD9C6xbL3NbrxKc7LmRt6BO2EcBGp80ypW/K4L5RQwfEZWeJ2ATg
+w7d62ujeipsf3dWTLdXdmjsNuFmWnYeHlRVhlzOf7YDBsb/491wHYJ3zfT00alBU9qLqrx+4ACXG
+//6o+hkf5PB9muZacyxby6thUfFRfROzlzgwodVP8zeZXFwBr8YA8DgpxOuRM3Vr/lsSBya0IunM
+ZzvY7RzJbX22ejAsypiSnJidxn+7xZFUoudv/d4efOYHOfTgsOqDTmSzB4fGJKU4maFAP96vtdmz
+/slMmAtQfPejG3qyb8vZyY/Hn8omumf9/5/xet4VHOc21C3rgswAzM6W5gqjamQJ2RlIvF9rsyvS
+BShV2bELnP2ujz8lc5LrGZ/k+J1KqbElZwOY7WzphLDE2KjouKEDUrKTc2ICsHbhAf2lyW2Pi/au
++uavQ8n/H0RmN6nF+GX0XQ58Dug5cM3GxRxi1eIfrbRmK6klLDEhKTE1Lio7mVgmAEqf+Llue3ok
+iJouP38HiN/udvvP0xyzkcKcpxJZEr16jPxI7Aajy4g3nb/UE7Ix+kJL7YsTvRxsvgPVoegbl1Nt
+KtvVphwsqkP0wKjBMQlR2cC3PNMumqIL9B9iLFgp3SDMPq+fI8VweUwxumUTOfNk8rVoAKArAIBz
+V3EYBxcWaPrJLgC7nB03OsRFDY4Zkb2yTJPHfzltp3xN62USlXFcHmTN7RP36euY4det+W8pyzR5
+PCdQ7pQ5gTJbl3Nok4GF7/Y6AeCEs5y2Q2JK1KB+AxKTk7OXVPpBsqhEdmE3Z3re8AWAl5tU+kFy
+lkpkzl/KIbDp8m7Qi7HGoTZRx5So2KjoxJTYxJTshZ5TrrrY7Rkzfm+w9swPevKdhq1rGvLiYM2h
+i/WJScmJAR0SU7NJeif7Utu7uAKuyS+T9E72fdSvL/psB8Pe9nrl2i8mvQ6A1yUqsU/4s2PuR5gN
+h0lrXw5S3zH1CH1T6lV1I+WkuwMeesBuv9Mv631m7Z7HAab4lZ1rUlv0bvxQy23zjgkA6PoA8Hvu
+4YnBJC6usigDQIazTRceN7hvyqDklH92nXAHBhGeFGdMdGpeVPOowuaCm1sqnN64Ukovv8xwdvhh
+6eylGOuFms2MF5bus/7QYaetyLQWhqJed4xF71WzFrtS1Vb8ZB9DiX0rbCW/+s7gt2akrdT8otbS
+03Mby7x23aAxtjWWjRpjLdf5oLF8KzdrhUBXW8WK9QyVdOtstTvcMNRpVdcYGDTQqv/gPXQKP2Hq
+HLfG9MytlG7EHVzcAN9eTMNd2Bi86TlvTHhc8ZSKUGTnS3Pl7S2iOi19ea78vEykQlAjCHkpRqRS
+UGMIuXSf0KlRAIAaBgCocYS8V80KANRIEKVq+oj3qbEAgBoMAKjRIErV8OICNR4AUAMCADUiRKka
+XlygxoQoVeMmdGpUiFI19cTfU+MCADUwAFAjAwA1NABQYwMQDQ4AL7XRueqHMNatX39efYgrMMTV
+yRni8JSEuOfNEHsAAIBymc/zP6q9vLPhm9ZdkfMMu06Ws+45F27duyzOtu9SO2n/0qLy/q1FsH+f
+Kh34MAiizvKk81aus1zEkGfXGVveSZ8ZucRZCWO5hQ1t5RtXtlWoXtxW0c/HJkqT5fI3tkjOY2vZ
+2IRn7cwUtXwd0qb+f14X4D0Zj2v5Erd7tLPm8f6ax/V7f1W2NzsNQubYvU3BnxyLLFPjcAGLecKc
+qZFIUkORpMYS9PxSO2Hm1GhCbi0iJsKp8USc/2EQshRPnnTemrV4chEDAFCjAgA1LLJUfiohdGpk
+iK2AlYWkxobYCugj5ONKS7m4AhM1PkQlIhOQza1yf2jHOFRTnvFV32YrXYGVrk4ONN3iEqIHJMYM
+cgKjggB4ACj66+SWSh4fWwMm7zLUiPOTar76PbQhGVKt2Fym2pWtUp02l0yB+R/IQZW+NgXdSJfr
+eXxqqnfILNe/+LapwcoP5YY7E02Npo6XG6+YjCZD+stNpySgWY/XJV2iHs0bxkgtOtZGyxLBUqua
+hdDqXhWpTd7baPNtbin4bgFTyDZ/OfTYT6a2C73lduuOmtq/9oMsdh/03S93HPa+Sd9ihdzJsA6d
+y02Vu+jeQ3ju5VJXzSh0/UGWuuEVdNubJEVam6H7p+GSy29JeQQkLzjmVPmIiSYfkaCYT7xSMHPf
+eh0tT9NkY6r5cXtzhk+74c/K8AEA6gMAoH4AAOoLAKD+ACD6BACoXwCA+gYAqH8AgPpIXLTS10Kn
+vgIA6i8hD5l5/+nFt4VOfQcA1H8AQH0IANSPAERfAgD1JwDRpwBA/QpA9C0AUP8CEH0MANTPQt6r
+wk6V9zaE/DY3O9XdArybYJu/DADU/wBANgAAZAcAQLYAx90Efffz/tNh7wudbAMAyD4ACBsBALIT
+AMJWAIDsRcgfZKGT3Qi5N0noZD8A/hIbcpjAPRtZ2WujG7DR7Xl+L4a4wTGZRytEPcvpvTN/AEDO
+fF7WBGDvpFaWw+V/Mh6O0uvuHe6qZqwforcvCddhdhe9y5t1da7Dg/RuQzqb3aM6WXJ1CjR7NK9j
+8axVxOz59mCLt3TX7L3se72P7VtzbusJi++JO7o85o2WvBtO6PItPa6X3tuoyz/pC32BER/oCsbN
+1RfqPlZXOHSMvkiDKF3RKgZ9sXKvmot7t9CXcO1jLlGtnKbETb255Lk6qt/h2uZSWwurpVcVMpdZ
+cEfVpAbryo47oZaLqKIrP/C2tkLd3LqKbY9rKxW+pvOv+oXW3+5truIzV1sl/Qdz1StjtNU27TdX
+T3tfDZi3wlxj9Wi15pipZu303mqt2OW62kN1ap1gWRfYpZwa5J+kq1vHXa3nGa6r71dWW/9SXV2D
+B27ahruK6RqdvKBtrAaam2zcpW0qFzE3m7NUq0u8a24+fofaouO35pbRH6utan5pbt1qktr61o+6
+4ICPtMFph3Shed7Uhq5erWt7baC23dyD5vZHJ6hho1eaO6wdoHbsNd2sn9lW7dQ4xdw5tbraxa+b
+OTwirxr+oIE5IsimRlyI0EWWyaON3FFf1z3jR22PxSV0PdMPaXuNf6B7ZdNqbe/odF2fee9qDWH3
+zFETV6p9A06Z+8VOV6PzbDLHBKeoMdcUc6x/NzV2/2vmOM8GatyyaPPASyXVQTPH6eIt9bWDU/vp
+EtQS2sSI1jrjlAfaIXX9dUmJ6dqhRbx1yR3N2mR7RXNq/VNq6hlP8/Bim9Thm743j7irqCPn7TWP
+Ov6aOnrMMvOr66PVMb2nmMfObqNSttHzPcHDy/NPfACfngPs9hg93O32oQZKIB5qcPEVr0jieSH6
+jWJ2+zijSynxvDh5Yocu4ejQLRwd9OFo0SUcLVqFo3lIl3CEtAoHABcAePLkGU7mk/kDAFMzn5cl
+RG+29aFY7391Yo/eghZNaiVmL8nxhIzSi0VHckCSwgnpHzkiAHJGACCHBABySgAgxwQAck4AIAcF
+AHJSACBHFfLtwUInhxVy2fd6ACDHhUgNOiHeJwcGAHJiACBHhkgNOq4HAHJoACCnBgBybAAg5wYA
+cnAAICcHAHJ0ACBnBwByeAAgpwcAcnwhq/E+HAIAACAQAAACAgAgMAAAAgQAIFAAAAIGACBwAAAC
+CAAgkAAAAgoAILAAAAIMACDQENLubQYAAg8h038QOoEIABCQAACBCQAQoAAAgQoAELAAAIELj8TL
+dQBAIAMABDQAQGADAAQ4AECgAwAEPABA4CPkJe5fAiEAICCCqARxQegESBCVIHYJnYAJohLEUi2P
+xNy/BFI8EnP/EljxSPyl0Am0hLz1ow4ACLyETDskdAIxIVevFjqBGQAQoAEAgRoAELABAIEbABDA
+AQCBHAAQ0AEAgR0AEOABAIGekA8aCJ3AT8gLEToAIBAUckd9oRMYAgABIgAQKAIAASMAEDgCAAEk
+ABBIAgABJQAQWAIAASYAEGgCAAEnABB4CnlNETqBqJD7XxM6gamQy6KFTqAKAASsAEDgCgAEsABA
+IAsABLQAQGALAAS4AECgCwAEvABA4CukvaIZAAiEhTzjKXQCYyE3fS90AmUAIGAGAAJnACCABgAC
+aQAgoAaATLB2d83l8byHp7fXn/h4hNY9+v4xmA0XZEVtn5Au4bW0Ia1E2YjaomxErRq1avDs14Af
+pYBAbyDwxeVCH7GthH4DEpOiYmOyQbkqM+Wqa2bK1UJ773CwkrG+n86+pI0Ws1vrXN6srHUd7q9z
+G9JKdY9qac7VqZLq0byi2bOWl+r5dh+zt3RF9V52Uudj26fmtu41+574XpvHvMycd8Nebb6le3TS
+e8u0+Sd9piswYoq2YNw7ukLdh2gLhxp1RRp00Rat0llXrFyiWtw7UFfCtZNaolohqcTNFmrJcxUV
+v8MV1FJbPZXSqzzUMgu+VzSpDbRlx+1VykWU1JYfeFlTIeihtmLbPZpKhc9o/at+pvHPuK9W8XlH
+UyX9tFr1ilFTbdNmtXraZCVg3gK1xuoEpeaY11XtdL1SK1bR1h5aW6kT/Jo2sEshJcg/Wlu39m2l
+nmcbbX2/gpr6lyprG9z/WdNwl4+20cljmsZqJbXJxg2aprKX2mzOHI0u8YrafPw6pUXHfWrL6PeU
+VjWXq61bjVJa3zqrDQ6YrQlO26YNzTNSE7p6sbbttV6adnO3qO2PDlfCRi9UO6ztoXTs9Yaqn9lY
+6dQ4Vu2cWlrp4heqhkdACX9QTY0IOqdEXAjRRpa2ayJ3VNV2zzir6bHYV9szfZum1/jr2lc2Ldb0
+jrZo+8ybqDGEXVWjJi5U+gYcUPvFvqFE51HVmOBYJebaNDXWP1SJ3Z+sxnlWU+KWRagDL+VRBs0c
+qo23VNUMTu2qTVB9NYkR9bTGKdc1Q+oW1yYlWjRDC9/XJndcpUm2F1VT6x9QUtN/UYcXU5Xhm06q
+I+5OU0bO+0oddTxZGT1mvvrq+ghlTO9x6tjZ9ZW/H+XafhcQTukuHNRL/PYVr0jieWH6TWd0TTG5
+lBbPy4tTk7qEo3m3cDTXZ7ptc/Lb7vSkHXlyuy6/k3lVzsq8mocw86przsq8WmiZeQUrzLz66Zh5
+tdECAPkkM6/KWmZejODkn8y8WpqZeVVSmXkxgpO/MvNihkN+y8zrpI6Z1z6VmRcjOPkxMy9GcPJn
+Zl57dMy8lmmZeX2mY+Y1RcvM6x0dM68hWmZeRh0zry5aZl6ddcy8ElVmXoE6Zl6dVGZehSRmXi1U
+Zl4VFWZeFVRmXp4KMy8PlZnX9wozrwZaZl68Q59wgpnXZQ0AEF4w89qjYeZ1hhlXVd5pT/jBzOsd
+DTOv0yozL6OGmddmlZnXZIWZ1wKVmVeCwszrdZWZl15h5qVomXnVVph5vaZl5sU7bwl3IGpw3VaY
+eXH/EgYx8+L+JSxi5uWjZeZ1TMPMi/uXsImZF/cvYRQzL+5fwipmXty/hFnMvJYz42o1SmHmdZYZ
+VwDvWCYcY+Y1UsPMa7GWmVcvDTOvLSozr+EKM6+FKjOvHgozrzdUZl6NFWZesSozr9IKM69QlZkX
+FGZe1ZhxBfGOb8JBACAsZOZVVcvM66yGmZevlpkX70gnfGTmtVjDzMuiZeY1UcPM66rKzGshVzgI
+OKAy8+Id84SfzLxiFWZe05hx+YcqzLySVWZe1RRmXhEqMy/e0U/4ysyrqoaZV1ctMy9fDTOvelpm
+Xtc1zLyKa5l5WYRO+MvMa5XQCYeZeR3gygbpvzDjKqYqzLxOqsy8pinMvL5SmXklK8y85qvMvCIU
+Zl7jVGZe9ZW/IfPKBO3hfyh0/38CRu80aN7u6QzsvSJzjC29gZbeL8xwe8zAYlPi4rNX1Gd6vSCN
+i92+3QrY7bvMcH/0hbK7ovrMD/Jp3iWcS9Arp3T3X7yimi/LN0qOi03JzkmIvtPr1bGdanFKTzWy
+dkqA3b5BpQ7dZeZV/9Zd+Laym1FAn8tn1r74s5985fdLfzvuxfW3stDopKjYlKg4p3KoAh/d4MxP
+krXr84Va1z/UKRtuBGq/SE/WfFt/ufbbH+5pTxU/q57a0F1z+n5hbfqcdM2Z0+9rreF1ND/Nsqs/
+5+qtuW2O1t7JW1q526m69u6uHZp7PofVe0vuKvevemgf/O9VpdTX72hLDz+mlFGCVE2dg0rZcXHa
+crl3K+UjJbX8OaOmYr2basVvdmm0phHaWqXClNrdNGodj4GawPrh2sC7WzR1NWW0dQ8OUOrd3qnW
+X/+GpsGZ89qGU6orjXbb1caJeZUm26dqm3awKfrXY7SdNA2UsXa7/Tect+Bqtx+2AHb7eauLu91+
+xPKoWEJtBGjrIDutyVtSkgURolYV8qFOECJqXa4VkCyOHKZWFvKHe0JSawu5obt4n1pdLHDPSRc6
+tT5J6gGS1AskqSdIUm+QpB4hSb0i5K4d4n3qHSGX3BXvUy+RpJ4CAOotAKAeAwDqNQCgnmOCEqcF
+AOpBAKBeFPIcEw3qTSG/2SV06lUAoJ4FAOpdAKAeBgDqZSHvbhE69baQB7lUCPU6AFDPAwD1PgCQ
+BQAAWQEAkCUAAFkDAJBFAABZBQCQZQDAH2MdPo0DtHWactLjvN6ddcluQPJz1xlKZ3HZ2JiE5LgE
+Op8tJiFxaJ/mcUkxzmy/avrocHKxe2PaFO3aUZ1160p8ra4vPUW3/huj9ouir5q/+HyhuvHuSt2X
+M49pvzrxnXnTcB9188ZiOnOPKeqWDz7QbW20TbttjMW83e+2usPgrtuRUVW7q3k98y7rCu3ucTN0
+e8IWqHuuTzAfCLNrD6jXzGlVgtRvWkabf2ripf1p/m3zz7nitbdrS7rbF+erdwu2NN/dlab95adh
+untpddX724LMD77son34WWddxtwgrX1yoM5zYVvVq0KiUn7G52oFaYYi/N+WqviXzaf1t0QqVXBD
+W0VtqFS1pmmrvddNI/x+c1U1eJi3GnxqrCbk3odq6JdRGupJl99Wq8VN/M5lt38dCNjt2yyPQJh6
+lkbs81YXiZ5zFmNL0dOBoqepx7PTM+RbSXOXtBd7YKZNEb5GvUSSekpgQ+kpQqceE9hQ9FURVFHP
+kaTeI0k9SJJ6kST1JEnqTZLUoySpV0lSz5Kk3iVJPUySelnIjKrifeptIa0rhE69TpJ6XsjrE8T7
+ZAFCqteETpZAkqxBYFETLy3vFLltZkyKFzpZh5AX54u/JythTEoT75O1CExKqyveJ6sRmPRlF/E+
+WY8IJsmC6N9kDrrIkgCArAkAyKIAgKwqCxbZUoVOFiakJVLoZGlCqg2FThYHAGR1WbBnc1UhyQKF
+PMVlocgSAYCs8RG2/DUW6Yg/3lUarwp0BwKfe4J8yafiT8uo5KjBUUn9orJj4v8u8LHrngY+uo03
+tLojuc2PQOi3dbUrd7Urd/XW8D8HfFZUzwGfvyP42HXZAR+yQCGP5DY/DYz+Oot0BJ9PmvXd9GLw
+0TwdfBIHJ0Z3jkuNio5yblNRDgLlIFAOAuUg0G9AoOLPQKCk6MS+OdDzh0Rerll47jbLnwM9y9Qc
+6PnnQ88fE3m9DIt0hJ61K+9LL4aeok+FnrYpA1Oc2fTZ+F8FPFW7mf3PnVQrX3/rucDzW7rX1e1x
+99K4svsPgpvGWeFm+focuPkbwE1Vzosj2wIAsq/fAzd/lf05gsvP5ZQzxdyBYs8FlxJPBZf2UX06
+xyUOdIbYNPvXEZv9nyuVK1XSPWta+SXFVbmy0Ngtf1Rg1Swr3Kg5gdXfid3s/1zoZG6/ZX75Dwqw
+XoZpOiLRgmXv5WrhDrT4DRFWWExCdOLI/16EVTxQ579/m1r5Xqy5ihqiVrWe1WUBIbWg2jyiqfl3
+Eh43V4eO3jz6z4mwVrnnYNDfAIOK8/9HVgYAZGmMPSEqY89Z3XOxRy0oJFnhS6RAL8EiHaGnxMlm
+b7w4wnr62nrnuMQ+wVHx8THOlTb9T62tvxwSlHWg2ez3J+FPdA7+/PPX1v8Y7vMyLNIRfzqNuOXz
+W2d4ukTFJ0f952Z4nJxa/p0TeBRhf/YnzfB8kwM3/74J5b/K/hzBJe7Dau/91hmeLlEJfdqmRCXk
+zPA8hdx8MV9t7qbVvdQZns3b/5wZnpVf5sDNv2iG5wve1kLm+IfN8Gze/nuR6LMlJd5/8QzPs5Go
+fUrcUCdN3fNfgUQPFz+Z4gkuaX4q8kx/oASndVZDbqTqQlcHqm0PRep+Y1DNXS1eogm9fS/qbn7l
+d+CRp0Mqz5ocPPoL8ejh4qfP9gSXNGcLb6Y/UIRM6yx0MkqIbZKBQifj/B2zPi/PQB1RKbjFsnwd
+3IEOv2HeOTylX8pgp+iR7j+XVLj3kO6lZvi4Psqo2HrqD+JIul+FZItyMOlflF6495DuD8/5+Z02
+6ghLQ5IqHtG7A/rfAEtdhw5Iifov5zo/I2Lb0OHlT0ff/XOmo1e+mQNG/4Lp6A0d/vjp6Lu/F3rm
+BS7634uno/NkgR7njh12BeAGIO/00jXr3559NYS2rG8KAex28/rMG/bhHbCBdbTZ3RAvjq2hTxam
+4tSnP9kSb3hnVaMvAHwBp+saDE1J6BeX6Az7iwOQC4BkArC+6M9yrmrf6jwf2FSfg8nm3LP2qL4r
+fzHnGZ6hzTs9QJevRy1VSjaY80e3VAt0naEr2Ga3Wqh+cV1h/wfaIsXbm4t611SL3jPpil3ppy1+
+crW5xL7L2pKb6pv9lpdUS82P15We0lFbxjTfrDG+ppaNStOV6+Kplm8VqasQ2EhbsXkjXaXCCaq/
+x2ad/+0PtZwiGaJWPfiVudoGb231Tz/VBRh91RqT0801O15XtQkFdLXqDtDW7lbDXCffKjWwnmQO
+vHtQW7fITXPdsz+o9W5/Y66/Zb/a4Ohac8MFK9RGm9N0jVes1TYZIpubTpmtbdZjmU6XOFLbfMh1
+XYs6n6ktO1l0rQq+o7autUrX+pZRDS5xwCzC13uqWYSv304zt9vSSNv+Y7s5bEEpbYeJZ80dTXat
+3pih69QhXttZb9V1qdFBG67dquuaV6uNkBbpIu60V7vZJugij9VQu1sG6Hqsk9SeG8abe828qb7y
+Xn9z79Rv1D4jQsyGPje0Ud2rmvs2S9P2a+Brji7zuTam2HVzjH2WNtY1ty42z7fqIwd0/+Mfbm52
++9c2QPx28FXHuKW5g5dmx8zIAeIm5hdFvMncAIBMDgDI7ACATA8AyPwAgEwQAMgMIXw0gKtK9qgl
+3ieTBAAySwAg0wQAMk8AIBMFADJTACBTBQAyVyHvmbjG0ZV+Woha+Ku5quS+y0InEwYAMmOIWvjx
+4u/JnAGATBoAyKwhauGniffJvCFq4XNwSWYOAGTqEDWNEsT7ZPJC3v5QmzV7MzOV4eBXZgAgNwAA
+cgUAIHcAAHIJACC3AAByDQAg9wAAchEAIDcBAHIVIe8e5NIDRW4KndyGx4ZvhE7uAwDkQgBAbgQA
+5EoQdZ3XaiHqOsvifXIriLrOy8T75F4AQC4GAORmAECuBgDkbgBALifkLSOPOSUOmLME6/dUc5Zg
+/dtpQid3BABySQAgtwQAck0AIPcEAHJRACA3BQByVQAgdwUAclkAILcFAHJdIe+0VwGAXBgAyI0B
+gFwZAMidAYBcGgDIrQGAXBsAyL0BgFwcAMjNAYBcHQDI3QGAXB4AyO0BgFxfSPssoRMECJnnW9Vx
+LP4bQoHjYH3vW73PSA9gpIfT41Zy3KDEQc+ctfDI/AEAz8znxUwA5q1fYJr/xS/G3Xe7Gfa+mWHi
+4ojeNqpk4eaey27/xI9rz1Api08V+gobVGIeG1RiHhtUFBLMo1U4WlIV/W7haKnn6jQA4JL5Ozu3
+Jbjx9fEiVYNujyTdouCgd7uJww7oVrPWknvpt+zTukt4kOiN1h06ntC7AHoXp6uBJz/zfNGcauA5
+1cBzqoHnVAPPqQaOnGrgOdXAc6qB51QD/y3VwB0nqlpEDXB6XjyzhOEgv7+6hKGY1/7qPM9rz/if
+puqVPGq1QbWV6ubPtQH9t2tqKLO0Ndt89LtLHXZ4P0TtGDpN8xuL2WUpa3fyu99V9LBp1qKHg/xy
+ih46zD5/dT7r7POM/2l4BMwj/p5sAwDIPgCAbAQAyE4AgGwFf4MiimRvAEA2h99eRNFpu3MMmxu2
+v1oT7gCeO8dd5NfQ0adFVEJ0TFLUs/KQPDN/xNfNfF5o8drLxhU3qhnX9o8wrGvexLjet65h/cMJ
+tr1XhhhqxY6Xag8tYqoT3F8K7HzXFOQfItWt/a2pnmdVqX7JO6h/yVdqcP8EGv5cQG609hAaqz5y
+k40foOmUq3KzOWOhSzwgNx8/x9Sioyq3jH7V1KrmNLl1qz6mNvmS5eAKzU3Bd6dKoe7lTaHHh0pt
+v8tlare+q9R+f0Fb2Owq6PDZz7aOw3NDP/mYrVOUv6lz/AZbl+bepvD2c2xdNT+YIqq/auuG/abI
+wu8bI60rTN1/Hm3sYZ5q6nmkt7HX0uV45XOdsfckGX3eLWc0xCUhakxTW9/QcPTrrbG5uLq70czG
+cx65XJ/1sNs/CAfs9g9kPI7LP1VcfB+9wtH5pwp3b1gXjs7DWnGkDhcnaP9TO4qclzqLJHWYWGrr
+HyGmEajjBPj41jUwCE0QhXepE5lGjpeYRhYxMY3sL3TqVKaRIUKnzmUaWVXo1MlMI32FTp0NQHQ4
+AFCnM430kZlGfgCeBrwqM40cC6aRB2SmkXNMTCNVmWnkqyamkdNkppF9hE4GAgBkJELencpnJrqX
+FzoZDACQ0QAAGQ4AkPEAABkQAGFEAECGBEAYEwCQQQEAGRUAkGEBABkXAJCBAQAZGQCQoQEAGZuQ
+1hVCJ6MDADI8ACDjAwAyQADCCAGADBGAMEYAIIMEIIwSAMgwAfy9jNOnZZfwugKPvBadK9neHWjv
+7uw0XouopL5R0c/cdOY4X5Yv83mBabqlMXvP77Xsm/Gadf/RcpYDo8OsB+d8ZLHEDDTkxU1r3hmT
+LflmwSolJ1jyp56zFgjXWwr2sRsKaZvq3Qhh3SkJSzzs9t0lATE/+XjGkn8f2O3C85Zca7sVF58O
+0NapowVcXOCS+Ts7Ny4OR9YtjREed36vCMTpS5CkLyIWlUeHCZ2+EEn6UgBAX0zIGZMt4kNnwcoL
+GglCpy8Kuki4Xuj0hQHgD/nSTxYcj99qdmyNC7DGxWnyGhMfM9gJ8lTaof528p9NXl84iFPN48m/
+ixyW/lVF7OT/Ajn8ve3qSH5yf7rQonMFdK4vPEP8ienFjYxxwmP7Zj4vMb33CXX+/EZWpXWn4wvG
+lrJ+WCMhcmFvu3VRXn3k4qZnrYuv14782D/D8PGBQpFLPa2GpctuR35yaavh01kFjn+2a5FhWepP
+x5d/PMGwIuLocXXiAMPKuuuPrzKOt64u8v7xNfr+1jW3Rx9fqw2xrj3zXuR6qap1/eZRkRtsvtYv
+5r8SufFEFcOXY5tFfrUht2FTn7KRm2dfM5ibuUVuGX7QsLVymePbIlcatnu5HN9Rf7phx6Xzx3eV
+XWHdtWvH8a9dplq//vjj43vODrXulbdH7tOVs4p59p1D9Eer3DMcPdH2+HHvU4bjG6ofP/H9JsO3
+7+U9fvLwt9ZTw23HT6/60poeefj4mWnzrNbmP0aeTTJZz5U9FHm+c1/rdy6rIy/UbmW9cPbdyEsl
+owyXtgyLvHy/peH7Bd0jr5ysaPjhrZTjVzd6Gq4N7Hb8+vvfG35s2+C4bXwu642qJY/f7HfJesv7
+4fGfWn5t/en7M8dvl//Uevvwg8i7bm9b765Kj/zlfKL13jRz5P19kw0Pkj6M9Ox70uC5waIvFjXE
+UOzTevpsk2g392z8MQ+Iy/SA3b5qSeYqio8YBPOK3wXE7yJsiUF1tFnWUVq2yvbSz2P7ErkevU8I
+HyM7I0m2RpLsTaRL1UiIJEl2R5JsT3C8pmeFTjZIkuxQyAOFhE72KOSy20InuyRJtkmS7JMk2ShJ
+slPBGSOOCp3slSTZLEmyW5JkuyTJfoW8PVroZMdCnnlPXI/sWcjNo4ROdi2wbP4rQif7Jkk2LtK5
+NuQWOtk6SbJ3kmTzJMnuSZLti3SuyJVCJx8gSX4g5KXzQid/EHLXDqGTXwj58cdCJ/8QI7G8XXw+
++UmWqfGdPBVOPiPkibbi/yPfEXJDdaGTDwlMfi+v0MmXBCYPtwmdfEpgcuRhoZNvCUxu/qO4LvkY
+SfIzkuRrJMnfSJLPCXn2XaGT7wm5ZZjQyQdJkh+SJF8kSf5IknySJPklSfJNkuSfJMlHSZKfkiRf
+JUn+KsaMll8LnfxWjBnlPxU6+a8YK9zeFjr5sUhjO58odPJnMWbsmyyuR34NAOTbQm6w8NR8FMce
+/zQ/f0J7347vl6uDB9DhuavXhRxHoviohH4BXaL6xj8z8ypX5g8AeGQ+L8Qr2G8Y5n9xWr/3zXMG
+geuGaxZuCbt9T/KviR2nzR2xuEiOlO7RiTqPv49Ldu6CerN3kwa+vGD9hoEXrE/reaH6nCGLF72U
+u3tCOG9NP/BJVRegqouzh760SIzqExE31Kn95eWekM6x1vX5utnWP+ygbrjRQvdF+mvab+t/qfv2
+qpfuVPEfzac2xGpP3y+vS59zVXvm9BKdNby59qfZeTgL2Jyku5O3unq3U0Md5Xbe87Ga7y31UO9f
+LaR78L//qaW+nqsrPfyCWkZpadbUOa2WHTdMVy73UU5DWz9d2+DMDV2D70upTs0kudrtNK/m2Kxs
+vnVEokV2vjMT0bEM2vm62ZiIdlCZiLbQMRF9jQlo/S+FTu3BRPRHMxPRWC0T0fI6JqJXtUxEl+iY
+iDbn3NjZebLmxpqTdExEq3NubKeGOsfcWGpDIZd6cG7s1UI6JqL/4zyrr+dyntXwC3x2nNLSzESU
+z3qjNmYiejRr3tL66XwW25kbvP71fSnV6Vm8F7Q9kdXApmy2y6ZI+Ya7AsOdJ6uJsYlO2WwuAO6P
+0jJnR3Y1VnUbqq+WPIl30Lm5Ul7OFzIgfovbdBEZIpxC2+JxRk4QsnMxgQFzA4WR0EUBgC4MAHTx
+x8lH2bkBarGgppyE8nXlCw1uA7gNpyPLxLihz2L3Xpk/ADAr87mfQNOPQpT5GwvLyoSqyoL378hL
+2rTX7J3kK3NmSjHcu5si3b8ciQfHu0kPdzdExvoGkn2JHzC7pOQyMQOuwx5KbkNKmNyjisu59A9M
+Hrr7sqc23eS5qozsLZlN3stWSj62D025rQtk3xObkcf8upx3wwLkW6pI0nuvI/+k16QCI2JQMC5a
+KtQ9GIVD20hFGlRB0SqVpWLlWpuKe/tIJVz9TSVuVpJLnvM2+R32kktt+cFUeuUVucyC/SZNaimp
+7LgVpnJd7VL5gftQIeisVLHtclQqvE3yryrDP+OMXMUnCVXSt8hVr4Sj2qaFcvU0oylg3htyjdWd
+TTXHxMra6YEmp6dwd16XGp3c6PQUbutbO6TggNEITvtYCs3TG6GrJ0ltr+nQbu5iuf3RXqaw0RPl
+Dmubmjr2ipP1MzWmTo3byZ1TXU1d/ALk8K7fmcIf5JMjgnaaIi5UlyJLn0fkjrxS94wd6LHIJvVM
+/xi9xh+WXtk0Cb2j10h95g2CIcwiR02caOobsEruFxtnis4zQ44JbmeKuZYqx/oHmGL3R8pxnvlM
+ccsaygMv3jANmtlNirfkxeDUBlLCChsSI0pKximHMSTooZSUuAZDC5+RkjvORHLGfTm1/ipTavpp
+eXixGabhmzbLI+6mmkbOWyCPOh5pGj3mdfnV9Q1NY3rHyGNn+5nEnIuHey4Pz7/Vw26fno/n/Qix
+ppjg9eg3z/59qtC83wcyDfKfKi7FyEP1rcKhp9m/buEI0/NsYNij7JRHbMjlmZPUT3U9AR/uJQSm
+kwsKCrGxsMj1JVcUAdD7d2Q+HrW9hnNXfOWsuSvFIMaEuykCjshNxZhwvJvQyV05p6WBxDktfgAg
+XBcAyH0BCBfmnJYSJgAgVwYAcmcAIJcGAHJrIVeVETq5t5DLVkqc0/KhiXNaFsic07IZnNPyusw5
+LQvAOS2KxDktr4NzWl6TOKclBpzTEi1xTkswOKeljcQ5LVXAOS2VJc5paW3inBYfiXNaeLKaoIJz
+WXiymiADAAg2AICgg3NZeLKaIITHSJ6sJijhXJZ9gDhf9azEuSzLwbks2yTOZZEhZMYZmXNZkiBk
++haZV/LCwbksC2XOZTGaOJflDZlzWTqbOJclVuZclkDTH7IIsfO6xLksG/+QRQiCOM5lGQ0h0z7m
+RYg8vcG5LJN4EYJTgAT0cS5LLxPnskyUOZelqYlzWeJkzmXRmDiXpZ3MuSyuJs5lCRA6waOQD/LJ
+nMuykxcjLlSXIM5XPQ8hd+SVOJdlBwAI6ORclo/BuSyHJc5lmQTOZVkjcS7LIAAQkMq5LBNNnMuy
+SuZcljgT57LMkDmXpZ3QCWo5lyXAxLkskUIn2BVyWUOhE/xyLks3iXNZ8oJzWRoIneCYc1nYXwmW
+AQho5lyWNYA4X/WMxLksMyFkBvsrwbWQ6adlzmWZIXSCbs5lSTVxLgv7K8E457KwvxKccy5LjMy5
+LP9JWPcJ6xJeL+zpuSvGT4OjBnoDA72djnxzTvHLOcUvZ2tnzil+f/gpfoUdUKdl1OC+SXHRsTF9
+WkSNcCKHelLmc82Cm/tNjuHgr8M8L81pEeb5YLP8rwjzLmagwf09tobu3+tzQj2nQz0399/28Mjl
+6eHu9rIeAACIEV7MbdM0ywcyHo//G1QXMf5TRusGFX6PXqe/CdBq/x8TeLRjiHcK/TqpFciOI4mA
+7uZ+07MCOWcCL3I2x8CLnC4n8PobBV4XM8CB1x4bABCI5ARgOQHYSw7A/ptg+7wwzDq7/u4DXsAB
+r+cRokJZCZExsU9wEiUCO0H2ez1ZFxsZ+Gevi5WPLG0uf26MtmJ9mCt+c0TrX7aUzv+rG6oo3DXj
+PW3VKyXN1Qbp1OrmbbqA/oe1NZTFuppt1mi1pom6WqV6qqLsg8dwbWD9vjpR9kEToKt7MDXLelvD
+KQ3VRl/nMTdO9FObbJ+va9ohQ202zVenq2FVm0/00LUo+lBLe9Fb3jmjbR0WZW5tva8G55+hC163
+UCvKPsx6QyvKPpgWqO2/SDaHGV5XO7wfYe4Yqmj1rw/VddIEq537ddV1QRU1vGU9Xfj3bbQR5Yvr
+Ir6urI10va+LXOqj7X7utK7Hm1e1PdMTzL0GHND+7kzyF65e7dv3u1YOe2VdORwZ+F9YOSRrFPIc
+79QjqxTymyMcepUtJf6eLBSOpb5mvKflwZnLEZLFisHZvE28T5YrBmdlsdDJggGArFgMzqV6ir9/
+XKHBY7h4n6wajhUaNFzxgiz8eSudZPFiUP46j3ifLF8Mytvni/fJA8SgPM1X6OQJYlCe6CF08gg4
+VGggzxCDcVgU7/Sz3udQMj9X2CBPgWOFhllvCP1xhQbTAvH35D0AQB4kBuX3I4ROngQA5E1iUNYE
+i/fJqwCAPAsAyLuE/L6N+HvyMiG/rix08jYhl/oInbxODMpvXtXyoJzAFRoGHNDiZezn+F1e6LiG
+PD1+Y8SiXMCiXM9D+bxZUD6hX0qCE+sy1TKfF1q89ltDkbcuotiwfZZasamoPdTLWCc4EoGdrxiD
+/KvZgrbDULf2PmM9Tz/UL3nZ9jhe/Nnd1GjtVlvjFQ9MTTa+Y2s6Jd3UbI7Rpks0m5qPf9vYouOH
+ppbRicZWNcebWrfqZGyTr78puEIdY/Dd1xHqXtgYejwGbc/fMbZbHwxndnaE7z8NkQBPBTVfOFi7
+2u3Ll/BgzcntT2ok8Cu7zC7Sk6oJ3AWPBmMamFs7JL0/ktlpVt6T8a1BRBNvXQT9oyZm1p0KZt1e
+RmbdkWDWfcXIrLuaTcjtMDD73mdk9s3LWdQNT2XfP7ubhIOv3Sp06hpm3e/YmHVzVEXdxKyboyrq
+LmbdHFVRtzHrHs9su1UnoVMXCseuUEfo1JUQey8KC526VDj4+TtCp67FS9x78TcwgSclM/5X9OKH
+BjfA4PY838yXxTeTovpFOTMH7gHAHUABE4Dppas3pqpgwT2nGbtVXGp8PCPnZrdv0QF2+5ZW9HXM
+6zPT+vM6zKbV0To7tD++JhdhKqHjqmTVGz+qSiZ6vOc00bN0H1lmB7NxL44At3/hq7m9XAAvp3O7
+WkYlRw2OSuqXcyx1zon4OUsJOSfi/0En4mfF7RExCQnOBM1umT++U3+qJlM38Vd2tdsP2ABxx1m2
+uDhdIc/NMe6iD39kB0/a9QUXcWyCysMX/LQTwE44TytHOFcv/FFNS18xcuVbk+HiarevXgPY7etd
+Hw3DdF/twnigzc5n8sjkv0+MTE589pPB2rOV+7C1ANbC6U4fENcvKjbRCcp3NfM5Fxv7qLooNqZM
+yGta8P5J44d7k7Hw9Tu2Ra0Pmxb3O2H7qMsJfFx4lnFJnY1YkrHa9knBD/BJ+ru2T2+NxWebhtmW
+pUVh+bzuthWrW0Id08i2cm4f06rYSOPq0c1Na4IbGj/vVd601t/PuK5xLtN6jwzjhpKXTBsuWo0b
+c7lj486txi+/u4ivVqTbNm3fjc1TzDZzj6PYojY2bh0/Gds6jrdtj07Ajpr9bTvD3jLtyhdi2x0Q
+b9p9N8a4J08H057jwUbHAmlpKbfwje4H25GuR3BUs992LGgdjmOF7UTh93DCOtX2bcbnppPmobZT
+6bNMp5fKxvRNI0xnJiUZrfN6ms7GhRvPjWliOh9a1/hd7zKmC90a2y4GN8aleqVtl/1L4/uisF3x
+BK7cPmf74eI5XD263XZt53Zc33zW+OMKq8k2f5vxxpStpptjFxtvJS4y/dRnovHnjhNMt5vFGe/U
+HGC6W/kN2y/52pruecXa7t2Nxf3LobYHx0PxcHc1W8b6arAvyWPD7DxwmfijzXXYj3Ab4mt0j8pt
+yqW/bvTQXTN5ai1Gz7f9Td7SKqP3soXwsc0w5rZON/meUG15zCmmvBum2fItnQbpvWRb/knJKDAi
+wlYwLgKFute3FQ6tjyINStiKVimBYuXqGYt7PUAJ1+LGEjeLmUqevW/0O3TPVGrLaWPpladMZRZs
+NmpS86HsuAXGcl1voPzAr2wVgtJQse18W6XCn8O/6jibf8YhUxWffrYq6atNVa+0tlXb9K6pelpf
+Y8C8YaYaq1sZa47pbtJOr2T8/9FZQzw1MttpRaOTnzkdmbW+tQ7BAYNtwWnvITRPR1vo6lFoe62W
+rd3cWab2RzsYw0aPMHVYqzV27NXTpJ9ZwNipcRNT55SfjF38ypjCux41hj9wMUUErTdGXCiNyNLf
+2CJ30MT3WluPRefQM322rdf47Xhl00hb7+iP0GdeL5shbKspauIIY9+ARaZ+sT2N0XkmmGKCmxhj
+rg0wxfqXMcbub2uK83Qxxi2rbhp48bxx0MxQxB+02wanVkPCirO2xIg8ME7ZZhsS9COSEhfbhhY+
+hOSOE23JGddMqfUXGVPTD5qGF5tgHL5ppWnE3QHGkfOmm0Ydb2scPSbF9Or66sYxvbuZxs7Oa3Rz
+Jlp33G2Sy+MPnBb4Yx92+7Jvfl0y8FHhQDgUDsycwfZyLB/Y2mFLDAAX8V+mzA4+igxQtwyFM0Cr
+Z6l6SHjJGaAnhU64SZKwkyThpwip+50QOuGo2PpWeJb4e8JTITNWi/cJV4VMf1fohK8kCWNJEs6S
+JKwlSXhLkjCXJOEuScJekoS/JAmDSRIOC67q7yd0wmPBUT0yhE64LORFq9AJn4XcuVXohNOCq65I
+F9cjvBZcdYrZxlz1qNAJuwVXHT9Z6IThgqtGJwidsJwk4bngqPlChE64LuTdGPH/E74LeTzY+Kzq
+koKzptwSn0u4T5KwnyThP0kaA0jSOECSxgIhrVOFTmMCSRoXxBxv+iyh0/hAksYIMcc7KUnoNFaI
+LXFx4UKnMYMkjRskaewgSeMHSRpDSNI4QpLGErEVriiETmOKkLfPCZ3GFpI0vpCkMYYkjTMkaawh
+SeMNSRpzSNK4Q5LGHsHZ+0wUOo1Bgqs3ixM6jUWCq1d+Q3w+jUmCq3vFCp3GJs5UDrVxpnIoOFO5
+mo0zlauBM5Xz2ED/ZucRgsYtiEzlHwGRqexr5AXz3CZeML9u5AXzayZeMLcIncY0XjBfJXQa23jB
+fIaRF8ynm3jBXLXxgnmKiRfMp9l4wXwaeME82cYL5sngBfMIGy+YR/DKUvf6Nl4wrw9eMC9h4wXz
+EjzVVa6euB6NjbxgXtzIC+aCJoHGSQCgsZIXzE8becH8lIkXzDcbecE8H3hOfoGRF8xvgBfMv7Lx
+gnkaeMF8vo0XzD8HL5iPEzqNrbxg3k/oNMbynHxrGy+Yv2viBfO+Rl4wH2biBfNWRl4w727iBfNK
+Ruem7hoiW1N2O63ghfLP/pApOxrbeaF8sPj/aYznhfKOQqexnhfKa9l4oXyWiRfKOxh5oXyEiRfK
+tUZeKO9p4oXyAkZeKG/CU3YpPxl5obyMiRfKjwqduAEvlK838kJ5aV4gL/2NjRfKhSr4Ai+UnwPP
+yc+28UL5dvBC+UgbL5R/BF4o72XjhfKtJl4oH2HkhfJFJl4o72nkhfIJJl4obyJ04hi8UF7GyAvl
+bU28UO5i5IXy6iZeKD9v5IXyUNA/4h+8UF4NvFB+1sYL5eyvxEd4oZz9lXgJL5Qf4gXyjhOFThyF
+F8oXic8nrsIL5ROETpyFF8oHGHmhnP2V+AsvlLO/Eo/hhfJuJl4oz+EzL4PPiC2+LZ++5v7+20vv
+e+UFvPI+L4jMnzWIHJASNSAlygma1PjJgszTy279f/r/Z5TcKoew2fWkDsvc0XF4cUk/+SI6RQXJ
+neN3o0vzonJ4+0/QVfOLHFF9MrrhpBxZeIkp0vqV3P3nt0w9zPPlnkfiTb2Wfim98nkHU+9J86Q+
+72pNhjiTFDUmDH1D+0r9etfkGX2xUzvbGRmudvvcyc4UNXLxfWIGzyptlI1VnsZZV3mcr7zl3BDy
+b6m4VQ686lNP6GRDvOpTXOJVH14VI3viVZ/d4FWfojKv+nwCiIpbvwidbIz+kZ2JoaPwEq68Zf1K
+6GRzYggxzxc62Z4YQpZ+yblWn3cQOtmhGELe1Qqd7BGi4lYYxBAS2lfof0fbfFKB4OquC/tefPxf
+lozouJToqGg6FzkpZqQT5eV6vqgO4J8OSH9GVnHvmug34Ybhr8os/j0G94I/sNvnVnfOHl9KdcKe
+v7U64X8CI/8t+ai9a0LICTcM+I/lpf5DXPV5WaRdfwqa6OoBuHo4PYgkJkXF9wmJSuqbmJKU3fWg
+//0UddnF1W7/3AjY7Z8Xc1yzafmb14Mqia3eznz2k/Wg2YbCCdlcD0pMik7sm5N18IdsYHTNsl1s
+m+XPyTpYpuZkHfzzsw7+0INxf5dFOi65r115X3px1kGBLIAzNDmqT+c4pzKdfAC4A8hvArDwcpGw
+/HVDDfnXJegLFqlmKDhLr/efZTVUTlhrqTJsq6HK1hMWl8df2W5fWQcQv3lk8RYjTh76Rl3aOkyb
+ZOc2GJmrhoklnMtFwkAv1g01CLkuQQ8AdGtCztILnW4RAOg2hQEM2yr033i7T0KlxAdfrG3sAjR2
+PsksccSAqLghKc7UFpqQ+VzA/RRtcOs5vS7o548+t2MBhpjV3sN2rBzWRLeqOSyrI43mNeUC9Z83
+WKRb6xZrWVfimHndd3P06x/2Mm/YpdHv80vQ7Yu/ZPmm6HndkTpdzUdSp+keHmqsy5h2T7Xv229G
+0inVJUVvdtWFqm5da5vdNdXUXE076jyQR/XM/aXO88yPqldGQZ335kOqz+mfdbmXXNf6fnVMl+dN
+izbvBxt0+Qas0koTjpjzh8zQFui/zlywcqq2UJv3zIXzT1OLVBplLnIjWS3m8Yq52KEItfjFZuYS
+K+urJQ/20vlNLaGWWtFUV9r4QC0zRaPTjHXTlk101ZVrcF9bvsN3ugrFT/NGjV82ayvdvGv2P7FA
+W/nOdnOVrV+pVY99ZK724Xy1+ro3zQGvjVNrzBportm3nyo2arRordY2xOnqNDmmDdS10wXlbqWt
+m2e9rp7HEbU+8unq7/XSNvi+mrnhJ1e0YqPGW/u0TZb8aG46cLm22ZuHzLoue9TmA1abxfmcIe+a
+s5zPSRs1fnTV0kn+oV8maukE/3brobZf2VAXttNT20H/mrnj3OWqvsUJc6fRstq52m1dl15Janju
+47pu9S5qu624au5edLe2+5QD5h53PtH2TFTNvZbGqq8kjzb3nhSq9gnvbTbEVVOjAnXmvqF51H6l
+XtFFV/5Rjf7J3dy/gK+2/+myutiT4zPP5XRxc3lyap6bq5u7q9vzD0d56Q9PT7v9S8GMvtr4JBl2
+l5k41C7zo4p8j145YoGozteFqyI+BjXxz8XlscyOdzAilBFcjbyEJHmKWAQefW4HSfIYkuQ1JMlz
+xCJsc4jK2ORBYhG2XKD4/8iTxNDtFmvhofuYeJ+8iqPJXkIn7yJJHiZk/CXx9+RpJMnbhEydJnTy
+OjG0keeJ/4GPZiIPBADyQgAgTwQA8kYAII8EAPJKACDPBADyTiHP/MhDY0ZBoZOnAgB5KwCQxwIA
+eS0AkOcCAHkvAJAHAwB5sYCskBlCJ28WSFo5Vejk1QBAng0A5N1C3kgWOnm5kIcihE7eDgDk8QBA
+Xg8A5PkAQN4PAIQAAEAoAACEBABAaAAAhAhiyO/wndAJGeC4YeaXzUInlAAAQgqB+He2mxnpv+Ij
+TY99JHRCDgAg9AAAQhAAIBQBAEISOG6YadFa6IQqAEDIAgCELgBACAMAhDIAQEgDAIQ2Qu714g0z
+31fjI00/uSL0xxtm3tondEIhACAkAgBCIwAgRAIAQiU4Hmka8q7Qn3mk6aMNMz+68oaZG6k6pjCJ
+Qif04plViL8nFAMAQjIAIDQDAEI0ACBUAwBCNgAgdAMAQjgAIJQDAEI6IVdcFX9PiCfkFD5ilZAP
+AAj9eCY1lo8wTR5t5plUtn9CQ55JZfsnVOSZVLZ/QkcAIIQU8id38T4hpZCny4r3CTGfULZ/K2o6
+0sIjO0om9PICenk5HYcmxQxNdurwbrdMMiZC7zlH2xnn/dIILq4udvu07U8mF7hmED1/NJWgd47r
+Pf54rg1r/FIg+tF2Yj4vG5cS8xOiMRZ99kVrCwCL82mpKXFRTsXkPZ7sZh0147+ym/VfuYvV1W7f
+G/+79rD2yLqHddSMnD2s/749rDl7Vv88n3Pc0PXWwsVvvZkLeDOX0wNaCu2KS8zGvG/e6aVrlKcd
+aS6udvvmVwCx34xvzKf9471mzbM3m5yX97vVKP9ov9uLP/1JjfLyRaYlfQHgCzhbjqFVVMLgqKRB
+QwdEpTpzonm5zOfSHFMoCoaN1heqc08uXP4HuUjBU3JRt/1y0Vub5GLnV8jF0xS5xLapcskvv5L8
+PlsulZo7Xyo9WZbKjB4naeKTpLK9+knl2odL5Ru3lipUrytV9POXKvkWk/xztZT9fw6UK1+oKFc5
+UkSuusNTrrbmrlx90fdywIxv5Rrj98o1k7+UtdHLZO2lDloX9xfNpNnt101sNJn7UdzFhJkX/wb9
+831iRrURHBb+mCi5PLu47FNbRXAPUyjoH7WOaO0692QAoFYS0U/BU0JSawl5a5OQ1GoiCkpThKTW
+AwBqQQCgVhSQO3e+kNSaAnJHjxOSWlVAbq9+QlLrCqht3FpIamUBtX7+QlJrAwC1uJA/BwpJLQ8A
+1PoCcnd4Ckm9ICB30fdCUm8IyB2/V0jqFQCgnhHyT+kdn+CwcF5E0nZqtvkLV+ALpwudt4oaNvSZ
+FNbx9PhXMp9X5FMQ9qrzN5ZXFIuvuuDDw6a9kyqrvFUlUHOnZqp8t/KHkqf2qlwwLklTqHtXqXBo
+uKZIg3pS0Sp1NcXKdZGLexfTlHANkkvcDFRKnisq+x0uopTa8otceuVdpcyCk7ImtZqm7Liv5HIR
+eTTlB34rVQj6UVOx7ZdSpcKHNP5V50n+GdeUKj4mqUr6QaXqlb5StU0rleppY+SAedOVGqsNcs0x
+KYp2egu5Vuw0Te2hFeQ6wcmawC4ecpB/hKZu7ctyPc/6mvp+uaT6l0poGty/JDXc+UDT6OTXUmO1
+mNJk46dS0yn3lGZz3pZ0iaeU5uOXyi06blJaRv9PblVTUVq3Giy3vpWmCQ54SwpO+1wTmideCl09
+S9P2Wgep3dzVSvujA+Ww0e8qHda2lzv2GqboZ9aQOzXurnROleQufo2U8K435fAHpZSIoG/kiAsN
+NZGlb0iRO/w03TPSpB6LMjQ90z+Xeo23al7ZNEvqHb1VQ+mVj07byOX68suXetntr7YHKCagEWWc
+kcuXUkg1xcQ1cyhzb4oJxcXzUvQbZckgu7cKR/cu4ejeLRzd9aRnVjXVt+KgAv/vDPXsWJo4On3C
+O2v4pIu9fE7PxvJi0wJZHkmyPq5qVlnNmkQfqOGk8FSZk8I/lCCSszm7gCxTIFN3zpAiCxXI1IAz
+pMhSBSKV68KI5F1M6GS1Qt4MVAQyneMMKbJggUxbOEOKLFkg0wLOkCKLZjLIGVJk2QKZBn7LyBT0
+o9DJygUyFT4kdLJ2ITOu8ZKMD2dIkeUzGeQMKfIAgUxpYxiZ5k0XOnkDRPJ0itDJKzirgw9MJe/g
+rI5koZOXcFZHhIazOi7LnNVRX+jkNUJeKqHhrI5LQicPgkie/lrirI5ifGDrxk8lzuq4p3BWx9sS
+Z3WcUjirY6nMWR2bFM7q+J/MWR2Kwlkdg2Wen0kTn09eJ2Ta50In7xNy9SyhkxdyVsdqhbM6Bsqc
+1fGuwlkd7WXO6himcFZHDZmzOrornNUhyZzV0UjhrI6bQieP5ayOb4ROnstZHTc4u2OHn4azOtIk
+zurI0DA5/FzirA6rhrM6Zkmc1bFVg8zk6X+Pdz+ZQBgRa389e6fy8EjUp2VSTMwgJ/hGw8znvNVz
+/VfiHIm9k0ooPA5Vko50vaE5qrkiHQtK0xzHPulE4c81J6zLpW8zDiknzbJ0Kn21cnrpZ3L6pneV
+M5Peka3zhiln44zyuTHdlfOhneXvejdSLlQJlC8GR2ouFegkXfZvqLl8s450xdNPc+VwYemHixma
+qyvvSNd2WjXXp56QflzxQLEN2SjdmJKu3By7Rb6VaFZ+6rNQ/rnjh8rtZm/Id2qOVwiFfsnXX7nn
+NV66d/d1zf3L/aUHx2M0D3eHSBnrgzX2JVUlzK6icXnTV3IdnlvjNqSy7B7lr+RyscnZPW/p8SOX
+3T4l5Nf1qT+QOYXlUWVqx5rU+m7h0Ot/3ccuyE6v8ExUcn3G7q+yHDFAvZQVqzlvhHqMNzhdkXiD
+U5qGNzjtk3iD0+dCp17kDU6HFN7gJEu8wWm1whucPpN5g9O7Cm9wekfmDU7DFN7gZJR5g1N3hTc4
+dZZ5g1MjoVOv8wanSHE96n3e4NRQ6GQFvMHJT+hkDbzBKUPoZBW8wckqdLIO3uD0QHw+WQlvcEpX
+eIPTFpk3OJkV3uC0UOYNTh8qvMHpDZnHsvHKo7GMNzj1V3iD03ihk0XxBqf+Em9witHwBqcQiTc4
+BWt4gxNn6JGlAQBZGwCQxUFscKosQ2xw8lcA4O9hfU+wZdUPAY1KuAMl3J1muTEJqTFJTtjvPMeE
+oXkfNZTnb/Q0KRP85AXvf2/6MCZDXjjeA4taW+VFUz7KUpqYWe8M/JIvUr7nlfrbT655Oyjn5Jr/
++sk19HDP9bfc6vPBjCdlNz+Q4fXo95OTDsSCQiZDeFRm8/GpBi7PPOvuqV7IB9Uw0ydv5FHE08Tb
+lP1k3qb8vdDJO0mSh/I2ZavQyVOfd4DNkwhhBhhVI2VG1dS/5oCbt4NyDrjJOeAmp77yf/WAm381
++j8vBX7e2dF+c72Bud7OLli0iklOSoxLdmJIeftRYPLGV+V6vtd4Bva+aTPyAa73rceCltiOY4H1
+fh158v3LjawPjje0PdxdyuqhO2P01G41eL5d3OgtLTL4YKHRxzbBkNv6htH3xIfWPOZYY94N4635
+lr5uk97rb80/KcZWYESItWBcsK1Q96rWwqFVbEUa+FqLVsltK1auiqG41zVbCdfchhI3fYwlz14z
++B26aiy15aCh9MoDxjILVho0qbCVHTfdUK7rOVv5gSusFYK22yq2nWqtVPgjm3/VoVb/jG3GKj5d
+rVXSFxurXqlnrbZporF6WrghYF6cscbquoaaY9oZtdOLGWrFxtpqJ90z1AkOtQV2PmWgIpZ1a28y
+1PPMY6tf8ltr/Ys/2hrc/9LacOchW6OT86yNV1w3NtlosjadYjE2m9PXqktcZWw+fqyhRccZxpbR
+UYZWNVONrVu1NLS+tcQWHNDHGpz2li00T3Nr6Op4W9tr5a3t5k4ytj+qM4SNHmTssLacoWOvMKN+
+pruhU+Oaxs4pFw1d/PIbw7vuNoTfv2WMCPrEEHEhny2y9E5r5PYbtu4ZS6w9FqXZeqa/Ze01/nPb
+K5virb2jZ9n6zOtgNYStMUZNHGToGzDT2C82zBCdZ7gxJrimIeZaD2Osf35D7P7GxjiPW4a4ZaWN
+Ay8eMQya2dAWf/CGdXCqny1hRZo1sWuGzTjlc+uQIKstKXGWdWjhrbbkjiOsyRlnjKn1ZxpS07cY
+hxcbbhi+aaFxxN0ehpHz3jCOOt7YMHpMrPHV9aUNY3qHGsfOhiFzdubv8bDbV1X8dSlNzoDhIpq7
+zC6FHMtntu4Wjtb6zBKa2Yvm33aM5slxSJLzcNkSmzHrWcP3rRy1L7Fx1L5A6ORUzJ/4hHVyLuZP
+pazMf85wuQwt5xiTszH/WSR0cjohbRMMzH/eMDL/+dDK/CfWyPxnvJX5z+s25j/9rcx/YmzMf0Ks
+zH+Cbcx/qlqZ/1SxMf/xtTL/yW1j/lNFXI+clvlPbgPzHx9xPXJgiLIZV43Mfw4amP8cMDL/WWlg
+/gMb85/pBuY/52zMf1ZYmf9stzH/mWpl/vORjfnPUKGT0zP/6Sp0cn7mP/WszH8mGpn/hBuY/8QZ
+mf/UNTD/aWdk/lPMwPwnVnw+AQTzn1Ab859TBjhUvCXAYP7D5VAIOIS8yOVQCECY/xyyMf+ZJ3QC
+E+Y/JivzHy6HQsDC/IfLoRDAMP/hcigENMx/Uo3Mf1oamP8ssTH/6WNl/vOWjflPcyvzn3gb85/y
+VuY/k4zMf3QG5j+DjMx/yhmY/4QZmf+4G5j/1BQ6ARXzn/xG5j+7hU6gxfznEwPzn3xcLqM01wsh
+EGP+s8TK/CfNxvznLSvzn89tzH/ircx/ZtmY/3SwMv9ZY2T+M8jA/GemkflPWOY+vuFG5j81Dcx/
+ehiZ/+Q3MP9pLHQCQuY/pY3Mf44YmP80FNcjYGT+42dj/pMmdAJJ5j+fW5n/WG3Mf2ZZmf9wBWMC
+TiEz2F8JQIVM32Jk/jPcwPyH/ZUAlfkP+ysBK/Mf9lcCWOY/oUbmP/9SoBVb3Vo/nfK4NTS9stYL
+WPvcpEPJkfIkDo5LcG4nigsAVwBeU38KbO9it+80PqpJKc57dy4n4/GHcLnLZ3zQk/SL/peKNZkB
+YAac/UatowcnJiQ7dRT/zMznhd5MP2Cdt34FT8/Nniorh98wffjWZZ6eG7hHXhy0zfRRl0vSx4UX
+m5bU+VpakrEVjtN1R7qel45qTv37TxTLmX7L1vRblnjnt014/wEPu/1dhZHIxd3pkAsu4ld2vIpc
+nDyLF21WZJ1umz1V6ORpYrrtrctZp9sG7hE6eR5XBbwkcVXAxSauCvi10MkTnzcdR57Ji0CngJxj
+yXKmy3Kmy3Kmy54xXfbvQ+vnTY+FBsQf9vMG/LydZlZxSSkJMUZnyqWXyxwDfGd+8qZpfb7+EFth
+b+j1X6TLum/r79F/e7WI/lTxh5ZTG1J0p+/X0qfPuac7c3qt3hreUffT7JKWn3ON1N02m/R38jY0
+3+0Uor+766zuns91y72lhcz3r5bTP/jfe+ZSX3+iLz38lrmM0smiqfODuey4N/Tlcn9nrm4+pA/o
+b9WFGMbqO491NzuRQe3m6mq3bzIxD3Vx4OeOxSZ4208QsvP9eSfCm1xBNl9/ZNm8eEOv550IMu9A
+qL9H6NQ2vBPhodjMSG3EOxFq6Xknwj0d70RYq+edCB11vBOhpIV3IozU8U4Ek553IjTk/f+dQoRO
+bck7Ea6Lv6c25Z0I5fS8E4E3GVL7ijFl+C2hUztD7ET4wcxjyhvifWpzMQaYDwmd2h4AqP0Fto11
+f1zN/mX2A2WnBzXlsh/T602YL7sC8nNTVQs6WnN8ny5R8alR0YnOVDvJ9WhrycIjH2k075+ylu01
+R19u5CZr+cav6jP99DND1rqF7bO3xT6X4+4Vuoxo6/dPWUVb95rDbT1yk9CdvOyTrfJ9WhTxpP/T
+6nwglTA0JiEq2pkKh3MynxejalBLR3Q0rrjRwLS2f7RxXUkJ631u2vZeeZuLz3+w3HasZHccn7rG
+dhKe4CLzWTOnzo1pJ3PGVIDMGVNtJc6Yqi5xxlReiTOmbBJnTB2WKCcmt3WF4ntiv5THPFXJu2GF
+lG/pco303lQp/yRZU2DEUOnfmj3cJt9rSnCFjnLw3fmaUPdacujxcZq23xWU/5js4RGSISxdiZr4
+rtw3wKz0ix0mR+f5UIkJ7i7HXBuvxPo3kmP391fiPEvJcctClIEX7fKgmTGaeIufNDg1WJOwIkNK
+jKiiMU6xSkPq5tYkJW6Vhha+pknuuEhKtnsrqfXNcmr6D8rwYh/KwzftV0bcHS+PnLdCGXW8vzx6
+zFTl1fUh8pjeQ5Wxs6vKhBbuVOnU/W/3sNuntX/a7shfpypl5kp6Oe6a1Ld6krTkUL4UTtZkn+NY
+k/1RcTZySS7S1oBrm/ePFjq5pxiRfG6KSXRy0yxR1AfLxevksiTJbUmS62atBf78VDly6aypcQFy
+1tS4tlLW1LjqUtbUuLxS1tQ4m5Q1Ne6w9Cg1jqO0FQpHafsljtKmKhylrZA4Sluu4SiNi7kRRHCU
+xsXcctK5/1np3ASBEEX6OgqdoBAACA6FPD5O6ASL+Eelc4+QOEpLVzhKe1fmKM2scJQ2TOYo7UOF
+o7TuHK1dG69wlNaIo7X9/RWO0kpxtLYsROEozS5zlBaj4SjNT+IoLVjDUVqGxFEap3YSbIsorS6n
+dhJ8c5R2TcNR2iKO1uzeCkdpZo7W0n9QOEr7kKO1TfsVjtLGyxylsb8SzHOUxv5KcM9R2lCFo7T/
+JOz76LuE19c/PYxbb0na/b438L63s8S3TWJScp8OMfHP3qjluGg75T+VGN/JR/Zo7q3Qth4vzQ+K
+t3RA9sF+xcemyv9VevkyNqf9neZTXFxz2e2Tf/mTdjBMybqDIXVizg6Gf8EOhk48yUxQAYddgAQZ
+vHjAk8wEHULaeJI5h5bm7DLEn7zL8F8KvU+270THX19m9gLMXs7W3xQEqFvUiARnikD2y3zu97Qz
+IPd0K/eHnq14+WYRcZZixno/m/1juxWzMmwuE89a/+sZlG7PSWv2eDnbXu32FQ2fdQyNi8MxNLTM
+vcvsUsxx1vXRETStuzxO33F5bLkuQHbMLjtH65E5/pOPgiNzdzzqTZg9/SPTp3+zOLeMXCAnt++/
+ndv3D4eA52Xx1Zx0eeg0D2Cah7NVjWlEi4qPGelMGl/lJwXzBhVbny/Uuv6hTtlwI1D7RXqy5tv6
+y7Xf/nBPe6r4WfXUhu6a0/cLa9PnpGvOnH5faw2vo/lpll39OVdvzW1ztPZO3tLK3U7VtXd37dDc
+8zms3ltyV7l/1UP74H+vKqW+fkdbevgxpYwSpGrqHFTKjovTlsu9WykfKanlzxk1ogj9N7u42Pz6
+NzQNzpzXNrjZVtNot11tnJhXyUbRK1e7/eRlQFS3yXI6dm1nj+CunLVs3KBivFgbauXFWp3Ci7WB
+XCw+PVnDi7XLhU6txYu1Z8W+Qmo1XqwtrOXF2nQNL9a+r+XF2jpCp5bkxdreGl6sjdbyYm1pjmk6
+VRc6tS4v1h4Wf0+tzIu1HlperH2VOfzX72h5sfYYc3glSOXFWubc1AO8WLtb6NQTQp4zarIUb/9m
+V9Zi7OvfYM585jyXa7vZljnzbrt4n3oL2StT9oIec6yiuR8+D/a5AvtcnXWF4PiofjF9WkSNcCJT
+bXLm80KmG0sC561/wzD/i9P6vW+eM/BOnmsW67xmhrNx9fXuhh8NufSH9R66QwZP7Rq9l2a1wVua
+qffBuwYf23B9buswg++JGZY85u6GvBtSLfmWplil9yIt+Sd1sxYY0dBSMK6BtVB3P0vh0JLWIvUz
+LEUrP7QWK1dSX9zrjLWEy0N9iRsPDCXPntH7HUo3lNqyRV96pdlQZsFCvSblplVkNXT9xlp+4AJL
+haC11optX7dUKjzb6l81xuKf8bmhik+wpUr6LEPVK1Us1TaNMFRPa6MPmNfTUGN1ZX3NMU0M2uk+
++lqx3a21k67q6wQ3sgZ2PqAP8i9lrVtb1dfzsFvrl9xnqX/xrLXB/eWWhju3WRudlC2NV1gNTTYm
+WZpO2WpoNifcoktcZGg+foi+RccJhpbRXfStag4wtG4VpG99631rcEAnS3DaaGtonjqW0NW9rW2v
+Fba0mzvK0P5obX3Y6FcMHdYW0nfs1cygf/e2vlPjsobOKcf1XfzcDOFdv9CH379giAiaq4+44GKN
+LL3eErn9vLV7xvuWHot2WHumj7b0Gv+x9ZVNvS29oydZ+8zTWQxhHxmiJr6i7xvwpqFfbDN9dJ6B
+hpjgsvqYa+0Nsf5u+tj9NQxxHhf0ccskw8CLu/SDZla3xh88bxmcmteasGKHJbGrzWqc8rFlSNBh
+a1LiJMvQwmusyR0HWZIzDhlS67+pT01fbRhebKB++KZ3DSPuttePnDfMMOp4Df3oMd0Nr66X9GN6
+NzKMnXVT//fKD9q1L2sFv0dnGFAJW5FR3Yqzqpt3C0fz7GZzTnbM5iQ/4QmsNwxMRE/rmWieM2Td
+vHPNwsSvmYGJX309AJAfiQvoD+s5+/KQgSdQ1uh5AmW1gSdQZgqd/EtI23A9T6AMM/AEygwLT6B0
+N/AESqqFJ1BSrDyBEmnhCZRuVp5AaWjhCZQGVp5A8bPwBEpJoZNPAgD5JU+glBTXI/8EAPJRIW88
+ENcjX4XYrJNu4AmULXqeQDEbeAJlodDJf7NkynT9xsoTKAssPIGy1soTKK9beAJltpUnUGKETv7N
+EyjBQic/5wmUKhaeQBlh4AmUNpx5M6+ngSdQKut5AqWJgSdQfPQ8gdJdfD5hAU+gNLJy9uUBPU+g
+8CYqwgYxCHgw8SaMEPIiE2/CCp5A2WblCRRZ6IQbPIGSZOEJFN6ERRjCEyi8CYuwhCdQeBMWYQoT
++gFM5FsF6ZnQv89EPoAzjwhnmNDXsTCh721lQl/YwoR+lIEJfW09E/pXDEzoC+mZ0DcTOmERE/qy
+Bib0x/VM6N0MTOi/0DOhv8BEPmiungm9CxP50ustTOjPW5nQv29hQr/DyoR+tIUJ/cdWJvS9LUzo
+J1mZ0OssTOg/MjChf0XPhP5NAxP6Znom9AOZyAeX1TOhb89E3t9Nz4S+BhN5jwt6JvSSgQn9Lj0T
++upWJvTnLUzo81qZ0O+wMKG3WZnQf2xhQn/YyoR+koUJ/Rom8h0HCZ1wkgn9m+LzCS+Z0A/UM6Fn
+fyXsZELP/koYyoSe/ZWwlAl9I6H/mzBV7F1p/nRmv3N240qyFyA7XRQ8ODE6eUBUXwLqOv//Lx1P
+5UvJfC7NMQ34y+uI1nrlhFQ7aY1Up8lGKbDzTCmo1AdS3drDpboPx0r1S86Q65+OkhrcT5UbftVS
+anQyUm78UR+5ycaGctMJzeVmc/xkXf/ycvPxDaQWbXLJLaNLSq0qXpJbt3wotcn1tRxc/owUfP2i
+FOq2RQo9sFtqe36h1G75J1L7fZvlsHcmSx0+WyB3TEiQ9JNflzt1e0vuHB8jd6kXL4e3D5a7Fu0g
+R1SvIkfc0cqRhdtIkccKyN1/riz1WPuT3POIj9RLyS+9suaq1HvsLanPjAOSoc8RKerVK3LfZuuk
+fq/sk6PLvCf19/pcji0ly7GXZ8kDHibJcbtHyANPh8uDlvSU43cOkQa/2URO+KiLlDigjGycECQN
+6dRYSupfVBpaq7SU3PoXKSU/pNSaheVU2zlpeN478nDLdmnE9RPySPUjadSBjfLo97bKry7/QB4z
+YpE89p2xsqiZ7+70OUfZeTy3Rn4uu/3gcwqJ0m/yixs2lyzlRAO0tQC4uNB/LLJjvFzudQCQU+4V
+5EQ8WnPOPjkTj9YzhSSn4tF6OMuHYzP3SnDOPjkZj9acs0/OxqM15+yT0/FozTn75Hw8WvsJSU7I
+ozXn7JMz8mjNOfvklABAjgkA5JwAQA4q5PWLQpKjCnlgt5DksABATgsA5LgAQM4rRuvPOGefnBji
+OGHO2SdnFqN1POfsk1NDHCccLCQ5txilq1dheUebeZww77EhZxej9c+8x4acXozWR3iPDTm/GK3X
+XBWSQECM1jMOCElgAAAECGK0brZO6AQMAEDgAAAEEABAICHk5VlCElgAAAGGGKVPhwtJwAEABB5i
+lH6zidAJRACAgAQACEzEKN2psZAEKmKUrlVaSAIX4Uz5ISSBjJC2c0InsBHSsl3oBDpilFY/EjqB
+DwAQAIlRevkHQhIQiVH6nbHyo1D/Hw1INMNQu2ntxgHaWk1pmA6qoa0ZUKt6WC2teMpjdaWJrbYm
+egKJns8bq7NMPSQmDn3e1EPuzB/6ty/zeVlOqxkzef4XR0dvmhcWb15fq/6WET8u2Tq7YP1t3Q8t
+2T7s5/o7GqxesjMq//pdxd9dslt3a/3Xrqsq79EcWb/n3IzK+7BuPU9XXNyd9mar49+0dQs5MqDS
+8aNVLoQcC/E6ftx7V8gJ/yvHT3y/NORkfs/Ik4d37D6Vnhx5eungkPRNEZFnJnUMsc6rH3k2rlbI
+uTElIs+HFgz57pUHkRcq/xxyMbjY8UsF8u++XOne8cs3bu2+4nHq+JVDR3b/cHHT8asr1+2+tlM5
+fn3zppAfV3wVaZuvhNyYMj/y5tjXQm4ljov8qU90yM8d+0XebtYm5E7N1pF3K/fdfffbQZG/5Kth
+uOfVznLvblvr/csBlgfHq1sf7s5nyVif12r/+IYFs2xWl4lpFtdhh61uRttfOPVy9EHZcXHx5bqu
+f1B+4LBTFYLef1CxbezVSoVHP/CvGnrVP+O90VV8ql2tkj5qdNUrea5W2/TK6OppVeMD5jUbXWO1
+b3zNMWVHa6ddj68V2+RB7SRLfJ3gMg8CO6+KD/J3eVC39oz4eh7nH9QvqV6tf3HHgwb3p11tuPPj
+B41OJl9tvGL76CYbI642nfLR6GZz6l/VJb45uvn4rvEtOg4c3TK6Xnyrmu1Ht25VPL71rTEPggOC
+rganGR6E5il6NXR1iwdtr/5ytd3cPqPbHy0SHza6+eh/49QLnR7keKKuRy6nz+b18szGwztbf/3b
+Hnb7rl6A3X5gBiHZqUWEYQd2i+fH6fmp4xRynDrOR1G6iGUGFBNo58evEM516BKODq3C0UEfjg7d
+HEKTVrzu4FBV9wWHfD8VoDhxqXEFnvcZM5nnfY6OJkmARZJAiyQBF0kCL5IEYCQJxEgSkJEkMCNJ
+gEaSQI0kAZtYwNQcEToBHEkCuazzSxd3kyTAEwuZbd1CSBLwkSTwEwuZIV5CJxAUC5j+V4ROYEiS
+AFHIwzt280JmciQvZA4O4YXMiEheyOwYwvNZ9SN5PqtWCC9klojkhcyCQifgJEngyQlTxY5zwlR+
+8fkEpELeuCV0AlQhDx3ZzQlTm45zwtS63ZwwpQidQJYTpr4Sn09gywlT8yM5Yeq1EE6YGhfJCVPR
+IZww1S+SE6bahHDCVOtITpjqKz6fAJkTp2oYOHGqHe8Iu9uWi+1cDrBw4lR1KydO5bPwwmxeKy/M
+3rDwwizPIxBwQxQp5HkEAvD/5rzf0QeZiy7xPO+3/gHP+w07xfN+7z/geb/YqzzvN/oBz/uFCp0G
+F573qyZ0GmR43i/PVZ73e2U0z/tVjed5v2ajed7PN57n/coKnQYgnvdr8oAjCUs8z/uVecCRxKp4
+nvdzecCRxIx4nvc7/4AjCVVcjwYpjiSmXeV5v48fcCSRfJXn/baP5kgi4irP+300miOJ+ld53u/N
+0RxJdI3neb+BozmSqBfP837tR/O8X3Gh0+DG835B4v+nQY7n/YoKnQY7AKABj+f9+ozmeb8i8Tzv
+13x0zrzfP2PeL2dAfwkD+vMmHaPfHBv4li/wlu/zAhnHBLngpKiE6D7hKUmDnFg9H+pY43p6vjUZ
+OWULX5x09w8oUfiSHu7O/qHd/pUesNvXu/7/+liQnuz+bhf2JKvGyaJTQx0LQMdNLC0SJ8hUkVPQ
+L6eg30tK+stJ5vtdhfr+KXDzvMS9kD2Nzlb1BKp6Or28l/S8EgtA1nH+UfW9aCqa5/cSqu89/YOe
+VN879kFHrxdX38tCHVKiomPiE1OMMdm5nalHzqp0K/rf/Z2e9UFPvlPBC5f3vPg75c/6nZJjBkfF
+O9NN3o9yCed/cOWUZ9fPDJ77Z1qKVx9uKH7wO73/levWyrt7WVqFdza0LtNO77De/GmF31U7xNsx
+hZEuDQB0eSH3z7SIkao6eyrdihg5rly3AgDdEgDQbQFANm7tSX0RnzfWTSrmAhRzyUazjogakhIX
+72RtFHcAkgnA2kUtilUr8sBSbchFnViLcHN1c7PbP68M2O1rB/CJni4iTZdPOuyUveI9jy/GdKGS
+mCKhi4rhtMgDTr0ZclH3eDEkOzfgWLUmNvl05G0At50+Fzs4ZURUQpSTX4J+CkwrlW/i7BOjdD5j
+c31Z5YhOVENycXVzcbHbvx7xyE3s9r2tOMkBeZ84TuY554FBzmbSPr4otRhdmCRdHADoBnhZVPe4
+HNBvuhHHU2nXTl7u4+ECeLg4C7khUfFx/aOGO5H69t2T9NBPlfe/SzfPOVpQ+yhN9MMPiuoWaldq
+F42M0C0uWUb7URfF/NGDzuqSxl/plpwKVD/xm6/7ZH9D7acPxuk+2zxVu+z6cd3yyRnaFV+21qlj
+u2pXLp+rW9XOqK5+Z4xuTYWN2s8TDLq1hc3qug4tdOu9iqobKnxv3nDpF3Wj+17zxkNFtF/hFd1X
+H9RSN91co9ssf6s1p10yb+kdrN369ju6bfp52u2DjbodWpN2Z1Jj3S6pr3Z33QTz7l/GqHuK6M17
+ThhUx7TWtJSj1m90By1Huq63HtWstBwLet96HNMtJwqPtp6wplhOpY8ynF6arE/f9IrhzKQI/aM0
+2HNjyhrOh5bQXwwuY71UoJjlsr+L9fKNe5YrHuetVw6dsvxwcYf16spNlhtTPjLcHDtJfyvxTcNP
+fQbpf+440HC7WZj+Ts32hruV4yz/nDWgnPTbv2n6bfYef6MdkM4+7Pbthj8wrfe7rGm9n4o9BYRx
+IgHnaEHt09J8CfNIEu6RJOwjSfjHRVoV8f8TDpIkLBTyVKDQCROF3N9Q/D1hI0nCR5KEkSQJJ0kS
+VpIkvCRJmEmScJMkYSdJwk+ShKFirC5sFu8TlpIkPCVJmCrkpV+ETtgq5KEi4v8njBXyg1rifcJa
+koS3JAlzxTJX72ChE/aKZS79PKETBotlLq1J6ITFJAmPSRImC/nLGPH5hM1CnjCoz0ujJrzm/XoH
+Lbxfb72V9+uttPB+vfetvF9vuoX3640WOmE5L2uNMvCyVjIXENz0ioGXtSL0v07T5mWtsgZe1iqh
+52WsMlZexipm4X3/LkIn7OdlrPNCpzGAl7F2WHkZa5OFl6k+MvAy1SQ9L1O9aeBlqkF6XqYaaOBl
+qjA9L1O1N/AyVZwlZ3kqJy09Jy09Z3kKf11aeg7X+I3p7pc7trplyAMY8jwvOMubJThLfX5465Ep
+52Y+FxMCn/XdalqZNkO/KvkV3e6e/S270zrp9jQKsez5crB5X4UY/b65Hc2HtufWH270szlt4TX9
+NyWPme8Yclnu2F/V/dKkleWX813M9042stz/eILuwY6llocTB+gy1sNi//i8GbPOWVwm7jC7Dttu
+cTOe07kbzupzBRUye+i26T2LhOi8NIv1XndGmX0wUe9jG6jLbY3T+56YYM5jbqfPu2GAOd+qD/XS
+q3N0+TtdsRTov85csNY+S6E275kL93zNUqTSKHPRRtGWYh6vmIuXbGMpfrGZucT9ypaSB3vp/E76
+WEqtaKorPbKLvswUjU7TI0hfNtFVV65lJ0v5Dt/pKpSvY6lYY6euUuG3LP5Fz5n9Mz7WV75z2FIl
+fZK+6rE1lmqbBumrr5tpCZgXpq8xa7il5piaeu2wHpZase0stbs1ttQJDrAE6rrrg0od0ROu1n24
+Ts+xSJqFY5HPLRyLHNZzLLJGL2KR/j0tHHtMtgRXKKoPPrVUH+r2iz50td7S9vxJfbu5g/Xt992x
+hL0xydJhcwdLx2FWC8ceBfUi9ij5s55jj2N6EXt8d8vCsccRC8ce6ywce7xn4dhjlIVjj9l6jj1G
+6jn26KXn2KOpnmMPjZ5jD1c9xx6lLRx7wMKxxzkLxx7bLRx7fGTh2GObnmOPxXqOPSbqOfaI03Ps
+0U7PsUeAXixXv4Q5asdHrpf4sNtX7Qbs9lXmrFNQj37TK+TNIQ4zePTPxSU7HsezYpVFQhZ5nmDm
+aTMEcyMPFAy3Z3/BxMgTBcNtFCJ08kiS5JVCzu0odPJOwXgb/Sx08lLBeEseEzp5q5D2V8XnkdcK
+eb6LeJ+8VzDCjyeI98mLBSOcOEDHjBAWZoTnzcwIz1mYEe4wMyPcbmFGeE7HjPCsHgDI05kRbhM6
+eTwzwsVCJ89nRjiRmaFtoI4ZYZyeGeEEMzPCdnpmhAOETqgAAIQMAEDoIBhh/3XifUIJACCkAABC
+C8EIK/H1CDUAgJBDMMKSbXiK92IzoROKAAAhCQAQmghGuKKp0AlVAICQBQAIXQQjTHQVOqEMABDS
+CEZYnpkNIQ4zwreYCRY9J65H6AMAhEDMCCcJnZCIGeEgoRMiMSMMEzohEzPCmkInhGJG2E5IQipm
+hAFCEmJBbH04ondkhIReWRkhM/0njPBzS1ZGyEz/CSNkpv+YEfbvacnK+CYz06tQVOiEehBbG35h
+uVov3icEZMY3WEhCQgAgNAQAQkQAIFTMyvgK6rMwvpI/67MyvmP6LIzvu1uWrIzviCUr41tnycr4
+3rNkZXyjLFkZ32x9VsY3Up+V8fXSZ2V8TfVZGZ9Gn5XxueqzMr7SlqyMD5asjI/98QnjY398wvg+
+smRlfOyPTxgf++MTxsf++ITxsT8+YXzsj08YX4D+cULSvxLhaaUmqOVjnlbzMVOrWUtwteaDhl8x
+egNGb2eX+kJikgYnDo2Lj090Yr4pT+ZziUpSr7hRzbi2f4RhXfMmxvW+dQ3rH06w7b0yxPCs46dc
+XN3d3F0zs77s9ll3nxRZfVSVhSuI8WpBWBf+4nQuxuNSqsjOTTrWzqab5ZrZEWISgm6aJN24kA8n
+ZNbOHmLI7gk3L+WLPSmFdrX2sZnjXYDxz10M8XPow9CE6LiohMfSmBgfNzSnKFpOUbScomg5RdFy
+iqLlFEX7pxVFK/SUsa1dQuJwJ8Dl/cznxZ42pjmOZS9jDLvQrbHtYnBjXKpX2nbZvzQejWlXDt21
+/XDxHK4e3W67tnM7rm8+a/xxhdVkm7/NeGPKVtNPfSYa792Nxf3LobYHx0PxcHc1W8b6arAvyWPD
+7DxwmfijzXXYj3Ab4mt0j8ptyqW/bvTQXTN5ai1Gz7f9Td7SKqP3soXwsc0w5rZON/meUG15zCmm
+vBum2fItnQbpvWRb/knJKDAiwlYwLgKFute3FQ6tjyINStiKVimBYuXqGYt7PUAJ1+LGEjeLmUqe
+vW/0O3TPVGrLaWPpladMZRZsNmpS86HsuAXGcl1voPzAr2wVgtJQse18W6XCn8O/6jibf8YhUxWf
+fjYerzsZW99ah+CAwbbgtPcQmqejLXT1KLS9VsvWbu4sU/ujHYxho0eYOqzVGjv26mnSzyxg7NS4
+ialzyk/GLn5lTOFdjxrDH7iYIoLWGyMulEZk6W9skTsITNbaeiw6h57ps229xm/HK5tG2npHf4Q+
+83rZDGFbTVETRxj7Biwy9YvtaYzOM8EUE9zEGHNtgCnWv4wxdn9bU5ynizFuWXXTwIvnjYNmhiL+
+oN02OLUaElactSVG5IFxyjbbkKAfkZS42Da08CEkd5xoS864Zkqtv8iYmn7QNLzYBOPwTStNI+4O
+MI6cN9006nhb4+gxKaZX11c3jundzTR2dl5jLqdmiz2yP5/r8fvng5fv+bWPO3r3o1dQiJ8/y8fp
+n4sLHsvseGF2hvhnDel/lyGcvJ3XURuL65HX8zpqaTxtiCcU4HXUc0InNODtgNvB2wHPGnk7oNXE
+2wG3GXmdlWfjCCV4nTQWvE4aauN10lDwOmk1G1OJauD655zCTQjCVIJTuAlJIOqf+xoh6p/nNvE6
+KadwE7LwOimncBPC8Dopp3AT0vCsGKdwE+LwrJhq41mxFBPPik2z8TrpNPA6abKN10mTOeocEWHj
+ddIIRvnu9W28TlofEKn5JYROCMXrpPWMvE76ABCp+cWFTojF66T3jbxOes/E66SnjbxOesrE66Sb
+hU5IxuukC4y8TnoDvE76lY3XSdPA66TzbTwr9jl4nXSc0AnpeJ20ny0rNevE8tY6MDUbzBQt7T0w
+NevIFG31KDA1q2VjajbLxNSsg5Gp2QgTUzOtkalZTxNTswJGpmZNTEzNfjIyNStjYmp2VOiEnEzN
+1jNFu1AaTM2+YYq2Q6gCTZmanQNTs9k2pmbbwdRspI2p2UdgatbLxtRsq4mp2QgjU7NFJqZmPY1M
+zSaYmJo1YYp2bYCJqVkZpmj72wqd0JipWXUTU7PzRqZmoWBqZrcxNasGpmZnhU5IzdRsm42pGdsz
+oTZTs0NgajaRKVoG2zOhuJDpB01MzSYwRdu00sTUbICRqRnbM6E7UzO2Z0J5pmbdTEzN/otoL3KD
+Wz6dzX04OvruZG9g8nNnm4o+hc2FRSXFJMQmOjGUxD5vksKR0D0ibFkIWpViRkHQCgQ+naCt/JYJ
+2tQvbQTDv56McB1mtbkZMwzuhofGXHqrwUN3xuip3WrwfLu4kUnYDJuPbYLhvz5p8Sy3cIg4XF5O
+1JLHWRt3ImrJLquJ/S0TF89iNb9mJc9kHVWKGbOwjgKB2WMdK7/NyjqmfmlzZB0vmoggF+A1tQwD
+r6k9NDJ74GwccglmD5yNQ66RlT3MsDF7mGDImdj4b09s/Atg4nmTGy6D5ift8QD2PHdyo8RThkN9
+THJM0tC+KUmxTsBQsrMj4nexTazZHQEfTVHcHLvYeCtxkZiq+LnjBNPtZnHGOzUHmO55xdr+qKmL
+/z+VkDOKvoBSEm38nb6yPOAvHFKTX+aQSvb+ZwyZvw7UyVc4IXpRlsCd/IYTouOMnBA9wMQJ0LG2
+f2Jg/+LAN2fo/q8N3f9UbHreOL7lowSDyRMwPXcDePGnjOPhMfHxfVrGJT+rdqRP5o+wpsznf+ow
+/v+G7f+38p4zk/90Axfk9PfP0SzNjkE/a7/284z70bPsWOC/efB94eD6wgX4nFnenFnefzISPG8G
+91bqgIeLPIBFzw1ZCz9lqIuISX1miWRHht/J2WiVJsBeNP+aM8/KhvlSckA8BcVSdX9h+NfpZYZ/
+j2ZQf++MZs4MZU6Y849wsOfFMBP0LerNzAXMzPU8YH9aDBMRl9AvJiEhxpkM4hhnwf1R5vD/y6LK
+TmzzKItqyDzb02Kbp2cS50wnPnOVOpPI/E77XnbjLxxAYl7mAPLr3OEXJgb9USHPrxODhsyzZSfk
+cT63OGceL2ce7x8GCs8b9I7Glr223ANY7pHdfJRucQlRg6P6OYE4g7I75P0picYvyGPJOhTm5Kc8
+1/Z/L+FbfvAvHBAH/ZED4r8mE/d35sQ8ewDNiRD/6wPoPwRKnjeMBmSc+drVE3D1zOYmnZyNpzkb
+T3M2nuZsPM0Z23I2nv4jN57mzTKmpaTGOXN0zhuPirBaPTqY3KP8lVydfGSP5t6Kp/aq7KX5QfGW
+Dsg+2K/42FQ5t3WF4ntiv5THPFXJu2GFlG/pco303lQp/yRZU2BEDArGRUuFugejcGgbqUiDKiha
+pbJUrFxrU3FvH6mEq7+pxM1Kcslz3ia/w15yqS0/mEqvvCKXWbDfpEktJZUdt8JUrqtdKj9wHyoE
+nZUqtl2OSoW3Sf5VZfhnnJGr+CShSvoWueqVcFTbtFCunmY0Bcx7Q66xurOp5phYWTs90PSs4h31
+PKtK9UveQf1LvlKD+yfQcOd1qdHJjWis+shNNn6AplOuys3mjIUu8YDcfPwcU4uOqtwy+lVTq5rT
+5Nat+pha39ohBQeMRnDax1Jont4IXT1JantNh3ZzF8vtj/YyhY2eKHdY29TUsVecrJ+pMXVq3E7u
+nOpq6uIXIId3/c4U/iCfHBG00xRxoboUWfo8Infklbpn7ECPRTapZ/rH6DX+sPTKpknoHb1G6jNv
+EAxhFjlq4kRT34BVcr/YOFN0nhlyTHA7U8y1VDnWP8AUuz9SjvPMZ4pb1lAeePGGadDMblK8JS8G
+pzaQElbYkBhRUjJOOYwhQQ+lpMQ1GFr4jJTccSaSM+7LqfVXmVLTT8vDi80wDd+0WR5xN9U0ct4C
+edTxSNPoMa/Lr65vaBrTO0YeO9vP9Csvcnf7Kx4Q/6gCCTndFBO52wcyfMn1XKQArRb6VuHQd+Ha
+JFSXJDtmL0Yjjw4mTkH0V0BvdPKRAYDcgFMQrwqd3IFTEA8IndxCSJsqcwriCoVTEPdLnII4VeEU
+xBVCJ3eB2Fs4VejkNhB7C2MAsbcwWrxOLgSxt5APjSZXgthbyIdGk0sBALkVxN5CTo0k9wIAcjEA
+IDeD2Fv4g9DJ3SD2Fu4XOrkdxN7CFUIn94PYW7gPEHsLzwqdXBFib+E2oZNLCplxRjwh1xQyfYvQ
+yUUhKm4tFDq5KkTFrTeETi4LUXErVujkushm2RqIY3+qZh42fgdCXvLNPGz8BCAqbl3PPGx8I+gf
+uTtExa0PAFFx62rmYeNjAVGD9UDmYeNzxOcTDEDUYH3VxOximszsoo+J2cUOidnFaI6g0z6WmF30
+BrOLSRKzCx2YXSyWmV30MjG7mCgzu2hqYnYRJzO70JiYXbQTOkEJs4sAmdnFd0InWGF2sdPE7KI6
+HzJe+jyE3JFXYnbB6TUEN8wuPgazi8MSs4tJYHbBh7wTBDG7sMjMLiaamF2skpldxJmYXfAh7wRN
+Ql7jQ94Jophd8CHvBFXMLviQd4IsZhfdxPUIuphd8CHvBGGcVcOHvBOUMbvgQ94J0phd8CHvBG1C
+ZtyXmV2s4uya9NMys4sZJmYXfMg7QR6zCz7knaCP2QUf8k4QyOyCD3n/B0OhKMwU9nRW4T5/U0oJ
+L6CE1/NYheO5FaFDouJT4pKfga/emT/0b2Lmc7998Qs4EDbct/56I6KXZovRW1pk8MFCI+2wym19
+w+h74kNrHnOsMe+G8dZ8S1+3Se/1t/5zTvPLQIP7e2x/xWl+/8Sg3f03n7mVy+MlHvj/yKt2mV3+
+3zFcmTNVheh1l2KPXqe/IW9r7cDnW3fL5PmPC6PB4ZTM7LiMKEoavyBroG64b4UTGxjJpZiicDlx
+ci04bGAkF2OK8qGVKQoHVORuTFFetzFF6W9FzsmEf8DJhBlgirLHhv/QyYQ5EyB//ATIfwhOnzdZ
+Uqz959vf9wLed5rWtI0aHBXXz5kTzYo4ngFHp7dyLYVFhke1FH4vhXF5xkGR612fdlDkrw+HzM43
+4BLa5cc4nkP7ZPs7jx6/3v7+V44ev69lhMEI8/il+ozgcy7AuecWKM3jaB4pA1NGONG0jR1Pof5w
+2hTt2lGddetKfK2uLz1Ft/4bo/aLoq+av/h8obrx7krdlzOPab868Z1503AfdfPGYjpzjynqlg8+
+0G1ttE27bYzFvN3vtrrD4K7bkVFVu6t5PfMu6wrt7nEzdHvCFqh7rk8wHwizaw+o18xpVYLUb1pG
+m39q4qX9af5t88+54rW3a0u62xfnq3cLtjTf3ZWm/eWnYbp7aXXV+9uCzA++7KJ9+FlnXcbcIK19
+cqDOc2Fb1atColJ+xudqBWmGUrHeTbWiLVXxr9rN7H/upFr5+lu6KmpDpao1TVvtvW6aerd3qvU3
+V1WDh3mrwafGajJP0HPywRWEqTpxLvdcbrncc+Wy27eKasLbLPjVuQ/nrXxEOR9f2PLJEXwI0NZG
+dvqDTDxp7nJRLZ76hST1DUnqH5LUR0J+YxTvU18J+flCPqfp7krxPvUbSeo7ktR/JKkPSVI/kqS+
+JEn9SZL6lCT1K0nqWyEzqor3qY+FtK4QOvW1WLkJW8DnNF2fIN6nfhdSvSZ06n+xctMyWuhkB0LO
+vy10sgeSZBNCXpwv/p5sQ8hdaeJ9shGSZCckyVZIkr2QJJshKeyG/k0O1AEA2Q8AkA0BANmRIGbS
+DKGTPQlpS1WYmHXjau3nTorXyb4AgGwMAMjOAIBsDQDI3gCAbA4AyO6EPDVWvP9X2R8dAFmnaR0B
+KT+XU84Ucwfox7np+bYpCTFRKU4EBVMyn1cyAdg7qYTCi8qVpIz1wRr7kqoSZlfRuLzpK7kOz61x
+G1JZ/vUUvufbLcUUvveyzZoXT+EPlQrGJWkKde+nKRwarinSoJ5UtEpdTbFyXeTi3sU0JVyD5BLV
+CkklbrZQS56rqPgdrqCW2uqplF7loZZZ8L2iSW2gLTtur1IuoqS2/MDLmgpBD7UV2+7RVCp8Rutf
+9TONf8Z9tYrPO5oq6afVqleMmmqbNqvV0yYrAfMWqDVWJyg1x7yuaqfrlVqxirb20NpKneDXtIFd
+CilB/tHaurVvK/U822jr+xXU1L9UWdvg/s+ahrt8tI1OHtM0ViupTTZu0DSVvdRmc+ZodIlX1Obj
+1yktOu5TW0a/p7SquVxt3WqU0vrWWW1wwGxNcNo2bWiekZrQ1Yu1ba/10rSbu0Vtf3S4EjZ6odph
+bQ+lY683VP3MxkqnxrFq59TSShe/UDU8Akr4g2pqRNA5JeJCiDaytF0TuaOqtnvGWU2Pxb7anunb
+NL3GX9e+smmxpne0Rdtn3kSNIeyqGjVxodI34IDaL/YNJTqPqsYExyox16apsf6hSuz+ZDXOs5oS
+tyxCHXgpjzJo5lBtvKWqZnBqV22C6qtJjKinNU65rhlSt7g2KdGiGVr4vja54ypNsr2omlr/gJKa
+/os6vJiqDN90Uh1xd5oyct5X6qjjycroMfPVV9dHKGN6j1PHzq6v0Amn7k995GLp4eX5Jz6AtFGA
+mL9yF3NZYhaLi4yTy00xuRSy28cZUUw89xPPy5IT0syWvls49PpwMdPVnX7oSKB2dDZQuy7hwP8j
+i05sJZUzn1ey2+32ZlsfHiL56sTaO8SS+aQSStYl80oSL0UHa7i+AU9CkyOKq77Jk9DkkBD1DSrL
+ziwukKM6Li6Qw76cxYWhEnOvJA1zr34a5l7hGuZe9STmXnU1zL26yLy4UEzD3CtI6OT4HMG3UHmR
+oaLCiwwVhE5AAAAEBhzBf69wBN9AyxH8XqETOHAEf1nDEfxDLUfwezQcwZ/R8kDxmdAJNDiCf0fo
+BB4cwRs1HMFvVjmCn6xwBL9A5Qg+QeEI/nWVI3i9whG8ouVFhtoKR/CvCZ2AhiP4aC1H8LcVjuDb
+CJ2AhxcZKms5gv9Z6ARCHMEf0/AiQyWVI/gNQidQ4gh+joYj+CsqR/DrFI7g96kcwb+ncAS/XOUI
+fpTCEfxZLUfws8X/T+DFEfxIDUfwi7UcwffScAS/ReUIfrjCEfxClSP4HgpH8G+oHME3VjiCj1V5
+kaG0whF8qNAJ8HiRoZrKEfw5hSP4EC1H8HYNLzJU1XIEf1boBIYcwW/TcAR/XcsR/GINR/AWLUfw
+EzUcwV9VOYJfqHAEf0DlCP4NhSN4VeUIPlboBJ4cwYcqHMEnq7zIUE3hCD5C6ASoHMEP1fIiQ1UN
+R/BdhU4Ay4sM9bQcwV8XOoEtR/AWDUfw97Ucwa8SOoEvR/AHxOcTCHMEryocwTOxIUDmCP4rlSP4
+ZIUj+PkqR/ARCkfw41SO4AVQu7vmeurDg6Wnt9ef+HiE1OPu/4F4/QSwM5/50DsNmrd7enjfdX6i
+sZY3UOu5ZRtLOpCtdjEJySn9Bo2o2T4xJW5oalx8fIwT+Varnpfmt2joHSwukt+4J0+Hp6T7HfNL
+W+RtzFaK/KNdZJkp8t8XBafI3z739FrcpWXjg+MNbQ93l7L+1rKP//zVlh9tDe5/ac1ZbclWiuQz
+MqQyH1nSnVxe2kbS35xu9f5fmHG56rdkXBIykCR0EHF7ng5OZmAeE9ch5PhDdx/8ervfr3YfEPJk
+2X1w+1z26oCX5vsjdOKKJaWsf0WlzpyFrr96oYvLvBFC5yx05Sx0/QGZvjkj2QsTh/cOeBj7nQ/w
+nU+2yXJYYkJyXL+YZx+t5zhUznB2X+k//cCYKumrTVWvtLZV2/SuqXpaX2PAvGGmGqtbGWuO6W7S
+Tq9krBWbitpDvYx1giMR2PmKMci/IerW3mes5+mH+iUv23JShP6Iva9OPF6iN9vtavKLd7btMruI
+ZW483Zlb/ebjaWa8zE2yOcex/LXHsRCeME1rbWOa9q6JaVpfI9O0YSamaa2MTNO6m5imVTIyTUsF
+z2Z6GZmmRYJp2hUj07SGYJq2z8g0zQ9M0y7bcvKRcmjaX7/Z+N+D3c8jYgf0ZarrvAGdt7NJSXRk
+YJ/QDjmnBuacGphzamDOqYE5pwbm0NScUwNz6knnnBr4Tzg1sIAjkUuKio9JiI4b+Kzt+O6ZP47P
+JZHvd7u2mffOXbO4uLm62O1bPTmzHu4iSzEzV5G+V3NaVteHo3m3cAAu4ic717Pb7faRF9/dIEal
+27XNWTcf/bbr+zTvEh4oWq1y1Tq3bgO4jee1mmOGZPuoPvqokU5k+WZpsfWlZ363/vqRbzKzQt3s
+drMfIH672+0HqrsIcv8ko7NFF87qzM51OHG+ZDRnDc/8TsjrR75xyEZ1+rqURRrYlNso72etYy8D
+uPzcNvJ1bKO4wc5sWsifeee+JgCTk3XfbDvYVr+99XndjpptLTvLBJh35atu2WXPZy5dbLu+9JRX
+dGV+uWPRGGVzxXnnLZUKjzYHVHTTB8yZqxPf7lcptW52+7rtgN2+7rOsmz7aP06YDUJ2bjJzX8KX
+Isc4WfcNSbphknTTJOnGSdLNk6QvIKQ9nxkA6IsIOeUVnRgWf7ljAQD6UmLYm3fewsPeaKHTFxRy
+zlzd4278LV+U+jOoKW80iBtxdslMF2DmczcaFHTsz8ShfZonxMbEP7N8sUfmD/37wnG7wbyPQpT5
+GwvLyoSqyoL378iOqcJHM5J7HTctk7/N2CafNMvSqfTF8umln8npmybKZya9I1vnxcln44zyuTHt
+5POhneXvegfIF6oEyheD20qXCnSSLvtXly7frCNd8cwrXTlcWPrhok26uvKOdG3nYen61BPSjyuu
+y7YhG6UbUyzyzbFb5FuJq+Sf+iyUf+44Q77d7A35Ts1U+W7lD6Vf8kXK97zGS/fuvq65f7m/9OB4
+jObh7pA/OJW5q/TMVOabgUrJc0Vlv8NFlFJbfpFLr7yrlFlwUtakVtOUHfeVXC4ij6b8wG+lCkE/
+aiq2/VKqVPiQxr/qPMk/45pSxcckVUk/qFS90leqtmmlUj1tjBwwb7pSY7VBrjkmRdFObyHXip2m
+qT20glwnOFkT2MVDDvKP0NStfVmu51lfU98vl1T/UglNg/uXpIY7H2ganfxaaqwWU5ps/FRqOuWe
+0mzO25Iu8ZTSfPxSuUXHTUrL6P/JrWoqSutWg+XWt9I0wQFvScFpn2tC88RLoatnadpe6yC1m7ta
+aX90oBw2+l2lw9r2csdewxT9zBpyp8bdlc6pktzFr5ES3vWmHP6glBIR9I0ccaGhJrL0DSlyh5+m
+e0aa1GNRhqZn+udSr/FWzSubZkm9o7dq+swbIRnC0pWoie/KfQPMSr/YYXJ0ng+VmODucsy18Uqs
+fyM5dn9/Jc6zlBy3LEQZeNEuD5oZo4m3+EmDU4M1CSsypMSIKhrjFKs0pG5uTVLiVmlo4Wua5I6L
+pGS7t5Ja3yynpv+gDC/2oTx8035lxN3x8sh5K5RRx/vLo8dMVV5dHyKP6T1UGTu7qvzS5pP+9Ifd
+PrXS/9+375hBxzCib5U1Z+4JMXFxyQ4iiBDUvYSZQ9AQEYoSOnAIWlXhEPSO/Lz8ZkINEXKalskc
+Ym6TOcSUJQ4xF8scYn4mc4g5UeYQ8x2ZQ8w4mUNMo8whZjuZQ8zOMoeYATInpATKHGK2lbj+dCeJ
+Q8zqLG/WkTjEzMvycGGJQ0ybxOUw70gcYh6WuBzmCYlDzOsy15/eKHGIaZG5/vQWmetPr5I55Fwo
+c/3pGTKfd/eGzOfdpQpJyEWS0IvPvxsvcYj6uoZD1P4Sh6gxGg5RQ/7h+eFdJfyW/PCbgQrnhReV
+OS+8iMIh6i8yh6h3FQ5RT8ocolbTcIj6lcx54Xk0HKJ+K3GI+qOGQ9QvJR6rD2k4RJ0ndEJhDlFN
+Qic05pWUvhKvpKxUeCVljMwrKdMVXkkxyLySkqLwSkoLmVdSpml4JaWCzCspyRrOC/eQeSUlQsMr
+KZdlXkmpr+G88FxchOZSCQ2vpFySeCXlgYZXUr6WOC+8mMIrKZ9KvJJy7//Yuw64KM6mPzRBFF07
+9rNiQ1cBRUU9BQQUZFVEVNRFQUQRTkRERV0Ta2LiauwaPUssieXs2C9WjCWnWLDh2kssZ+/e95tn
+QLi8SiBfkjdvwt5P5h7AO26fmf/UZ0ZLmZSvOMqkXNRSJmWVTJmU3VrKpHwpkwuu1ZILPkgmFzyV
+6sGdv2D/H7UBueDRHLngs1XkgnfgyAXfqCUXfIBMLvhMLbng/jK54MO05ILXl8kF76alunBOJhe8
+uZZc8McyueAVteSCn5LJBW9G9eCVHlETmgMVVOSCp3Lkgr9XkQu+mSMXXFGRCz6bIxd8r4pc8OEc
+ueDpWnLBZ8rkguu15IIPk8kFX6IlF7wbW6M2Ihe8uUwueD8tueAVZXLBfbXkgptkcsEjVFQXXoEj
+F9xHRS74e45ccJJX1FxUF07yihqMXPD7KnLBl7E1ajNywfXs9VGrkQu+RCYXnJpFoYYjF5zkFTUd
+ueAkr6jxyAUfoiUX/N+u+eyFzkHuwsfdcXe+v1K9EED1QjkZ2cXNjOz8IvD8IvD8IvD80rn8IvD8
+IvD8IvD8IvD86qL8IvD8IvBSZjbysIi43kIcDtMbkp+1yn3WKiCsb0RUbC7SHXUAwAoACs/6vn9S
+clE/JfmdWrvtkSu/PT1edd59LX/+l9f8xbJXdBe3dVNdelOKT5+frrp8aR6vBLmons426Z7Z9FI9
+14fzL4pU0r7sWI9/eeiA6rX9Sd3rlS+1b+4V4N9+OUpb8fDXfKXEs9rKWjedyuVnbZWxUXzVQina
+aiGcrtpVjYr1+jl1SMVLw/mGFQO0rLdP8nhV08vX+KaP26map5h0HrFFtJgOybXHZ2kypY8DYP1T
+2P3OzJA0Yr1T8nJvcBfx/iDFe8ToOzUzwfBeIcX7xUwl97VsjfeNmUplr7A+Nnj/mMn0phT7Pt5H
+ZjJdmsfWeD+R4j2lfjm92BrvLQsDFqnE3g/vMaOHDrCf471mdOVL9nO858zk+XIUhb0Of80DAOD9
+Zype66YDAMB9IBUfxX6O+8FUfAjHfo77Atn75pw6xNa4R0wlVwxgv/+hL07yeAo7Xb7Gfo77xlRs
+ion9HPcvM6X1R+1h9t43u+P6cLctAW5b5iQjRc1kJCYscmhuEpdc9gzXLmff2wU848WCy1oKxTq+
+VIqdihVKNDyvlNjUUagdNk1V58f2Ql3PS0qjww+FNiF6xbNVlOAVFCN5V+7GeodZW1pbWmVQk2kd
+S8+uc4RshQNgVjiAHzgggNDXO6MRXV7+Zspj1mNpYvzbAX/gGU991Za1FAAA8HMweiqWrfHzMLqp
+I1vj52IS8WN7tsbPBwCAn5HZQyF6tsbPCgCAnxcA4I/8zKwYgm312dij3621AFhrkXs4jAkbOiQX
+d02VBYdjvJKLdjUmv+ug2/aojXp7+mf8efed6vP37NQXyz7UX9wWyV96U02dPv8ef/nSSrUS1Jp/
+OseB2p7p49QvitTTvezYTI1trV7bK/rXqwro3twrqX775Ze6iocXqCsl3tBV1nrqVS6XdFXGDlNX
+LXRGV0+/T+3c7ySfCxGxNJkO7QRgCiSjXRQJhktuywhU5uA2xovArauRwK2DjsCtjZrA7TMCNfed
+bI13gcDtoZ7ALZIncKumJnC7xxO4rVQTuLVma7xDZs3A9HFqArd61AysYzN19mZgeOcYXVVAR+BW
+Uk3g9iX1ejlMeXK8mwRunnoCN+rNgneWwO0MW+MdBgDAu5xLMPqNO529cKLOTI/zoZYAoXmAoLio
+yNj4fMvmOeQ2bBoQFhcfFRM1eGhEHmplikkAMG1pileJgBh1SdfSOgvWsvEg29iDOzM6rhVkf7UD
+/tVt22b+6blD2w9vRGhbcj9SfEOGogExjOvy/MZZ92n2qqZ7f7smp5jZfYoPGxQbF5sbs7lrxvPC
+3205L2YWnef6BOEza6n5lr1Gj3VvpRY7vja2nJoutZqvMapj9VLrcV9p2gQukTzDYzVeDcZJWLbZ
+tmg/yae6i8bn5efgZ11K45cWAe2uvdD804vVLf7A1rbZHibTsjkATG2yOiALcuas/QN+1bf202HG
+j7ICCxtuOS9+rGr3Dz/s9cxaYrbiFnLbkZUoHPO1kcIx6RKFYzRGCsfoJQrHfKWhcMwSicIxsRoK
+x4yTslcJI8sBACDbMfryc8AL2Y/RtAjAC9kQ8quE/6erhP8GYpZT2W7tVREHvrAB+MImJyDnzIB8
+RFh8dFjMJ4TXJuMfk5eM59x3W25r1j2qq9nSL1jc2rqFJrlwYzH53QTjkbuDxVzPpnpWXG6+5USu
+Z1O1LRov+1RvLfm8nMb5WVeT/NKGcO2u20jtk7tw/seqQsCcJlyHNdYQmFiWE6bchI5hbnKn6BTo
+3LqMHOT/PXRRvZKD602BrnBBDim1UgpRdsndnn0hddcvknucjpZCV+3kem7uIPWavJDrPZOXxCiJ
+CxsdAH38+nB9ezUANgvl985DMZkWeFB6GLKFxCg9TJsc0Jk2OiBzo9mXvGwKIeptZjnh5iDFDUKK
+m8QM78KNRTLAJzBDHDcM/oyJRM+Ky4S4JJl/9EQiZAZC3NaEvC+p2AcZgxCXin2QQQAAkEkY4h6r
+CnghszDEXWMNeCHTAAAg4+CFzMMQNzoF8EImAgBARsILmYkhbb0pGWqNin2QsRhVqNgHGQwAAJmM
+Ie7paLZGZmOIu5kmciHTMcSdybM1Mh8AADIgQ1w/Kvb5rzEim6/DEKbJs6tp1a0Bqlvn2guJiAmP
+HZGbQEjLf1Rn8bKuaqdj+3S1Xkfqa+t8dXWUK+rsncXVuhK61sEt9f/PDuPY49nKxpJ6PO9J+pN6
+jLc07zG+wTq/x/jfoMd4Wfp/yGUAAMhpwHqM+7I1chzk0GMcORAAALkQ/rie438AR2aPvJa70Gq8
+qzWAq3WufdOImNhBUTERn3LhbTP+QUbjcdvcHCrPnG79qmg76bVdJDvUTdVG/+TuPQo0v7A61773
+v/WguGW2ukWbv0Mx4ppLOZ0XzOVJQcgkeREjk8lkChvm1/f/cyr811O6UeSoJD3yw6lo8zKZ/NPN
+8D/ZhEcBKpNZ/afEZfJPb/974jL/cAjOKeaztl3Az1ftAK7a5TpfGREXFR6Wi+BC06yIz3mx9Bc3
+wbH6ec1fHrlP9gH/YyWMAXNqQ4fVz4yBiYVAmHLW2DHMSeoUvc3YuXVBKch/vrGL6hcpuN4oY1c4
+JoWUmqcJUdZJ3Z4labrrp0k9TvfShK5aCz03qzW9JsvQe2ZVjRgVB2GjWxr7+AVB314qo8Xvd6p/
+eJs9eJe12dlDeN7Zw3i5je40NY/uULwcNwIv3Ix/Rbw82QcoelPCSNGb2oAXMgNFbwoBRW/OGil6
+Q3YAMgdFb2hOODIJRW9oTjgyC0VvaE44Mg2jCs0JR+ah6M00iaI3vTQUvaE54chMFL2RCZdnVtVQ
+9CYOKHrT0kjRGxLn/wqTZdU1fF9p9f57VgD3rHLCCbNkaER8dNhADA/nwgQUMp5X+mtHOH363HOO
+I4y82mr/98YYZT5sCuAjg/zmA+BMzZzmWozVoC6aKlmU/dgsCza+gg2yMFNIuTlT0DHjeSXzeUN8
+uX/mvKHfd570d8+b8Wqrhfy5M3/U3JnMB9UwW+ay1DlTtMYt/4MELFPCCLpzHBCzSTvv4FQbgKk2
+ue27ERCRGNU3trdnVPzwXEivV8Zzx09l+w6k68SDs12Fw10eKYfvXhJ+tjyo/Lw1WDg/t5RyIeFz
+wz/aTsw+rOh3PEympYU/nYfJ8hIssnkJ2TMzzGPoGmTOL7lDZa/sDfzymjDEPUeK+44U957Ru5fY
+GnmA0a3BbI28gBT5Id9U/R80Vf+mfJ5l1cYradxBa4CD1rmueMDqvujYmFykvaIyyxSnXS5Tq0RM
+X71q6HUDq6ftctBQbcA3+upuKw012g3V1yz1hYGNEn6/Qqht31RfO32yUOdueX3d3QOFeqnuaueF
+AUL9jeXUDUY3EPjpb9UNI9sbGsWlq118nA2unfRqN6eihsaNlqibFHhkcC+/W+9+M9XQ9I1W3+zg
+ZkPzC5/pPdadFFrsCNe3nLpJaDW/rV4dO0toPa6vuk1gouAZ7q32atBd8PZyUns/mWLwcfbU+6TG
+GPwcauj9NgqGdvdt9e0XDBL8z1RXByQFCh22FFAHhjYUhJm31R09Sgidhv6k7lz+mRDUZbU66M1Z
+Idjta3Xw9SeGkErf60P2nzZ0ez9F333ZVkOP9Bh96Li5hp67BX2v8JGG3gsb6cWAOULYxEB1H+cR
+Qt/Ihupwh1AhwqeEOuJ+SyGy5jN15DGVEFXgrDpqjaUw4OY29cBZlQzRP5/WD0oAQ8y6rfrYLlcN
+mqlz9YPd9hviYkfqh5RabogP7KmPf79PSHAfoU5I/05IdAxVJ+6eKAx/2VI9YmGUMDJNpU4a3V4Y
+lWypHt3LWRgz+7o6b6mq/9/D0mQ6EpK9HhWZ+pqSVY+ah7xqVPY6WGQyprNj+lKZ8dDrBsheZtzl
+oIHiv9/oKf670kDx36F6iv9+YYDss4ffr6DyevumbI1MSfHf8nqK/w4UKP7rTmXLCwMEiv+WU1P8
+twFbI8MSeLdnr4+MS+DtbCDw1qsJvIsaCLyXsDUyM4H3bj2Bd6qBwFurp/jvZgPFfz/TE3ifFAi8
+w/UE3psEAu+2egLvWQKBd181gXeiQODtrSbw7i4QeDuxNQoDxX+pbBuFguK/NdgahYPiv7Z6iv8O
+Eij+W11N8d9AgeK/BdQU/23I1ig4FP8tIVD89ye2RiGi+O9qtkZhIhP/a7ZGoSIT/3v2fihcZOJP
+0VP8d6uBTPwYPZn2cw1k2gt6Mu1HGsi0b6Qn036OQKZ9oJpM+xECmfYN1WTahwpk2pdQk2lPxzFQ
+MMm0V7E1CiiZ9pYCxX+3qcm0r2Sg+O9pPZn2YKD471a2RuEl036unuK/+w1k2o/Uk2m/3ECmfU+2
+RqEm034Ee30UbjLtQ9Vk2k8UyLRvqSbTno57oMCTaU/HQ1DwybR3ZmsEgLznq/8iEMhIZXs4841a
+fsyMd+jR5OhnBQA+K5CT/jKrp4mN6Rv/SfWVvVHWzxnPWdpJerRFWJg8Xly0/ZKww8PdsGNTNfWu
+CuUMu2bYqHe/eWvYM+SWWn8h3fBj58PqvTv0hn0uP6j3z19iOFDiK/VBzUvDIYdj6hTfR4aU++vU
+P5XuZfjpzBH9kUlXxcyTAqlDzyin1D8bTndJVs6o1hvOus1T0uAbw7lSSco5Zajh/Pu54gV9V8PF
+9JHipVXxQvrunuLlycGCsrCVeCXKXbg6uop4za+ccL2XlXij1lvhpk9l5VZxR8NtJwvlTunrhrsF
+ril3nx80/HLzgHLvzErD/YMrlAd7DggP1+0XjYtWCI+mLhcfj5ksPImdJD7tPVB4FjhAfN4qQHjR
+wF98WSvK8KpoffG1XXvD65ftlDe3nQ1v0+op71KKGt4nF1FMKx4ZYLZRsZiYarAcdlKx0hgFa/Gh
+aCOcFOxUG8WC3CzBHmaK9sZEoZAyTCx8bobBQd9NLLItwVB01VCFmxtiKDa5q1J8eDNDiaimSslu
+FQyl/Morpd3fG8rUeqc4Vi0vlLW7rJSzeCeUe/RWLH/lslDhRLpY8ccfhUrr9WLlxUsF1dDHSpWx
+44WqXU4p1QYsNlR326LUaPe5oWapOYpTnQiD0/vNYm17H0Pt9Nlinbu1DXV390yql1on2nlhq6T6
+GwtHNxhdJYmf/iC6YWSLt43iDNEuPpXfunbaEO3mZPG2caMZ0U0KXHvrXl53z/3mgbdN30y/1+zg
+irfNL8Tf81i3P6nFjuB7LacuT2o13/2eOnZSUutxXaLbBA5I8gxvEu3VwD/J26tstPeTeYqPc0eD
+T2qS4ufgYvDb2Etpd7+Uof2CkaL/mUZCQFJPscOWkkJgaCtRmPlc6OhRRew0NE3oXMFKDOqyXQh6
+c0MMdlsgBN+wUEIqJRtC9l9Tur2fZ+i+7IDSIz3JEDpuhdJzdy9Dr/DJSu+FaoMYsFwMm9hT6OM8
+Sewb2UoIdxggRvhUESLu+4uRTlZC5LH6YlSBG0LUGk4ccPOQMHBWPSX652uGQQlFlJh1BwyxXYyK
+ZuoKw2C3k0pc7GTDkFKblPjAgYb49yfEBPdJQkL6RjHRcYCQuHumOPylvzBi4TBxZFp9IWl0N3FU
+MieM7tVcHDP7sWDxUQCwtrGy/J98mEz7vCgzlXWYBlu2suesJMeipH8ARZJbexGWte5Kh2yyWeAW
+eYEf1lOk0n3W6xRhCClCERUHkOeIkMToJjrjhdDE6AwbtkaIQoowhRShCinCFVKELKQIW0gRupjH
+WuIrtkYIQ4owhhShjNH766g4rHQvtkZYo+KEq+KvDyNRccIZhYoTfjZQC/tkhVrYrzdQC/t5bI3Q
+Ry3sk9gaIZB6ncwVqddJVwP1OhkpUq+TeIF6nfQUqdcJedAIj9TrxF2gXidVROp1Uk6gXidWbI2Q
+Sb1OKivUX9DRQL1OLNgaIRQpwiijzw8aqNfJAYV6naw0UK+TFQr1OjkgUK+T/SK1sF8hUH/B5SL1
+F5wsUH/BSSL1FxwoUH/BASL1FwwQqL+gv0j9BaMMVMxRX6RijvZsjXBM/QWdDdRLpZ5CvVSKGige
+XEShXipkTCJMAwAgVAMAIFwDACBkAwAgbAMAIHQDACB8U/yXjEeEcUaNZDwinFP8d4aB4r/dRIr/
+Jhgo/jtUofhviIHiv10Viv82M1D8t6lC8d8KBor/lmdrhH4AAIR/iv+WF6go5DJboypg9NFbkYpC
+LgtUFJIuUlHIjwIVhehFKgpZKpBT8Fghp2C8QE7BKYWcgsUGcgq2KOQUfJ7R+3eOQk5BBDkH7zeL
+5BT4sDWqE3IKahvIKeiZRE5BnWhyClolkVNQOJqcgipJ5BQ8iCanoMVbcgoM0eQUVH5LTsGGaHIK
+LN6SUzAjmpyCa2/JKdDdI6fgwFtyCqbfI6dgxVtyCuLvkVOwP4mcguB75BQsTyKnwP0eOQWTksgp
+6BJNTsGAJHIKmkSTU+CfRE5B2WhyCuZRzxTnjuQcpCYp5BS4kHOwsZdCTkEpAzkFI0VyChoJ5BT0
+FMkpKCmQU9BKJKfguUBOQRWRnII0gYpCrERyCrYL5BTcEMkpWEDOwQ0L6plSKZmcg/3XFHIK5hnI
+KTigkFOQZCCnYIVCTkEvAzkFkxVyCtQGcgqWi+QU9BTIKZgkklPQSiCnYIBITkEVcg7u+4sU76fe
+0KhWySm4Qc7BGk4kp+CQQE5BPYWcAuotjeqWnIIDBnIKSD5R9ZJTQPKJKpicgk0KOQUDyTl4f0Ik
+p2ASOQfpJK+omskpIHlFFU1OAckrqmpyCkheUWWTU9BcJKcgX3V/QnWzc5+tP55VmLX3cfFOhQE6
+Fc7JHSlm7o7ER8TFRQzPRY64ZcbzkljK8LGcwn/tdOhfnjv4PQ+TafHT346mfiqOmj17C5CX3cos
+PsltpgD+jYc6/5GR//82l2bF+S3njZrX3Rqgu3Wuq1cQmBKiwiNy06ioK74ZANSc4nrDZcn0iRtX
+mi6oVz0qqvv+ajf3H0568qv3TUleszFBt+Pp5uSdezbrdp2anbx70Wzdnk3Dk/Vjhut+nNEjeW/v
+Hrp9oxLd97dqoTvQs7v7wcqVdYccO7mn2HnwhytWcj98uxL/05eL3I9VOsgfm9mv1r1C43X3HrvV
+uu/dTnd//BnVixJxuhevy6vevm/Jv3terBbMGspDWieVddcvdNYHm2ltZ5XX2V4+P8fu4KJaBbt3
+4AteGKJyKFecdxgzUVvk1hi+aO8oLXfuDF+sVXttscnOuhINl2ir7Aviqzolqqotvk7Hk9pF6mrc
+8lA5VSnKOxlCtLXt6+pq65pp6yipfF25gra1/3O+TdsGek/fpQavEgv03pXbC95PRut97CINPqmi
+3ve2n8FvYxt9u5S6hvYLeqv9VzoYApJaqztMfGgIDK2mFgYXFjp62Kg7CQ+EzuVvqYN4gxD05nBG
+hP4TxU1YpmJrY5vx1Y5Ibh82JtORAAD29cMpk+MpGXqxOH6F4ibTxTSL0ixEV8FkunvbQkXPM1vB
+ZJw+ceEzA3cufEYfoIYuPOSFgehAzw0XOiE1cSNSZCakyFBIkamQImMhReZCigzGnOCnm9kaGY05
+wadmszUyHHOCNw1na2Q85gTP6MHWyIDMCR6VyF4fGZE5wT27u9MgkcpsjUzJnF87D/b+yJyM3q7E
+1sikSJFRGZ3ZrxZzEguNZ/8fmZY5i97t2BqZlzl7JeLYGpmYOXPvW9KJJ2RmvJCh8UrrpAIAQMZm
+9GAzKtqZVZ6tkckBAJDRAQCQ2Rm9MIT9P2R6RsdMpGKdW2PYGgUAAACFAAAABYGcNmf2uigQzHna
+F8R+joLBlNTi6+YnrdpF0kmrWx7s5ygsjBpCMpqx12U/R8EBdtIqlf0cBQgAAIWIKZm2DfRMyfgu
+NQAAoEABa1xEkWwULAAAFC5GU0W2RiEjZ6QNW6OwkTPSW82UzEoHAzkjrdkahY+ckWpsjUJIzogN
+W6MwUobiFlujUJIzcjhb5Px/VDizHxZr1uMEf9MG4Gbuz7/HxsTHRYRF56IY907Gc5pEk/yNZtH2
+X0TtxPopi+etVy+ZUcC4tMsq9bIJPY3f1UoUl/drZVxRuIOysm0V48p7vPJ9hcOa708HiD+8/UGz
+enMDcc3FrzRrZxYT1+2M1ehGOyvrF3TUbIhsJ25MctFs8qknbg4tpdniVETc2vyFJrmAUdxWo4Rx
+282T4g7rZ8YdPz9Qdl4/a9y1zqDs3r/NuGfqBkW/bL7xx9gZyt5xo4z7AhOU/eG9jQcahCgHA5I0
+h4o2U1Kce2mOTDJqKFz/Rkkdet14Sn1JOd3loPGMao9y1m2lMQ0WK+dKfWE87zJdPP9+heZiiXjx
+YvpkzaVVn4npuwdqLk8OF5WFAZorUW3Fq6MbaK751RKv9yqmuVHbXrzp42y8VbymctupqPFO6cfK
+3QKPjHefn1J+uZlqvHdmi3L/4Gbjgz2p4sN1JzXGRZvFR1M3aR6PmS0+iZ2ledp7uPgsMFHzvFUP
+8UWD7pqXtYYpr4p6aF7bdVNevwwxvrndXMlrB3A71Y+agtwy0R6WavI7gP/7OoDn7cDG/+TQAXqY
+TJtnmE/qYs8Ls6+cf0DWIGfvrkHgLWSrM7TIC+ixk98PEu9R8P4bDQXvf2FOH4Igneyjk90IhkgR
+EJEiKDInslYi+30ERxbTLdyBBXcRJBm9x7M1giWjpwPY7yNoMjtpcwO2RvBEigDKnNKdsWyNQIoU
+wRQpAipSBFWkCKxIEVyZE+tUhJzY5i/IiS1gZGsEW0ZvnmRrBF1Gf37AXh/Bl9ll6wxsjSDM7LKp
+G9gawZjZZbEz2BpBmdllgQlsjeBMA95C2BpBmga8NWNrBGtKQhg15kmINwolIa4bKQlxSaEkxEEj
+JSH2KJSEWGmkJMRiSj6U+oKtEdgZfb+CvR4CPKPpkzWUhPhMpCTEQA0lIcJFSkIEaCgJ0VakJEQD
+DSUhaomUhKAG8KgIKAnhbKQkRE2FkhBFjZSEeEzJhwKPjJSEOKVQEiLVSEmILQolITYbKQmRKlIS
+4qSGkhCbRUpCbNJQEmK2SEmIWRpKQgwXKQmRqKEkRA+RkhDdNZSEGKZQEsJDQ0mIbmyNSoaSEM2V
+v6KhOyonSkosY2tUUpDf0D2/oXt+Q/d/fEP3fMMkF4ZJTn3e251Y9O1yB4DlDjn5eL+OBA6JiIsL
+i89FIMcCACwBwG7aUzfOwmQ6sDqzzCuztCsvL8LqOj/1QlkNODdsnDB6BgDMyHUL9g5hQ4aEDc2F
+ATcv04D7pkmbL448fSgYXvvoT1RzFZgnmDhPn+955Xtef7TnRb24/24Pk2lrwm8gUtcMRBKyzZ/I
+qanxRyUNhR6ljZnwTx+yOhiUOqQoeWYmfeI8fb7Jmm+y5pus+Sbr39lk/echek425g+zq/cNKggQ
+VDAneyx7HqFDxLDe3WLjBuaiLvae+UT7SpRHmACaxfOOiUsirmqWfn5HWTZgreY7txXi8s4/GVeU
+miyudFltXPn+O+X7El8bv0+fqPzwRGNcvTtKWZPaybh2YXtl3UZXY67yCOXPaT7kEQ5uEn9PHiHl
+ZVfxJwe15qe0pmJucwrnlM8VDDtd0EcoucopdK2vsJxCE+5vk1OgqaLLxPypov/yqaK5fVjb/MYv
+/H0fJtP2hb9G1lz68BZgQV/zgoU0d9QoU+PASubphQmgofTCMbZGjGTphc/vMFsXsZKlF9xWUHqh
+80+UXig1ma0ROxl9/x2lF0p8zdaIoyy98ETD1oinLL2Q2omtEVeRIrb+oemF8uc0H00vHNwk/pXp
+BcRxmtuqZmvE8z8j7YDYnz3tgDrgD007dK2vmKUdmnD5aYcc58iSD5c/Rzbfh8v34f5lc2TzbZdc
+2S45eYdPXn+9S3EAUBxye2a7Q5QmKjI2Jr/ILL/ILN8hzC8yyy8yyy8yyy8yyy8yy8/Y5Xt7+d5e
+vreXX2T2v1lkVji7ixc76FMDpwpm/MNLznhek9qkexmoTbqgfn2yi+598mDBtDJIDXM6CxaTGqst
+E90Eq8Gd9NZhHQ02HV31BVq7GGwbltbbfjXIUJB7qS+45o5gbzyvL6ScMxQ+90LtoN9hKLLtnLro
+qjSBm7tDXWzydqH48G/VJaIWCCW7jVGX8hstlG4api5TWxQcq47Sly3YRihn2Vtfrm5JrtzjNrry
+V2toK5ysrqu411ZbaUMBXeXFd7SqhKZ8lbFHtFWDy/PVBtxWVXd7x9do95OqZqnLvFOd1Sqn9290
+te2/VtVOv6Src1ejqrt7j65e6hSt88LFuvobY7QNRn+u478RtA0jtXyjIY20Lj6f8a6dS2rdnML5
+xo2ea5vYtuXdK5RQud+qxTd980zV7JA93/zCWZWHrqauxY5tqpayna7V/PkqdexdXetxW7VtAo/q
+PMPnar0arNV5e43Uej+5wvs4z1H5pO7j/RxGqPw2fse3ux+qar/gR53/mURtQNJSXYct3bWBoeN1
+wiwPbUePSF2nhErazhX8dEHBoA16W1eXY6v4v3ObeJucHgXsbP/CB8CWGACTKUIAa5NpiGhhx74W
+Zt/h2POSrBe2I+uFXYE9r4IS2qFzEHToGgQdhCBo0zkI2ngFQbfMptisPTZkNYDNuX2BfcY/vKZl
+P5Gb1X4+8CS1n/cymLefF2hm38kuOjLGBgvUhj5IDXjN6Sywd5/UmK1RMoG1oe+kB9aGntrJoJQC
+a0NP7WRQWin0PshAxthLPYXe7whkjJ3XkzF2zkDG2As1GWM7DGSMnVOTMUbtZFCqyRijdjIo3WSM
+UTsZlHIyxkYLZIyFqckYEwUyxkax90PJJ2OsN1sjApBR1kYHAIBIAACAaMCMsr22bI2oQEbZHS0Z
+ZU15MsqOsDWiBBllt1VklL3jySj7SUVG2WWejLLVbI3oQUbZ12yNKEJGmUZFRtkeHRllU7RklC3W
+kVEWoyWj7HMdGWWClowyLXt9RBoyyj5ja0QcMsrCeTLKnmvJKGvL1ohAjN6qxZNR9oytEY3IKDvL
+1ohKZJRtY2tEJzLK5qvIKLurI6Nsq5aMsqM6MsrmaskoW6sjo2ykloyyKzwZZXPY/0cUI6NshIqM
+su94MspCVWSU/agjoyxRS0bZUh0ZZd21ZJSN15FR5qElo4xOMiPikVHmx9aIfIy+pZPMv3sMQf74
+gTyOH8hxWIdtQbu/8JEJ2eEP/kTgzkLujGc5jzUYXMx+TquCAK0K5jq2HhsXG9M/N/OFawGAFQAU
+nvV9RHhy0eaG5HeN5G2Paqq2p/fjzrtrVed/uae6WDZVe3Fbe+7SG1tV+nwDd/nSFJUSVIN7OvuR
+9pmNwD3XB6teFOHklx0rqV4e2sq9tt+vfb3yrvzml5eqt1/GyhUPS6pKiYflylonrcrlR7nK2B6q
+qoW2y9VCLLXVrvbhajS5rq1xahvX5Hmy1j15GNf08mlV0zsWcvOUR1qPWJDxLH4ujV9Lk+nKAgCT
+6ZERrd9rCrVUceYb5rb/9Ic7YjKZTHhXkOKdYfRdI1aOgXcIKd4lFthx17I13i0W2Cmbyka54F1j
+AZ43tuz7ePdYgOfSFLbGu8gCKLMfaWmersDWeEdZAKUIx94P7yyjh7ayn+MdZnTlXfZzvNNI8W4z
+3XRYYrKLd53pJi2NhMG7T7qpB/s57gLTTSGW7Oe4G0w3NbnO1rgrTCc8T2Zr3B2mCy6fZv8fd4np
+gpRH7Oe4W5DRPeGP2TF71ia4EROE6bV9ih21BDhqmZMglDcXhPj+vb3CBsbGhzVoEzE0Oqx/LuZB
+zMp4XoGqEZvJi3bYStoJFeTF8+5IRyYXlslBcYTXL4dyb26HwNu0rty7lGbwPrkpZ1pZAWBOec5i
+4nuwHPaOsxpcTrIOKyvbCG+lAuo3si2fLtl+5SYX5PRSwTXrOXvjEqmQslgufG4POOg/l4tsWwxF
+V2k5bu7nUGzyZ1zx4RFQIiqcK9nNB0r5teVKN60NZWrX4hyrektlC9pz5SydpHKPa8rlrxaUKpy0
+kyv++ItUaf1dufLiY5IqoSJXZew6qWoXE1dtwFGo7naFq9FuLdQstY9zqiOD0/vLcm37OKid/qNc
+524Q1N29VK6XqpGcF46X62/sJDUYHSnz37hKOAwfB+HjEHwcgI/D73HwPQ69x4H3OOweB903O/iA
+a35hBxtuj4Ptcag9DrTHYfY4yB6H2OMAexxej4PrvZ8c4Hyck8AndQXn59AL/DZO5trdV0P7Bd/J
+/mdCpX/qdFybbKMZ/4SHbYHf9TCZtDNpnpoFG9qT1aXqB60Fl/mdD5MpHFkvEi/qVxXQNQgChKy5
+jXhZZPatsgDIi9Sxujfrwjqqe2vGAA4lkOreKsiU8bgjkdNSWDZ3WhyBIstDOYoshwBFlrtyFFlu
+BuTMNOXImakA7JpTnqPI8nugyPI7jpyZchI5M2VliixTTzWUZIosU081lGhyZqinGko2OTPUUw0l
+nJyZPUDOzOcyOTOLSXuv0nLkzHwO5Mx8xpEzEwHkzIRz5Mz4ADkzbTlyZmoDOTO1OHJmvCVyZuzJ
+ebGknmqIEOTEUE81RAqKLFNPNUQMcmKopxoiBykK6qmGCEJOzFEgJ+YKKYp21FMNEYWcGPYyDFnI
+iYkDRtNJ8SDKkBOzVCYnRiOREzNeJiemk0ROTKRMToyrRE7MOI6cmNISOTH9OIosv5TIifHlyIk5
+L5ETQ7PSEJ3IiaFZaYhSFFl+wJETswPIiaFZaYhaFFmmWWmIXuTE0Kw0RDFyYmhWGqIZOTHTZXJi
+ekvkxBzgyIlJAnJiVnDkxPQCcmImc+TEqIGcmO9kcmJCJcgftPs/NWj334fmOc3ePf79tYDQggCh
+BX+XkeYZgb1T8420nIy0mRLOJ8fZ5DiXHGeS4zzyXDeIPaiw+eK5bRCLM8NxXjjOCsc54TgjHOeD
+42xwnAueb6T9USKbm4fJtNgu30jLN9L+GiNtpkRGWh8NGWnDJDLSvDRkpHWTyEir+edM3aYsOEMr
++BMaGiOykZE2iMoAUueSceYQaCQjbSSQkdbQSEbabImMtA6afCPtX2Ok/Y+ieU5G2okyizS/baRV
++pSRhid7O4dFRwzKt9P+G8G0fDvtTw2m/UGSrb2fb6fl22n5wbSPBdPy7bR8O+0vCqb97dE8JztN
+d6Wi8bftNLOay6FDWcMVl//8teyDbIZmPOfmS/2hRECSUNLltVyq2i9y6RIX5TJWx+QyT3bLjtfW
+yWVTtXK5fdPk8jt3cRVWr+UqLljEVZoic5WTxnKq6DiuSmhfrqp/EFfNw5urXq8xV6OCE1ezsCPn
+ZOMpOz1zlWvdqCHXPl1arnPAVq676aVcb9kd2XnGebn+uCNyg/idMh++Rm7Y8xzXKG4T59JiB+fa
+aRbnVvFbrnGjRK7xuzGce/kZsvulMK7pmwS52S5PrvmFENljeW+5xY5mcssJreVW8yvI6n7V5Nbj
+mnJt2trInuHlOa8at2Rvz3dcW5vDsk+1y5zPg5ucn9WPnN/xFK7dtaVc+7Xfc/5H98gBX0/hOqxe
+LAfGxHDClM/ljl2/kDtFR8idm0TLQf4+cpcyHeTgerXl4Be8HFKqLRdytrjc7VktrvuWp3KP0/Zc
+qLYY13PTPa7XmCdc7xnHObH3aS5s1F25T6utXN+eR+XwynO5fnab5ciKshx5e7bc/12cHJUyXB5w
+KUgeuLKHHH1wMDdoUgs5ZnlnLrZ/ZVkzwY0b3NGDi+tXhhvSsBIX7/2KG1oMuIQGpeQE41UuscgL
+OdGwnxv+4Jw8QrecG3l8h5w0d688au238ujhy+QxX49htRIW1n/KtK0c63RsTCYEATa9wDrz6zXF
+wi7rK4rIIyPNA86cBYxpfiYGFhYZJC/MazKZTMjATKkHJFG5n8trmSn1ar8wigzNlLrVMaJPdjOK
+zM2Ue6qWUWRyptR37uIAAJDZmVJfsIhRZHqm1JPGMorMz5R6aF9GUQiYUvfwZhSFgSn1Ck6MolAA
+AKBgMPrMlVEUEKbUT5cmpX7AllEUFqbUl91hFIWGKfVxRxhF4QEAQAFiSr3nOVLqcZsYRWEipT6L
+URQqUuqJRN+NyVDqM9j/RyEjpZ7A1ihspNRD2BqFjpR6M0ZR+EipV2AUhZCUelP2/1AYSamTMYhC
+yZS5JxmDKJwAACigjD64ySgKKqPHUxhFgWVKfe33jKLgAgCg8DKlvpqMQRRiYNOkyBhEYWZKPTqC
+URRqYNOkfBhF4WbKvF5toi94RlHQGT1bnK1R4AEAUOiZUj9NxiAKP1Pqm+4xiiDAlPqM44wiGAAA
+ICAwpd5qK1sjMDClXnkuWyNAAAAgSDB6ezajCBYAAAgYTJlfCmIUgYMp84OD2f9HAGHKfHlntkYg
+AQBAMGHKvKMHowgqTJk3rMQoggsTpmLAKIIMo8arbI1gw6hhP1sj6DBlrlvO1gg+TJnP3csoghBT
+5sOXMYpglFkK9D8NSBkVSB7OfEM2rtytPt/AuWG9gIY8e0qKuuZEr72xtgCxtjkp6uw1eoEDomLC
+IsNyMXSue9aIwNuaj40I/M/MzyciCs+Ky823nMh1eU7bovGyT/XWks/LaZyfdTXJL20I1+66jZRV
+njNR7rClpRQYGiULs1RSR4/2cqcES6lzBWc5qMt1KehtUTnY7aAUfKMeF1LpGoQcKMJ1e38Aui8z
+cj3SV0DouJNcz92ToVf4Jq73woEgBhjksIkTpT7OG+S+vRrAfyuikDXszco6j4/fHAS3sMJfOK6w
+u/m4wtuavIwr/O1ofh69xGcEqMiBf0bJBXIrsPGErdkauRYAADmX0bQhpFCu20gfL7mYKJOX2FIi
+LzFKJi9RJZGX2J4USoKlRF6iMymULtcl8hKLkiJxO0je4o16pEgqXSNv8UARjrxEchdRCshLXAHk
+JZ4khbJ7MpCXSIocJYO8RINMXuJEibzEDWyNkvJv8xL/NyQ0J8/OdtqeLk9tAJ7a5La9uRAWEzYo
+NzXdlpkVzBIAfPk07DY7E6gBMJk2O0LGmUD86z0zJuPm5TVNJpMpamJNZnrn5rXZiUL2ieeIpWK2
+AMCWHBu6lzD/xJExUXHxQ2Mic3GMcGLG8wpHoxdLme1W/vntSTKLOvLbk+SmPYm1xe+1Nm0K/IGW
+K7DrU2d0Gb6UxO9bOGZ+H3/HmeepaW/njDO8XQl9siNP1pO8iAxKM4rNr5teQH5ThX9AU4VfV1Xk
+N1WA/KYKf0hThX8RnObUGsHRf/P+eXYA8+xyMm2Km5k2cWGDwuKi+uRmEndmX6ji02y+Tt5dp/3+
+k33qbSq/u2EyDam1srY2mY76AphMRz0An/vj1+MpeBtOG6AI2mMCxTgyBsbm5U3ZvB6br2nacp32
++5lu6FNvE8PU3Q2TPwR5fs8fkn1S7Y5lQT8WsAAoYJHTXcweQxH6x0bERCXmop1WoYzn3KeqUH4u
+c7nCz1NvrDaMLVchsxLFmuWiLK1YU41ZDz6eAMLnv073QF46fX34035Pqh7/bEan3liNFP/8X6fs
+/4CPYR/QOagJ26QyPXdf7m8B0D/HTSqdfZNi4+Kdw4Y6C3FRMX0jcuC8Ahk0OON5MQkAFsQMflu2
+fFtDOYuH6nKPHgjlr5xQVzhhECr+uFFdaf0GofLimWrVtBlClbHD1FW7nFLI2N6ikLE9RyFje7NI
+xvZskYzt4SIZ2z1EMrZbiGRsd1PI2G6uoHZtE5goeIZ7q70adBe8vZzUv2UM/68YwRbZsPNjXTJ+
+HwqbTDt5AJNppxeNtbYoyGDWIftzBgBCthY0eFlY5IUpyAGtzFpRIHMwk698WwMAADIJo49ouDcy
+C5l8NNwbmYZMvg0CmXwz2RoZiEy+YWoy+U4p5ibfFsXc5JujmJt8m0Vzk2+2aG7yDRfNTb4eornJ
+10I0N/m6KeYmX3Mlu8mHjEkmmLeaTLDuAplgTuo/wmTKN5H+OBPpbydsORkyL+yLps+zAZiXY1Sq
+1K/QvXdsv96dNWFRMXkZA/hNE5fVFibTwaD/7yzBT75Q1izBn5Ncav32LMHiv/pYsb1b942LyMWf
+o8rQ4IVnfT9BTi7aD5Lfheq3PRKE7emy+rz7T8L5e6WFi2XfGS5uG6q+9KahkD7/tfrypS2CEhSo
+fjqnvOGZzQj1c70kvCjSTP+yo6/w8tAV9Wv7B4bXq0rq39yrKrz9cq6+4uHvhUqJT/SVtR0NKpdf
+9FXGjheqFrqu9xXHCJ3GWOt/88i9laWlyaTX/KednD1s58y7gjPvBnn51NQoYYJMjRL6AaPvQvXU
+KEEQqFGCrKZGCT+xNd4RapTwjjVWwDtDjRIaCtQo4bWaGiVsEahRQiBb492iRgkj1NQoQRKoUUIz
+PTVK8GVrvIPUKOEB+328k8weuldVoEYJc/VMIxz+XmAaIfEJW+PdZR/O5Rc9aYTx7Od4pwEA8G4z
+JB1jrYfcNDrI011Ha9itJYVLbz54tCfeEiDeMtfhUsazwRHR/XPjVFTK6vzx2e3kol2Nye866LY9
+aqPenv4Zf959p/r8PTv1xbIP9Re3RfKX3lRTp8+/x1++tFKtBLXmn85x0D+zieaf6+PUL4rU073s
+2Ez98lAq/9pe0b9eVUD35l5J9dsvv9RVPLxAXSnxhq6y1lOvcrmkY1ZaoTO637xtlibTfkfK3low
+gAO7rCytK+Tl8xF7fnab2LOrkdizg47Ys42a2PMznthzJ7HpPTs1sedDPbFnJE/sWU1N7HmPJ/Zc
+qSb2bM0TezrQ6EKbaJ7YM05N7FlPR+zZTE3smcoTeyp6Ys8COmLPkmpizy+px9ThBWSwJN7QEXt6
+6ok9qSfUB4Ol0BldrtjxN+4rMqBrSwLNulXO2KstAdS5Z8ChEciBnaL6fooBrTP+ZX/OXLIjzxvp
+KWZ/32BhZWlhMu21zcRz9scyD9KicCa2txaCoHXXoAxd8MlZlB99P7zBI27O3Mb8qOeN9OZBz9/3
+/lm6plYdlyfPAeB5jrqmpNlti4kP6906LiImbEguSrsTMp6zdvhTeR/v+aE3hEVJVw8shsF6Xa9h
+B9YPa6He0BoMG0M0+k1VXYXNTZept1hFGraWO6vfen2+wLTTIZVwtEKM+mj0LcOpMtfUp1266E8n
+TFe/O+Ghfj/9tc509Jge4i7qLIYKeku1n86qSyO9taquzqZloLoAOOhsC+1U215+qLN7X0JdcM8J
+nf2lZ+pCKx/whXedVTtMMvBFvt2mLtp/A89NOK0v5juDL95vq75ErQS+ZNu5+lLFputK1xypL/0o
+XudYoKfe8USwruzNVvpy69115X8OVVeYVk5XcV1LdSXNW13lqSq1aowVXyXWUl216Ru+Wofr6upl
+L/E13EFf49Uevubjl3qnc4v5Wi/262vv3aWrc3a5vu6SRbp6WyfpnT8bq6s/e4C+QZ++Ol6aqG7Y
+xlvXSIxSu7Q4y7uq26vdCnnxjR2S1U0KnNa5Q1G1+xE7vumduvpm39/lmx920Ht8cZRvsfKhvuWA
+tXyrSSf06s4/6Vr336hv47Ja5+k7U+9V4mudd8MNau8nGp1PsRlqn4eWvO+jBLXfzli+3YkQdftk
+0Pmvb6YOOGjLdxA+0wcuWKsT2pzTd0ySdZ3qPld3Do3TBRVKU3dtcpPvuu6ePsRzgo4CKRZWFlkD
+/dButLTKqGr5sx62tibTrnEAJtOuHZBdSxXOjJidNlhwmd85bQDWSLQzgw63DP2VUbWXrbY1L9xM
+fl3Ft0iRq5EiZ7MoSNLVA0iRw5EilyNFTkeK3I4UOR4pcj1S5HykyP1IUQIYvT5fMLNSDqnYGiWC
+0ehb7PdRMpCidDCaMJ2tUUqQMklh/+GYHvCKu6gDAECpAQBAyQEAQOkBAEAJAgBAKQIAQEkCAEBp
+YvTyQ7ZGqQIAQMkCAEDpAgBACQMAQCkDAEBJAwBAaQMAQIkDAECpAwBAyQMAQOljYF0rga1RCgEA
+UBIBAFAaGX1EreVQKhk9Qa3lUDoBAFBCAQBQSgEAUFKZmlrXktSU5i2pqakq8qvHWFErxFhLUlNN
+qbUcSjHzq8teYmuUZkZf7WFrlGoAAJRsAACUbgAAlHAAAJRyAACUdAAAlHYAAJR4AACUegAAlHwA
+AJR+AABEAAAARAFgNZJnqRWiuj1bIyIAACAqAAAgMgAAIDowesSO/RxRAgAAkQIAANECAAARAwAA
+UQMAAJEDAADRAwAAEQQAAFGE+fEuq9ka0QQAABEFAABRhVIpGrZGdGH0oSV7PUQZAABEGgAARBsA
+AEQcAABEHQAARB4AAEQfAABEIAAARCEAAEQiAABEIwAARCQAAEQlAABEJkbX3WO/jwiVFe79p6BU
+9hj0kl0BE5JsAZJsc2v0dAqLihneu1NUwie7VmSvyNqe8Zwp7/kWb5T5+7YLC5MXS4u2v9Ls1TaX
+9gWOMx6Z9J4KGMIKGlMn9YdTnUXj6S6n4YzqmPGs21ZIg3XGc6XmwjllmvEC2MKFlFDjxfTZ0qVV
+siZ993Dp8uQ4jbKwh3QlKkhzdXQL6ZpfY831XpWlG7UdNTd9POBWcVfjbadKcPtxaeNdW4C7J14a
+f7l5Fe6d2W+8f3A/PJi20/gkdpn0tGEf46ui7aTXdgnw+mUk0OlMP6DTmXWBTmc6AJ3OfAh0OrOQ
+RKcz70t4nstO9bOE57jsYb1EpzO/keh05lCJTmdOBzqdGQ90OjMY6HSmO9DpzHLsHFZZu7dApzMd
+JXY688RriU5nXpTodGZRoNOZj4BOZ6YCnc7cDHQ684REpzM3SlldNDQSddHoJFEXDVeJailLS1RL
++VKiLhrnJeqi8QKo4OIcUBeNHUBdNL4F6qKReTpzvkSnM0dJ//bTmZlu8G+f6Pmj0eL/30d92T0A
+ljb8UAm2TUfJQwsu+/dZvVPG6R5PTCJm9lQnkLEAyAsymEwmU/h13QxmAFnQ4BFECRoAQzUaiBZI
+ETFowMk4Iw0ueW9ewxFWkH0fUYQZNJ1FIw0uOQ00uOSYkQaXbAUaXLLOSINL5rI1IgxSRBlGU0KN
+NLhktkSDS2QNDS4ZLtHgkjgNDS7pIdHgkiANDS5pIdHgksYaGlxSWaLBJY4aGlziATS4hMaKIjox
++rg0W99lzpjJhEhFg0uuAg0u2W+kwSX72RqRiwaTLGOvjwhGg0faSTR4JCHjRGskmJ9o9QPzE611
+wfxEqwMjWSdaqfYx60RrIQnMTrRS7WPmiVZEQch2ohXREMxOtFLtY9aJVqp9zDrROh3A7ERrPIDZ
+idZgMD/R6k55uA8nWssBZD/RavcWwOxEqyOjH060nngtgdmJ1osSmJ1oLQpgdqL1EYDZidZUALMT
+rZsBzE60npDA7ETrRgk+2nlEI5l3HukkmXceyTzRmgDmJ1pDwPxEazMwr1WuAGa1yh9qZDJPtCpg
+dqL1Q+eRzFrldMm8VlkvmdcqL5HMa5XzT7TCv/REa74GhN845Rr+9ZMfXAsBuBbKyeguaWZ0xwyM
+iuntFxMdEZ+LWPD4zBKLW1NigCzUBxqyUA0aslA3aMhCnaEhC1VnJAt1upEs1HgjWajBRrJQ3Y1k
+oZYzkoXaREMWalkNs1CvvNGQhXpJQxbqHg1ZqIs1ZKHuMpKFushIFupYI1mofY1koXob8/u8/aUW
+6u94WP1xXR6BXb8WNShMz7FSLVO8clmwPz57VRGyvbmZQiWfWWYKlXxmmSlU8pllplDJZ5aZojOa
+mynTjeZmSrzR3EwJNpqbKe5GczOlnNHcTGmiMTdTaEr3BzPlyhuNuZlySWNupuzRmJspizXmZsou
+o7mZsshobqaMNZqbKX2N5maKtxHyG6Tlmyn/PDPlfwkGc7IpVOcGbS9uB1DcLrfnqzpF9I3qF5G3
+mRmRyclF/ZTkd2rttkeu/Pb0eNV597X8+V9e8xfLXtFd3NZNdelNKT59frrq8qV5vBLkono626R7
+ZtNL9Vwfzr8oUkn7smM9/uWhA6rX9id1r1e+1L65V4B/++UobcXDX/OVEs9qK2vddCqXn7VVxkbx
+VQulaKuFcLpqVzWqGk0e62qcOqRq8vygzj15vKrp5Wt80ztFtc1TTDqP2CLavE1guMyKdq8pFnRS
+OiMn3Ci3tcK/mpkRmUy5dj8Wu8C7Q7l2V55y7fE0K8N9LeXcf3nNU679Ckum4F2jXHspnnLt6SrK
+tc/jKdfuoqKZGSYd5dp7qSjXHs5Trr2SlnLt9dga7y7l2k+y38e7TLn2Ajzl2kdpKdf+NU+59rNa
+yrW76SjX/rOWlEgUT7n2FLbGnWD0Ks1lwh1h9NQhtsadATYzYzxb4w5R8qAo+/+4Uwy0Y4toIc8z
+M3LasewBbatjXSx/e2aGuRxERsV86pxhgYx/eLllPHec8O6bCguT17Ga6s0RgcYtvK+y1buhMZmr
+o7D56cbCyo4ivIbNT39QXLNrWyFxb5ydcV/YL8r+jneNB9y/EQ82PGo8VGWdklLujuaw/VHx8Osj
+mp/urhWzdwhM7TuFO9U5Fk53ucadUV2Es24HuDTYDedKreDOKVo4/36ffEH/GVxM/06+tGqhlL57
+onx5siQpC6PkK1F9pKuj28vX/Lyk672c5Ru1a0o3fdpxt4p7wm2netztxzXgl5tG7t6ZVLh/8CT3
+qXKenLw4G5Pp2+s5tQnCcwgfbQ1kNhfok3nTj958ZGHcAAoPrjOrGsfNQIobwvKe3g3ZGjfGbN6y
+sTBb4yaZzVt+UJytccNYuDHOjuYph/1C85Q73mVr3ECkuIlIcSOR4mYixQ1l9PURtsaNzanhHG40
+hStjgcKV1zgKV14EClce4ChcuZvClKVWsDUyAc1Z3sdeF5mBwpXfyRSuXChRuHKiTOFKSaJwZZRM
+4co+EoUr28sUrvSSKFzpLFO4sqZE4cp2ZFcX96QwpVM9jsKVNYDCk0aOwpOpQOHJ/zJDMU3J8ODk
+wL3nT1kBnLLKCQ84MzwYEhs9ND4iL07H6TdjpXw/O9/P/uP9bKu/s5+NbJ/vZ+f72fl+dr6f/ef6
+2VZ/Fz97R1L3pN/2s7MfregUFdu7TVxYTN/Y/KMVv/Wp849W/PeOVmQ/aNwpdkhYXFRuGLZlhl/G
+CryWTJ/KbxnZSb213GFdcqWp6uRTGn57mVH67ZuX6na8XK/eOessv+vcdf3uRHvdnh2Oan33qbof
+v/1Wvbf5Pn7faIN+f4XnugOitfrA+zr8odZN9IeUdXzK2BnqnwIW6356MEF/PMDEH9fd16fWdtOd
+8gzXP21hxz9d9JyOZjTi1M9vLtK9LOGpx+MGr54OU79Obax7s89N/3ZnZ/7d6k7q9wvceNMUV7Xt
+0nY6u+qx2mozNuuqczO0LLhkTNA6VSnKOxlCtLXBpK6ta6ato6Tyded2paDTnjo6n2EFdT4Xx6h8
+Xy/R+e0MU2UcA8/lI/MInCV+tWLPbUymfQYA9jXjeALV3bNQB4fPoXBme6HMer5cDnb9sDPIpHEL
+1rBgEO4Q85BHdlJThfBh9n3cLUZPaShoVWYUY3LcOeYhv1zPfo47yDzkc9fZz3EnkeJuIsUdRYq7
+yjzo5vvY7+PuMg+6wnP2c9xlRt/XYT/H3WZUWcfWuOvMcw5YzH4fdx8pcgCjuvtsjZzAPGfPcLZG
+jmB00XPzgymNOBLym4voYEoJT332gynILUy4UxvTwZR9buznyDlIkXuQMg7Ca4orVTAvbUcVzNVj
+KUg2YzNbI1eZBcmMCWyNHMaoIYStkdMY1TVja+Q4AADkOrOg2p46jCIHMnpxDPs5ciKwytgwFWQ1
+JvgvcGT2INyW9W84V2sA/Je7BHfnMDwT4jckrE9EdC6q6OdnPHfEJn+rhgdq1j1qKm3pF67ZWp6D
+ZPvHxiN3v6KS0m/XGs+W7wZp0zZR6ah+iPFi+kbtpVWr5fTdM7WXJ38tU5isk0xhMleZwmQdOQqT
+uXB3bYtwd0+W4li4bP0LDqMbD6ad4wop67SFzx3jHPTTtEW2reOKrlqr4uZO44pNllXFhw/hSkTF
+qUp268KV8gtSlW7ahCtTu7HKsWpnuWxBR1U5Sze53GNXbfmrZeQKJ0trK/74Sq60/qW28uILsiqh
+rqrK2F1y1WAHVbUB57nqbg9VNdrt5GqWOqFyqrOQc3p/X1vbXuJqp/+srXO3D1d393ptvdTRsvPC
+b7T1N4pyg9FDtfw3beSGkdNVjYZUl1184lWunQvIbk7BqsaNbstNbN1V7hVsOPdb5VRN39zimh18
+q2p+4TDnoXPUttjxA9dy6mttq/lfcerYi9rW41bJbQJ3az3Dv5S9Gmi13l6D5LZFP9P6VA+UfV4u
+UvlZN5T90saq2l0vIbdfsFHrf2aAHJA0U9thi78cGDpMK8yqL3f06KbtlMDJnSs01wZ1eSwHva2o
+DXY7JQffaKYKqfSICzlQQdXtfSrXfdl7VY/0zVzoOEXVc/dsrlf4XlXvhcM5MSBdGzZxptzHWa/t
+GzlMDndYoo3w6SZH3B+njXRqLkce66eNsq0oR63x1Q64aZIHzopQRRsqcIMSfFQx695zscG1VZqp
+Cje4cSFVXOxebkip+6r4wGVcvKmgNsFdLyek/6JNdFwiJ+4+ph3+cpw8YuE67ci0fnLS6GnaUcm+
+8uheQ7Rj5tSRUa5werul9d/uYTJN98/q4jdVArvM59/K1O2CurZblKSfZvbyE/CfVxAIXfHodGYb
+GIsPNC+imL3nJook9d5sKlHvzXC2RvFkasb+MdVv3v3KvH7z27VUn1m+G9VnTttkXo+pH5JRj7lR
+SwHO1TIFOGdqKcD5tWwe0Owkmwc0XWXzgGZHzjyg6cJR/WURoidLcWYBzvUvuMwAJ9VfUhNqhAIK
+YBzjKIAxTUsBjHUcBTDWqiiAQT06ESIogEE9OhEqKIDRhaMARpCKAhhNOApgNFZRAKMzNfEu6Kii
+AAZNDkEooXrGMmyNkEIBjFcyBTBeUo5n8QWZAhh1VWST7mJrhBoKYJynZt5uDynH024nRwGMEyoK
+YCxka4QgCmBIbI1QRAEMmqeOkEQBjNEyBTC+0VIAQ5QpgDFUSwGMNjIFMKarKIBRXaYARjxbI2xR
+ACNYRQGM2zIFMNzZGmGM0VvlKOf05hZHAYy3KgpgHGZrhDYKYPzAUQDjtZYCGF9xFMC4qKUAxiqZ
+Ahi7tRTA+FKmAIZWSwGMQWyNEAis92ogWyMUAgAgHDKaNpatERYpgLFRSwGMATIFMGZqKYDhL1MA
+Y5iWAhj1ZQpgdGNrhE0KYDTXUgDjMVsjhFIA45RMAYxmKgpgPKIerAcqqCiAkcpRAOO9igIYmzkK
+YCgqCmDM5iiAsVdFAYzhHAUw0rUUwJhJzbyd9VoKYAyTKYCxREsBjG5sjVBMAYzmMgUw+mkpgFFR
+pgCGr5YCGCaZAhgR7P0QpimA4aOiAMZ7jgIYtVUUwFDYGqGbAhh7OQpg3FdRAGMZWyOUUwBDz14f
+IZ0CGEvYGqGdAhjjZApgkLwizFMAg+QV4Z4CGEMoR/nvhH17oXOQu/DxAEiyIS5lXkGAeQVzm1Bh
+tl3cJ4enZb8qA4A1hT9G+P7Vh/R9xcHq33bCrUymo8nmJ8pNrNt6Hs/rf/ioFPMY4ftvOK+Ptzh3
+sY5c3ebsPof+eJ2n3S0BulvmhS+jwiJjPzGBI7uRo/2jz6CnXpyqP1XmNJ9/Dv2/fw69W5kUvtvU
+4/ruL77ne8Tq9KGrInU945P0vSb76XoH9dKLUXV1Ya5qfR8/B13fij3V4bUe6sKfWuv7FS/M97tU
+RR356AHff5eVOuqEgR+w4IZ64PoNfHTSIfWgaTP4mNBV6tgROp3G40v14O7TdXE1VuiHNIvXxdtM
+1g8tF6wbemOgPuG1u27YgQB94vlyuuHfNdCP2NeEHzm+mD5paVl+VEx99ejP3vBjOnDqj59CtbG2
+tCpg+zd5FGAnVX/zvGrWqdVPnlfNdmA1L/KZcar+wN/pVD3KOp2uP83nn67PP12ff7qeTtcj7jI6
+9ThbI/4yJyVWx9aIw8xJiU9ia8RjAADEZAAAxGUAAMRm5qT4Ef8jRgMAIE4z+pQSLIjXjF6qwn6O
+uA0AgNgNAID4DQCAGM6clPXE/4jlAACI5wAAiOkAAIjrAACI7cxJ6U78jxgPAIA4DwCAWA8AgHjP
+6I2BbI24DwCA2A8AgPgPAIA6gDkp+5qw90NdAACA+oA5KTH12fuhXgAAQN0An+xS8M/XDxlNnJgt
+WC+gaf2G9Rs3aORaL8A14xkzD4sUKTfzSkGAKwVz2+kPzcPY3l6xg6JiInOTCSuWkW8pLwHA9stt
+xNd27Q2vV+xTm1Y8MoDnyBSLiakGi9djfK00RsHqkrWvjXBSsNkz2deW3yTYanemFORmCU3f7FSa
+1b7CuihmzfCwsbLBVAuAybRb/HUDRH2yRTH8DpRhN8srWwNEZ97Vhc9tk8EPf3+GDmXNBvFz0EHi
+9pT8xM+DF34mvDxHpgA7KJzK1vj5AADwMzJ6ydqXKoNOsjV+XqoM2sTW+LmpMmiWQAGcnQrDwj/h
+HmT1rIo70nzjTxYAP+XY1LeYGTPE9hbChkbnhhFCs+rl4zbluV6+e0H+j6yXZynNXde0teERX3vG
+l6o6dx10dQc20tbTb+ad++1X1dfO5hu0Xa7ipeF8w4oB2kZdVTqXAgNUru5BvOvLH1WNVZX5xj/3
+12avu282td6HuvsW+6fxLTsYta2mvefV9U9qW094ybcp81Dl2XEv7/nihMo7oLPOW7mv9Sk2gffZ
+OpNSp7OHqdqdH6drL32j9d/eTxcgDtV2mOerC/SbrhI+j+A7qppqO/X14TtDeW2QZ20+6I67Krha
+IT74cDlViOV9PmTlW1W3qz/z3Selq3qki7rQ/npVHqrJf8/D0mQ65f//OjMQan5mIG7TX3JmoHtB
+/u98ZuBDOnTXtYx06CO2Rk6leDKpdORYAADkWgAA5FwAAOReAADkYDLNhrM1cjIAAHIzAAByNAAA
+cjWjL3+keLKqMploP/fXQg5nGJDj4SNnGJD7mWnWwUjx5Gnv2RolAQAApYGZZmUono5SwegLiqej
+dDCqUDwdpYTRrTPN07uzh1E8+fw4tkbJAQBA6QEAQAkCAEApAgBASQIAQGkCAECJAgBAqQIAQMkC
+AEDpYvQOxdNRyhg9TPF0lDZGV1I8HaUOAAAlj+LJIns/lEDI25mOP0EKs4eDAkrb1VlmA7DMJtcp
+6L6xcRFD+gwfMjQm/BMuZ/ZZMgkZz7n5kr9jiYAYdUnX6vpS1V5p8ycK/7EThSNarJX/K1OFLa0K
+fKyB9f/7YWv36YedyfRLf+JwsGbTOu2ynhMLWxSmr9l/miUFDaEuz1MnAosMGzmnMcMf5WgaM+zv
+yJz8gBg1kxTX6uTkV3ulhfwxw/ljhv/mY4YRNeDfMm74H4VUuZo/vPlKv7RvbQG+tc11tqd/lCYu
+tu/AXJw1XJi9pvXXw3OWRLyXl44rAMu8FXnZ1OVS9gObLxokyC9rzYBXRUNkaiJIg3XepnXlqIlg
+U46aCJbnqIngO46aCJaVqYngGxlbaNl+5SZj66yCa9Zz1ERwsUxNBD+XqYmglqMmgp9x1EQwnKMm
+gm05aiJYi2NNBAvac9REsKbMmgietJOpieBdmZoIVuSoiaCJoyaCVzhqIriPoyaCl2VqIvijTE0E
+l8rURHC8TE0EI2VqIjiOoyaC/ThqIviJgcwHH3CsiWAuBzJ7PznA+TgngU/qCs7PoRf4bZzMtbuv
+hr9kIHNklBTuMEOO8GkvRdxPkCOdnKXIYyFylG1RKWpNM3nAzUfSwFlduWhDERiU0JSLWWeE2ODy
+nGbqSRjs9o6Li90EQ0pd5uIDZ0H8+zdygvsGKSH9kpzoOENK3L1HHv4yQRqxcLE8Mi1EShr9uTwq
+uZk0uleEPGZOBenDaQdrmz/Rmv69D5Pp2xm/PqT566OaHzukCQAWYEFf8yKFeZkThdKJFCUUKUop
+o1OXSzmdwEXJZY5wrRlADfRCZPMGelnzpaiBXlfOvIFeU868gR6p56wGeqSesxrolZXBrIHeGxmy
+NdBDBIBsDfQQCcCsgR6p56wGeqSesxroaTkwa6D3GQdmDfTCOTBroEfqOauBHqnnDw30CpJ6zmqg
+V1M2a6B30k4GswZ6pJ6zGuhV5Mwb6Jk48wZ6VzjzBnr7OPMGepdlMGug96Ns3kBvqWzeQG+8bN5A
+L1IGswZ64zjzBnr9OPMGenkc9n3wAWfWQO8PHvaNSAjsxFwSMJq6gsw5h17A6MbJZNbdVwP8k4Z9
+R0ZJVHBG5jSiMaP3yZxGVGb0GJnTiM6MriFzGlGaCs66clRwVgSo4IzMaURtKjgjeUX0BnZijuQV
+UZwKzsicRjRn9D3JK6I6o+mXyJxznMHWiPBUcJYgUcEZySuiPRWckbwi6lPBGZnT/w70Z+P1Aj5e
+X7bwSlKFBQUBFuSYqHHIbtlFxQ8My0Wl8tSM51XZAI7J5bRkttXk3if7qEwr63Awp7bKYlJhzjKx
+kMpqcC3ZOsxJa9PRXi7QuqDWlr8n237lqS3IHZcLrtmjsjfq5P/3AYC6Jblyj9voyl+toa1wsrqu
+4l5bbaUNBXSVF9/RqhKa8lXGHtFWDS7PVxtwW1Xd7R1fo91PqpqlLvNOdVarnN6/0dW2/1pVO/2S
+rs5djaru7j26eqlTtM4LF+vqb4zRNhj9uY7/RtA2jNTyjYY00rr4fMa7di6pdXMK5xs3eq5tYtuW
+d69QQuV+qxbf9M0zVbND9nzzC2dVHrqauhY7tqlayna6VvPnq9Sxd3Wtx23Vtgk8qvMMn6v1arBW
+5+01Uuv95Arv4zxH5ZO6j/dzGKHy2/gd3+5+qKr9gh91/mcStQFJS3UdtnTXBoaO1wmzPLQdPSJ1
+nRIqaTtX8NMFBYM26G1dXbDbVW3wDV8+pJJJFXKgDt/t/RVV9+8K8z3S96lCxz3ge+7+TtUr3MD3
+XjhRJQbc04VNXKrt43xc1zdyvDbcQaeL8InURtyfrot08tNGHovXRdnW1UatCdYNuOWgHThrCB9t
+qKMalNCFj9EVVsUGN+E1Ux+oBjcuy8fFGlRDSr3h4wM3qOJNZXQJ7se1CemvdImOOm3i7gu64S+n
+a0cs3KUbmRavTRq9SDcqOVg7utdY3Zg57loLSysL65weNn9pRhXg5AoAVt1JVZ925rWfYzXAaj9x
+EuxYDVT0D8io/syo/MQq0G4436W9F37pnCGnAB9oLsZqyxnPq5pMJlOrve9OIB010WUuWVzltOYW
+Fw3TZ0KH10rSrCh87E0nkWZFISSLqZZMFpOTFgAABRIAAIWSLCbSrCicZDGRZkUhJYuJNOt/vUS/
+bkmyoB63oaqXqzW0ZDlVZ2sUfgAABACynO5oyXJqSlUvY4+wNQICWU63VWQ5vaOql3Y/qchyusyT
+5bSarREoyHL6mq0RMMhyopQMAgdZTlO0ZDkt1pHlFKMly+lzHVlOgpYsJy1PlhOlZBBYgJXql9SS
+5RROKZVGzymlYtuWrRFsyHKqxZPl9IytEXjIcjqrIsuppo4sp21sjUBEltN8FVlOd3VkOW3VkuV0
+VEeW01wtWU5UlYIgRZbTFUqpOM9RkeW0jyfLaYSKLKfveLKcQlVkOf1IVS9nErVkOS3VkeXUXUuW
+03gdWU4eWrKcqEoFwY0sJ6pSQZAjy4mqVBDsyHLypZRKJROlWA7U4clyusLWCIBkOe1TkeVEVSoI
+hmQ5UZUKgiJZTveoCmbiUi1ZTsd1ZDmN15LlRFUqCJZkOVGVCoImWU5UpYLgSZYTVakgiJLlNISq
+YAx1VGQ5dWFrBFWynKhKBcGVWU6NqUoFQZYsJ6pSQbBl1FRGR5bTcSrZT3+lI8tJx9YIwGQ5TdeS
+5URVWgjGZDlRlRaCMllOVKWVAc7WljY5PQrY2f6Fj0x0Huv0h2P0rwZ22+P3m7Zu/3GbavCzJ55l
+CyIm5br4Jb53m7C4+P4R0RGDhucPN8t5uBlnfuPaxfaPGZILtfkw43lZNvf55z6Fv1128+3C5NFT
+Fm0/k6TdMuniYneT/5KZZUOXjnvrvyz+Teh34en+y3uVubjCS++/ssWri6uqL/H/vuKFiz9Yj/P/
+4d2ui6uv9/Nfc2nRxbXHPju6btfYi7o14UfXL18YuuGrtkc3TpBCNw2qdXRzvz6hWwLsj25t6xWa
+XO/e0W01a4ZuL13Qf0fRNhd3PPvFf+fD6hd3nT7mv3thQPTunwus3LN5naM+uaH7j8Mfrtw7p4T7
+vm4nVu4f9sz9QNONKw+GFUs+VHbmyhT1k+TDlhtq/aQ6nfzT1Rm1jsLWZNr/mympk7zSTrWz8j3d
+v2bamdo3fM/62qWlFTzke87pbtq5O6t8LxSzDblw8kDKxfT4kEurBvmm7w4OuTw50FdZ6B5yJaqh
+79XR5UKu+ZXwvd7zbciNWs98b/o4pt0qXizlds3XabcfPUm5W+Bi2t0Tp1N+ubk77d76rSn3D2rT
+HuzZ7ftw3a4Q4yKt76Opi0Iej/nM90ns2JCnvcN9nwX2DXneqq3viwbeIS9r9Ul5VdQp5LWdV8rr
+l55pb27XTHmbViPtXYpdyvtk2zTTirspMPtOmsXEoymWw46kWWnu+FqLt0NshCO+BdQ/hdjya3zt
+VKtDCnJTfe3h6xB742DfQoompPC5r1Ic9J1CimyLTSm6KiaNm9sxpdhkIa34cJeUElGN0kp2K5VS
+yq9kWmn3Fyllaj1Pc6xa0resXVpaOYvnvuUePQspfyXNt8KJsyEVf9zuW2n9tpDKixf4qob+/LTK
+2D7hVbusf1ptgOZUdbdvntZoJ16vWWroU6c6ba47vZ8eX9u++vXa6fHxde4WuF53d3B8vdRq4c4L
+3ePrb7QJbzC6XDw//VZ4w8jGTxvFHQ538XF86trph3C3mq+fNm70VXiTAhefupdfdd395u6nTd98
+eb3ZQe3T5hcGXfdYtyu+xY7A6y2nLopvNb/hdXXs2PjW4zqEtwnsG+8Zzod7NfCO9/YqHu79ZNhT
+H+f6131Suz31c+Cu+21s/rTdvcfX2y8Iifc/UzQ8IKlZfIctDr6Boe4hwsyHvh09yoV0GnrCt3P5
+tyFBXTb6Br1JDwl2m+kbfP11Wkil9Skh+y+mdXv/TUr3ZbvTeqQPTQkdp03rubtrSq/wz9J6L2ya
+IgYsCgmbGOzbx3lsSN9Id99wh74hET7lfCPue4dE1nzrG3nMKSSqQLpv1JqCIQNu6n0HzqqRFv3z
+xZRBCbZpMet2p8R2uZOmmapNGex2JC0u9rOUIaXWpMUHhqfEv/8pJMF9rG9C+uqQRMe+vom7vw4Z
+/tLbd8RCTcjINCffpNGdQkYlF/Qd3cs1ZMzsX3xz5TNbW5k9Ctj86ht/zMP2T3lV84fJdJx5/xeX
+WdBzO5PpYhp7npLxvDD7yplMd29blKSfItB28AqCDp2DoIMQBB264torCP5Tk+UFN1lguIXrPaSI
+n0gRQ6k15OgpNDnmTBJSxFOkiKksUDyzbCgFit+yNeIrUsRYpIizSBFrmZfS4hVbI+YiRdxFitjL
+6LtdbI0YjBRxGCliMVLEY6SIyUgRl5EiNiNFfEaKGI0UcRopYjVSxGukiNlIEbdZpVjpguz9EL8Z
+ffYLWyOOI0UsR4p4zujPBVYiRVxHitiOFPEdKWI8UsR5pIj1SBHvkSLmI0XcR4rYjxTxHynqAEav
+zmBr1AXmOvlmCk3o8UpDiroBKeoHpKgjkKKeQIq6AinqC0bvrGJr1BuMnjyQQifC40PoRPggXzoR
+HhxCJ8IDfanlpXsItbxs6EsnxMuF0AnxEmyN+gUp6hg6Ie6YRifEi7HXR33D6KMnbI16h9ETp1Po
+hPjuNDohvjWFTohr2Rp1EVLUR0hRJyFFvYQUdRNN8BnL1qijkKKeQoq6ihIU3iGUoOiTQgkKpxBK
+UHixNeovSlDUTKEERY00SlDYpZC7bZtGhbt3UwCv2XfSKEFxNIUSFEfSqHD3ji8AAOo5SlAc8aUE
+xU8h5G6vYWvUe+RuT2Vr1H+MGgf7krutCSF3+6sUcrc7hZC7HZtC7nZMGrnbHVPI3RbSyN12SSF3
+u1EaudulUsjdLsnWqDMBAFBvkrtdkr0f6k8AANShjD56xt4PdSmwln5nQyhBsd2XEhTbQsjNXsDW
+qF/Jze4TTgmK9U/JzdacIjf7m6fkZovXyc0e+pTc7DZsjfqX3OzqbI16mNzsAtfJzQ6OJze7Wji5
+2e7x5GbbhJObXY6tUUeTm934KdWdHA4nN9vxKSUofmBr1NvkZn/F1qi/KUGxir0f6nFys7+8TgkK
+7VNyswexNep1crMDr1OCYlE8udkNr5ObPTae3OwO4eRm940nN5sPJzfbO57c7OLh5GYPe0pudv3r
+5GZ3e0puNned3OzmbI02AbnZIfHkZhcNJze7WTy52Q6+5Ga7s/1BW4Hc7HJsjTYDAADaDZSg2MjW
+aD+Qmz2TrdGOIDd7PeMXtCfIzf4mhRIUu9PIzR6aQm62No3c7K4p5GZ/lkZudtMUcrMXhZCbHexL
+bvbYEHKz3X3Jze4bQm52OV9ys73ZGm0RcrOd2BptEnKzC4ZQgkLvS252DfZ+aKOQm22bRgmK3WyN
+9gq52doUSlCQvKLtQm72mjRys8PZGu0YcrPHstdHe4bc7L5sjXYNudnevuRmk7yijUNuNskr2jrk
+Zruydb7N88fbPPYdOge5NHPhO3zce0878PSqTxEAnyJ5cELbR8XHD8lFWboFAFgCgN03TVyPWphM
+B1wzPeVM7zgvL2IymUyffKEst7rRaX7XDACYkRe32n9o36iwXPwxlpmF9hIATL95SGdhaTIdNACw
+r9mGwHvm/gNaZi/ej5pYktUi5Oa1sz7zSvua97YAwBbI9ZmT+N5B/WMHheVpG6c9bSJamEwYO/z/
+beMnXyjrIxWqW2fWb29jcfOPFBwV0zciJv53bORtdrPnsJs9J/vNbv/7N/J2xkb+5mtnfWpP76+m
+//ZGmgXThkX1i+/tOTQu7tMfPHvlS5msQQOzHDMHDZgNBkiwyHEwALYzulOmqFmD/wfTjsDDdQ9k
+4+A1rPrk473YbUymebf/opb+Zcxb+s9y/FhL/0+2zE+w+H+1zM/s+IS36VMt7KnD0xEg+/0B+zvw
+9mUW8PzJtzCrif3wmOmDeEsA3jK3U5qDIiKH9o2KDIvW5AYybTOlYpl/ey/VvItKldD5QtURu5Vq
+HqMEr55TFK8zKYYP+f+1HuatdLMPw8vLmzG327+9FwAAvikzv0PnU5PUEbvZGv8AAAD8IxjN7R+S
+dfNWnZ323VsAeAu5LSgI6j80Ojft/3tnfpL50rC3Ts9GKLVu9DTUPh2q1DnQylB393CxXmpbwXlh
+D7H+xlpCg9EtRP4be6FhZDelUdw9wcWnueLa6bjg5lRRadxIJzQpYFLcyx81uN+8ojR9s9bQ7OA+
+pfkF2eCxThFb7IgztJy6V2w1P8igjl0mth43WGgTOEH0DO8seDXoL3p7uQneT+YpPs4dDT6pSYqf
+g4vBb2Mvpd39Uob2C0aK/mcaCQFJPcUOW0oKgaGtRGHmc6GjRxWx09A0oXMFKzGoy3Yh6M0NMdht
+gRB8w0IJqZRsCNl/Ten2fp6h+7IDSo/0JEPouBVKz929DL3CJyu9F6oNYsByMWxiT6GP8ySxb2Qr
+IdxhgBjhU0WIuO8vRjpZCZHH6otRBW4IUWs4ccDNQ8LAWfWU6J+vGQYlFFFi1h0wxHYxKpqpKwyD
+3U4qcbGTDUNKbVLiAwca4t+fEBPcJwkJ6RvFRMcBQuLumeLwl/7CiIXDxJFp9YWk0d3EUcmcMLpX
+c3HM7MfCn17usmeceRsapge9cq1xemdnd2QUYKcURijATin0NAA7pRDK1sg45C0OF8lbbCuQt9hD
+JG+xlkDeYguRkrL2AnmL3RTyFu8J5C02V8hbPC5QUraiQt6iTiBv0aSQt3jUQN7iFYW8xbUG8hb3
+KeQtygbyFhWRvMU4A3mLe0XyFoMM5C0uE8lbHCyQtzhBJG+xM4lvg/4ieYtuAnmL8xTyFqlHMjIt
+eYsuBvIWeymUlC1lIG9xpEjeYiOBvMWeInmLJQXyFluJ5C0+F8hbrCKSt5gmUFLWSiRvcbtA3uIN
+kbzFBQIlZS0U8haTDeQtXlPIW5xnIG/xgELeYpKBvMUVCnmLvQzkLU5WyFtUG8hbXC6St9hTIG9x
+kkjeYiuBvMUBInmLVQTyFv1FSspaCeQt1hfJW7whkLfIieQtHhLIW6ynkLd4zUDeYhGFvMUDBvIW
+jQp5iysM5C2eVMhbnGwgb3GTQt7iQLZGoSNvcRJ7fRQ+8hYHsDUKIXmL/gJ5i8NE8hbrC+QtdhPJ
+W+QE8habi+Qt/pcFlJltrT/uV0GxqfPSbQDSbXKtVfsPjQmPiOvdJuxTOVGbjH943cl4TscIkr/R
+LNr+i6idWD9l8bz16iUzChiXdlmlXjahp/G7Woni8n6tjCsKd1BWtq1iXHmPV76vcFjz/ekA8Ye3
+P2hWb24grrn4lWbtzGLiup2xGt1oZ2X9go6aDZHtxI1JLppNPvXEzaGlNFuciohbm7/QJBcwimwO
+1c2T4g7rZ0Y2h+r6WeOudQZl9/5txj1TNyj6ZfONP8bOUPaOG2XcF5ig7A/vbTzQIEQ5GJCkOVS0
+mZLi3EtzZJJRQ3m8N0rq0OvGU+pLyukuB41nVHuUs24rjWmwWDlX6gvjeZfp4vn3KzQXS8SLF9Mn
+ay6t+kxM3z1Qc3lyuKgsDNBciWorXh3dQHPNr5Z4vVcxzY3a9uJNH2fjreI1ldtORY13Sj9W7hZ4
+ZLz7/JTyy81U470zW5T7BzcbH+xJFR+uO6kxLtosPpq6SfN4zGzxSewszdPew8VngYma5616iC8a
+dNe8rDVMeVXUQ/Parpvy+mWI8c3t5srbtGbGdykVlffJFYymFSYFZr83Wky8olgOU4xWmveitfhO
+YyMoYgH1ZY0tv1e0U/2oKcgtE+1hqcbeOEEspIzXFD63RHHQR2qKbBunFF31uZGb208pNjnCWHy4
+r1IiysdYslsdpZRfbWPppoWVMrULGR2r1hbL2t03lrMsJJZ7bK8pf+W+WOHEPU3FH38WK60/rqm8
+eL2oSgBjlbHfiFW7XDVWG7BOqe6231ij3TSlZqnlRqc6QxSn9/s0te27KLXTv9PUudtEqbt7oqZe
+apDovDBKU39jY7HB6PYa/htHsWFkpLFR3GvRxcfP6NrpoujmVNfYuNFusYmtg9G9/HnF/eZDI2sm
+cfCEsfmFhYrHugeaFjskpeVUg6bV/D6KOnaDpvW4MWKbwBkaz/Aw0atBgsbby1P0frLS6OPcW/FJ
+/cLo59Ba8dsYbWx3v5rSfsFkjf8ZtRiQNFDTYUtVMTA0QCPMshY7ejTQdBp6U+xcoZgmqEuKGPTm
+iSbY7Xsx+EZRY0ilg0rI/kfGbu9XKt2XpRp7pH+hhI7bbOy5O1rpFT7b2HthB0UM2KQJmzhQ7OM8
+S9M3MkAMd0jURPg0ECPud9dEOhUTI495aKIKPBGj1lTSDLh5Whw4q5kx+udHyqCECsaYdalKbJf3
+Rs3UzcpgN8UYFztbGVJqrzE+cLgS//6yJsF9lpiQ/qMm0TFRTNy9VDP8ZXdxxMLxmpFpHmLS6EjN
+qORK4uhefpoxc0DM42gK679hZW7uHibT5hn/OULAgjqwcP7ZOmd4dw0CbyGbT2KRF9BjXecfJN4j
+p+4bDSXjfmHdRBAE6dQGdZVHMGTJuC6r2BpBkSXjaiWy30dwZLmLwh1YuwQESUbv8WyNYMno6QD2
++wiaLBm3uQFbI3iyZNzMYmyNIIoUgRQpgilLxkW2Yz9HUGXJOJ96bI3gypJxTkXYGkEWKQKt2Vy5
+myfZGkHXbK7c9bNsjQCMFEGYJeGmbmBrBGOWhIudwdYIyiwJF5jA1gjOSBGgkSJII0WgRopgzZzl
+SUaNebLtDfs5gjdzltWXFHKWDxrJWd6jkLO80kjO8mKFnOUv2BqBndH3K9jrIcAzmj5ZQ8m2z0RK
+tg3UULItXKRkW4CGkm1tRUq2NdBQsq2WSO2Yi2moHbO9SMk2ZyMl22oq5JwXZWtUDJRse8TWqCDI
+WU81krO+RSFnfbORkm2pIjnrJzWUbNssUrJtk4aSbbNFSrbN0lCybbhIybZEDSXbeoiUbOuuoWTb
+MIWSbR4aSrZ1Y2tUMpRsa65Qsq2ZkZJtFRVKtlUwUrKNzGxUPpRsIzMblRAl296LlGx7p6FkG5nZ
+qJQo2UZmNionSraRmY1KilEjmdmorCjZtkShZFukhpJt4xRKtn1upGRbP4WSbRFGSrb5KpRs8zFS
+sq2OQsm22myNig1YbWshIyXbaouUbLvP1qjoGH1sr6Fk232Rkm33NJRs+1mkZNtxDSXb1rM1KkFK
+tn0jUrLtqpGSbesUSrbtN1KybZpCybblRkq2DWFrVJKUbOvC1qgsKdnWRCH3aaKG3KcgkdynKA25
+T41Fcp/aa8h9chTJfYo0kvv0WiT3yc9I7tNFkdynukZyn3azNSpZcp/Okxt186ERsncvOnjCSO7T
+QoXcJ5qLhgqY3Ceai4aKmNwnmouGCpncJ5qLhoqZ3KcEDblPnuRGPVlpJPepN7lRqV8YyX1qTW7U
+xmgjuU/VFHKfJmvIfVKL5D4N1JD7VFUk9ymArVGhk/vUQEPu002R3KdiGnKfUkRyn55oyH36ntyo
+G0WN5D7R4DBU+uQ+rVTIfUo1kvv0hULu02YjuU/RCrlPs43kPnVQyH3apCH3aaBI7tMsDblPASK5
+T4kacp8akBt1v7uG3Kdi5EYd89CQ+/SE3Kg1lTTkPp0WyX1qZiT36ZFC7lMFI7lPqQq5TySvaFiQ
++0TyigYGuU97jeQ+DSc36j3JKxocjKaTvKLhwehuklc0QMh9InlFQ4TcJ5JXNEjIffJj63zDJJeG
+ib135yA374+7ee1OLPp2uQPAcoec3Lzs04+CogYMDYsJy59Dkj+HJH8OSf4ckvw5JPlzSPLnkOTP
+IcmfQ/K/N4fEzKyLjYuNiY/Nj9znR+7zI/f5kfv8yH1+5D4/cp8fuc+P3OdH7vMj9/mR+/zI/T8g
+ch8bFx8bHZbHAxMl8ZxD9B9wYOLjL5R1dMB4qm293z4wkf0MSHBYTN/YoQkRcbkw4Habe627tIt2
+lJKz9zlLTSiqOtXajjvd5ZHqjOoud9YtVZUGR7lzpTarzilrufPvT2gv6GXu14kJZeEw7ZUojXx1
+dDctJSiaaylBEaKiBEUzFSUoKqgoQfFeRQkKRYWRyYfr3mqNg3dwj6amax+P+VF+EqvXPu29VH4W
+uET7vNV4+UWDcdqXtZZwr4r20762G8e9fvm56s3tftzbtAjVuxTfXPdns1P9wvqz2cMx7R/Sn+1/
+NDHi/SRV5eP8BeeTulnl5xDN+W2crWp3vwP3T0+MfPw8xv/Kw2SaMoOCaBbZgmiZ4TP/gIxQWbbG
+QHly73Znd+96tUjIcO92aem0TSk5p2ZtiBzMDWltx5Eb8khFbshdjtyQVBW5IUfplE2pzWyNqEJu
+yAkt5U1kLjd5E0QcckM0GXmUblrzPEpzrXkeJURlnkdppjLPo1RQmedR3qvM8yiKKjOPQm7IWy2d
+9tnBkRuSriU35EeZ3BC9ltyQpTK5IUu05IaMl8kNGaclN2QJR25IPy25IeM4ckM+V5Eb0o8jNyRC
+RW6I75/SHA+REbI1x0OEhL9Tc7z8vNFfmjdCDUFuyBccuSGbVeSGRHPkhsymvNH9Dlx+3uh/O2/0
+79WKOaWPpiwePcy/EIB/oZxM8SLZTfGouMiomPxeeM9zfdq7a/+o+Ij+sXFDInLR1alnxvMa5L0c
+0S3aUU2rNRTWLV5yUjoyuZaOvBhXFQ3ZWMK9PDBOzrfg/wgLPtRIM/exd+Yf36LYzmQaG079L1GE
+x2pQhKdKKMJTJbO8cFn2vCJ+hSrIkN0yOl926xoE3QRce2UljQM+2ggzL5zG2hRP+GIBWcJHdGQJ
+V2OWGnIeUuQ+soxr6cwtY1eV+eAIsvSQK/MtonyL6O9jEf2TpJsNDmDa6ELTmkM7FADoUCAnbZS9
+hUzXqJiYKE1E5CcQwjbjH17bM56zSNp8izfK/H3bhYXJi6VF219p9mqbS/sCxxmPTHpPpb1hBY2p
+k/rDqc6i8XSX03BGdcx41m0rpME647lSc+GcMo1KflNCjRfTZ0uXVsma9N3DpcuT4zTKwh7Slagg
+zdXRLaRrfo0113tVlm7UdtTc9PGAW8VdjbedKsHtx6WNd20B7p54afzl5lW4d2a/8f7B/fBg2k7j
+k9hl0tOGfYyviraTaOBUJNDAKT+ggVN1gQZOOQANnHoINHCqkEQDp+5LOHLGTvWzhKNm7GG9RAOn
+vpFo4NRQiQZOTQcaOBUPNHAqGGjglDvQwKlybFRMWbu3QAOnHCU2cOrEa4kGTl2UaOBUUaCBU4+A
+Bk6lAg2c2gw0cOqERAOnNko0cGqmRAOnhkk0cKqbRAOnEoAGToUADZxqBjRwqgIb0eJ+8z3QwCmF
+jWTxWPdWooFT6RINnNJLNHBqiUQDp8ZJ3l4dNd5PtoKP8yCjT+pc8HMINPptHAnt7jc0tl8wW/I/
+00ETkDRc6rCF1wSG9pCEWcU1HT1aSJ2GPtV0rlBZCupyRhP01kIKdkvWBN+oBCGVThlDDqDEbjF2
+X3YVeqTPMYaO2w89d48w9gpfziRTDNgrhU0crunjvEzqG9lDE+4wQYrwaaGJuN9finSqrIk81k6K
+srXQRK2pJw24eU0zcJYfRP9sMg5KqAsx664YY4MdQDN1n3Gw20OIi/3OOKTUCYgPnGiMf39fSnBf
+pklI/1lKdJygSdy9Xhr+sr9mxMJvpJFp7TRJo4dKo5LraUb36iqNmVNEk2Fs58JPsCnwxz7+//7B
+snvmXUDY88LsK/ep7iCeXYPAM9NXsMisOssLMqCuD7+um4EUEYLRfdsFsiUWS1Q08YrZCogYVBQw
+jqqNJ703rzYOK2iknpj9gXraiEaKsp0GirIdo2pkt61UjQzrjBRlm8vWiDBm1ckpoRnVybMlirLJ
+GoqyDZcoyhanoShbD4mibEEairK1kCjK1lhDUbbKEkXZHDUUZfMAirK5GinKVgkoylaakvzMs8Ce
+mC+NFGW7CpTs32+kKNt+oCjbTiNF0Zax10cEoyhZO8l86FYkmA/d8gPzoVt1wXzolgOA2dCthwBm
+Q7cKSWA2dOu+BNmGbiEKQrahW4iGYDZ06xsJzIZuDZXAbOjWdACzoVvxYD50KxjAbOiWO4DZ0K1y
+ANmHbtm9BTAbuuUoQfahWydeS2A2dOuiBGZDt4oCmA3degRgNnQrFcBs6NZmALOhWyckMBu6tVEC
+s6FbMyUwG7o1TAKzoVvdJDAbupUAYDZ0KwTAbOhWMwCzoVu0rx+Gbt2kfc0auqUAZB+6tY72NWvo
+Fu1r1tAt2tesoVu0r1lDt8ZJZBN2pKT9k61ANuEgStqnzgWyCQMpab9xJJBN2NBINuFsiWzCDhqy
+CYdLZBPyGrIJe0hkExbXkE3YQqJk/VMN2YSVJbIJz2jIJrSQyCZMpqT9jUpANuEpStofYEumYcgm
+vApkE84xkk24nxye3SOMZBMuh0ybkKJkeyWKkg3XUJRsmURRsh4aipJNkChK1oKS9vf7SxQlq0xJ
++2PtJIqSWWgoSlZPoijZNQ1FyfyAkvUmI0XJ6gJFya4YKUpG8ooajJL1JK+oyShKdgIoSjaRrVGr
+UZRsGSXt00leUcNRlIzkFTUdRclIXlHjUZSM5BU1H0XJukoUJcvXgEiAumZ5fjxiFv71kx9cCwG4
+FsptNr5b2MCh8WHxuVCrUzOeV6LRXFnBnvfJXXjTSncVzGnCW0wqp7JMLMtbDW6stQ5z09l0dNQW
+aF1GZ8u/1tp+1VFXkLuoLbjmGG9v3K0tpOzSFT53QeWgX6Qrsm2XquiqnTw3d5Gq2OSFfPHhY1Ul
+oiS+ZLe+qlJ+ffjSTb1VZWp78Y5Vw7RlC9bky1l6avNHc/0DR3NZFLD5Cx8Ax8ey4S8aOhRh8SvH
+eKqEjvFYDZT1D/i1I/zxgVw59MfPfhJOznheyXwcV5M9OcWxmKDhtZLiMChwlHGkOAwKHtlSjbVk
+S7npKONIcRgURLKlKA6DAkm2FMVhUDDJlqI4DAoo2VIXVGRL0VgfFFaypXbyZEvRiSYUXLKl6EQT
+CjDZUn1VZEv14cmW8laRLeXFky0VpqX4Wk2ebCkaE5Y/jit/HBfkj+P6L4/jsvyLhyUSIo+y+INw
+2WxSYs4juEr2LrjLoiCARcHcNpvrFhEdHTtsYExUv4hclPfMynheclL6cSWzZ7B2zjRZe3K8tOSL
+2zSzfsBP8ndu+6TlnW9xK0p9J610OcytfL8XsvcWzuwp/OuZ9HaqSzKFCPfI+TPp/30z6c18jr9N
+QYHJhGmXT/Va/sQc4twXzc3KXjSHkvWxFtUoZYyeHM/WKG1mM+gH/MTWKHlIUfqQogQyi8vlMFuj
+JObU8jp7i2vIxQx5lFjzcBbNpM6fIZ8/Qx7yZ8j/o2fI//PQOqeZ8X7O0ScrFASoUPA3k8Ex8WFx
+fePRvvIMGxKRm9GmxTOeF35f6ajU7nwBVfupPxk7rFqgCvQ8JofYN5e6LyvK9dhWUh36VUWp5yxv
+vlffA9A7Uesh+m8VwkI+8+hTb67Q1z3cI9xuPYwxmUxsS7Lflw85bo0E1gDzdGBHz515Hury7lC3
+YcPMG2GRl7+YOfaVjkpMqs8XIIdq6k+MXfETMCn2PCYz6bNvLpHUFSWp21ZSzaTuq4rs+/jJmNT1
+JenETwgAgJ+SSV3IZ2yNn5ZJnXs4W+OnZlz5//7k9h51efeWzu5sy2+OW1B/swXAZos8bLlXWELU
+kFzcQLvMG3hrgRqe+1zm3vv8AO3Ol+Tbz36t7rDqez7Q84WefSDAD2RllfkifTT4/9oKYEfP6WM0
+gbq8W1420C77BuLfgBT/DrahPj9Q1uF8SZ5t6OzXatrQ73na0Bf6Dzf8d/x9eJubtHSmMosly9y2
+1rYAqJ3jbS77q9s8dFBsTLxXl7iEqOhcDVKwzjxHdWpngpTuKoq3PCyljL//A4tM0Wb8vQ15yMtr
+sozqzgRmauFrs3vqQSoqp/ew96jbkG/p3JAgpl6txYP3AsDeHKsfS5rfiICwvoOHhsVF5XQPbLNF
+7fB5EVZzcjpRWrCzAWgnbhLPFu4Pb/Z+BW83jIZ3h7rB+wSdZFrRHCB4umQxsSJYtloHVpqmkrVD
+BbARyks2z90l2wbvJNsz5aSCRS9LBTe/lewf/igVWlQWCqelg8PoN1AkWQ9Fe10Cbs4SKNZyDxRP
+HAclKi2GkiH9oOT7z6G0uy+UsQoExyrhkuO1hlDOoq1UbkVfqfyVWlKFid5SxR/tpUqRTlLl2RtA
+1W20VEU6LlVtMx+qRf0C1auOghp+x6CmZW9wqr0OnJ5ugVr3r0i1T82BOsf2SXU3jYB6a76TnGeE
+Qv2vJkoN4lsCPyhKatizu9QoeAK4tPCQXBv3B7eKlaTGpdtB43cgNXleD9wvXpWarrgjNdu5X2q+
+p47k8d0VaLGosNRy/D5oNfqBpI74Dlr3MkhtvCeCZ8sNklf9pZJ3pRlSW5v24GO7HnxeLAHfW9+A
+39lx0O7QUAiY7QsdJjaFwGF1QNB0kTqKbaVOQhOps7qWFMSXlbqo7KXgom+kYNM9qevDS1LI5eNS
+t5/3SN336KQeyRchdOUx6DlnN/SatA7BEMT+0xAEoY/vEAQ/CK/VBSIc20K/YoOlSIs+UuSjzlL/
+K15S1Ak3acCPNaWB68tI0YvtpEHyKylGuivFai5ImgG2MLjbS4jzuwNDmp6H+NpHYGjZnZBQcA0k
+vFoIw+5MhcRzEgw/PBhGbOsDIzd8JSUtGS2Nmh4rjf5MlMbEdZRQ31vYWP1tHtllCyHeomDmc7qy
+voOS1xqPibb2pjlpFhYWFnkRHRTtJJvSDC5RhJCiGFHnik1M9FGckKJIIUWxQoqixeAVxQuvFc3p
+1YOnS1RUUJEtUdzwQpEDAECxIy+sPFuj+LE/rsE7omeo+ABFkdHN5K2hSAIAoFjihaKJF4onXiii
+eKGY4oWiiheKK1PyiePIC6tE3hqKLqPvyVsrTXMJmRjjhaLM6LWGgBeKNKMr+jKKog0AgOJNXpg9
+eWGR5K2hqOOF4g4AgCIPAIBijxeKPl4o/nghBOCFMIAXQgGjT7cwipAAAICwgBdCAwAAwgNeCBEA
+AAgTeCFUAAAgXOCFkAEAgLABAIDQgRfCBwAAQgheCCMAAAgljL4D8sae1yMv7OJVtkZoAQBAeAEA
+QIgBAECYwQuhBgAA4QYvhBwAAIQdvBB6AAAQfvBCCAIAQBgCAEAoAgBAOMILIYnRF7SvCE14ITzh
+hRCFF8IUXghVeCFc4YWQxbwukfYRoQsAAOELAAAhDAAAYYyxcdE3RE33GEVIAwBAWAMAQGgDAEB4
+Y/ZfMkUZEObwQqjDC+Euw/5jFGEvw/4jE8N3CGTYf4wiDOKFUIgXwiEAAEIio486M4rQCACA8AgA
+gBDJvK71ZRhFqAQAQLgEAEDIBABA2AQAQOjEC+ETL4RQvBBG8UIoxQvhFC+EVEZfLWQUoRUvhFe8
+EGLxQpjFC6EWAADhlnld02PJ6/pMJFPinwe99vgd54Z8a3Y+vyGP/la9AFckDVyYVTTZ8yvplB3A
+KbucrKJiv7aKhg2JjcmFCWeV8a/wVVUjqd35qmryISwzfx4kZlqzZMc2ZnZsXl6YVb2pGmU4SFXV
+WY7Kb7wJGsluLZ3d2F3oFq1yOgQAh377ZEy2u9A3YGhceGwOf23mNkkZz4vcWHUHCjq+Uwqu/k6x
+f/idWGjhW6Vw2o+Kw+h0pUjyUqVoL73CzRmvFGu5RCmeGKmUqDROKRnip5R8308p7V5XKX3rc9Gx
+iq/oeChCLGdRRyy3wkcsf6WwWGFibbGi/oFYKbKQWFlrEFVt74tVpA1iVb6gUi3quFK96C9KjfoW
+Yk3LNoqTwzXR6Wp1pdb9A2LtvQWUOsdWiHV/qCbWWzNZdP7SRqz/1UCxwYBbIj8oQGzY7rDYKDhK
+canzg+jauL3iZv+V2Li0s9L4ySqlyfOiinvql0rT04+UZhsHKc33FBE9vglUWiw0ii2HNFRajT4p
+qkM7iK17bRLbePCiZ8tZoleF4qJ3pUTR+81Tse377qLPi/GKb7qH6Hc2Umm3u5LYfquf4r8QxIDZ
+dZUOo66KgcMcFKHfe6WjWEfs1FZROqsLi0E19ypdKj8QgwssU4JNBrHrzQlKyOUNYreD/ZXue2aI
+PdaNF0NX6pSeUyPFXpOmK71j/USxf7wSFlhX7OMbrPRt4CCG13JXIoo8FPsV6yL2e1lIiXzUROx/
+9r4SdaKsOGDrz8pA3RsxevZ6ZZB8SYwZ9o0Sq9kjakSdOLjbBSVOPV0c0nSXEq+KF4eWXaQkQLCY
+8GqsMkxxFxPP9VWG68uJI7Z5KyNXNVaSlvQRR012VEZ/5iWO6f9asfovx28yGXOLwcIaYKUeCtJX
+FIwO3XHWbXfMi1lYQF64mlWYriKwRu5mdPV3ChlJ31GbpIVvFTKSflTISEpXyEhaqpCRpFfISBqv
+kJFE7ZRQCshIonZKKA1kJFE7JZQKRm99LpKR5Ev0UIRIRlIdoit8RDKSCotkJFE7JZQaMpKonRJK
+DwAAShAZSRsYRUkiI+k4oyhRAAAoVWQktWFrlC5Gr1ZXyEg6wNYoaWQkrWBrlDgykiYzipJHRhK1
+3UEJJCOJ2u6gJJKRFMVeByWSjKT2bI2SSUaSM1ujhJKRVJRRlFQAAJRWAACUWDKSirD/h5ILAIDS
+CwCAEkxG0km2RkkmI2kToyjRZCRR2x2UbDKSqO0OSjgAAEo5GUm0ryjtZCTRvqLUAwCg5AMAoPST
+0UT7iihARpMDWyMakNFE+4qoQEYT7SuiAzOaKtO+IkowaqJ9RbQgo4n2FVGDjKYZbI3oQUaTjn0f
+UYSMpulsjWhCRlM8WyOqkNEUzNaILmQ0ubM1ogwZTV2IviykkNHUhK0RdchoKsvWiD7MaNK9YWtE
+ITKaLrE1ohEZTXvYGlGJjKYL7PuITmQ07WJrRCkymhaxNaIVGU1j2RpRi4ymvmyN6EVGkzdbI4qR
+0dSHfR/RjIwmL7b+30I1e3zm3LARfrdeQNP6br82h77fZO932BbgsG1OhsCvzCEhLHrQJ/v7ZK/r
+6pzxnHs+Zw08v7lI97KEp/7loVT+1dNh6tepjXVv9rnp3+7szL9b3Un9foEbb5riqrZd2k5nVz1W
+W4bfqXMs0FPveCJYV/ZmK3259e668j+HqitMK6eruK6lupLmra7yVJVaNcaKrxJrqa7a9A1frcN1
+dfWyl/ga7qCv8WoPX/PxS73TucV8rRf79bX37tLVObtcX3fJIl29rZP0zp+N1dWfPUDfoE9fHS9N
+VDds461rJEapXVqc5V3V7dVuhbz4xg7J6iYFTuvcoaja/Ygd3/ROXX2z7+/yzQ876D2+OMq3WPlQ
+33LAWr7VpBN6deefdK37b9S3cVmt8/Sdqfcq8bXOu+EGtfcTjc6n2Ay1z0NL3vdRgtpvZyzf7kSI
+un0y6PzXN1MHHLTlOwif6QMXrNUJbc7pOybJuk51n6s7h8bpggqlqbs2ucl3XXdPH+I5QYeWXhYf
+WKPt/v+v+7POUHDmR6yvKZjlOG0Au8zvZP6U7Epn3hWceRdw5jOYIDMmaWEBeWENFiies4YCxjcX
+sVI/ZBNGD6WyEj9kF6TIMkiRbZAi6yBF9kHKWAivKa5qAABkJfbG1WO1AADIUgAAyFaMnqBSJWQv
+pjTXu7M1shlTmtPKsTWyG1OamrdsjWwHAICsx5RmrCVbIwsypdnhOlsjKzKl6Q7s9ZElmfJ8/JKt
+kTWZ0nyxX09Kk0qVkE0BAJBVmdLcOomtkWWZ0pw9gK2RdQEAkH0BAJCFAQCQjSmycJZK69Tt2RpZ
+GgAA2ZopywKn2e8jezN6xI5K6+7UZa+PrM6U5mEHtkaWZ0pz5UO2RtZnSnPSCbZGEQAAQDFgStNl
+NVujODClWeJrtkaxoPyuhq1RPBh9aMleD8WEKc2dsWyN4gIAgCIDAIBiAwCAosOUpvAZe30UIQAA
+FCOmNJNktkZxYkwXGsfWKFZMOTa5yf4/ihdTkp4TdJkO1f+AmNl7OPMuLQnI0+utKfyFDcAXNnnw
+6DrFxvePiAvL5RR6/GfnYOlM/qZF5qc5bSBnHP/KvLwSAAC+2gcP9qOvmP0zTutf+uAyAFj223Ps
+sz5j59ih8f17C7HRuUlpLMh4XkICAN2mDbfXd5wYsiHxRv+NnjfETSGH+m+udkjc4r6q/1arVWKy
+45f9k+8dULa9HNR/+9EVyo60wP47r91Wdq19fnv3vp+UPV+n3dYvXa38GLP99t7Pv1b2dVhwe39f
+jXKg/ujbB/2/Eg8VEW+n1IsVU16M6n/y/Dx1vqP873WUWZr5E48C1jb/1QfA0jQAgHUpFtYAi5PJ
+3MTnWwwWRfA5kBnK+kcy27NDd/bMK+g3LIKPyh4q8NZb/VciRRlEinKIFGURKcojUpRJpCiXSFE2
+kaJ8IkUZZfTeAVYJh7KKFOUVKcosUpRbpCi7SFF+kaIMI0U5RoqyjBTlGSnKNFKUa6Qo20hRvpGi
+jCNFOWf0xSj2fijv+eGD/PBBfvjg3xc++Kdj/W8GHfiW7snjCwKMz0vxW+fhscPyZKneWh9iZlcC
+1OEz64fyZqmy+pv1If9hqZq/IiZYXFo6Z3zEZeNOfQcA30EePmJQXGx0dC6Krbwynhdu4+ADbdra
+yJ7h5TmvGrdkb893XFubw7JPtcucz4ObnJ/Vj5zf8RSu3bWlXPu133P+R/fIAV9P4TqsXiwHxsRw
+wpTP5Y5dv5A7RUfInZtEy0H+PnKXMh3k4Hq15eAXvBxSqi0Xcra43O1ZLa77lqdyj9P2XKi2GNdz
+0z2u15gnXO8Zxzmx92kubNRduU+rrVzfnkfl8MpzuYgWa+V+dpvlyIqyHHl7ttz/XZwclTJcHnAp
+SB64soccfXAwN2hSCzlmeWcutn9lWTPBjRvc0YOL61eGG9KwEhfv/YobWgy4hAal5ATjVS6xyAs5
+0bCfG/7gnDxCt5wbeXyHnDR3rzxq7bfy6OHL5DFfj5FxY/6AwNyHLFoFlYX1h6gFZObSGkFdnmdc
+noNV89G9YlrAwQcYbWuTUfdM9be4dwAAuH+UcT/M1riPjD64ySjuJ6PHUxjFfQUAwL0FAMD9BQDA
+PWbaYDXV3+JeAwDgfjNt0PULRnHfmTZoEs0o7j/TBmU6MIp8wOgLnlHkB0bPFmdr5AsAAOQNpg1O
+U7088gjTBpvuMYq8wrTBjOOMIs8AACDfMG3QaitbI/8wbVB5LlsjHwEAIC8xLVBRJnp7NqPIVwAA
+yFtMG1wKYhR5jGmDg4Op2eWkFmyN/Ma0Qf/KbI18x7RBRw9Gkf+YNmhYiVHkQ6YNigGjyI+MGq+y
+NfIlo4b9bI38ybSBbjlbI58ybTB3L6PIr0wbDF/GKPJtJqj81byLcMW35D3q8o1aOjfCGmW3+nyD
+hixr7vYBqrcvsO7+hTXAF9Y54div4sPBsUPiYwfmAmFtMrPad0ImS84BTbn6m8dDxBhvPrMS9MMn
+yioDzV4Ampe3YDNeQiZThU4A1cHj2zE+G0OVwrl8W7NU+l2HlpOuAcA1+M1jcuz2NPCPjYkcHhEW
+12d4REwuev9NzXhenFVZFi4jzj993rjg3Fr522Wb5YXbZHnRuNlySsHB8tFb7bljq8rIx5d4cj9P
+d+UMn9XgTsSV5k5smQUnQ3ZLJxcHcql9y8un2leTTzc9oTodeUs+vXIInKm9iTtbrxyXVnAWd87y
+R/ncnUSuRIAXV9LltVyq2i9y6RIX5TJWx+QyT3bLjtfWyWVTtXK5fdPk8jt3cRVWr+UqLljEVZoi
+c5WTxnKq6DiuSmhfrqp/EFfNw5urXq8xV6OCE1ezsCPnZOMpOz1zlWvdqCHXPl1arnPAVq676aVc
+b9kd2XnGebn+uCNyg/idMh++Rm7Y8xzXKG4T59JiB+faaRbnVvFbrnGjRK7xuzGce/kZsvulMK7p
+mwS52S5PrvmFENljeW+5xY5mcssJreVW8yvI6n7V5NbjmnL5ypGUIztS8eFhgyFNqwLsq7Wt3V//
+ACg8KQuninBQ0Px5Hd7CIfNr1vdZMxCs+PH0xn8BGVU/mc1A2CIvAoWIELsk7CVSFCxGT59njZ1Q
+wJCikCFFQUOKwsb8+IKDGUWhQ4qChxSFDykKIFIUQqQoiIxumcUyHSiQjC4OZN9HwUSKwokUBZTR
+yFu0XjmEGmvV3kTt6+uVYxSFFikKLqN3Eqm9eYAXoyjEAAAoyAAAKMzAaizpDAUKNbAay3WMonAD
+AKCAAwCgkLOdWr2WURR2AAAUeAAAFHoAABR8AAAUfgAABAAAAAQBAAAEAgAABAMAAAQEAAAEBUaf
+uTKK4AAAgAABAIAgAazG8iWjCBbAaizPM4qgAazGciejCB7AaixpvDCCCGVCdmScdJvFKAIKsJNu
+dL8QWICddKMTVwgwwE660YkrBBpgJ93oxBUCDrCTbnTiCoEH2Em3CowiAAE76UaaJt/iy7f4Mi2+
+fw0Y23t6Bzk3xN/KMC9/ZVy6TpvFv7YDeJ1jLWZBAGg9JCqsQevwiJi8uO3nw9yDM931OnUy3XX8
+w39PAABfLXsA4D9f0SwAMKfUL7/8dgCg0IfPFj0oLH54Dn9TpvZyy3jOfV925MXNSx/ypZxGaUs7
+buDK2K1XlXl1Q3a8c11b9kJZrtzRKG35nQn6Cqsj9RUXhOgrTfHTV05qpldF19VXCa2gr+rvoK/W
+/L2+et2H+hrlFX3NQif0Ttbv1E5PH6hrXb8v1E6cKdQ+ZVDX2f+juu6mDep6y5aqnWfMUNcfN17d
+ID5BzYdHqhv2nK5vFDdI79IiXu/aKVDvVjFY37hRQ33jd+569/Id1O6XyumbvuHVzXa+1Te/UFzt
+sbysusX2p+qW49+oW807o1ZHXFK3/vyxPkI5qGL3NTMOZhaTKmCbQ8DKwgLAtzoAs9Kt0WK3sAMI
+EqFw5tfM71gUzv47tGluGTZ94wyOzfn0xn/sAZsDWnbkRZaBWPqQZ5rWaZSWaVrHDdQ22249tfd5
+dYM07Z3r7Oe4R0zTHo3SkqZN0JOmjdSTpg3Rk6b105OmbaYnTUu5edxD0rSUm8e9ZJq2LuXmcU+Z
+pi1EuXncW0afPmAU9xgAAPeZ0VMG9n3cb9K4lKPHfSeNSzl63H/SuJSjRz4gjTtdTxp3kJ40brye
+NG6gnjRusJ40bkOi79z1pHE7UO3BpXJ60rg8WyPPkMYtriaNW5ZR5B+mcce/YRT5CNiphkuMIj8x
+TaIcVH2Q178BX5k5jW0n6H64YQVwwyondLD/gA6DBoXlBH0FMmhIxvNiW5aeMRSIG67YFv5BsKve
+RLS7n26wtxmg2P9cXCh066FS+PxQg8PhamKR6T8Ziq6yEbm41YYKa06LFRcVNFSa/k6s/JkiqOIu
+i1X67BWqdvpRrNY+3VA9fKdYo67eULNJrOhUaImhVqEhSq2r7YTa13eLdY70M9TdEaHU+8HX4Dzf
+R6mfuNnQYFy4yId8pzQMbys2ar1XdPGqJbpWWSa6VbcXG1tMEJuM+EZscneJ4n41SWmaukdstreX
+0nzjYtFj6XSxxTefiy0/jxdbzQkU1X2DxdYxz5U2/nGKp0dfxatekOLduoHStnBjxadKMcXnnqPi
+Z19f9DvyWml3lxPb/3BR8T/8WAyY8UrssOqUGBh/Qey0sZfQ2XWRGNRpk9Kl5FgxuNEsJfjdt0pI
+8UQl5NIYpdvj7kr3XWFKj5MeSui3nkrPDZWUXqNqKL3nNxfFnrZK2MiKYp8yJqVvd5NoXhzyqYcV
+PUjHW3zsYfkf37GxAahCBUC8hTVqWyiY+ZW+U4cHBzadkI0m9Ca9QxyWF/ZgmdKlZwzsh3E0VxJZ
+BVitFGVakGUYE9oMoMzkz8XZz5F9AACQhQAAkI0AAJCVgPXgoMwcshTDszU0BxNZi+HZ9HeUOfxM
+Ya+HbMbwrM9etkZ2Y3jWnt4f2Y7hWV09WyP7AQAgCzIcK0RzWpEVAQCQHdktPNKP/RzZkuHYD75s
+jezJcCxxM1sjmwIAIKsCACC7AgAgywIAINsyHKtC83CRfQEAkIUBAJCNGb1LGVhkZ4ZjqZRxQrZm
+OLZxMVsjezMc+4YysMjmDMfmBDKK7A4snvac/T9kewAAZH0AAGR/AAAUAQAAFAMAABQFRu9RxglF
+gtEjrylzeJdjaxQPAAAUEQAAFBNgh7FPMYriAgCAIgMAgGIDAICiwzyFkmMpU9hoFmUK333LKIoS
+o5fGMIoiBQCAYsU8hZMejKJ4AQCgiAEAoJgxT2F+c/a6KG4AAChyAAAodgAAKHrwH7VZfxvxMzP+
+4qeXbrfCBmCFTe6Mv5iw8OFxOQiwfQZtnfG85PdlKy7YvHSKvpRTeb60Y6C2jF0HXZlX03jHO7K6
+7Pn9unJHS6nL76wqVlhdQqy4wFqs9OUzsfLIm6Jq4FmxSo8UsWr7bWK15t+L1evOF2uUnyLWLDRK
+dLJeqTg9navUuj7HWDtRbax9aqRSZ3+0UndTT6Xesg6K84xWSv1xvNIgvorChxdXGvZsKTaKKyu6
+tFCJrh3fiG4VLcXGDS+Jjd9eF93LvVLcLx4Um76+oDTbuVJsfn6X4vHdfqXF9kVKy/HLlVbzxirq
+iElK688Xim28ByiefSXRq4a/4u3ZR2xrU1/xqeYl+jxoL/pZ1RT9jjuL7a7Zie3XFhX9jzYyBnxl
+0mQqddYk06zKztYuV8V4tnY2NgDLwwEyc5r7FQs7zHJCYfxqUTjz+5nPLajbA5eV/azbsBHUbegK
+dRu6ZHZ9+G0b8j+2kmzIigvIhpyiJxuSWjTi1pIN2UFHNuQ0nmxIWQ0AgFtNNmQpNdmQNGMZt51s
+SGtGcfsZ5o6kGcvIBgxze9CMZWQHsiFpxjKyBdmQU0SyIUcR5lrTjGVkE7IhqW0zsgvZkCMVsiFp
+1jKyDtmQNGsZWYhsSF4hG7KKQjZkccLeni0Je+Mou4+sxbC3I2X3kcUAAJDNGH17nVFkN0YvHmRr
+ZDuyIVeyNbIfsJOx+xWyISm7j6xINiRl95ElyYZcSFUb3qT7kD0pauNP2OtJ2X1kVYraUHYfWZai
+NjWJHncWKWpjR9i7tqhIUZtG7H4hK2e3Uf+m7Ix9JRq1dG7YiIHbleYLay6zBlhmnTvbdXB82NBc
+CIRLxnPu+7IzlM1L36lLOx7nyal9onW881hX9oKTqtzRYbryO8cZKqweaqi4oJ+h0pSuhspJvgZV
+dFNDldA6hqr+5Q3VPAobqtd9Z6hR/oGhZqHLBiebQoLT07dCretvxNqJS8Xap9KFOvt/Fupu0gv1
+lq0XnGcsEeqP+0ZoED9d5MM/Fxv2XKs0ipusuLSQFddOAxW3inFK40YBSuN3QYp7+QGi+6XGStM3
+/mKzXY5K8wv1RY/lbmKLHZzYckIZsdW8x6I64pXYelwRhW0qdjKytvz4nn4sGmNjA9CA2pyqsxqf
+ZLodFoWzvkPPLeyy/yY5H64ZDkjjvCHShw0gRJqhECKR14ibYe7NPtESEj2mCvoLTipComE6QqJx
+ZP2tJisRN4u82a4G8mbJGsONI2+2joG82fIGihsXJuuv7jsDIdEDsv4KXTZQ3LiQQEj0ViAkIoTA
+DSYkShcIiX4WCIn0AiHReoGQaIlASPSNQEhEVhkyACHRWoWQaLJCSCQr5M0OVAiJyCpDxiBvlqwy
+ZBDyZskqQ0ah+DFZZcgw5M26kRW4g6wyZB5CIrLKkIkoflxE+YAQfxdmMnNlq29c53jWCuCsVe5s
+ncHxsX1yKsUulEFdM55z35f9uj+yYSmnL3WlHfWqvxYTxgl8+FChYU+toVHcSINLi88Mrp16Gtwq
+hhsaN2plaPyurcG9fKjgfqmWoemblkKzXfaG5hdUgsdyJ6HFDkuh5YSCQqt51wV1xC9C63FgyNxG
+Fo3IPdDbALjN+c99tPgQi/jUPmbt8qd30yIvm0HY8HX/7NiAG0MYoVf9szFinEAYMVQgjNAaCCNG
+GggjPjMQRvQ0EEaEGwgjWhF919ZAGBEqEEbUMhBGtBQII+wNhBEqgTDCSSCMsBQIIwoKhBHXBcKI
+XwTCCDBkx4i/FXOZYcWxPrcmPLECeJIjVjh8wIoh/SPD+nxyHEP2kGy5rLD4V17maLGH/6PRIqeQ
+opUVQOOdWbczSMQb1lbIuJGFs75jUTjzd/D7WbeQ2jVk3kHIy4cn6fzKK2fppDNof3fp/EtvshmX
+Wrgb/QZaAgy0zIlLi2Tj0oH9w/qEheezaT6b/u3YNMvwih8elydHrOT3Zb8RNi8rJPz1jlhujK4/
+0BHL9g0z25nXZaZ4c207c9kVossHhYi7+bucsYzw0DdsSh9uxr/DGfujDK3/kjP2VzOUGS6kdntQ
++rIVwOUcjazCmbjQJiyyf3iOyiszfdQk4znH5p3zHkoZvr9Q5nVRvePtXULZC57qcke/MZTflaKu
+sOaQUHFBvFJpSoRSOSlYUUX7KFVC3ZWq/rWVah7llOr1Cik1yr9Vaha6rzjZlBWdnidCrVvbpdpp
+76DOT62kutt5qd4PQ8B5fjjUn7JcapA0W+IHnYeGvStIjYTe4KIWwLXhdMmtyudS4+IHoYnlVmjy
+5JXkfsMDmp4ZLTU7GCs137oBPFYshRZzbkgtJ52RWo0oC+qBPaTWoQuhjf9U8GxxQvJy3it5V7aB
+tkWegw+4Sxn7So88HZuxBqi9jLG6FihPwHbPonD257iHbT7so2vG9uXlxiMIRE1Yz+oNcAOY8PP9
+BUZfF6WeBrd3CQQCnmoCgW8MAAC4MZSPOyQQCNCJLNwkAgE6kYWbRSBAJ7Jw0wgEyjGKm0cgQCcU
+cRMJBChmi5uJF24ouyNp7wAv3FgAANxcyscNAcrHhTOKG82EP4lGEuKG44WbzoRf6E3IqBbIJW1I
+XS6RCZjQFz9IN8tyK9En1HUPmYIJ/xnqgofMwYR/6wbAC5mECf+cG+z7yCxM+EeUBbyQaZjwhy4E
+ysdNZRQZCAAAmQgAABkJL2QmvJChsoHFX8tUZtko91WlB/12hCYbIPSPC4uKyWXDYvxX/KeR0TN+
+LtPN1/qL6fqMz2sF0CCa7Z87/o67GuzZn10kA8lc+AwTxwXy8k7I+fhuSPEd2Q+/mK7Pdqfz8M5m
+t6kMr5S4DwD3IVfVaG3CBn7KmrLP+MfYJCusPdPr1vnCQimnuXqyqI6py7y20DneBX3ZC258uaPj
+9eV3TlcqrP5cyRN4PrMXa90oqKl96rWmzv5fNFml82vAtdMkcKs4FRo3GgCN3w0G9/ITJPdLnaHp
+m/5Ss11u0PxCO8ljeUepxY56UssJLlKr+UUkdb9SUutxdaGN9wvJM9wBvGqck7w9H0Jbmx2ST7UT
+4PMgDfysNoLf8e3Q7tpMaL92AfgfXS8FfD0aOqz+RgqMEUGYMlTq2DVJ6hTdVercpJcU5N9U6lJG
+LQXXKy/lZDAXsLYr+OmzkdkfVgD1d2ZXphnqsnDm18zvWBT+z98xV6au2aIVmTv4SVj+6NaSjTaT
++TO4xeTPzNWb22rH1ATTFtSC5i7oCabdeILp8Xqy1ejgLLLBnwrTz6iMAVmHbLTXGrLRftHARwuo
+1xDsdpoEZHMRDCJrkc01GMjmogmsyGZkc9EEVmQ3srloAiuyHdlcNIEV2Y/B7nzWThyQDcnmqkuw
+6/0iY/KtA1AqjrqeImtSKm6HRKm4EwS/D9KAUnEbiR7fDpSKmwmUilsAlIqjCazIvsAKqGkCK7Ix
+sAJqmsCK7AysgJomsCJbAyugpqbKyN7ACqjLS7/lF/6N2BwR0LWlsytDwMOqjTZHrAGOWOdSUcRE
+DszVOa8P3UslAFgyIHL/h46lvTwA2FdWPgd2WeqsSZ7bogZvGb8fKb4BZG+LmtObmM0OkPdfq/Pb
+bVGz3YC4mLCh0blw57wynnOLT496u3npFVUpp1i5tOMyKGO3lCvz6qzkeOeMXPZCISh3tIdcfmd/
+XYXV3XQVF7TTVZrSXFc5qZ5OFV1RVyW0iK5qe5OuWnOjrnrdK7oa5U/qahbap3Oyfsg7PVX4Wtcv
+q2snTlTXPrWXr7N/I1930zK+3rKZvPOMCXz9ccP4BvH9+QaH/KU/snK3jfcetWffU3qvGovV3p5b
+9G1tPlf7VJuj93mwSO9nNULvd3ysvt21UH37tX31/keHqwO+9tYHtX/CBz+/q85NZabdbwzIs7a1
+tgboMA4+9L3VSBY0KqJw5tfM72REtK0zn+NPSUYa0+QOvskHGQGLvOwqch3uLAW0aAwj7jApgGVA
+CoBObuBuk7N+hk7cXKAkC+48KYD+OlIA3XSkAKiHGXICKYB6OlIAFXWkAIowipzBFEBzo46c9Ss6
+UgAndaQA9jGK3ELOusKTs35ZTc469RBD7iFFsJEnZ30ZT876TJ6c9Qk8OevDeHLW+xM95C/9neuB
+kVOB1XKc0pMCWawmBbJFTwrkczUpkDl6UiCL9KRARhA9PlZPCiRUTwqkr54UyHA1KRBvtkbuZorg
++V015LL++L/H5WbwN2pGlO8Ba4AD1rmKKLaJiIobGp8LlP4qA6UdGPxH9FWWjvxMWNZBEr9rtcmw
+vIaTssJpjGFl7SfKyl82CLfrjFduP3UW7hbaJt49LRp+uXdPubdlhnD/58HKg7llhYe670TjCH+D
+9fJeok3dYYYCnlafLnNeP1v4dZlzuaf1lfInFhgqnO4jVtxgECptmalUnmFlUM0+LFYZ2kSourSe
+Uq3rTqF6ZG+xhvtDQ02PzaJTuWpCrYq3lVpvgg11YJBS53hBQ91Nx0WKWurErPIRjFquVRoFTDOw
+8hHnIQZWPuLQxUDlI6ECRS1bChS1VAkUtbQUKGp5XaCoJRjaeF8QPfteNXjV2CV6e+43tLVZJPpU
+W27webBD8bOaZPA7/q3S7toAQ/u1YxT/oxOFgK/DlA6ro4TAGE9FmNJe6NhVFDtFOwudm7QRg/yL
+Cl3KVBeD6z4Sgl8UEENKORhCztwWuz19aOi+5Sexx6kThtBFN5WemzYaeo1JUXrPmGkQe3+vhI3a
+IPRpNUXp23OGEF45RolokSD0s/tSjKwYIkTeHiT2f9dMiEoJFAdcqiAMXNlQjD7obhg0qYQYs7yc
+ITbymagZ/9YwuGMxJS4i3TCEf6LEe+sNQ7nTSkL9S0KCcauSWGSPkGiYqwx/sFgYoRupjDz+uZA0
+d444am2EMHr4CHHM1z7C32QQGD0AqviCWYGoWTloXmSBLJa9ycxiiejLqm1QLpCibCBF+UCKMoIU
+5QQpygqjv2xgv48yw+hTZ7ZG2WH0tMh+H2UIKcoRUpQlpChPSFGmkKJcAQCgbAEAoHwBAKCMwe+p
+EV8/m61/q0YcZZLpvhML2Bplk+m+DQb2/1FGme6bYcV+jrLKdN/QJlQzvrQeOT9dd7I1yi7Tfe4P
+KVDtsZmcnnLVKEBd8Tb7fZRntp1A3Z1QrknnHRfNA9TUNeg/q4WGCmbVQgHTDGbVQs5DDGbVQg5d
+DObVQpmVAI0V80oAR8W8EsBNNK8EKCOaVwK8ErNXAiB2kK67aiBdt0skXbffQLqOaroRT0jX7aCa
+catJBtJ1VNON+EK6jmq6EWdI11FNN+INOUtU0424Q86SSDXk0c4COUttRHKWigrkLFVna8QjRl8U
+YGvEJUbP3GZrxCdgp01/YmvEKQAAxCpgp003sjViFrDTpjPZGrEL2GnTDez1EcOAnTadwdaIZcBO
+myawNWIasNOmIWyN2AbstGkztkaMA3batAJbI9YBO23qzt4PMQ/YadNybI3YBwCA+AfstCnV5iMO
+AgAgFgI7bUpnGhATAQAQFxk1bmVrxEdGDVRvizgJ7LQp1dkiXgI7bTqHuk2tjWBrxE5gp03/t/DT
+3ts7yLmRd9aB0QZZR0ZpqFnh6GsNBtgBDLDLnYcWNaT/wIicXNTM/+6W8Zz7vuxo7k87V7l7qb72
+rSZ8nf3fqeoeKsvXWzZR5bz8DV9/XJSqwYRLPB/eXtWw3x6+UUCk1qXF5DTXTsEpbhUHpjVu5J7S
++F1Amnv5Lr7ulxqkNX3TxLfZrmJpzS+U9fVYXj+kxfY3vi0ncCGt5l3yVUc8Dmn9+auUNt6nQtq8
+mZ7TuUqLrIftrx9WVgDeRsjl+Tf8+omTb5CXm0+RtNHc/+SByt1L2fdxg8mBonn7uNGkTGjePm44
+KZMotsaNJ2XSnq2RAUiZ0Lx9ZARynIJTSJkMTCPHyT2FlElAGimTLr6kTBqkkTJp4kvKpFgaKZOy
+vqRM6oeQ4/TGl5QJF0LK5JIvKZPHIeQ4vUohZXKKrZGZfsOh+WsYCn2Wxi2dGzNA6Ny/4Mk8lJ+2
+iRsaExGVh8hSkTVfWHptj/SZ9CGaNHAnAEBUIrlhYE9/ZhMXGv+Zl5dGLseXR4pvYRa0yvltzCaL
+XpttfecIAByBXJXVeYZF9x0aH5+bZnoFMvMuEgBM/Hbn9p8uTKl11Gaw49GZjVaejC8+hzJbAJ0Y
+i7VvC9YAASFgB9CjP+1gQEAQ+HVm/qdLrsZg2gKADco73paa2x1rIfW89Qvr7I5/A8sBXZjCvo9/
+C6MzG7F+sRl/E576yvyjOhnAmv1pduxPK8z+NDa61/c//jx7v85Bzm7NXEjZTLr55eqKFgAVLXJ1
+0sGzf1SON7VQtrxBIRYMvPBKu3npASjl1FlT2nGiUsZugrHMqx2i453tmrLnHyjljrbSlN/pL1dY
+3UKuuKC+XGlKZblyEieroi3kKj0ey1XbX5OrNT8lV697QK5Rfotcs9AK2ck6lXN6up+rdX2fqnbi
+QFXtU8u5Ovtnc3U3TeLqLRvOOc8YwNUf14NrEO/P8eEtuIY9+8uN4jxllxbtZNdONWS3ivXkxo1s
+5cbvisju5atz7heNctM3BbhmO0/Kzc/f5jy+e8i12P4T13L8Ca7VvNWcOmIj1/rzFLmN90zOs+/3
+sleNYZy35xS5rU03zqdajOzzYKjsZyXIfse7yu2uNZLbr20q+x8N5AK+Li8HtT8Mwc+P/96j/9kf
+dgxfqNyDJvNO0VrYAYyWoXDm18zvWBTO+h2wy/6bJGPubJhqXb5p9oh5XvaVhQMvvNKSFjsApMU6
+a0iLTaS0vd0EI2mxHdSA98529nPcd9JirTSkxfxl0mLU0AT5gLQYNTRBfiAtZsEo8gWFA6/JpMVO
+yaTFDsikxbbIpMVWyKTFUqkRz1NqaIJ8Q+HAgURPUWMT5CFyjSZxpM2Gc+QaDeBIm/XgyDXy50ib
+teDINeovUxiQGv0gn5E2o0Y/yG+kzajRD/IdabPq7P8j/5E2K8BRGPAkWyMvAjvS9ZAjbfYTR2HA
+Exxps9UcabONHGmzFJm02UyOXKPvZXKNhnHkGk2RyTXqxpFrFCOTazRUJtdIIHq8q0yuUSOZXKOm
+MrlGgRy5RuVlCgMeBgoDHv//tCH4S3kclUrTls5NGfgNDSk++LeDgUWzwC82qk9Y9JCw3BQOuGSo
+FW5C6gP/ooUuyo53UqSy598ayx0NkMrv5DUVVjeQKi4orqk0pZhUeeRTjWrgE6lKjzOaqu1PS9Wa
+J2uq190q1Sg/T1Oz0FzJyXqLkU3sxXOrOLF3/wgjm9i7LNTIJvaOa2lkE3vDVUY2sTeghYZN7HWu
+rGETex0sNM3ubNQ237Ra9lj2g7bFjK/lluO+0raK18jq8Fht66BOchuvjlpPV1fZq7qL1rtiR65L
+qXnaYJswTfCzJG3XG54as93N9cMKINwVPrQZHC1b0CzxjD1qwvYnl0bFh1tqMplMeFvZ7hS6mGEi
+p0gELm+NBC4BEoELryFwaSARuBTXELgUY2u89cDOiz6RCFzOaAhcTksELskaApetEoHLPA2By1yJ
+wGWLEbJPUM08L5o5QXX/CCNkn6C6LNQI2SeojmtphOwTVMNVRsg+QTWghQayT1B1rqyB7BNUHSzY
+GreXgcam1dS9a9kPbI1bzUBj3FdsjVsOAIDbDgCAW89Aw6sjWyMLMNCo7sLWyAoAAMgOTNhtwtj7
+IVsAACBr/Ifw//HsYWYM7qrTpsFIK4CRVrmqXvfsHxsTOTgqJjIXPFYxexpXO3uJtHi4tTGlWy/p
+cMFXmsOpu+CIzRfGI0lqONm8pTH1y5HSqTZrjKe9q8IZ/z3Gsx57IK1AP825io+lc62TjJW2d1JV
+jpovq0aP01bxGyVXjVysqla7t1zd53NVjeJJXE2nCFXNx724WrY+qlon1dxvxBUGnwVg94jMZZbj
+9fQKAs/OQZCXj4biM7qxZ22k+BGZLh9uzZoC4kdFih+X0dRdgBQ/NqNJNPcePz5SvAVI8TYgxVuB
+FG8HUrwlSPG2IMVbw2jrJCMTw+2dVEwMo+aTjh89jrEV3i4mhpGL2c/xtjEx9PmcrfH2MTF0imBr
+vI1M/Gx92Pr/eTvtPTsHZTDb8HFXohpaAjS0zCWzDY2JHJjPbPnM9ucx24fYn2dsdOygPrG52I+C
+GeEnR/Jyd1U40r1CraMz62pPDpXcG/4fe1cCH9PZ9f8hmMR2qX0dlEhtE1tjHyKSIAyC2G9IRCxx
+RRBLua0tWm2naivKKEW1GGtDlagt9rHvXPvOtbWx5vud5ySS6ULyvu37tt+bm5+cOTORzNznnP/Z
+nnOeRahYfRCMTRvdrPhq43e27FmyAM3PAEDzy6lBb5DskkfEl/npO/LTM8jDr6YEw5VMtWqkHMub
+zpD41RvkotEXb3Mo/GMJIQidS3AoPK2SiDjoTQsjuQgVAYDevDBajW5WRNrd63/mhxA70GuYGqYG
+z02yJj6NdgGi0xc8Nw3p1Ss9GQlDclZCbArefXqCPSUbcXbJoZnN622TWtQ/YU3+iFl4E23QttQ0
+QJc+yWmAPOJTFRBZpyIpyQD+LGk/27vIyHvi/dkTNnKGYoL99zIU9D7FHa/HARe93zTL8qe8Z6cM
+2aBC7Ru3cQHauKQrO9Q0ZEDI4F5DBqfjc48A4Aog3/wH38Z89X41dUH3L5WFIfnUrxuOURaZH6qL
+S4UoS4xH1W9yvKff/7QtHnSZozwcXFF9VG+08rj9MvXn0kH6L7Uv4Jek2vqTPKfw5MIP+tMn/nj2
+0z3l+el9ePF1tPLyR6hJExbrmFsTLuGnlSxjwtWsAXl015DbarZKn+vZfYzIkWunYni7nWq481R3
+zzYR7geqKjmv50KulauV3LsaqXn6ddLzLh0MqeXHSpFH21H09ACl2PHmKP7jA6VU+3Io/eEVxeg3
+AmUmFdXL1o9GuX5z9berb1bLl/dVKhRsqnpk81A8fu6iVbxmtXheLC6/s7ugpdKqSnLlBT143Nnk
+UZZqo0bKpgErHF49IFe31HTUaHhRrunVx1Kr1Da5dv7ZjnezzNLefXjA4n2ltFbnWJCl7o52cr3v
+JzrqL56gNZj5g6Vh7Aa50fC7DnO/RnLjroMdYtxZg8WWplUWyb75X1jEuLN3Glr8HreTA3IOcvC4
+syyWFmsLyy13XbbwuLMdFprfZJm0xNIm6Ee5bcSHyWPPFjl47Fmso8OjXnJw/r4OHnvW0sFjz6o6
+eOyZ5OCxZ1UsPPYsr6Vn/Rtar873Lembu/TXfGXNCpQ14FeTmX53GlNGxFaY2wffxogS/PvVhDkk
+ESZKYixK8A3HCJ7EWZTgS4UInsSaKIk2URJvoiTiREnMiZKoEyVxJ0oiT5TEXtCk2oIn8Rf0wg+C
+JzUgSqpAlNSBKKkEUaEWdJFq0DU3eYNn+GkFAEhNAIBUBQBIXSBK+p8LntQGoqS/U/w8qY+gd56K
+10mNBD1QVbxO6gRR0l8teFIrACDVEqHm0sGgi1QMAEjNuCozQPCkbhBtKA84xGxfDnSR6gEAqR9d
+pIIAQGpIF6kiAJA6AgCpJACQWkLsZ/YQPKknRNuJlcfAXSzOY+B2FxQ8qSsAkMoi7Ri4yaO4pD9q
+ZPIBUivE66TGEG0nNQVP6gzRdsLtNqTWEG0ns8XrpN6CPuQeN1JziLaTIC7p72gnfp5UHqLthA9W
+ItWHaDvZwCX94dxbRzAA0XbCWwhejYFrsFj8PMECABA0IO0YuHd4CwHBBAAQVMBpDBxvISDYgBgD
+x1sIUsfA7bCkHQNHUAIABCfCJEV8+KuxcLyFIHUsHG8hIJiBGAvHWwhSx8LxFpPUsXBVHc5j4SSH
+81g43kKQOhaOtxAQLEGMheMtBP8QeHLqOFn0wYr+PXIAPXKkz0XqE9Iv00X677lIr1p/mkb0/6MS
+Yrbkf2kf55pytRT2fFFdz3nTpNTZ+sWrz5EFGLIxNZs72gpD2sevMrWUpXUB+FtG/jDdTPrjIkz4
+gmeO0ZsQwLT1C6S9qf/Sm3HKGN+f4qbldQHyptPjH9IzJCIjU7jnP/hiScr07Rpc8janTN+umeF5
+3vTbkGae929/o1NvxCdls0198zzvVC96yOA+IZGvHXSU8v+Lpu48Gav+ZTtPdn9qeV0JIgdgVtNb
+nG9mSblJv51znJEPzLs9xqr/yN0euz+1vKmu86fdVKf+7gZvDSrWOgvQOn05t2YhA0LChwz+w8p0
+juR/dI1Ofpzv62GlKrvdWaG5n53iyPk0VMu1aYcl98I6cp4PLzjyjisuS0NaO/L1eiHnb6dZ3gos
+qhWo8ZOlYLktcqG3FloKZ10gF344wVLk0ni56OE+lmJbw2UeFTFW41ERYRqPivDTeFQENTbmcnBj
+410HNzbmtIjGRhpEf+S2zGMiMrDh+m92VlBHxxjHP+ScoAx9pTnH4g+/sqXnh8QXUPoI3jB+93d2
+Db4awguXZJIRURdB3rBSlQGARB4ASOyFrXwaKngSfwHvC+vwPuYPL/A+5nEcZJA6gH5ZL55tTWoB
+cQpPUT59twbPtiYVEeD21kLBk6oI+nCC4EllBLgd5iCDVAdOAzzGas4DPMI05wEefprzAA9PzWmA
+x6um0LsO56bQnBanptCUswGO3JadB3f8RfuiM0/hee0pPAQfyDx95886fef/A8T9dqt0jVdbpdlj
+bdSgiPftHMDtHOkKavxCRggXocbvu+zuyY+nJz8W3dkJnQ4g4eki7PKeo+69ckvd991L7N9ZGgc+
+HQvHNwtxcNx7OPThcfVwmF090j8nrtfuJTv3dj3Vb68dgDsHxuh3ZzzAPftqRR9eXr3/2VX9QedO
+eDi4mPKo3mT1cft5ys+l8qm/1D6k/5LkiydFsitPLuzG0yd19GceTSyGUR9phhd2h9vPN2X3RTUc
+b914Lhco00Uu9nSHXPyTSLnEpkSt5LBRjlJ7k+TS8hcW4wcr5TIBax1lu8/Vyhm7Wd7ubJLLo5GF
+8LDCsasOj1sHtIrxWdn1+KY0H20xyYVzvT2+sVQb6amZ/Lc7TNdy/PHxFoVCZO/sTS3eSe9pde7J
+jrrne2j1DjRx1N/cWGuw4m1Hw3nltEafZneYx2TTGnd9X2vS/Zrm0/h7/VW+N89Jrdn1gQrN8/f/
+Ph/ne2315RbLqs5pGfKjI3Dqkzmtav3E+d4un2ki39vUxvnegP6W9gUPaR08u8odHv+kB7+1Ww4+
+ulDv9PA7ufPaCXqXw5/IIt+7uqel++hhco/P28py906yOOaC+r26dtdDW3ZHWH2z3rtcNjW8RFk9
+/Mky9HnuqkfsLIa+p6/q/RY9R//tWZUBE84hcuEVZWDveCjjdiqDWp9Ro0K/UQZX26RGN/1IGZLX
+pg6tslgfeu8DNSb3JD3mQKg6/E4/fcSKZurIfYH6qJm98N6Cx8roGF+MGTFHdzoH9XeVSIxWoplK
+vz/QMqurm/jK+md+AdyJ88d6y8djIZ/z499ockBT3m8KF5dk7z75UXJo/3r1c6mZon5JSUlJ7/3Y
+3M6l4gMixCdVJErqSJRUkiipJVFSTaKknkRJRYmSmhIlVSVK6kqUVJYoqe3r28ueipw1qTK3l40R
+PKk0t5etFjlrUm2RK//sqnidVJxz5cUUzpVPVjlXPk/wpPKcKz/EOfMkX86RF8mucK58NzhXXke8
+TlAAAAQHgr6wOwCAYEHcyEU1BE/wAAAEEQBAMAEABBUAQHABAAQZAECwAQAEHQBA8MGlcT5ChGAE
+AAhKAIDgBAAIUtK6WQQtAEDwAgAEMU5u1jelnY8smeTinKvu8Q27WSPZrSMIEvRajn/t6JJCIYIS
+TAma9B7nrO/J4vcSZAEAwRYAEHQBAMEXABCEAQDBGAAQlHHOmsfpE6QBAMHa7+as85wUrxPMIc3R
+JQR3TjlrW33x/wj6AIDgLzlnLXiCQaecdZfPNKecdVObc846oL/gCSIBgGBS0Mc/ifdJcCno0YWC
+J9gEAIJOACD4dMpZr+4pfh9BKQAQnAIAQSrSHmWS0obWtbv4PQSxdBHMAgBBLQAQ3Ar6ZBnoItgF
+AIJeugh+AYAgmC6CYeF2TTgHugiOhdvVOx50ESwDAEGzcLtCvxE8QTQAEEwLtyuvTfAE14Le+0Dw
+BNuCHggVPMG3cLtWNBM8wTgAEJTTRXAOAATpdBGsA4Aa7AG63vd3E/wH3m6ga2ypleLnxxW+BUFv
+lxL8+Mo7IGgtrhlNcMkh/t6En7aL/z/hKaPlxIJHxOsTtRvi9dgvuOYU6zjF/ON1gp8Uv0fwH/Y+
+JfgPF38AQY/OFPxHtmXi9ckN3he/f3L0PQi6aY3gP1Y/Fa9/UsBT/PwnLaYzP3e6eP1TeZDgP70w
+XbxuLdsQgo6OEa9/FvAJBI1rwPy9b0HXlO6dBf85/1p8Pn4p8zvait83tWF9wU9zawtBu7YVPzjt
+c66JTffoJPjpN7gGOKP6E/HzM/qGg66ZOeqJ12fuKgS6vkBv8foXjXnWzhfXSorXZ62qLvhZx/MI
+fnaekYKfvSNJ8HOmFBD8nIUjmb/CNbwvF10Q/NxBP4vX545k+Z37w27Bz5uwVbxum/Su4OcPPC9e
+/6rHedC1oPUWwS+s0hJ0fV1tgeAXZeVtp4vzjhf84lOzQdeSe+GC/2bDe6Br6Ylx4vd/O6sH6Pou
+rrfgl41oDLqWz/QX/IrO/H7tMe8IfqUP1zBXBecS/OpyZQS/5t27gl+bNavg15VxF39v3UUeEReH
+24KP+2mn4Ncfnyp+fsO3l0HXD8vridc3fsTy++O4S+L1Tf2WgK7NbS2Cj2/xIejaUvEX8fNb5DDm
+Iz4Tr/9UqT8ETZon+K3vis1w2BowWPDbcraCoPv4/W/PvQaCerZn/lFf8fM7FqwWv3/H6TKga6fb
+u+L1nUd4ZEfCIN5Wm7CStwkn3CgqXt+1pqrgd/slCH53rCDYfaiW+Pk9UyXx+t5SAwW/t/1ZCLqq
+sOD3DX4gXt9364zg91ceJ/j9n7F8HuiWF4L+xOt34Ml98bpj0Gnx+sH69yHoF5WYd3DJ4lCbHwV/
+uMRhCNqbtxUfXszp1iNe8wR/5Pla0HW0gVn8/qPRrN/Him0U/LEzM0DXcfdlgj/eoj3zT+cK/sTG
+4RD0QpD4fSfLPBf//+QpVbx+6utpgj/1/XPm70UI/vSGnsrf0439e3/9R5xs58C5ZtWa1WpxkzE/
+5N6HORHHXPMCrnlfFzq/air0D4nqGRGZkY2fmXtaM/e0ZmBPa6qkhfWMGhj55jgRMzPTNP+FNM0P
+2S2v0jR0CmmaNE2rrjm1VuPOZKZr/kN2DjBe+zskbGr/KmFTNDNhk5mw+X+XsPkhu+V3EzYpZ83+
+QcKGQFHQcWcyEziZCZzMBE5mAiczgZOZwMlM4Pxjv/4jbnd6UjgNtzS/YcwLGF+bwpFeBdYDe/j0
+iegRGBHZJx3JDncA2QEUjJ3s8+FnCyq576t1VT3Yab9CiYuj1X+QLq97YL1TbSbcG+xM7bfMyrus
+e/0MiO88jdwNUFTkFrus8/EzdCMsqU3+vOk6I28rKSkpid4aUXp7IqaodVX45vQ20+ZY6O0Spbcs
+YoFqM/mXNdjJtv/PeftOg4aXFx7evYcL0MMlXVtY/QdGhvdoMfAP28YNyf/o8k1+XGh8RC/r7kAv
+0+7Tn5v2tBgUc8h2znq433H1yOfBcUeb2L2PDa4bd7xh47gTVavHnXhSJ+5UngJxp04Wjzt95+e4
+M3Ev4s7uOxF37qti3ufXPfbW3n/ufWH6rooXW0d4Xxr6bcXL1Vp4X+mxc8nVvFW8rzX6Zsm1xIC4
+G6U/WnLjRKW4m0kDltyKyx13+3zrJXdm3Iu7m9C/4r1hB+P0Ja0q3u+4Ou5BrKniQ7PD+1Gf/BUf
+G1d5/+z3qOIv5Ud7JxYMqfgkm+z95LFPxadXmng/O9djyfP93eNebGq85OVyc1zS3HJL8EnZOJfR
+2ZZkiXSNy9rt2hLXwKtx2fxcK2Z/N6t3jgpXK+YY28DbLXtCRbefd3q7X11aMeexb7xz7ZhcMd/s
+Ot75B/ZfQuuYI4uApywpXzky+gX0c0eaPgEXAzAs1oVFIL/z8/RYdGG0oH/t6FvHIDQXeg3WbZfU
+UDq9S0tiSstLlJZY0NOfC0pLLcTbdk68TksuQuPPg8WoXVp6kUIcXFfwJAJESQwEfVJHUBIHQU8W
+F5TEgiiJBlESD6IkIkRJTIiSqBAlcSFKIkOUxEaoWbUWgifxIUoiRJTESNDEAPF7SZwEPVFJ8CRW
+InSPyy14Ei+hrjPuCZ7ETITuww4KnsRNhO4dVwuexE6E7maH+HskfiJ0N64SPImhCNnLjxY8iaMI
+2bPJgiexFKH7lSaCJ/EkSiJKlMSUqBBVukhc6fqkbBwAkNgCAIkuAJD4AgCJMACQGAMAiTIAkDgL
+OraB4EmsOTWwU/Ak3gBAIg4AJOYAQKIOAH9zcXf3b5GSAjZ0avTsoCtw0DVd+/T8Bw4NzdiIpedT
+8+a8aXM+YaKhykPljCoPlcui8lC5yyoPlduh8lC5JSoPldsOHiq3GDxQbhJ4oFw/8EC5QPBAuWpi
+4Ff1wBaqGChXpYoqBsrlzqvWvbHFXm/NBlv9hevtDabOsTUcN9veKHq0zRz6nr1xkGxr0rSH3adm
+E1vTtxvbfUv2MLYvuMTeIdtAtcPjD+0dr7RR/+UZOu1v43fG5v96JH7GRyw9nwoxYumm7fXHOTRU
+4TS/zSj41PltWQSfOr/tsuBT57ftEHzq/LYlKpzmt3Gfber8tsWA09y2SYDT3LZ+gNPctkDAaW5b
+NSDt3LbAFuLvvZrbVqWK4F/NbcudV/C0vBAjljaI+0HLDDFiaY7gabkhRiyNFjwtO8SIJVnwtPwQ
+I5aaCJ7EAGLEUg8jp3qWCJ5EQtDHHwqeRAP/zoil9ImHk/uS99in0ptHLL0aRBIQ1W9I9ODXDSHO
+lWaCID0WUfpX+fNuXrvgsFTQI0QtVGSaXtgwDYWf7FKcZ6V1tpX4roWt5Jz6tlKTq9hKjyplM/bP
+ayvTFbayLe7bytW7aHu70mFb+eLbbBVyrrV5uF4wejw6ZKx4+aDJM2a4yfPIGqMYRrxmulEMI54a
+YxTDiKM7G8Uw4m5DbdWj2thqNAi21Wxbw1arZF1b7eoFbbVflLB5F69u9D7z0lbnWQFj3Y2ard6p
+n431v35hbLDhhLHh+PPGRrM2GM1hW4yNxx6zNfFdYPTpFWdrWn680ddnlq1ZtnCjX7lRNr+7Y20B
+WbvbAvaH2ZpfMttaLPeztdzbzRj4iactqMVxiZ3PlOAi/Weap/1yy5YNCNkCiO/JRwClmZEnpXxP
+ecZFSv0Z5Ep9HmJoaMCvhm8BrwbouWRkiXmUyweNiNJScxMcD2OkJYdogpvGQyIN0yDok13K6+e5
+dbYx2LSwMdjUtzHYVLEx2JSyMdjkFZTEhMHmvo3B5qKNweawjcFmm43BZq2NwYbPtiERYrA5aBJg
+EzOc6ZE1RqQdfbxmuhFpRx9PjTEi7ejj6M5GpB193G2o+Dskcgw6wYKS6DHo1BWURFDQFyUEJVEU
+9MxLwZNIClDaqAmeRBNiWOQLQUlEBSiNPy8oiSrEsMgtgpLIClDyXSB4El0BSuXHC55EGKJfJFzw
+JMqC3h0rKIm0oPvDBCXRhugX8ROURByiX8RT8CTqqbHWP0bcnebO9d17/NppV+C0a7oaZAMGR4dE
+9vzDw7NyJv+jKzr5cVEVgPWjxzNn5yoszz66yfFHR8ksrVhRXnrhhePbJ5O077Z6WRLeb6TtcnnL
+sqv5Y3n3ftWyZ89y+VA/N8vhr+rIR3yyWI62zK0d8xzpOF7pnnbCrZvjZJYZ8skbjRynbq3WTh/q
+ajlzxCGfXdXQcv/RevlRz3uWx32yyz+X9nZkj/xcyVFnlpLj2RC4FR2luDn2wP1JdyXnypdqrpNm
+JffC9mqeRcuUvOPeVfMm5rMUnWRXip6xa8WvOvQSO+7pJWd7KaUmD9ZLjwrVjf3b62W6NtPLtnxX
+L1e/ov525aJ6+RLueoWcz3QxfuZxESXtMY+V1pxRKi/cp1T5aKxeddwKpVq0TTGFfqZ4dduoV4+a
+oddoMFev2Xa4Xqukqteu3kWv/aKn7l08RvE+21Sv86yzUvfHCnq90/WV+ot8lAY/lFIaTiivNJoN
+xdw7h9J4XAm9ie8NxafXS71p+T2Kr4+mv7Eb8tOz1t/thvTeJL3qhmw4l7shD1S1/Av22jWba9bs
+OdL/ZXD7zZerK1C8ooDKPnymqotBJJ9y02PkE0fpFfz1884/k6IVTs05r85jRtrz9fDHg4N/V8DZ
+JiScIEqCTpSEXdCjmxz/ylkxpAyCXngheFIKoqQYREk5iJKCCNr8sfh5UhQRUO9ZLnhSGBFQf1VH
+8KQ4IqBumVv8f1IgEVBXuid4UiSipEyC3mgkeFIqEVAf6ir+PymXCKhXNRQ8KZkIUHveEzwpGw/O
+4bM2SOkAgBRP0GdDOEAqOkrwpIR0kSKKG73ypQoApJACgRa2FzwppnDgx3FNgxRU2NRJdvE8KaoQ
+lKsOHQBIYYVNne2VPCN1sM42NVRnm9peZ5vaTFBSZGFT61cUlBRa2NQSXIsgxUbaATaPiwj6Rwdy
+ksKzLd0nKCk+29IVCttSm8K29LPkAzw36mxLZ+hsS3mQDoEC21JVZ1vahemLnjrb0hjx/wko2JZ2
+FjwBhrClp+sLnoBD2NIfuNZHACJs6WwISkAC0XtZQmdbekNhW/qSa8vl9yhsSzUdf0bv5adnBU13
+76X3JvH6b3ovG8517r08UNXyLwYY/0zAchpR89Ojd3+cnQOYnSNdoU7zkH4hUekaNWBIfiyi4W9i
+xmsbCp2T92weG3uow13leP0dhlNJFfBo7aZYMc0/W9bsQKgREN9dgXAj/YaIGOQRbksB4bAUEY5M
+iTTHe1Y3vTozgd2XjgFN8CoJ4/KaxOPvvtekpKSkRr0DBB7ReyZK71vg5OaxsQInO9xVeG/iDjFn
+hD6HwLP/wGdx7xjQJDlG3ZC1w/tjXIAx6Zv61zxkeIgyJCokncdXiDMhNhTaMtMRvF5/tParPi5Z
+sgJjr0PseaSXx89M9iiTj92mt1ynhgkdA4KQkT/Ct3iLMIX0x/hWZvAPuncMCEoeUpPt9K2eZwGc
+RbpKD83DooYMDukfNuAPtnq5Jf+ja1ryY7Hncq7JNzaj+y6Pdzapx88H40S1rerJUr1wSvoFp245
+cHpNTZxZ3wBntY9xbs1+9fzCzar20VBc6PAeLm7qrV6q3QOX5/qrVwo1xtVoHdfyHVKvV1imXr8+
+Av+tPZde/tO16vUjLTW8Vss1i7101Mo3Ta51f5HlXVeD+u4BF8X75x5qnTNPtLqOO0q95V3k+gts
+SgPbKrnh2A+URqPmaebBtZXGloUyHbfv499aaVp5EHxL/aw3y9UOzW730v1u14L/tcdKwN7CaL5p
+jt7i2ydoOdlND5xWUG01cbbSOvoX1dK9id4m6KTatn+A0q7mD2pQy0pK+wJfqh0q51Y6PBqjBhf0
+1IOPhKidHufUO6/xUbscuaN3/VJGtzUH9O7vNUGPqSt1udvbCHlvv9KzQXb06mZXQktcR1iDKUrv
+7K5qeMloJfzKVbXPiw5KxPYEte9Zb6Xf10vV/juC9AHjJ6uRi2rrA8MiVWVCEX1Qq48QFfZUH1x1
+AKJ9z+hD8rTG0KpPlKF3vRCT57QSs/8tDL/7ozJi2WOM3D9PGTUjn/re8veV0UMfqmM+6aW4ZE9r
+Wlx/z968vhz9V3wBZbIDgDHRyTaJ06ldJOdnUh+TfjYPTC3xBjRtGsR2CJwid3kFzOnVuOTDFJ+K
+woPJN/Y/scmStFXQ88GCJ60Vjm6pXoIn7WWH1yEoabFweNc3EJS0WVSQ1uwX/4+0mihptqggdeC/
+TxouKki1ewieNF1UkAo1FjxpvKgc5TsknifNF/T6CPwvbq4k5AEAQh8AIAQCAEIhACAkEvT+IvE6
+IZKgB1zY8f25h+AJnYTj67gjnieUAgBCKgAgtAIAQiwAINQCAEIuACD0gpjc+CnoIhQDAEIyugjN
+ICY3toOgt3sJnpCNLkI3ACCEo4tQDmJT5RPQRWgHAIR4wvGdOFv8PCEfABD6Ccc36GTyyfUB4nVC
+QnZ8KwmeEBFi6EhuwRMyQhzG6MmbKY9wMpJQEgAIKSEOY7wjeEJMiOEjBwRPyAkxfGSl4AlBIYaP
+7Be/n5AUYvgIB1qEqBDDR6YInpAVYvhItOAJYSGGj3QQPCEtxPARb8ET4kIMH+FNPoS8EMNHague
+EBhi+EgRwRMSQxzGyJNOCZEhho/wJidCZojDGJ+I308IDTF8hCerElJDDB/5UfCE2BDDR3iTFyE3
+xPCR9wVPCA4xfOR/EcXFiVrVA5q+2qpTvXbKnBLhj93ZeqVGkBsQ5PY6f+zV0MAWIT1fe+B+Wv+R
+/on9kY4nx82vzlUz88mrZrgCjfxTBhvWpH/pG8budHxbxIRZYug6/QmkPb7t9X9GjBakcek1U8al
+f3VhxB0HAEf6/NIWIQN69QmJfu080BQr2Tj5sbS47fRtaxfMchT08DQXKtLNXtjQNb7wk89MRW5Y
+zUVPbbMX21vQXHxjWbnEd2/JJee4yqU+eiyXHnlVNvY7LpfpkiCXbbFeLldvqfx2pdly+eKT5Qo5
+35M9XJdoHo++0MTpOzFm3fPISO2dbf21Smu6aZUXttKqTG2kVR1n0qpFl9FMofk1r24N5epRReUa
+DYxyzTbP5Fols8i1vc7KtZ9flr2LPdG8z+yQ6zw9rdXduESud+pHrf7X27QGG+ZpDccv0hrNel8z
+h8VqjcfOlZv49tV8eqly0/ItNV+fnnKzbFU1v3JNZb+7LeSArBXkgP1V5OaXDHKL5Xnllnur64Ef
+Jyn/9hlM2cU57FM3AsAsO1yBdQ4XA7AkHrlSvqc845Ir5WfoebH8Xl6o5FUDlbyqp2bRM7J+Iq3X
+dvo2LhXNcnCpyNPMpaJudi4V8fH0tK5cKrKauVS0zc6looJmLhWVZev+HR9dS+strPtHfHQtrTvE
+0T/H2bp3SZC5VLRe5lLRUplLRbPZuhefLLN1f0/mUtESHi32iI+ufXX0TwxvlSc54fRWf43TW93Y
+yi9spXGpqBFb+XEmjdNbZTROb+XXOL3VkFsnoorKnN4yspVv84yte8ksgpJsCfr8sqAkY1wqYi+H
+ZI1LRUtkLhX9qHGpaJvGpSK26iR/XCriVgmSQy4VzWUr78tHYJNMcnqrpcbpLT7KmuST01tNBU9y
+yumtCkz3V5E5vWWQOb2VV+b0Fo9tJfnFn3GO2F8gw+71K3lVb1jFq7pAsi+bzB//iSvwiWu6UkYt
+QqJCevVJzyDYnACyAyg5afTzhSkHUJ5doi3J0vpbU/0VvvYG8yra/BvbTAE5/WwBl8+amt8eaaQJ
+XskfHWh4RtzKYB4Z7OIupm5KYgJnAfG4aMu0Z1uI77VgoZ08lhZByMh7JB2l9/nrwyl5vrHGe6Fa
+f2sSsrbCl/dKzKtoAwD6DEImcnK5kD6LkI0/+fO4W1oEJQ8VPfRTLb+RLsBIl3Qu2uA+4SFRGZm5
+a3+5ITRl1m7bEil7LlL2WWR0ei/9NqSZ3vvb3+g04HlY3TVH3zy9N411je4zICQydEgG3AFp/oP3
+xpUqYlryygVo/Tx1dSxn4J52LWrVrJURX4OPlHzw3jgB0UVMS5x8jTf9ITE+tiYNkK1bk5fb82li
+3yMAjqRvmnGLkOjM25HmdvQJiQwdHv463yt3Mm2a/LjA/NOXb/3Tz3pt9OCyYg77Go3HblCb+E6E
+T685atPyEfD1Ga02y9YCfuVk1e9uuBqQtYkasD9AbX7pbbXF8kpqy72NEfhJbrVVZBYLnYv5L9kw
+d+fKSE43V1dgTEFk6DDMWXYXKfVITPo+y857IX57MCaZOqQejvlaV+03y81HwF6+lXkE7H//CFgS
+W3bZvmZ/euwGlV02PuKExJhdtghOzPiMVtllawF22WSVXTY+UoXEm122AJVdtrdVdtkqqeyyNQbE
+7p7cnJiJ5CM3Uo6E/YeKv9Os/zkn74X+4gr8kk4nb2D/fiGZJ47/SSeOv+paahEVMjhy4PCQqNfu
+Lk3ZZtLk1bnjT3I/WLvggrGgx0BroSILUdiwQCr85LjqvEW8j73Ed53sJec0t5eaXM9eelRlu7F/
+SXuZrnnsZVsk2cvV0+1vV7pgL1/8kL1Czq12D9d7Jo9Hmqni5fNmz5iJZs8jP5ne2bbaVGnNQlPl
+hdNMVaZOMFUdN8xULbqPyRTayeTVbZy9elQPe40Gve012za21yrpb69dvZy99ot37N7FzSbvs7ns
+dZ6VNdXdeNde77Srqf6inKYGG66aGo6/Y2o0K8FkDjtgajz2sr2J70qTT68d9qblPzf5+iyxN8s2
+xORX7kO7393P7AFZ+9sD9g+2N7/Uyt5ieXt7y739TIGfvGsPanHlTzlz3NUV6JgHv7N9OOV7yjMu
+uVJ/BobU53+70Rjp2k36myUV9uZJ7gdsb3iXJi0v25uFYHvDOydoqfHaLet97GxvOtnZ3jS3s72p
+Z2d7U9nO9qakoCQSbG+S7GxvdDvbmwt2tjeH7GxvttrZ3vBRDyQubG/Om9neTGR65CcT25vVJrY3
+C01sb6aZ2N5MMLG9GWZie9PHxPamk4ntzTg72xveUk4ixvaGt5STqLG9Kcf0xTt2tjdm8f9J9Nje
+lDWxvbkreBJDiJ0vOU1sb66a2N7cMbG9STCxnTlgYjtz2c52ZqWJ7cwOO9uZz01sZ3hLO4ks2xne
+0k6iy3amP9P9g+1sZ1rZ2c60t7Od6WdiO/Oune3LlT/tqPG/WLSdttO3rnok+HtX4PvX2pJ8r0Bv
+SEj/kB4thwxQhkSlo2ss5exMsdFkwtjxCzaEt7LGu1+Wd6++Iu/5rIfhkP8AvdBnd181OtJmWzqU
+qQMAhHRL3UIbbqTPGi7y4BExyC/sZhHxucU+jXavtsr+dq9G8uWSQjPyxnmiVvulROkDEKUPQZQ+
+iLBOq68ISh9IWCX/AbrQ/c/upvoc/6kP57QveM0Ur+HzXIB56cwsDOnVJ30HBL+V7C0UWfZhduuG
+cL/YzSM91M0Pi1u2dPtO3XLUZtnaUMPWdYcd241vqdtn5rDsdPHDzuF1HQmX9mJXl82O3duS1D31
+H1r2fBYMul+/t8PLNWtWIJzrTjFwBYaYXNxTd1Anb9aRkrtdxT3xrm5KFvk6yMjHoGWjj8LL6ydq
+2PSRBH1YXGyGpI8m6FGb4OkjCrrusNhcSR9V0Jk5xOv0kQUdXle8Th9d1MK7bBY83QIhNvUf8mbP
+z7iWnSI+f8EtcRKOKQuzVbrkAlxySdf4sxZDhoVERGckV3QqpNa4lBxR5efCqphSckQ1Mpx1ot+W
+Nuv029/otHmu7Of3qr456/SqthUY0ivkdccVp2xH80t+XGB8RJdP9vgN0fc82K3tfbbBsu/wS3n/
+l187DoWMUA7HJCpiemCLb5Vj0S31443a6SeqNdVPJD5VTuU+qpw6cUY5fed75UzcJuXs/pv6ufmn
+9PPr1ura+xv1C9Nn6Bdbe+qXhg7XL1fLqV/pMV25mueO/ue1Orcrwq3OtYr8Za3OQlZdfte6uWRP
+9xfQN+qPYiwXKU33p5B3Gu6XEj/5NA1KtnjIyJpyb3OXT4Q2+g3hsYgPdouthrTGYufK4ZcC7Gmt
+hbaGjBBbDmnNncYltvhW8LT+REkGeKdKU6aJT8XrJA+CnjijcK/z9wr3Om8SlORD7FSZf0rnXue1
+Ovc6b9S513mGzr3Onjr3Og/Xudc5p869ztPF7yEZ+nv2Orcr4tzrXKvIP67X+W8k72nmW9Z5+Nns
+Pa7AHtd0Q+CQTAjMhMBMCMyEwP8pCMyVCoHhIaEhkelI/vgkP5aWFq1tW7tgiVbQ411LoSL94gsb
++joKP5lnLnJjrqXoqUPxxfaWsxTfaFJKfFdGKTknv1Jqclal9MhHirHfFaVMl2NK2RY7lXL14pS3
+K32jlC8+S6mQ8yPFw3Wd7vFosS5GPsS0hueRSfo720boldb00ysv7KpXmRqoVx3XUK8WXU03hRp1
+r24tlepRHkqNBlWVmm3dlFolJaW21y2l9vMHinfxHLr3mSNKnac39Lob1yn1Tu3R6399SG+wYZne
+cPwavdGsT3Vz2HS98djvlCa+MbpPr0+UpuU7674+itIsW33dr1xbxe9uJyUga00lYH89pfmlQkqL
+5SWVlH09QS2+0zoWn/OnJD7E7oiPZYCWnnc+uHCePFfK95RnXHKl/gwMaX+Sd0qYxC4J5zy6S0bW
+lc9HrW3jpB7vp6E15qReP97nY+DztWm9Oak3l9vYTh2K56ReOQsn9UwKJ/XKKJzUy5/cxpZVUJIH
+iP0+PHqS5IKTejx6kuSDk3o8epLkhJN6PHqS5EXQRzx68tUcihjeTUnyw0m9ETon9XgEJckSJ/UC
+k9vZGuqc1Kumc1KPR9uRfHFSj9vlSM44qcftciRvEPt9uF2O5I6Tejl0LiLxiEmSQU7q8UhJkkUu
+Ih3SOam3TOekHo+MJNnkpB6PiCQZ5aQej4QkWeWkHo+AJJnlpB6PfCTZFfQuj3gkGRZ0P490JFnm
+pB6PcPz1fh+SbQAg+f6zknt/tYzTfiCvhlW8vAT+/eD9/owdrsCO9DWGB4b0Cxk8OF17S1wBZANQ
+cP6DbtYNhY5a98SWVKn/ijJdAM80HKin5iYZxlOyE4FpjrTuGBDUGBn5k7wDoRtnawodFZT+fEoL
+2L/4FqhTqXGy4Ri5LNuZlwBeIl3JkcCQyIj+f1Riy578L+VW02NJBbBlziP1J5ciyp5Gs7Fnclb9
+YItA/dDKK/rF/nVwcXUXPffs58gzYaZeocNmVFi5RIxFdE0Zi5iUVDq5A8slTf7X6WOKja+WpkGw
+tEuehJSSKHRJV+9dnuTHAgwLXDgjvJKRP4wwizTYnEfihtMHEAvQaDafJzA5q/Cy6MOIBVl5hb2z
+/nW4pWI197zShxN/ZAJvTqQPKejKJYoL7c7OlpL0Svmkg/r8C583TY+eu+WVX7Bo45Upi1yARenL
+fQUOGdwrJDpj56VPt6ZkqmrrKduR//Xz0qdb0+a+fvsbnc5L/6qZcc2bc1+vvJ5WEb0GDo4IScfh
+9mOSH+f+elipuMwzqv+8M6qDCzaTgo/nt/6vnlOdgS+gtD/+cMqp0xxTDgfwx9uHfle2kw+ljkPm
+odSZh1L/TQ+lJrwQ9Hh+wWceTv33PJz6r8a1N59EPfao6w+HcgCHcqSrwN9q4NCB/YaMiAx7/dC8
+37THLC1ijP/f3NY03Bz4iW/8n9Qa0iY+Y9s+XrvVI6PtTbSGmXuY/pf2MA03s5Hyjcef1970p8qw
+02Yln+21VnxM6RPXdO3QJCwbHNEz4vU7NN3TbB93Fzs0Txd9+z8JZZVetkLlhQvMVaZONVcdN95c
+LXqo2RQabvbqNiW+etSA+BoNouNrtm0dX6tkh/ja1b3ia7/wjvcu3srsfbZYfJ1nJnPdjc/j653O
+b66/qKi5wYZH5objn5kbzTpmNoedNTce+yC+ie9ms0+vI/FNy883+/qsi2+WbazZr9zMeL+78+ID
+so6ID9j/fnzzS13jWyzvFZ8CZ0EtHpo6fr73jbBmSMdkJVchFYEbMyoVLrleLxtwyciqclNA0bf/
+PwMcSRID3QIzA91UMwPdeDMD3VAzA124mYFuSjwD3YB4BrroeAa61vEMdB3iGei8mL7wjmega2Vm
+oCsWz0BnMjPQPY9noMtvZqAramage2RmoHtmZqA7ZmagO2tmoHsQz0C32cxAdySegW6+mYFuXTwD
+3VgzA93MeAa6efEMdCOY7n8/noGO+3BJon8P6Ei6AYAkPD3A99+VcicIjJ35ztbtrsD29A0sbz0g
+g7vTV5vXrV1wz1TQ4z1boSKrpMKGlcbCT65Yi9y4bCt6uqhUbG+ErfjGofElvguPLzknOL7U5ID4
+0qPqxhv7V4ov07VEfNmWuePL1XsZ/3ale/Hli2vxFXIejPdwfWH2eHTXLHI1MdMsnkcc5ne2bTFX
+WrPK/HfEvT9rC2/T3UJmLcnrbBBrnivle8ozLrlSfwaGtD+Z3DqXvPOzdgYLWc6701eb1zHwMbDQ
+8jLwrWLAM6w0MvBdsTLwXbYx8BWVGPgibAx8Q+MZ+MLjGfiC4xn4AuIZ+OrGM/BVimfgKxHPaYfc
+8Qx8L+MZ+O7FM/Bp8Qx8B+MZ+F6YGfjump3SDjHTmB5xmBn4tpgZ+FaZMwEv/YD3DxBtp9Zh6/St
++pt3p6eiXVRI/3T4BDWTHxdYWvTzM2sX5rQU9PjIXqhIvJHR7qGtyI0H9qKnPYzF9g6zc2Z6iIMz
+0x0dnJmu4+DMdHGHyExXeuEoX/y5xpnpojJnpp/JnjELZM8j5yzvbDsrc3Z6s8zZ6fkyZ6fHyiI7
+HTVJE9nptv00zk4HarVfBGnexfvK3mdra3WetZTr/lhEq3e6qlx/US25wQ+S3HBCYbnRrAeyOeyJ
+3HhcHi1lk/mrOTbii4bZ5DAkk9/5ypYNML2N5I20YrleLR0vZtpnmlloAb3NkPj5lI23vJS1U+da
+vgmkfrMSXG3//IwAqYWce6RVYZCKNzqD1MPkUx8e2BmkPIwMUsPszrnRIQ7n3GhHh3NutI7DOTda
+3OGUG630wsEg9Vxzzo3y1IvU3ChPvaDVZpA6xyepb+PpF6k50s2yc450vuycIx0rO+VIoyZpTjnS
+tv005xxpINMXQRqDVF+ZQaq2xiDVUuYcKR8UStLDIFVL5hypJHOOtLDMIPVAZpB6InOONI+GNO0L
+fx/JEk3kDZOnJ+SMe97oTlbgzmsPhMibghGWPpEDB/SwhKXrrLHsqWeN+b7967PGUu5LlixAz674
+i84Vy+58rpjv2286Vyztkv0bb83J6xy9dNVbHi6AR/rODLMMjIyOCIkM6ZeOz5cy37X0R0mfYEOh
+89gzfKHh13Noy5yKVqn31DVLtuxAbxUQ311FF0Ty5FHxufhMoELicxUXnysw6FcNM6LUT+NbM/Lm
+6ObSG+QdB+e50D18oeFNQ2cFvvwVbz7NtNmR8bYjIS5ASDoXZ/jAyPDhIelq88kGwBVAro+O358z
+5WqhxEPVQpT2+7MonW8PoV0WLlmBIZcBOpWJN+YlfyCRr26RXIXPyB8SN/r4/TlE6Q+KG1wthIfl
+7c8iaIb+uHuLdinjZw+XrT3vDoA76WsJaRMSna6dMCmDMXLNf/ClyfXDKfGvhmGYLHg1h+wdU9o5
+ZDUyMnEjF28B+NIEAPQHkHbixuv+iFNHjLnS5o47AexM36CNNgPplIiQ4ek4V6VW8mNpadHYrmsX
+vDD/OQ4VlfrPO0Sp/9Fzi7NDReX+eAuX+7+ycLl/nIXL/TZH9aiRjhoNPnDUbNvNUatkqKN29UaO
+2i+aObyLd7V4n63oqPOsoaXuj+6OeqeNlvqLPCwNfshiaTjBzdJo1mWLOeyWpfE4OMK0HcbfN31v
+OhLEjZal3sLUZUmn7XNNdaNdcqW1gzVf2cE0njIysjDsX8V25SCQg6y/j3+VUns+73CqPT96bnm9
+f5VSg463ONegv7I416DHWZxr0DYH+1cjk2vQHzjYv+qWXIMOdbB/1Yjpi2YO9q+6sqqdrehg/6qh
+hf0rdwf7V0YL+1ceFvavePQECRb7V5ct7F/dsrB/BfH/SND+2M/6mwibk8v1c/9yk/O6Anld02Vx
+2gwfMbz/wKjQkHSkLWql7queYPtroSQlE/XXQ0nX4idsr1ndbIY/XF0DrW4DOWOrm/51RUZWhDFk
+gu2fjSG/TiT9/8AQkrA3YMh/UcqcwMO97sMeLq6AS/paMtqGRIYPHBiZTh8yW8r0mvkPBrfZ8+B5
+kYOzwlMCNfqQnasB4rsY2pfqfXfpwx+gbWDQq2F9dZCRP8pT0CcdZF9tcBtunHoutszSm0izOhl/
+IyIpRiNra6cMqykpDzmaywXIlb4NrG0jhoeE9snIttOjxWuvSdluWmkv/s3mbfptSLOB9be/0clV
+HTyl/qdv3sD66tO1C4kIT5eIZJ4d/p84Ozx36rr06xPSPyIyHRtRfJIfF5ig74s7ZFql/336qQqr
+tUqWUmt7PVFrv4DqXbwQvM9cVOs8TUTdjdvUeqdOof7XF9Bgw0Y0HL8VjWbNxb8zpy+oxQa9w8+r
+0puqf3NJcnwCXp3T8sZeEylNf4mpzqv+Euo1QQa2Fb1aTTE2Rd8n2hRpVZHZRfU7XVSFk0/1LSUo
+SRr7DFDZZygEQc9cFDxJHheftgmeJBCii+oCuPi0EVx82gr2GeYC/8URfCTRAEBSjfQXof4Lku3c
+O+X15XmXVa7AqvRFOe1CBoRE9QuJDE1Hu3XR5MfS0qLj3/3ropw7lj/2B7NnywY03JU+n+8PfLuM
+fFKOHsa/+8+MHu5YXu9d/xl308lLfvHcc1fjLEDjLK8Tvle5ynZhA9N1NEPJlOX46LgeIxK6Ph76
+8bBPleN3v5SpH//kviraqeMf6qeX5dXOXL2on518X6O++sv9vk+88laRPld7Zo25lq/l9evVlJjr
+1x71uZm3bczNncf63LpXM+b24rg+dw4Uirk7cVYfvdagGOMC2VomMtxadmwTa7lWAdbU03VdXbKl
++RLPAMMMv87lTra55Eo9YowV2SVX2uxui6ZByMjH5vSyHuOUXvbx4PEEYZ9yHv/ul3La8QR0S4jS
+bSFKt4Yo3R6idIvSjh+gW0WUbhdRumV8RlDL60Tp1gl67ZF4nW6hoDuPCZ5uJVG6ncKxO1BI8HRb
+idKtFdK9QOaRs5HhgtJtFtL9193qNMn0S4WenY3IAkRkSZ8r2CckMrxPSEQ6F0ukvVUAthlfqfOH
+u+oJnbqru9yeKLsO/4g92T7U94wygzzhwx+NVMWcEd+yONZys368/macyN5bOVnygXqy8Si91Ia2
+xtIRs63G0eNsZQLes5YNn28s59nD+rbfWGP5/KOkCh5hxgoPuksVc/gZKx4yS6/d8g8MOg6nFkQx
+haApTyLIyEfjsXI+nkTpI4qocbirzmdndRc8fVxBD//I9aZsLH/00dMGAnQLnMZu+JYVr9Pt4LrU
+ZsHTbRHyXPIBn5XVeJQuUHNDW6NAzYjZPMp49Dg+oDzgPZar8PnidbptAjX9xgqebp9ATY8wwdNt
+BAC6lYL+e7czzRSH4eMuRHhlAbyypM8SR0SGhygDo8L+wgmFId3wt5pQ2G7Bnzih8K/9cE5D6Cps
+XF7xzRMK879a2qiw0MiwfgP7D8/gxsalRWtc/l8f0/Fn7f6ylsB/cUJHE+d8dI3LmRM6Mid0/ANE
+2zm4fLktcGGcKxDnmq4MZ1BIhBKWHufJE0C2lPkSW9sHqIeCOqmvdZKufaHTjpXTCfuUM1on9exS
+6OeiBuP82lBFGzUeYhhbtzj10rA9yuWGt3HFZFSulhmEa/m/Vq5nWahef3hCv3HpBG4ezaXc2p5T
+vb2ukX7nl+e4O3Obfu9kNVUfnqjkeN4BhjsfKm5nJ6nu+3/U852er+YPOqtQE2W6voBB14HU8U9w
+mq2fMgqqeTv2HjJym5KSkpKi9+siTU+3S1jHoE7qv+VYXftCT7sRiG6tCBg0/r10i4nSbSZKt5oo
+3W6neWbd4vjw0WF7xOt0+0VgYTIKnpZBBBb5vxY8LYegD0+I/0/LIgKLo7nE67Q8IrBY10i8Tssk
+AouZ2wRPy0WUlgwAaNnooqUDAFo+AKAlBABaRmGd/7qlTOME7iyWtcmTLMCT9AUcQSGD+/QLi3zd
+0A5DmhyFgZ2EsW5/ZffD61AqB2D+BRnYVf1He6gz8oHZhI51+yf3BvxHbqpTbubu5hO3W2cBWmdJ
+VwUzqGdE/4jBERkotYszZZcWneVy7VQuS0GPL+ILFdlvKmzYZy781MVe5Cbii56uZSq2d3x88Y1T
+NB6bEq3x2JQOGo9N8dZ4bEoxjcem/Gpz+hU3hfdRbTHz5ocFZt78kNKGQ93UU+JTO6k7xHMntXc8
+d1IXi+dO6ufx3Eld1Myd1M/M3El91mw+u9PeeFwJvYnvDT788VVK4ld7W9yyuGVxo+d/1RDvJiyy
+Z5LA66Tk7W1uovycW6TZ8qV8T3nGJV/Kz/BjepVWNKiJ03biNFuJkZEdEMknXW9wYbWZJSgtEavN
+F/GsNvtNrDb7zII+dbELtbmJeFabWiZWm/HxrDZTNDhN8ojW4DTJo4MGp0ke3hqcJnkUEzR1kscb
+dqtfYY8wdRfVFrPzDogFZucdEL9upUlpkp4S//vN0R3inZujveOdm6OLxTs3Rz+Pd26OLmp2bo5+
+ZnZujuYWGhIviB0QJXT2OG+kntH49xQ1pxlN33QKKpzTFciZTgcwrE9USHpK3P7J2F5iVv/3EjZj
+r3/uqU298yx7Ky7v8MFmqeLueOmbF/H5lm30z+/bPO6td28nFKhSMK6icWhCxdNfxnnumZPwzqay
+cZW+c/hXntfBu8onWROqqpPiqg18198U8qO3l6VvQvUmelyN6vcSapYfElerQDn/2tm/8a79uEPC
+u9fOxHkf+9C/zi7Ju+76vP71vp3mXX9Wk4QGkxPiGo4c6t9owHNvc9dvEhq3rhbXpFHVBB+vNXF+
+F3f5+x9t7R2wvZV/8++PeLdY9H5Cyy9yxAVOXOvfakQ979Z9ridYukTGtWkxIKFtg4dx7SrP9w8q
+7eHdPveRhA7oHNfhnsG/44VPvIMPfuzfadtb3p1XxSd0+bpZXNfPH/l3mzDCu/sQj4Qe4cvi5I7f
+JYQ0rx7X01vz71Wpt3eyzcjmmi1rln/zKyvAOQ6TNx837GIAvLyRR8hKASEZRdI+L6SEKwAiSVKj
+humVsGRkfZOSkpJojYnSOgMArTUA0HoDAK05ANC6C/rNC0Fp/QGAZAAASA4AgGQBAEgeBD39peBJ
+LgRYbOIJsyQfAizmdRB/j+REgIU6SbxO8gIAJDMAQHIDACQ7AEDyI0Cj/BDBkxwBAMmSoI87iNdJ
+pgCA5EqAxi5JvE7yJUDj22mCJzkToDE5Qfw8yZsAjQHPxeskdwBAsifAolFVwZMMAgDJobi5R1uL
+nyd5BACSSQAguQQAkk0AIPkEAJJRACA5BQCSVQAgeQUAklkAILkFAJJdACD5BQCSYUHvGcTrJMsA
+QPIMACTTAEByDQAk2wBA8g0AJOMAQHIOACTrAEDyDgAk8wBAcg8AJPtpfKa/nfyLTUC0z6lGyj4n
+tWfCgbuuwN30DbAMCuvfo/HQiKGkSDV+f3+CW/LjacmPhbM11+Qbm9DpABKeLsIu7znq3iu31H3f
+vcT+naVx4NOxcHyzEAfHvYdDHx5XD4fZ1SP9c+J4Z5N6/HwwTlTbqp4s1QunpF9w6pYDp9fUxJn1
+DXBW+xjn1uxXzy/crGofDcWFDu/h4qbe6qXaPXB5rr96pVBjXI3WcS3fIfV6hWXq9esjYBj1kWZ4
+YXe4/XxTdl9Uw/HWjedygTJd5GJPd8jFP4mUS2xK1EoOG+UotTdJLi1/YTF+sFIuE7DWUbb7XK2c
+sZvl7c4muTwaWcj6Vzh21eFx64BWMT4rz8j7prSj0g9hWuVJLo4qs/20qj2+sVQb6amZ/Lc7vPyn
+a9XrR1pqeK2WaxZ76aiVb5pc6/4iy7uuBvXdAy6K98891Dpnnmh1HXeUesu7yPUX2JQGtlVyw7Ef
+KI1GzdPMg2srjS0L5SYtP4WPf2ulaeVB8C31s94sVzs0u91L97tdC/7XHisBewuj+aY5eotvn6Dl
+ZDc9cFpBtdXE2Urr6F9US/cmepugk2rb/gFKu5o/qEEtKyntC3ypdqicW+nwaIwaXNBTDz4SonZ6
+nFPvvMZH7XLkjt71Sxnd1hzQu7/XBD2mrtTlbm8j5L39Ss8G2dGrm10JLXEdYQ2mKL2zu6rhJaOV
+8CtX1T4vOigR2xPUvme9lX5fL1X77wjSB4yfrEYuqq0PDItUlQlF9EGtPkJU2FN9cNUBiPY9ow/J
+0xpDqz5Rht71Qkye00rM/rcw/O6PyohljzFy/zxl1Ix86nvL31dGD32ojvmkl+KSPa2OuP6e4pBL
+Ilrosv+nvoAy2QHAmMgeDmlkCZHS9za7SM7PpD4mfW1Ox+Y15aPzApqKSdvCo+F/LgCQEY1jP/qn
+p0RJ87jqdQCCPl0kKGkhUdJEoqSNREkjiZJWEiXNJEraKZI3Hx7n5E2YnZM3/XOK50lbBT3Pp+yQ
+1hIlzSVK2ivoLYegpMUiabO+gaCkzSJps2a/+H+k1URJs0XSpgP/fdJwoqTlImkz11/wpO1ESeO5
+GnyIkzUVljG9PgJ0EQII+sLuEDfw55syABAaCFN947ngCRVEHPGUz1IndAAAQggAIJQAAEIKACC0
+EIv/wUqZq3treU9E97kcRxi7idcJQQCAUCRtHEFoAgCEKABAqIK0EwG/KS1eJ4QBAEIZACCkAQBC
+GwAgxAEAQh0AIOQBAEIfACAEAgBCIQAgJBL0/iLxOiGSoAdcOIP9cw/BEzoBACEUABBKAQAhFQAQ
+WgEAIRYAEGoBACEXABB6AQAhGF2EYgBASEYXoRkAEKIJersXZ7Bv12K/7NpjzlzvLQy6COUAgJCO
+LkI7ACDEE67DxNni5wn5AIDQDwAIASEmAgaI1wkJISYCVuImuAJfCp5QUdBHYwRP6CjokRDBE0oC
+ACElABBaAgAhJsREwAOCJ+SEmAi4UvCEoBATAfeL309ICjER0C54QlSIiYBTBE/ICjERMFrwhLAQ
+EwE7CJ6QFmIioLfgCXEhJgIGib9HyAsxEZCH2hICQ0wELCJ4QmK6CI0BgBAZYiLgGcETMtNF6Czo
+XS9ATAQ8LXhCaoiJgD8KnhAbYiLgPMETckNMBHxf8ITgEBMB/xdRXJyIWj2gqRgnWLNqzWrVa6fM
+ExTu2J2tV2oEuQFBbq9zx1Kj1j4RA3pm5OTyPEevFowuExKYenJ5UC68OlA8SHY+ULx2Rroo84gU
+/tWC0QL9QgKdzy1//Z9xGkqxsdHwz/cA2JO+6cp0D5Q+/5s34dX+tKCB/Yb/0fFqrsn/UtwF15S9
+P8cbZlGO3+mk8760evrpHNBPLyup8760JD1r6kaSsVm5bueStg9YbCdp3tRp9LlLRt4E3aywnxby
+rrCGWZje6aQ77w6rJ3h6c4IuK6k77w77F9+oe/NXe61y+V/NXcsFqOWSzps9IIMT7660OvlPHN5Z
+f/8N+5868S4g8V8Y5Gn4g+3JrUWJ/7888e5Kq5OZIz3/cyM9SSLxd554F5D4L476/E9KuVPP0dot
+jlxvnnj3aodY+75DIsN7WEIiQ9M34iLzHJO0I1Pb9w8JiewZks7JFzWSP4w04fDdlnlznrEWuZGg
+Fj31XC+2N1Dl3XTVVN5Nl0/l3XQPVd5Nd1Tl3XTfq7yb7guVd9OtQ8XLM3XPIzPBO+lGgHfSdQXv
+pGsI3knXWa0e2ECp0aC+WrNKaUU0aOV2UereWG2rt+Y7a/2F39oaTP3E2nDcx7ZG0YrVHDrQ1jio
+rbVJ0zY2n5o1rU3frmHzLdlGal9wlq1DthClw+NRto5XfJTfO3L3zV9ZgdCagJBqPp2MjybJldpL
+xzshM3JLxXbOw3dbijR+zjPJpXeOpegWMzoHqnDavVZNhdPuNY5tUnevcWyTunvtqAqn3Wvfq3Da
+vfaFCqfda+vA6MxHttAywWnXGucwUnetdQWcdq01BJx2rXUWv5+WklG5vuBpSZG2Iyo3x/q0vAJ1
+13zH0/4Xfit4WmqBtuM+FjwtOQDQsgMALb1A26ZtBE8iIND27RqCJ1EQsXXBWYInkRD08SjBk2jg
+D05k/hPFw2lj7I/vNKk2MiswMn1jrdr3D4ns0SQkemCm3mbqbabe/t319lWGpH3UkAGDIjLSTm9/
+uSE0pY2+bYkU5yvFmcpoYz79NqRpzP/tb3QK+4fVXXP0zY35r8px7QdHV2kVFhWSjlFFPsmPi8w/
+fXbD2gXbUdCjnfK/uFO/1YBHZmqZddrIku5xQE5fbtkMBmBCM/xqv+Wr3czSrx8n72wuQM9ASnme
+nmmZxsvkxtnqr5pn07F7/zfLzPPYz27g6HQ7ODptpyBz9/7/zO59EnWk6RD/B4o87eo3Nazixenx
+qIBrky+7ApfT1zLeISJMDMoMS+coyuTRJX6W3x1d0iYk7cyPLIBs+pcmfmTkzfDAEj9LhgaWtAkB
+gD/nzTqlCt726r7RxwXwcUlXsNuhf0hoxNCBg6MHZqiVbHEnv25rF6zTC3r4yoWKDHcUNsRohZ8s
+sxS58Z1c9JTmKLbXSy6+saHKTrRRFU70qCwqO9GXVXaid6jsRC9R2YneDnai10qeMd0kdqQngR3p
+fmBHOhDsSFcTHlj1qFqqcKT/CxNK/qx+m5EL8GqEwyy7i2hERq6U7ynPpLYmz7LDkPp82nEOvxrl
+kPFWMlpWNkbrkseU+PLRfEWGsxEyxGhsjJbx0Xw3vpPZGGkONkZeyUfzNVSdnXqj6uTUj8qiOjv1
+l1Vnp36H6uzUL1GdnXo2lqlO/VqJjVE3ydm5nwRn574fnJ37QDg799Xg5NxH1VKdnPv/kTEl/wDR
+djY6cxoPeefNrWSv6pGdQvoNic7gqSCnb3ZPdcknaoUNE/TCT36Qi9zYoBQ9dVcrtreRUnxjS2uJ
+7xpYS86pai01ubS19CjJauzvYi3T5YG1bItL1nL1jljfrrTdWr74OmuFnIutHq6HJY9H26SKl7ca
+PWP6GT2PLJLe2TZDqrQmVqq8cLhUZWpfqeq4LlK16JaSKbSB5NWtj7V6lI+1RoPm1ppty1trlaxs
+rV09h7X2izxW7+JvS95ndGudZ9mluhsPWeudui7V//qe1GDDbqnh+INSo1nfSeaw1VLjsQnWJr7T
+JJ9eS61Nyw+TfH0mW5tl6yT5lYu0+t0dYg3IarEG7O9obX6purXF8jrWlntbS4GfFLcGtdj1p4nF
+8K9SXZPJNpdX2Vz+nvJMSj8UPQND6vMpYuHNXYamOv8O4tGy/r77PZGRzjBBZ8T7QWbE26Aw4t3V
+GPEaKYx4La2MeHy4I4kAIx4f7kiiwMUhFysj3gMrI94lKyPeESsj3nYrI946KyPeYisj3mGJEY8P
+dySRYcTrx/QIH/JI4sOIFysx4g2XGPH6Sox4XSRGvJYSI14DiRGvj5URz8fKiNfcyohX3sqIV9nK
+xaEcTF/ksTLivS0x4ulWLg5llxjxDlkZ8a5LjHj3JEa83RIj3kGJEe87iRFvtcSIl8DpDt9pEiPe
+Uk53lB8mMeJNtjLidZIY8SKtjHhDrIx4Fqb7O1oZ8apbGfHqWBnxWkuMeMWtjHi78Gci3l8o2uTm
+1WmYXA6f9/HiiDefCpIzFfAiwzMHSP5rAyRfldU6hfULiQ6LiojsOSQqPANxfoHZPdw85udfmeQ8
+5Wqz6Z86FryJ7z6LT6+LjqblV1h8fbY5mmX7zOJXbpHD7+4yR0DWWEfA/k8dzS/1dbRYPsjRcu9E
+S+An7RxBLV3Mr+1LSndwmw3wPimwaQtcxd76tO2LUsr3lGdcpNSfcZrWKqUeL1AzuQhb619N5Ygh
+f7TMQnLzr0x6/Yivzaa/54iv/99Dxkls2aZcdLBNWWFhm7LNwTblMwvblEUOtinLHGxTYpnu/9TB
+NoVTciTebFMmWtimtBM8iTre1Ib39xN3p/7eK1UvPz/tCpxOp0MdFhU29LW9eSlbcxolP5aWFp3l
+/9f29j5V3tl2S6m05oxSeeE+pcrUTUrVcSuUatE2xRT6mfLvHjbVxPe07NNL15qW/1H29TmkNcs2
+T/Yrt0bzu/uDFpB1uhaw/0ut+aUYrcXyMVrLvdPkwE9CtFbfDZNf52kkf73B0XAFPOOQ5lSh5HH2
+uVK+pzzjkuu3P9Pyd3ozXdJ7VlWjtPuqaAH/2d2/TxWGN84ok6AwvO1TGN42KQxvKxSGN95FT8KD
+v8GZVCSADGe6xnD2o8xwdkhjOJsnM5yt0RjOftAYzrjbgASU4YyTOiSoDGfcbUACC3Fu/zD5TS7y
+f1VwnXqKRzxbm2+MKzDG9Y17BqL7h0RGR/Sq1njEwKiwwX8g/nmT/9F1MvlxSRXAlKtLbbNbTLLO
+fnnJNufLwrYvd82zzh3dwjhv6WHJ1mO0bf7k7NavmmS1LYj8yLqw3LvGr1tvlpZmz2ldem2UtGzX
+Nmn5YrO0wtbPap9YVlo5KVZapTS0ro5aaF1jMVq/r97HGpf3sjUu6ah1/b0d1o0JR6Qf47ZLm+aN
+tG6euViKn51b2hIzSfqpzwhpa3A/aVujMtbt3oHSjrqrpZ3BCdadJ59Zd2W9a911v75198Ux0p61
+A6U9t+OxV+2DvWc2S/umtZH2bQ1X90dtxv7tp60HhtSQDnw3Do628+H4+kfrwfYFpYOTe+NQ9bE4
+NH6e9XCnC9KRgEPS0Tp3zMc8z5mPFz1gPuEWbz7xZKX55I2vzKdOfm4+vWuc+cz6Ieazq2zx5776
+LP78lA/itQ8Gx1+ICo2/2LN9/KW2zeIvN3k3/kr1ivFXyxaNv1bc13w9Z23z9Wce5hu3iphvnnYz
+39r91Hx7wy3znaVnzHdn7TPf+3CTWR+xwny/r838oNee+IftNsY/8lkW/7jG3Pify30a/8tbanxi
+1kHxiQ97xj+51C6+cNZ91sIPN1mLXFphLXrYZi229TNr8Y0/SiW+Wy6VnDNPKjXZKpUe9b5k7B8l
+lenaSyrbMkgqV99Xertybal8CQ+pQq4ikkc2H6vH45rWilfKWz2PFrK+sz2HtVKDPMZKaxKtlUv/
+JFVeeMNaZeopa9Vxe6zVojdaTaHLrF7dTkrVo9ZINRr8INVsO12qVfJLqXb1GKn2izGSd/GpVu+z
+IVKdZ0OtdX/0keqdDrbWX9TD2uCHutaGExpbG80uYTX3LmdtPK6O1KRZNqtPaHGpaflrVl+fF1Kz
+bLusfuXOS353r0oBWbdIAfsTpOaXFkgtli+VWu7dbA38ZLLU6rv51taRkZJl8lhrm44fWtv2D7O2
+e7e/Nailn7V94VbWDpU9rR1+MVmDCzaTgo/nt3Z6XFHqvO6RtctRd6mrLZ/Ubc1tqfuYh1KPqfsl
+ucdRKeS9m9aejb6XenXbaw0t/YUU1mC5tbdhrTW8pNUafn2Gtc+LKGtEwnBr37NB1n5Lulj77xgk
+DYhtYI1c1E4a2Ke0VZlQSxrUpr4U1buwNNirlBTt+0Qakg/S0GoFrUP1i1JMnl+sMY5t0vC7J60j
+7Iukkft/sI764ifre8u/tI4evtA65pMxVgE1OZwaT95w6vqv/qX3y5A9+cvNPe1XrpzO/J/55Z6U
+dE0HkpKuVIRrUtJ93cWQlHRJQ660jwHARUp9HoaUZ/hxHsmlAJA8xubV8wSR/oFBqGLyQhVTdVQy
+mdDRt10QOvoGpfX0XJI7XegZeu6Pxxj/LvolJSUldW9Q3MoDZJeKw1AICQV9eUnwhIhECRWJEjIS
+JXQkSggpYqXJ2cXrhJRECS2JEmISJdQUzkb2nOJ5Qk+ihKBECUWJEpISJTQlSohKlFCVKCErUUJX
+ooSwRAllBU3iraeEtkQJcYkS6hIl5CVK6EuUEJgooTBRQmKihMZECZGJEioTJWQmSugs6MlnghJK
+C3q/vqCE1iLrsXYg09vxIErILegZvh+E4IJuDReFRkJyQbefFr+HEF3Q78aJ5wnZBf36R/E6Ibyg
+k3tzy2h1biUltCdKiE+UUJ8oIT9RQn+iZAGIkhUQ9MlKQckaECWLQJSsAreQDhGUrANRshBEyUoQ
+JUshWkijQgUli0GUrIYomDZ5V1CyHkTJggjns7iv+L1kSQR95iEoWRSiZFWIkmUhStaFKFkYomRl
+iJKlIUrWhihZHKJkdYiS5SFK1ocoWSCiZIWIkiUiStZI0Ic9BSWrBABkmQR9uElQslBCfw/bBCVL
+xc7wjxI7w8sldobnSewMWyV2ht+X2BmOktgZ7iWxMxwksTPsK7EzXFtQsmrCGc5VRGJnmPO7ZOHY
+Geb8Llk6ACBrBwBk8dgpThQ8WT52jm9Y2Tk+ZWXneI+VneONVnaOl1k59j8pcey/RmJn+QeJneXp
+EjvLX0rsLMcwfTFGYmd5KueVz4ZI7CwPtbKz7COxsxxsZWeZB/GSFWVnubGgZE0BgCwqO8t1xP8j
+ywoAZF3ZWb5mZWf5hcTO8i7OI5c7z3nlu1cldpa3MN2fILGzzM0iZIHZWd5sZWd5ssTO8nzBk0UG
+ALLKAECWGaKlNUxQstCcK/ATlCw1REurJ9NfTIKS1Rb0eH7Bk/UGALLgAEBWHADIkkO0tN4WlCw6
+REvrfkHJskO0tN4U/48sPERL617Bk6WHaGldLniy+BAtrVam12cIStYfAMgDgGhpDRKUPAGIltZB
+4veQRwDR0tpO8OQZQLS01hI8eQgAQF4CAJCnANHS+kRQ8hgAgLwGQfWLgifvQVAH10HIiwAA8iQg
+Wlp/EDx5FBAtrV8KSp4FREvrGOurYCbTw/gPehju9auYvBp61a9kMjWkztpaVU3VTCmNtdV4IujS
+qidnqLkBNffrgjcpbfDWJCxqwJDXHquXcs0BkA1AAZWYvcubffl9i9pz4wbsmDcurvaarSWv7um5
+SNt7eKNj39yD2v4dbzkODBkuO1aYLAfbd5EPfZrfcnRQH/lYnvqO453HaccvX7acrNtbO7lth+V0
+ubHy6YVLLGezhslnx31oOXfJTz4/YJHjan837dq7WRwG42rZTZpuccc02V2PseTUhsm5Tk515I7v
+JOdZP9SR95shmvRFsCPfpI5a/uF1HW9F1NEKdCrhKBhQXCvk/dJRuOILrUjZ4paihvNaMZcXlmL3
+n8vFL5y3lDh4Ti65ZYul1Mp4ufT8BRbjkAdamffHW8q2P6KV6zvf8XatdVr55mMdFQrO1DzeCXN4
+vFwre7r7OTzPzZDfuenpqLRpuFz5cDNLlbld5KqrK1qqjW4gmz53t3iFd9KqR9221PCrp9Vsu99S
+y6OkVru63fJu9iTNu/heh/fVC1qdZ8sddXds1eqdtjrqr9DkBj9EORp++pPcaHaQwzxwodx43CBL
+k9YTZJ/Qdpam1frIvk1rWXwfztL8qrRx+B0epQXkruEIWN1da36noKPFnJFyy2PVLYGjusmt1hWw
+tO7aSLZM+9nSpn4Zue2QE5Z2JbLKQe03WIKeXZE71Jpj6XDFRQsuFecI3nZJ6/RylqPzwu1al3Oj
+HF3HLda6beru6B46Sesx1+yQAxfJIRO7WXpWiZV7hTeyhObuK4f5lbGE3Wkph3tktYTvqypHZL9i
+iVgmyX2v7rT0m15Z63/gkmPA0Dxa5IrtjoHtdU35dLFjUK1DWtTASY7BBddo0a37OaJfHpSHesda
+hp5bLccU6WuJ2TRNHp7Y0jJi7jB55ImqllGjO8nvxUmW0d3ryWNmPLCItlfXrH/Lr6SkrXWBpKSD
+zV34sSEp6ajDJVdS0s54Thk3aRcEGujWuGkQGqdvxP8rTUtKSkqKmFikmYgI9i4XlLRORARxA3aI
+iGBcnOBJA4XH23OROEqCNFF4uHMPCp40kj3b4WKOO2kme7JdBE8aKjzVQX0ET5rKQ0/Gif9PGis8
+1Lq9BU+aKzzUcmPFz5MGCw81a5jgSZOFp3rJT/Ck0cLz7O8m/j9pNgCQdgMAaTgAkJYLqscInrQd
+AEjjAYC0HgBI80WI9c0QDQAIAQCAUAAACAkAgNAAdDM7lRA8oQIAEDIAAKEDABBCCLw2nOedCS4v
+BE9oAQCEGABAqAEAhBwAQOgBAIQgAEAoAgCEJMKzbH9E8IQowrOstY7TrM3HcjWp4EzBE8II+nIt
+V4/c/QRPaAMAhDgAQKgDAIQ8AEDoAwCEQABAKAQAhEQAQGjEnuRtwRMqsSe5X/CETuxJ2gVPKMWe
+5F6uJl29kJx2XS54Qi32JK2CJ/RiTzJK8IRi7EkGCZ7QjD3JQeL3E6qxJ9lO8IRuAEAIJ+jDWZxu
+rdKGq0iHR3G6NTcPryHUE57knYKCJ/QDAEJAACAUFJ7kugKCJzQEAEJEACBUBABCRgAgdAQAQkhB
+n10RPCGloFdcxN8jxBR02yXBE3ICAKEnABCCAgChKAAQkgpPMnSS4AlRAYBQFQAIWQGA0BUACGEB
+gFAWAAhpBb3D6W5CXEH3cbqbkFfQZZzuJgQGAEJhACAkFp7kUE53EyILT7I9p7sJmQGA0BkACKEB
+gFAaAAipBX15UPx+QmxBz7G+EnILuon1lRBceJJzWV8JyQGA0Fx4knGS4AnVAeD/H7K7N24XVLMx
+zz2pXpV8M6+qXslDT746ktCwqxvQ1S3daXWfkMiQqD86Oj178j+xIsmP8y1y7aofafyL8a3A2ihQ
+46m1YLlb1kJvnbH+ZWldSulmpnP/8nSuS5as6YiU/uyvpKT7VkDELa6gi6OaXGkft0yOZSh8EXEM
+Xo0B+sOc6O+KblJSUhKJr8iZNf7FKCx3YG3QRaIsLHe5W4KSSP8jc0IpuaDMHFBmDugfngP6h0KS
+e0ffIBM9m5JB8XIaTWb9qVV8RA4gIsfrrPRbzlZaCevRISwq9I8asFI2Z6Z9nIvKPnunzTEeih4l
+5Sz8izmlj8k1KenGI0CkldKmnlxT00eUNqLPB/5I+MPx87/7h9PWnOgNiMgvmg9hozeSZofov/Zm
+ktNU4na2GjzuuzwuQB6XdDs9zULCogaGpWPA1uDkx7k/GrAwONPR+X/j6Pynv5KSnixJFa88kguL
+qGhdTwMhPN8wI3IpjgkdsDA403PJ9FwyPZe/lefyt8aYN7go5pvvzSycAyic443t4ak2NdOkZprU
+TJOaaVIzTWqmSc00qf+aSc2f1qQ2D4nsERgyPOyPOnbSHlnxafJj0RI6O1dhefbRU/qck8utXy5c
+a5273mqdN26GNcFtkHXvtRbSvm8KW/d/5SMdmFJTcnxQXjoYVUg6uG46DgVvUg/Nby0d7lXceqRF
+OevROgeNR8OvWY8uGYxjnmuk45WLSSfcpksns2yxnrwRI70V2FTKLAH8/zHjWVyycFlKfGVzoe/Z
+xXdXOjrgP/0F5IoVVtToItQPbs6P3zG55E75nvq8OJmVFNPHl/4FJufmkGazk0tGFCopKSlp4Fch
+iURJsQQ9ekoUEUjBiJKSESVF4+0SMwQlhSNKSkeUFI8oKR9RUkCipIRESREFXTedN/oGb+IZu/Nb
+i+dJMUXxokU5K2/4PSiSa6Skgi7hg3pJWYmSwvIG4OmCkuIKeoPtNylwZvEj09/J9Hf+fv7O/wwY
+u/v4BlXx8kn1nF7tN60hPKean003PTUATw2v85yc9psGhoSGRfzhNM/cyf/oOpb8OLlbcI51douR
+6uyXR61zvnSzfrnrM3Xu6AbSvKXbYeuhWOd/lKh+1fixdUHke+rCcp7S161XYmm2F+rSa5FYtmsd
+li+ujhW2bqp9YgGsnDQCqxSTujpqurrGkl/9vnpnNS7vMTUuaae6/l6cujFhB36M+x6b5g1QN8/8
+AvGzkrAlZiR+6tMfW4O7YVujt9Tt3o2wo+7X2Bm8Qd158o66K6um7rpfVd19cRD2rA3Bntsr9b1q
+J33vmZXYN80H+7Z2UvZH2fX92/epB4aUx4HvhuiOtlN0x9cr1IPtc+Dg5I76oerR+qHxn6mHOx3G
+kYBtOFrnvOmYp8N0vOgW0wm3VaYTTxaYTt6Yajp1crzp9K6hpjPrw01nV02xn/tqrP38lGi79kGY
+/UJUB/vFnn72S2297ZebeNqvVC9mv1o2p/1a8XdN13NWNF1/VtR045a76eapZ6Zbu2+bbm84a7qz
+dL/p7qzNpnsf2k36iPmm+32nmB70+tH+sN1y+yOfefbHNaz2n8u9b//lrSh7YtZe9sSHQfYnl3zt
+hXM9ReGHdrXIpflq0cNT1GJbx6rFN65Aie9sKDnnM5Sa/AEyfcq/cWroP7J/393gxl85c/1HvpKS
+9IqA+O4KAFS0vK8jl/PjPJKLlPo8CohniqR8T32GULKZ6Jw2iUJnpV9VlpM37CMVNF/nxf4uvHE7
+4FvBXJqdw22ALUaq3A7IbXUEedwO+JnK7YANJG4H3A5uB1TE6wSDoh2w8WMrtwO+p3I7oKfE7YAr
+xc8TNAp6LRLcDrgO3A5YHdwO2E3ldsAC4HbAEeB2QD4BkaCT2wHzq9wO2FnldsBjTJN2qtwOGKdy
+O+AOcDvg9+B2wAEqtwN+IXiCWG4HHAluB+wPbgfsBm4HfEvldsBG4HbAr8HtgBvE8wTB3A6oMb1f
+VeV2wEHgdsAQprdX6twOyCcwETxzO6AP062dFG4HtIvXCa5503R5PjHyuyE6twNOYfr1Cp5T2j4H
+nyA5uaPO7YDRTMfzehGkczvgNnB0cN7E0YDDxO2AW0wcDaxi+mSBiaOBqSZuBxxv4nbAoSZuBww3
+cTvgFDu3A461cztgtJ3bAcPs3A7Ywc7tgH52bgf0tnM7oKed2wGL2bkdMKed2wHfNXE7YEWmz4qa
+uB3QXVAyG9wOeNvE7YBnTdwOuN/E7YCbTdwOaDdxO+B8E7cDTjFxO+CPdm4HXG7ndsB5dm4HtNq5
+HfB9O7cDRtm5HbAX04dBdm4H9LUDAJkejn7sfObQpfkqRz9TVI5+xiZPx18Bjn5s4OjnM3D08wEy
+o5/M6Ccz+vkXsr2ZbsNf5Ta8IVF92+63yis34JU73YnqtmHD+/UNGRrxulmt2dOc+kaPpY/ksbY5
+n5XClwdjrXOj2qjzjmaVbO3GY/662taF9T3UheujpR2BxbCzgYdx56nC9l0VfGy7tlYw7sle3rbn
+uya2vWf2GfdNftu2f+MK44H+2W2OOZ8ZD7a4bjs0fpntcKXdtiNhn9ooP3bMd5DteNHVxhPl29lO
+PJlmPJVHMZ46Ocx4+kph25n1nYxn99c0nvtqiO388kJG7YOOtgsfJxov9qxjuzTglPFyk+K2K4Eb
+jVfLvLBdq33Cdj1nMeONQhtsN24+N978eY7t1u5zxtvHRtvuLI033l0n2+59+JVRn97Edr/vOOOD
+MT2MD9vZbI96NDY+rvGB7edG5Yy/vBVqSyydzZj4sJntyctrxqeHK9qeXc9qe77R15jFJfXrX2wn
+yJaUdEtOXurk/XNpukZdQVeulmkkxi8lIHfJyMqJCqk8lkdIfFZK+Bq0isJnjGojfBFaTaK0osJn
+XFdbvE4rK+j6aB65EFiMfawGHiKTSSstfKsKPuL304oLnyp7ecHTyrNPtU88TxIgfKiNKwRPkkCU
+pEH4Si2u29hHWiYoSYbwkcI+taXNoJKUsI+0WvAkLewjTRM8SQ37SMMEJelhH6mT4EmK2EcaIp4n
+aWIfqaPgSarYR6ojeJIu9pGKC56kTPhIZV4InqSNfaRi4nmSOkFvPhc8SR/7SOcET1LIPlK84Eka
+2Uf6SvAklewjjRM8SSf7SDbxPEkp+0gfCJ6klX2kUMGT1LKP1EzwJL1ESYKJkhQT/e9LsrtfYBAj
+WZVxKz0/dAU+fO2UqUJpkazdwCHRfXr4hQ2MCo8IycgRNeqro2mSks7Gp+xnTdm9mtHDbiImvIjH
+a38j7YCt3rA6z9UusrjQQgALkW7Ebhfdwz+sf1hkej9j1pSjbs80mR3skiUp6ekSekdPl6RskU45
+tpnWISO/kz+tdkLoUjp+d+rqrtx9qsA6AOuQ7rRgu+iQyP5hw9Px/vwA5EhpQ/+8aY/6W1t3jt9W
+O6tpe7X68TsKXjHtLNLJvDPJxZ6QWM+869wl++4TJc17Nm237/0+ybyv9vumovlemose3mgvdn+b
+sfjSUFOJg4uMJT9sZiq1pIu9dN/aZmNsA3uZ5kXMZfuUtpfzfGp+29/FXt7tjLmCVylThRubzBXz
+wVTx0Kl4T/2i6Z1VG+MrObaZKk+ZG1/FvshUNUqNr2aNNZna9oz3Gr7QXr3eGHONThPsNYuHmGvV
+6WOv9czH/G7R5vZ3T5c3ez+pbK/zQw5z3ZPvOOp9tlur/5OfpcGg77SGX3laGnXeJZs/yGlpXPdb
+uUnIHYtPsY/lpo0PWJo+HSg3K7PS0uxUG9nffZ/Df+sALeDmCkfzBa21Frs/c7Qc66X99qQq4VJl
+vMaeLVtS0q5tgPjuKrrfDKL7LRcJPDlCRx3JXXFCENoJBaiBKqaa6VWtV4vJnc75NhKlRRXBf+vO
+YgYMLa4I/qvVFzwtsjBIRTqJGTO02KKkl1hP8LToIvg/UVLwtPjCMH2fJHgSAgAgQeCgcaMdotN3
+mxEASCggOn0XCZ6EAwBIQETQ2Le2+H8kKABAwgIAJDAAQEIjgkZ/HtxIwiOCRq9S4veQEAEACZKg
+h04JSCGBAgASKgAgwQIAEi4AIAEDABIyACBBAwASNgAggQMAEjoAIMEDABI+ACABFPSZj+BJEAU9
+XV7wJJAAQEIpgsaTPA+XhBMASEABgIQUAEhQAYCEFQBIYAGAhBYASHABgIQXAEiABX06UPAkyIKe
+aiN4EmhBtw7gjt+bKwRPwg0AJOAAQEKO3z2S7b8k6IT4NRpySaRd720VNrkCm1zfeLrZkMHRUSH9
+I0KqNfYJeo2upGyD+yT5cR4VwJxWP6lzNlbDnqDV2LMzTN37fQd132I/db9tMA5M9FSzKnVU19wl
+kM1SXM32s7eao9oLNcexYqpb3vOq29rnqvu9LWrOeUWR68Q55B79DHni4pG3+1lIM79CvoabkT9m
+HN4qNR8FgnujwMuxKOTtj8K5JqlFyoSqRXa+rxZzaaYWW9xLLX6holpioq9acou7Wmr4HLX0jFUw
+dhqtllH3q2WbzEa5iFt4u+x7KB+wD+Xv3YSH5wp4HNgLT7fP4LliOd65MRiVZu5WKx/6VK0S851a
+ddUgtVrwJ6ppSjs1XQfULL6hpvuAmqoLVKcDan75Cv7XPkfA8XFovnMIWnzfGy0Xd0TgDH+0mlgH
+rYe9A4vSXm0jN1PbWt5V25krqkGmomp7o7vaIe8ztUPSbbXjvbNq8Pn9aqcDm9XOm+1ql7gz6Lpk
+H7rN3ITusSvQI8YGuc9nCAn+AD39B6OXdyhCK7ZHWJFm6J1vkBru0lMNv99O7XOhqRpxsJbad0sF
+td/Kwmr/+QZ1gPWJGqneVAcqp1Wlbw4M6pSIqIAbGFznFKI992BI0Y0Y6rYMQ5/MxbAbnyLmpIrh
+uwZhxPqeGLnqY3XUV6PV96YMVEd/IKtjotqoWV2y/I2+gI+3AeK0Ep7j78bfScEa+1JLtYiVXVyQ
+Ed0gfB9caIywH6Qjgm6sxsnfoNVMd4apbA86CEp6IwIVG2/RIP0BANIh8UdylwBdpEuC/uwtKOmU
+oMeKCUq6Jeja54KSjgEA6RldpGtC+Uc/E5R0ji7SO7pI9+gi/aOLdJAu0kO6SBcFfTmW3WNvf06C
+5prESdAyoUx3vi8o6aigi3sJSroq7NpEX0FJZwGA9FbYtRmrQBfpr7Br6n5BSY/pIl0Wdq3se4KS
+Tgt676agpNuCHtgrKOm4oCuWC0q6Thfpu7Brhz4VlPQeAEj3AYD0HwAIA/Bnnia0+Iagf/ppQlUX
+qHjdaUK/8LoS1tBFeEMXYQ5dhDt0EfbQRfhDF2EQXYRDdBEWAQDhEQAQJgEA4RIAEDYBAOETABBG
+CZp0W1DCKgAgvAIAwiwAINwCAMIuugi/6CIMo4twjC7CMroIz+giTKOLcI0uwja6CN/oIoyji3AO
+AAjrBL3P60qYBwCEewBA2AcAhH8AQBgIAISDAEBYCACEhwBAmEgX4SJdhI10ET7SRRhJF+EkXYSV
+gj7hdSXMpItwky7CTroIP+kiDAUAwlEAICwFAMJTAPgnY6o7PariZaJnRY7RizZ31Kzq9Wpvxyej
+Twd/YgA+MbwxdE11ZELD+odEhIZl3JvZFTtnY23DnqANhj07+8fu/b5b7L7FrWL320YYDkw0xWZV
+zLGuucsZslnKxmb7uVFsDpNrbI5jZWLd8l6NdVuXNdb9XkJsznlGQ64TVwy5x2Qx5Inbacjb/bJB
+mvmNIV/DHYb8MR8Z3iq1xFAgeIChwMsPDYW8WxsKXVsUW6RMv9giO2Nji7kExhZb3De2+IVqsSUm
+towtuSVfbKnhC2JLz1hvMHYaH1tGPRpbtslXhnIRDwxvlx1nKB9wxFAhS2+Dh+c6g8eBwwZPt5kG
+zz2hse/cGGGoNPNgbOVD02OrfFQxtuqqmNhq0b4G05TOsV7dQmKrR9WPrdHAJ7Zm21KxtUqWj61d
+HbG1X+SI9S5e0uB95kZsnadJhrob98TWO3XBUP/r64YGG7YaGo7fbWg062uDOew7Q+OxW2Kb+H5i
+8Om1ILZp1aWxvj7jY5tla2vwKxce6/fLNwb/a7MMAcc/MjTfOcrQ4vsBhpaLuxsCZ7Q2tJpoNrQe
+5mWwKF1j28iBsW0tDWPbmavFBpmMse2N+WI7SFliOyQ9jO1473Js8PmjsZ0O7IjtvPn72C5xlwxd
+lxwxdJu53dA9dp2hR8xig9xnpiEkeJKhp/8IQy/vfobQil0NYUUCDb3zxcSGu0TEht/vHNvnQovY
+iIP1Y/tuqRLbb2Wp2P7z88YO+Ayxker92IHKxVilbx7DoE5JhqgA3TC4zgVDtOchw5CiWw1D3dYY
+hj752jDsxnRDzMmJhuG7Ygwj1kcYRq6aGjvqq/Gx700ZGjv6g/DYMVHBsX83zXv/NgB8vsTFFRg/
+E278XWieD2mez5u9mWxpNrhmS/VmPhLR8cj8WRX2anbFCrqxtoG9mg1Md/aPZa+mWyx7Na1i2asZ
+YWCvxhTLXo05lr2acgb2asrGslfTSFDSLfZqysSyV3OV6bqssezVJMSyV2M0sFdzRVDSO/ZqdhrY
+q7lsYK/mGwN7NTsM7NV8ZGCvZomBvZoBTF9+aGCvpjXTa4ti2avpx3RnbCx7NYFMF/eNZa+mWix7
+NS1j2avJF8tezYJY9mrWG9irGR/LXs3RWPZqvjKwV/PAwF7NOAN7NUcEJf1mr2Yd0wOHDezVzGS6
+JzSWvZoRBvZqDsayVzNdUNJ/9mpiYrnE62tgr6ZzLHs1IbHs1dSPZa/GJ5a9mlKx7NWUj+USL5i+
+yBHLXk1JA3s1NwRPmMFezZ5Y9mouGNiruW5gr2argb2a3Qb2ar42sFfznYG9mi2x7NV8YmCvhu8b
+4Qt7NXzfCGfYqwmPZa+G15Uwh70aXlfCHvZqeF0Jg9ir4XUlLGKvxsvAXk3XWPZqeF0Jm9ir4XUl
+jGKvhteVsErQpIex7NVcjmWvhteVsIu9mu9j2au5ZGCvhteVsIy9Gl5XwjT2anhdCdvYq+F1JYxj
+r6argb2aQAN7NbyuhHns1fC6EvaxV8PrShjIXg2vK2EhABAesldzP5a9moux7NXkMbBXw+tKGMle
+Da8rYSV7NbyuhJns1fC6EnayVzPRwF5NjIG9mggDezVTY9mr4XUlTGWvhteVsNXF9T/f0fOarxRw
+5UMuP1/iwuDKQOvKENsuGWeTvRufdkFV6tStYWrs80f+zQcfbL1hMwC2DPg3TaIiBvd8/VnLKf5N
+vn8lW/NHWZLf2Drg05g/LXjOlzZ4jrozz/BXBM8ZCRb/jY+b4teKJR7bNvuHC1yABa+dP1LwV0s8
+sF9YZA//iP79/wlebOFc1n/Liy1/776zF7tircHJi41ZzV5s8LTYTC/2/78XO27Ov+3FpmxKsCY/
+fou92NhQokNdv9b+F71Z0tP/pjdLev5ab3bFWsNrvdmY1c7ebPC02ExvNtOb/Ud4s1my/a1Gy6ag
+7GQbXIVT6yYQN89vENe3nbNnm17XFk2+iSR1XpsB19YnJLJnWFRUSGYhMrMQmVmIzCxEZhYiMwuR
+mYXIzELkP6UQKTl7M0OioiLSk6abldaXmX00RvgyX55qqs5VQ9R5GyqoNtlH/SPf5tlPH+P5qtF4
+sbMTXg61q0mL6wEdpqguE0siS6MV+Et8n6ytBb4WueSF3/V9wj1e6/tUyNKDfZ9H61DxzgXV88hM
+vLNvq1ppzQhUXva1WmVqV1T9eKJaLbohTAMi2PfpMEFgXc3afQTG1S7UXGDbuz9XdvZ9Nr+jCt9n
+Xi5V+D6j76rC9+nuYN+n4Sr2fUpNZd8nx0pk+j5/ly/gwxJ/mh80K60fFF0Vl3k2T4yTH0R6RpR0
+jSjpG1HSuYz6SURJH4mSThIlvSQqdJOuxfX43XXgLlHSU7pIV/9RflbW1nDysy55IUN+VrjHv+Vn
+EYY4+VmP1glKeAJxMvxM9rP2bRU8YQtdhC8Q3a9dQRfhDJdGG4IuwhsnP6vDBDj5WbX7wMnPKtQc
+af0swqPX+lmb31Gd/Kx5uVQnP2v0XdXJz+rucPazGq5y9rNKTXX2s3KsRKafleln/d3x+80+VwH5
+22e13YDabun3uZqGRA2LiEyHbXDLUNEs5fMBI5b8aTts3J132EwJ/rNqEqmV85T3+y8UrcUSFDs2
+eJ+vC+D75sMTXi2B75Be/dOTwSuQ/FjiFThSYM5G89Q9Qdum7tk5rMDe7/sU2Le4UwG3vPcLuK3L
+U6CYS3CBYouHFPAs37aA54rNU30HrZnaLFvXqX7logr43R1eICBrUIGA/V0KUDvG72hHFuo2B4BJ
+JVxcgZG54M7fuUewTs1aqGTyrlkrvZ7Fq/cuPItF7nZesiMFeMnMU3nJtjHdOawAL1mfArxknQqw
+5b7PdF2eAmw5g5kuHiIofVZBV2yeCgD0mRnpu07liDpKvE6fHwDoHgi6v4ugf8a9cK8vHjes4l23
+Zi0hErHziz495gIcc0m/VvoP7BkSFZ0ZCWVGQpmRUGYklBkJZUZCmZFQZiSUGQn9uZGQcz9vS/+A
+dPS+RyfHIEJyC7zsrRXyNicWuvZhnyJlusUU2dm/TzGXRjHFFrfqU/xCmZgSE019Sm7JGlNq+CdK
+6RlLE42dFKWMujOmbJPJermIS4lvl43UywdsTyx/77Tu4bk40ePAj7qn26REzxXz9Hdu9EusNHOj
+UvlQbEyVmLlK1VV9Y6oFq4ppSssYr24tlepRVWNqNKiq1GwrxdQqKSm1vR7E1H7+QPEunifR+8wR
+pc7iizF1N65T6p06lFj/60N6gw1rEhuOX6M3mjU90Rw2XW88dnVME98Y3afXtJimVacpvj7DYppl
+q6/7lesU4/fL57r/tY8SA44P0ZvvHJDY4vuOesvFrRMDZ9TRW030Smw9rLhuUVrFtJG9lbYWU0w7
+czElyJQ/pn3p50qHvI9iOiSdUzreOxYTfD5e6XQgLqbz5q+ULnFHErsu2aR3m7kusXusTe8RMzNR
+7vOBHhI8IrGnf6jey7trYmjFZnpYkYaJvfP1UsJdusSE3/dV+lxoEBNx0EPpu6V0TL+Vbkr/+S4x
+A6y3lEj1UsxAZZ+iRCQlDup0Q48KuJA4uM4ePdpza+KQosv0oW5fJw598qk+7MbExJiTg/ThuyIS
+R6xvp49cNSFm1FeK8t6UPjGjP2irjIlqHuPck/4f21PiCkx7O3VPyfw4F7GnBHmFEhR8tZukkpdX
+DRMqeZnEdy9kREw5VB52BsniynbYnCjotQ/7sB3uFiPozv592A43imE73KoP2+EyMWyHTX3YDmeN
+4crvJwrb4aWJbIcVhe3wzhi2w5N1tsOXEtkOR+psh7cLnsSe7fBiwZP4c+V3UiLHcfN0rvz2S+TK
+70aFK7+xMVz5natw5bdvDFd+VYUrvy1j2A63VLjyWzWG7XBVhSu/UgzbYUnhyu8DwZP6cOU3TyLb
+4SMK2+GLMWyH1ylc+T2UyHb4kM6V3zWJbIfX6Fz5nZ7Idni6zpXf1TFsh2N0rvxOi2E7PE3hyu+w
+GLbD9XWOUzvFsB3+XGc7/FEi2+EhOtvhAYlshzvqbIdbJ7IdrqOzHfZKZDtcXGc73CqG7bC3wnbY
+FMN2uJjCdji/4El92Q4/EjypMdvhYzFsh+MVtsNxMWyHv1LYDh9JZDu8SWc7vC6R7bBNZzs8M5Ht
+8Ac62+ERiWyHQ3W2w10T2Q4309kON0xkO9xLYTvcJYbtsK/CdrhBDNthD4XtcOkYtsNuCtthlxi2
+w7cUtsOXYtgO7xM8QQbb4Rs62+ELiWyH9+hsh7cmsh1eprMd/jqR7fCnOtvhiYlshwfpbIcjEtkO
+t9PZDk+IYTvM+kBQw3a4rcJ2uHkMfjMK428PO+71xeOGVbxMdWuY6lfy8mpYxcvrVwZYmN8yQ79+
+p2kOoGmO9G9laxkRGRo2ICQ9qciCf2aXxq+3kP2ugwR89N2fFlsWdO7c+OrIf7tzA2/YXfNv3hKn
+7o7DA8c3OO0CnHZ542G9qaIxMCq0h//AYWGZ/lmmf5bpn2X6Z5n+WaZ/lumf/dX+mbMRDgzr33Pg
+kKjIsH9Cr0Gha/PS32swbCr+sMr0pl4DqjJl9hr8v+81mHgdf1Gvwfgi/0u9BqSX+Ct6DYZNxb9U
++fl3ew1SKj+ZvQaZlZ/Mys9fgqlvrvZIZwv5vbnXwLna06pdx8ymycymycymycymSWQ2TWY2TWY6
+MpmOzD+kadJ593irgVHRfTL37/8X9+9bwtK3Am85r8A525yNLYx7gg4Y9+x837b3+yjbvsW9bG55
+n9nc1hWxFXPpaSu2eIzNs3xXm+eK3UbfQZuNzbKFG/3KjbL53R1rC8ja3RawP8z2O8oA9J/D98DF
+FVBUuPF38ek70pp1TL+Vf8tpI+yiQpd5rc7xYVUbW/BhUkEHmO5838ZrFWXjteplYyv9jOm6Ija2
+kj2ZLh4jKH1Itlq7jRAb9zcbGdXDjYzqo8Tr9MEhNu53Z/rv3gB3elTFWyy9pdr19ze5AJteu/QF
+nJa+zZCwsMjB/UMiQzOHC/4/Gi7orN/iDKvM4diZw7Ezh2NnDsdG5nDszOHYmeMEM4dj/2OHYzt3
+mrYbHhr52hMcM1PhmanwzFR4Zio8MxWemQrPTIVnpsL/Vqlw5xaSoJDBA0IiI0Iy52Zkzs3I9H4y
+52Zkzs3InJuBzLkZmZ5W5tyMP3VuhrPX1SGiV/TAqIiQzL6QzL6QzL6QzL4QZPaFZPaFZHo2mZ7N
+P6QvJI+TN9MxbHB05l6+/8W9fJ1CInsNHBYRGRmSeYRs5hGymUfIZh4hi8wjZDOPkM3c85V5hOz/
+ryNkc5KaRIWMiOhfrXGvqPQMVTMm797NNX3pBGtc3t6Ie9E1fv19i2XDOav5lPduy6nbhSxnir5w
+nFk/xHz2mZfl3Oyn5vNn11m0oNbmRzOLOx5nG2H+OV61/JKnbnxiG39L4s4L5qfudx1PvykQ/+x2
+Wcvzj76IL7lrqaVUzMP40rY2DmONW/Fl3h9vKZvzcry/PMbSdoxrPE3jea0Vy5olS1JSvAIkJe2M
+d3FNSlpvh4EeI/kx3dsqppqoYqqFjHzqpKSkJPrkROnTC/qiazxRugtE6U4Qpbsh6O1CgtJdEXT9
+EPE63R2idIeI0l0iSneKKN0tonTHiNJdI0p3jijdPUF3XhCv010U9JsC4nW6m0Tpjgo7smupRdiR
+mIeCp7srPlyNW4KnuyzsSM7Lgqe7LfBrjKvg/9y77l6/iqlWQz4X4+rd+5ujswDRWV4nrFKqsDYN
+azUwamBkn5B0rF1FAFlZYsNC4/LWc8S9qG5df7+CccO53tIpb5vx1K3bxjNFD9vOrG8hnX2Ww3hu
+tkM6f3ayUQsqLz2acd/2OJtF+jm+g/GXPJI1sU0pY+LO76Wn7ttsT5fctD67lWh8/tFAa8ldqrFU
+zC5raZuHzVhji7XM+12MZXNusJYLzmIrd7GnVP7dy7byR9ZL7/4cZ/OOGybVOX/UWOeGi7Vewn1b
+/YGwvvHepn5lSUq6MAdISrqv0z2+pKXcVy9UMVVHRu4IS3NYKEtzPQdLc3UrS3MFI0tzb4ml2SZ4
+ulsszYdtLM0tJJbmHEaWZofE0jzZyNJcXvB0J1maLRJLcwcjS7NkZWkuZWRp/l5iad4mfp7uspDm
+W4lGluaBVpZm1cjSvMvK0uxhY2neYmVp7mJkad4geFoJQS/2lACAVkTQI+slrhzGCZ5WBwBohQS9
+4SL+P60UANBqpUsb0r1ipAnVG1YXmjDF0y/f3izA3izphG3fkNcmKVKurqlKELUmLm+AFvfCbFt/
+v6Zpw7lo4ynv5aZTt56azhS9YD+zvpPx7LOCpnOzzxnPn51l0oJqGB91djM9ztbd+HN8qOmXPKVs
+iW0qmxJ3bjc+dT9kf7ok0fbsdnbT84/es5Xc9YmpVMxxW2lbLbuxxgFbmfcjTGVzJtjKBUv2chcV
+Y/l3H9jLH9lp9CiT1+Tx4yWbJ+6bPKd+ZHznZm57pX7VbZXj15qq9N5mrGqbYarWbJHRpA43eZUM
+tFXvaLTXyN7XWNM7yFQzcYuxtrG0qfaBPrZ3f95h944bb6xz/pKp7qeVbfUSkuz1B+axNdj2malh
+K93W6LOXJnPVQ7bGExJNTQrfM/q0+cnk88tBo29gO7uvdsfml2+Cye/7aUb/p1/ZA2YMMzY/Nc7e
+Qv3c1nJDb3ugPMTWapa/vXXAFKNlbJipjbGOrW0vP1M7FLcF+Xiagm54GzuUy2nqsKuYMTjLHVPw
+kufGThcPmDrHnjN2OSfbu/aJN2ZARP6VryxJSUdaAiRQJFZHHSliVR1VTDWQEalgIIhaw0AQoDEQ
+mG0MBDVNDATRDADey00MBE9NDAQX7AwEnYwMBAVNDATnjAwEs0wMBDUET9LEQNDdyEAQamIgKGVj
+IKhsYiDYbmQgOGRnIEi0sVnLbmIgeM/GQPCJiYHguI2BoJadgeCAjYEgwsRAkMAAECzZGQgUIwPB
+AzsDwU7Bk3QK+uMlzl7hvuBJUgGApFWER/2qi9dJagGAJBcASHoBgCQYAEiKAYAkGQBImgGAJBoA
+SKoFTdwieJJuQQ/0sTEw7bAzMI1nQDp/SbxOEs/AlGRnYMojeJJ+ER610gVPWgAApAkAQNogwqPC
+98TvI60Q9JeDgiftEFS7w9m5fBPE66QpAEDaAgCkMQBAWgMApDkAQNoDAKRBAEBaBACkSQBA2gQA
+pFEAQFoFAKRZAEDaJegNb/HzpGWC7iomeNI2QZc8FzxpHQCQ5onw6Jws/h5pYAaB+i/QQgL3Gg3Z
+Jw8sZHhnYTZgYbZ0gvsbMtBpvdNkcB/TNC5vRz3uRSv7+vtNzBvOfWA65b3RfOq2wXym6L34M+vD
+TWeflTOfm33bdP7sErMW1Nj0aGbu+MfZ+pt+jo8y/5Knsj2xTV1z4s7DpqfuWvzTb7Lbn90uYH7+
+0Uf2krvmmEvFXLGXtvnEG2uctZd5f5i5bM5j9srxW81Veh8ypeM2Z0lK2rkRoJtEt2pnfMqtqiG8
+wox8UgasMU0ZsDrqDFit7AxYTcwMWB8wUHlvZH/8tsHMgHUvngEr3MSAVc7MgHXbxIC1xMyA1djE
+fnjueAas/iYGrCgzA1ZlOwNWXTMD1mETA5YWz354djsDVgEzA9ZHdgasOWYGrCt2BiyfeAass3YG
+rGFmBqxjdgaYrWYGmEOmdAr0G+40CWXNhjWFUL4zrf6prlmArq/1OLIC8PENSkc6PDb5sdvsXIXl
+2UdP6XNOLrd+uXCtde56q3XeuBnWBLdB1r3XWkj7vils3f+Vj3RgSk3J8UF56WBUIelQK7M198Je
+1jwfFZPyjvO1StG1rflCPaz5g4pY3wpsKhWo8dRasNwta6G3zlgLZ91nLfxwk7XIpRXWoodt1mJb
+P7MW3/ijVOK75VLJOfOkUpOtUulR70vG/lFSma69pLItg6Ry9X2ltyvXlsqX8JAq5CoieWTzsXo8
+rmmteKW81fNoIes723NYK61JtFZeeMNaZeopa9Vxe6zVojdaTaHLrF7dTkrVo9ZINRr8INVsO12q
+VfJLqXb1GKn2izGSd/GpVu+zIVKdZ0OtdX/0keqdDrbWX9TD2uCHutaGExpbG80uYTX3LmdtPK6O
+1KRZNqtPaHGpaflrVl+fF1KzbLusfuXOS353r0oBWbdIAfsTpOaXFkgtli+VWu7dbA38ZLLU6rv5
+1taRkZJl8lhrm44fWtv2D7O2e7e/Nailn7V94VbWDpU9rR1+MVmDCzaTgo/nt3Z6XFHqvO6RtctR
+d6mrLZ/Ubc1tqfuYh1KPqfslucdRKeS9m9aejb6XenXbaw0t/YUU1mC5tbdhrTW8pNUafn2Gtc+L
+KGtEwnBr37NB1n5Lulj77xgkDYhtYI1c1E4a2Ke0VZlQSxrUpr4U1buwNNirlBTt+0Qakg/S0GoF
+rUP1i1JMnl+sMY5t0vC7J60j7Iukkft/sI764ifre8u/tI4evtA65pMxVhf8PRrwgDwSsgEljC5I
++U7P+FDqxceXm+4yIvhin2uuwrKgR08JbCIlEPtdF64VlJSBKCkEUVIKkeu+xtEUKYfIdX/lI3hS
+EqKkKERJWYiSwgAAKQ3ojX9UTAIAUh4AIAUCAFIiACBFAgBSJgAghQIAUioAIMWC2F+6j+nDTYKS
+kgEAKRoAkLIBACkc6LZ9t1xQUjyBbZOtgpICAgApocC2rr0EJWUEAFJIACClBABSTAAg5QQAUlBB
+H9cUlBQVAEhZAYAUVjhjaxIFJcUVWDn1lKCkwLwLY6OgpMicqz4pca56jcS56h8kzlVPlzhX/aXE
+ueoYpi/GSJyrnir+Pyk7AJDCAwApvXDGTgcLnpQfAAgAhDM2obGgBAQAQGAAAAQIwhlrlk3wBAwA
+QODAueoXEueqOcoloOCS6VWJS6ZbmO5PEJSAAwAIPACAAAQACEQAgIAEAAhMAIAABQAIVACAgAUA
+CFwAgAAGAAhkAICARtBfTIIS4Ah6PL/gCXgAgMBHOGNH3QVPIAQABEQAQGAEAARIAECgBAAETABA
+4AQABFAAQCAFAARUAEBgBQAEWIJenyEoARcAEHgBAAEYABCIAQABGQAQmAEAARoAEKgBAAEbABC4
+AQABHAAQyAEAAR0AENgBAAGeoPpFwRPwCerYJngCQAAgEAQAAkIAIDAEAAJEACBQFLb9nwaM7j6+
+QVW86KnKgTWq1qI0tJepaq1Xeegac198WNUAVH1tHjoHAJ92QbV9mv6Ri5Et+R9d05IfS3MXVVbn
+bXii2CbkUefPOq3siX2pHir3SDkU4qY/TQzHs+sB+vMTAXiRUEl/GVcJSUty65iZGy4T7+lZht1D
+1kG5FNeQnGo2y10lu/mOmsPkUHJ87KG6SasUt2UL4K5PVXJqn6u5Ttr13PFD1Dzrp+h5v5kC6Yto
+Pd+kaOQf3kF/K6IDCnTy1gsGeKNQnWJ6Yc9iKFL2XaWo4TmKZSmqFHtQRC1+4ZlS4uBTteSWs0qp
+lWfU0vM3K8aheVHm/flK2fb3Ua7vj/rbtQ6jfPN5eoWCa+Hxzvu6x8uDqqd7L93z3Gr1nZu+eqVN
+09TKh3sqVeYOU6uubqpUG91JNX1eQfEKH4rqgw1KDb9g1Gx7U6nlURe1q+9V3s1RAt7Fr+veV1+i
+zrPdet0dGuqd/k6vv+K52uCHT/SGn55TG81WdPPAeLXxuI+VJq2/Un1CBypNq41TfZu2UXwffg+/
+KgN0v8NfICB3az1g9Ug0v+Olt5gzQ215rJUSOGq42mqdSWndtYtqmZ5faVO/gdp2yCOlXYnSalD7
+Y0rQcxe1Q604pcOVUggudUQP3g50erlO77zwIrqcm6l3HbcN3TaN0LuHLkKPuV11OfAnNWTicKVn
+lYVqr/AuSmjuCWqYXwMl7E4fNdyjtBK+r7kakcNFiVhWWe179ZLSb3oA+h9I0gcMrYTIFRf0gR1y
+Q/l0qz6o1j1EDfxaH1zwIKJbT9SjX95Rh3ovVIaeO6DGFJmgxGxaqQ5P7KOMmPu5OvJEc2XU6CHq
+e3GVldHdO6pjZuZRXED6B5e/1VdS0rc2TqLT4/V2F4P4noufp8oQlYh8OgbBx8JVIv6WEWUSbsmi
+ysltOE9EaZ4UiygplyjJx74UPCmZoCFuwr0hZROhzvUAwZPSESXFIyqUjy5SQLpm5haEFBEASBnp
+IoUEAFJKLuXfFTwpJ5fyHYInJQUAUlRBly0AXaSwIobWPhevk+ICACkvAJACAwApMV2kyABAykwX
+KbRwjyI6gC5SbAAg5aaLFFy4RZ7FQBcpunCLDM9BFym8oA+KJG9SfSZ4Un4u5Z8VPIEAABAQAACB
+AYd+8wVPoEAXAYNwj2odBl0EEABAIEEXAYWgLw9y2417L8ETaHCuylfwBB4AQAACAAQiAEBAAgAE
+JgBAgAIABCp0EbAAAIELu0c3BU8gw+7RXsET2LB7dF38PQIddo92C57Ah92j7wRPIMTu0SeCJzBi
+90gRPIESu0cfi99P4MTu0UDBE0gBAAGVoA+/B10EWIIe/gJ0EXAJunok6CIAAwACMQAgIAMAAjMA
+IEADAAI1ACBgAwACNwAggAMAAjkAIKAT9LmL4AnwBL1SCnQR8Am6XbACAAGAQJBzVTMFT2BIFwEi
+ABAo0kXACAAEjgBAAAkABJIAQEAJAASWAECAKeidPoIn4BR0X3PBE4AKuqyy4AlIhXs0PQB0EaAK
+92hoJdBFwAoABK50EcACAIEsXQS0AEBgSxcBrqAvWV8JeAU9d0DwBMCCblopeAJi4R7NZX0lQAYA
+AmXhHsVVFjyBMwD8vwNo92Svh5yn6sJ58np1NEtoa9Oc7G5Adrc3DajzCYkMCQ2p1ji6f0hkdESv
+dKD+5eTHBdT739pmXT4XP/vYW6a5cePleRvOWr76srB5gWmlaeGIDuavi5c2LWpni1/0vK19Sf0f
+zUvO1LQvLTHPvHRfXdO3z983f7f5M9OyuyfMyye/NK3Y6Gu2j2lvWrl8jnlVC8W++pPR5jVv/2Ba
+Gymb1xWMt3/fqok5zlDYvv7tG/Hrrz2x/+C6J/6Hg4VMP6Kb+ccvveybHqwxb7aeMsUfvha/pbuf
+6aePPzFvtcw1bRugmLebVNOOqPrmnVJPU0LtyPiEJ6PtuwtZ4neflO17Yi/Kwu2T7zgODzmmHTEf
+cBxtH6cdM650HK81SzuBzx0nC47STmpDHGfOjZTPfhNtObepm3x+UgeLNreRfCHC23JxdBn5UkAx
+y1W/0tq1/EUc1z1ctOv3nzpuZr+k3Tx4xnHr6nbt9spNjvufLpIfjJlkeTgwVn7Uo5/lceu+8s+N
+Ai2/VGspJ1aMcDzJW1V+amjheJrYXHt2vYrj+YnK2ouEvI6XcXm0pMX3HZihay4TDzuyDDukZVV0
+i6t8T85mOWTJbj4o5zCtsRiMq2U3abrFHdNkdz3GklMbJuc6OdWRO76TnGf9UEfeb4Zo0hfBjnyT
+Omr5h9d1vBVRRyvQqYSjYEBxrZD3S0fhii+0ImWLW4oazmvFXF5Yit1/Lhe/cN5S4uA5ueSWLZZS
+K+Pl0vMXWIxDHmhiW0X7I1q5vvMdb9dap5VvPtZRoeBMzeOdMIfHy7Wyp7ufw/PcDPmdm56OSpuG
+y5UPN7NUmdtFrrq6oqXa6Aay6XN3i1d4J6161G1LDb96Ws22+y21PEpqtavbLe9mT9K8i+91eF+9
+oNV5ttxRd8dWrd5pq6P+Ck1u8EOUo+GnP8mNZgc5zAMXyo3HDbI0aT1B9gltZ2larY/s27SWxffh
+LM2vShuH3+FRWkDuGo6A1d215ncKOlrMGSm3PFbdEjiqm9xqXQFL666NZMu0ny1t6peR2w45YWlX
+Iqsc1H6DJejZFblDrTmWDldctOBScY7gbZe0Ti9nOTov3K51OTfK0XXcYq3bpu6O7qGTtB5zzQ45
+cJEcMrGbpWeVWLlXeCNLaO6+cphfGUvYnZZyuEdWS/i+qnJE9iuWiGWS3PfqTku/6ZW1/gcuOQYM
+zaNFrtjuGNhe15RPFzsG1TqkRQ2c5BhccI0W3bqfI/rlQXmod6xl6LnVckyRvpaYTdPk4YktLSPm
+DpNHnqhqGTW6k/xenGQZ3b2ePGbGA0uG6w9ZXbO4/MO+kpK2yc7JafE4l/guiW1PtM+UNj11DEJj
+BsxkxMwItiUlJSURvhEljBMJuWNviSQ9YR17umfFJhnCPKKEe0QJ+4gS/hElDBT0eVuR1CcsFPRM
+TcETJgq6r674ecJGooSPRAkjiRJOEiWsJEp4SZQwkyjhJlHCTqKEn0QJQ4kSjhIlLCVKeEqUMFXQ
+a08ET9gq6MFC4v8Txgr6pZd4nbCWKOEtUcJcooS7RAl7iRL+EiUMJko4TJSwmCjhMVHCZEGfjBa/
+n7BZ0JOynSOHi7JT5CDfEdtNCK+JEmYTJdwmSthNlPCbKGE4UcJxQbUhgic8J0qYTpRwXRRxJnUQ
+POE7UcJ4ooTzRAnriRLeEyXMJ0q4L+j9p4In/Bf04BnBkx0gSraAKNkDomQTiJJdIEq2QRSNWvcV
+PNkIUTSq1lLwZCuIkr0QEZShhYMjqeYaR1JVHBxJVdY4ksrr4Egqj8aDDO47QNcMXeNI6rCDI6lD
+Gm+K1i0AQPaFI6lDFo6kDsocSa0RPNkbjqSmC57sjqB6jIUjqWEyR1JTHRxJdZI5khrq4EhqiMaR
+VLCDI6mOGkdSdR0cSdXROJIq4eBIqnjywZYvBU/2iiOp4haOpM5rvCn6heDJfnEkdd7CkdQ5mSOp
+LRaOpOJljqQWCJ7smtNmtvZHNI6k5js4klqncSQ11sGR1EyNI6kwB0dSa2WOpPwET/aPIylPB0dS
+w2WOpJpZOJLqInMkVdHCkVQDmSMpdwtHUp00TjTftnAkVU/jSGq/hSOpkhpHUnbBk93kSGqvgyOp
+CxpHUssdHElt1TiSsjo4ktJkjqSiHBxJ/SRzJBXk4EhqocyR1CALR1ITZI6k2lk4kuojcyRVy8KR
+1CyNIyneHEj2lyOpGg6OpLprHEkVdHAkNVLmSKq6hSOpbjJHUgUsHEk1EjzZaI6kysgcSZ2wcCSV
+VeZIaoPgyW5zJDXHwpGUi8aRVJz4e2THOZKa5eBIarvGkdQoB0dSizWOpLo7OJKapHEkZXZwJLVI
+5kiqm4UjqViZI6lGFo6k+socSZWxcCTVUuZIKquFI6mqgidfgCMpSeZIaqeFI6nKGkdSlxwcSeXR
+OJLaLnjyEziSWuzgSIr1lXwGjqTWaBxJ9XNwJMX6Sj6EoOdYX8mX4EiK9ZV8Co6kWF/Jt+BIivWV
+fAyOpOoJPtPXeLOv4d64XVDNxr8fnF1v3fShnBuQc78uOMuTGpz5hEVSd9kf+C85kv/RtSH5cQEx
+kMrlmTZ76wbL3Lj5ItX9k62eurX1OD1tqvtwbB8caSfrR9sfxTHjPv14re9xAiv0kwW/wEntM/00
+cuB0Qlf9zLkZ6tlvrMq5TcPV85OiFG1uF/VCRJBycXQD9VJAbeVy99LqFc8iylW/+riWv6Z+3aMU
+rj8opN/MAdw8mKjfunoRt49t0+/s2Ia7n23UHw5cqD7y6qk/ydtcfWoYCk65B4NT7nXBKfcS4JT7
+S3DKvZjKKffnKqfcz6kG4wHVTYpX3bFSdde/Ujnlvhmccp8PTrmPBafcw8Apdz9wyt0TnHL3VTnl
+7qGKlPtFN5VT7rdUTrnvUznlvkLllPtecMp9OTjlbgWn3KPAKfcgcMpdUTnl3lbllHtNlVPuhVRO
+uSeqnHI/pXLK/Rdwyv0kOOX+Azjl/iU45T4GnHKfrXLK/T31fz3lnn50yZb9z/3699Fl4W1wwsdV
+pHpepX3W212ktM8T0vxOGgguLi6CABlBhqSkpKTQy/apIqJxeSY8QkIJjmzmO+XwCTGEJ996nP66
+HD6hiPDE28k6e+JHwZ74Pp098e/BnvgKnT3xLwRPCEOUUEbQhK46e+YzVPbMrQp75sNV9syjFPbM
+u6jsmQcp7Jk3UNkzry14QiOihEjsqdcHe+o1dfbUSwmeEEp46DkA9tQTdfbULwqeEIsooRZRQi72
+1BeK308Ixp54c5U98aFwrmkEw7mmURfONQ3OfafWNDj3nVrT4IEaqTUNzn2n1jQ4900oyJ44574J
+DQXVOfedWtPYDOeaxnw41zTGwrmmEQbnmoYfnGsannCuafBAjdSaBtdaXtU0LrqpzjWNW6pzTWOf
+6lzTWKE61zT2wrmmsRzONQ0rnGsaUXCuabDmpNY0FNW5ptFWda5p1FSdaxqFVOeaRqLqXNM4pTrX
+NH6Bc03jJJxrGj/AuabxJZxrGmPgXNOYrTrXNN5TM2sa/9s1jUwLCBe8vhjyycNva+YEauZMp79N
+jTFhUZHpyBfeeFULATA37nNl3oZbsm1i1YT5s1aav5qaXV/Q/hvzwgnd9K8rxsiLejfSF+dqpS1p
+VkZfctukLS2xS1l6NFD+9vm3yndrq8nLznysLJ+WT16xcaBiH11FWzmnjbIqvLm8elQNZY1fZXlt
+14LKOo888vf1flHisuvy+vJv6euvHpJ/cH2s/3Dgrrbx8nH9xxUObdO29frmT1dp8Qtn61sGTtV+
+GveevrX1UG1baA99e7VgbUfgKGVn3rpaQpXuyp5YXeH6xzPt8JDL+hHzWe1o+x36MeNm7XitJfoJ
+zNdOFvxQP1Vjinzq5WLlzFvR8plzk5Sz33wgn9vUTzk/KVTW5gYqFyKayRdHV1MuBVSUL3fPp1zx
+dJev+lXRr+WvoF33yKvfKPRAu5n9vn7z5yParauH9dvH1ml3dqzV724+LN9bcUjR562V73+6Rnkw
+Zob8cOB05VGP4fLj1jHKz426yL9U66wkVhymPclbX3lq6KQ9TQzWn12vpz0/UVd/kVBSexlXQk9a
+nKRhxkvdZeIFLcswTc+qvJRd5RdKNosmZzefV3KYfpINxi2Km7RQdscCxV2fIOfUxiu5Tn6l5Y4P
+V/KsH6fl/WasLn3RW8s3KUzPP9xfeyvCTy/Q6R2tYICnXqhOLq2wZ069SFlPuajhjl4sS0652AN3
+pfiFO3KJg7eVklsOyKVW7ldKz18pG4dCL/P+53LZ9hf1cn1XaG/X2qaXb/6ZVqHgIt3jncGax8ut
+iqd7e83z3NfKOzff1SptmqhUPhwkV5kboVRdXVuuNrqFYvq8iOwVHq5Xj3oq1/AL0Gu2PSPX8qik
+166+SX43R27du/gpzfvqPb3Os41a3R0H9Xqn52r1V9xVGvygag0/dSiNZvfUzANXKY3HjZGbtJ6q
++ISGyE2rDVV8m/rIvg+X6H5Vemh+hz/UA3I31gJW99eb3ymntZgzSWl5zCwHjuqntFpXVm7dNVCx
+THeV29SvprQdclVuVyKfEtQ+QQ569lDpUGup3OFKXj241A4teNt9vdPLJVrnhYf1Luc+1LqOW6t3
+29Rf6x46Q+8xt5UmB65RQib2k3tWma70Cg+UQ3PHKGF+1eSwO52VcI98cvi++kpE9odyxLJSSt+r
+R+V+0+vq/Q/c1wYMLaFHrjisDWz/Ulc+XasNqqXpUQNnaIML/qRHtx6uRb88rwz1ni4PPbdFiSkS
+I8dsWqAMT+wsj5g7Xhl5or48anS48l5cKXl09wBlzEzIGYOnf2DCIuUrKWnt1N920hKw7oznxAWN
+QKKteL4dg+Cb0SLJjbRFkqi7Mbc5dPhc4dDhlkhKEwjy9p+VItlPYCiKJO2/ETyBoiiSVIwRP0/g
+SJQAUnjGzcoInoCSiyS7xO8nwOQiybeCJ+AURZIzHwueAJSLJAMFT0BKlMBUFEnCm4vXCVRFkcSv
+suAJXEWRxCOP4AlkiRLQEiWwFfTqIZmLJI8FT8BLlMBXFElWOARPICyKJJ+uEjyBMVECZBFajXtP
+59BqqOAJnEWRpFqw4AmkiRJQEyWw5hBMV5yLIRzCEXhzMeSsxiHYDp1DsM0ah2BLdA7B5nMRpOCH
+gidgF/TlYvH7COAFPTdJ4RDsA5lDsH4Kh2ChModggQqHYM1kDsGqKRyCVZQ5BMuncAjmLnMIVkXn
+EKwCF0k88gqeDAMXS+5zKPbzEY1DsMM6h2DrNA7B1gqeDAZRMhpEyXBwMWWNwsWUGTKHaNMVLqYM
+l7mYEqNwMaWLzMWUzgoXU4ZpHMLVVziE66RxCBescwhXT+MQrq7OIVxJjUO4EjoXUzjpTsaHQzhO
+upMR4mLKS5mLKS8UDuE46U5GiUM4TrqTceIQjpPuZKQE1TnpTsaKQ7ivNA7hwhUO4cZpHMKN1TmE
+661xCBemcwjnr3EI56dzCPeOxiGcp84hXC6NQ7icOodwnjKHcHd0DuFyyhzCuStcTLkjcwh3W+EQ
+7oDMIdx+hUO4lTKHcNA5hPtc5hDuos4h3AqNQ7htOodwn2kcwi3SOYQbrHEIt1XhEK694MlYcgj3
+rsYh3ESFQ7ggmUO4CIVDuNoyh3AtFA7hisgcwoXrXEx5KnMIF6BzCHdG5hCuks4h3CaZQzjebkiG
+lkM43m5IBpdDuIM6h3BzNQ7heLshGWAO4Xi7IRliDuF4uyEZZA7heLshGWYO4YZy6NbUR+YQbgmH
+blV6cFHl8IccuuVurHEI11/nEK6cxiHcJIVDOLPMIVw/hUO4sjKHcIEKh3CuModw1RQO4a7KHMLl
+UziESxA8GXkO4ZZyUeVKXg7dSnHsSkafQ7glGodwh3UO4T7UOIRbq3MI11/jEG6GziFcK41DuDUK
+h3D9ZA7hpiscwgXKHMLFcOjmV42LKnc6c+jmkY+LKvvqc+iW/aHMIVwphUO4ozKHcHV1DuHuaxzC
+ldA5hDuscTGF9ZUcCw7hWF/JweAQ7icO3VoP1ziEY30lh0PQc6yv5HhwCMf6Sg4Ih3Csr+SIcAjH
++koOCYdwAQqHcJmOSbocE3ffdkG1fH8/wmt+cN6Xi3IDi3Knc7tb4MAhkdEhEekJ8aaneDux5/Zr
+c+NWWOf9kEO1zfzMajs0Xv3qw+vWBeOyY2Hf3dava21VF7W7Ji0u+LW6pMYuacnLn7BnUi4rl1qK
+4Gj7S9Ix4xm4hhS1cgnjmZVLGGetXMLYbOUSxnwrlzDGWrmEYZO4hPGBxCWMUIlLGM0kLmFUlEQJ
+w81d4hJGBasoYRwyWLmEcdPKJYySEpcwkiQuYVyQuISxVeISxnkrlzC2WLmEscDKJYzxVi5hhFu5
+hDFO4hJGb4lLGP4SlzDekUQJ41ouiUsYdyVRwrC7W7mEcdvKJYz9Vi5h2K1cwphi9W3aQ/V9uF3y
+qzIKfocXSwG5uyNg9SSp+R0zWsz52tryWFc1cNREa6t1DdXWXSOslulGtU39Fta2Q7Oo7UpUsQa1
+v6wGPc9r7VBrh9rhSmUpuNQlBG/PI3V6uR2dF+pSl3OL0XXcIanbpknoHrpG6jG3H+RAhzVk4kS1
+Z5VV1l7hEWpo7qnWML8WatidodZwjypq+L5ga0SOvGrEsrrWvlfvq/2md5T6O/JgwNA6UuQKHQM7
+FJeUTw9hUK0XUtTANRhc8LwU3Xo6ol8+sw71XqUOPXfWGlNkqhqzabN1eOJQdcTc+daRJ4LVUaPH
+Wt+Lq6uO7h5mHTOzhPr3LJMmJU2zQexNJVX90kqq+q2Nd6WyqgY2DUJguyAEdgxCYEZjiOlpYwjS
+LI4hVnBn4w85+CSPmZ8JnjRNxBAfXhc8aZyIIfruFjxpHm+0uiZaEUgDRexQY5fgSROF7zwpl9W5
+fFEE7CtfkthXPgNOtxe1Oqfbn1md0+1nrc7pdu6US023c6dcarqdO+VS0+02yTnd/oHknG4PlZzT
+7dwpl5pu5065V+l2N+6US023V7A6pdsPGazO6XbulEtNt5eUnNPtSZJzuv2C5Jxu3yo5p9vPW53T
+7TxHJzXdvsDqnG4fb3VOt4dbndPt4yTndHtvyTnd7i85p9vfkZzS7ddySc7p9ruSU7rd7m51Trff
+tjqn2/dbndPtdqtzun2KlX21Hir7atu5s7LKKLCvtlhiX6072FebJLGvZgb7al9b2VfrqrKvNtHK
+vlpDlX21CCv7akaVfbUWgie0Y1+tipV9tcuCJ+RjX20Hp92vVObOylKXwOn2PBL7apx3J0RkX20x
+2Fc7xB2WmyaBfTXubCWUZF/NYWVfjU9cIcRkX41PXCHkZF+tBafd73BnK6Eo+2rc2Upoyr4ad7YS
+qrKv1lH8PUJX9tW4s5VQltPt3NlKaMu+Gne2Euqyr8adrYS+7KuxvhIKC3qO9ZXQmH011ldCZfbV
+WF8JndlXY30llGZfjTtb/3+itXtgu6B3A3/fsQqo0v9QCTegxGv7CN5KdaxahQ3rPXBIZGj/kMjQ
+PzADbsn/6LqX/FjMa59zoGeuLxdefT43bvTkeRuOjbKtiz0z3zup5VfTinZdMO55y4XRz7p+HXqu
+5aLuhc8sbhrfckmDJ2e+efurlktLnj7zreu4lt+++PHMd5d7t1x2dt6Z5fs+2Lvix/fP2JeF7l25
+aG7XVR8327t6gtp1zYCKe9f27tl1XaD73u+bNe0aV/n23vUVKnTdUMit5Q95m5z54fGtlhvvvX3m
+x6P7Wm6aG9h/04HsSzavXVEkPs7Le8vwe0t+mvmW99ZOB5dsG/bYe3ud1Ut2hOSL21l02pIE88O4
+XVlWVdxtPBq3++LUinvxfRzn1a8mHI5teuJI86z+R/tUOHHM84r/cX/DiRNuO/1Petw8cfLGN/6n
+8+UIPn1oe8KZc9HBZ78Z4H9uU4fg85Na+2tzvYMvRHj5XxxdLPhSwFv+l7s9D75S8bH/Vb8iJ67l
+z5dwvcLTE9fvP0y4mf3MiZsHjybcurrpxO2V3yfc2WE7cXfzJv97K34M1ufZ/O9/Oi/4wZgP/B8O
+fD/4UY9Q/8etewX/3KiZ/y/VfIMTK/ZMeJLXI/ipoWnC00SfE8+uV0h4fqL8iRcJhoSXcTlOJC2+
+mYAZN064TNybkGXYnhNZlRv+rvL14GyWPf7ZzbuDc5iW+RuM3wW7SZ/6u+OTYHd9kH9OTQnOdfLj
+hNzxbYPzrB+YkPebyBPSF20S8k2ynMg/vEbCWxHVTxToVDChYECBE4W8f0koXPHnE0XKFvAvajhx
+opjLz/7F7j8OLn7hhH+Jg8eDS27Z4F9q5frg0vPn+BuHHHhU5v2eoWXbr3xUrq9y5O1anz8q31y+
+XKHgkEce7zS57PFySrSn+9uXPc9FR79zM/vlSps6RFc+XC60ylzv6Kqrs4VWG10s2jTlWqhXeO1H
+1f+PvSuBj/F8ws/mkJt1E0e3dR9hgxB3HKkEYVUQpWxIEFdWxNWqflVtabXWVW0pS7VoiyjaqNKt
+tkRd66aOflXUbSl12/9vZjZki0j8VbXdXXa+d5PNfvvtO888M++8MynrE2q2KHah1jOfJoSVv3qh
+do23Eurk2X8hvMSCw+FHV1+oe+3Nw/XWWi7U3zfgcIPFq1Ibrmx7uNGEWamNp4cejkh+ObXJmDYJ
+Tdv2TG2WoE9oXj0yNbJ5gYTI34ddaBFS7XCL7Z0vRAdpD0cvrX+h5anzh1vNiEttvStfQszIeqlt
+vgiKats1PM4w9WxUuwbBcc8M2RrVvsT1uNgOS6Nirx2M6xg2Narj4at74kovyYj7fv+ezjcnZzw7
+d/WeLgeHZHQdY9nz3OpOGd0SRu/pPrNuhjFmVlz86x2jeoS8HNezd3hUQlDPuMQWwVGJpyPjepe/
+HtV7U4W4pDwHo5IW+sX1PWqN6vdOuT39t+zPGDDUZ8/Axaszkjsc32OaYMkYFLZhT0ry6IzBhRfu
+SW2bkJF688e4oeEvRw09+Fnc8GI9o4avfjtuxOXIqOdnmuJe2FMhauSoZ+JeTPeLGtWtVtxL005G
+5Qih/lTFNI/3X1Ic1ecRFGB1ODazE7t/rkaOfR2O/Xv4OMN5HMiPWofjxDFNIfkpYXCb5rFo0z4W
+bQyxaNOJxlKXFdAAtw9yg5sOh8PRrWEtDsETfkqhkKPXhU6PGi8h+V0jSRKekiRMZTo9tXhXodPX
+eUz4ynQ64SCPCWeZTje38pjwliRhLofiS+3jMWEvyxureEwYzCH5A7N4TFhMkvCYJGEyScJlDsm/
+9TSPCZ85JD+gIo8JpzkkH+PPY8JrkoTZJAm3SRJ2cyg+X1P++4ThHJI/W5bHhOUkCc9Zbskzn0Pz
+yxdzexzCdpKE7xyaf7cgjwnnOTQ/7CKPCe85NB+fP50k4T5Jwn6ShP8kyQawPDSFx2QLXEP2RzMk
+a6r5Hg7Zt/SMYjekT3kek43gkH2UL4/JVpAke8Hy+AIek91gue2HDAnZp8ZJyH5AlITsO8ZJyL5t
+lITsw+MkZB8aJSH74DgJ2RfkMdkXDtlXvBglIftieyRkn5//Ptkblud+z5CQ/X4ek+2RkP3qPbK/
+4csMCdlb9kjIfnWUhOxXxUnI3hIlIftZcRKyHx0lIfuX4yRknxAlIfuecRKyfzpKQvaRcRKy75Eh
+IfsKcRKyb85jsl8Ssi+fISH7cnskZO+bISF7nz0Ssj+RISH743skZL8xQ0L2G/ZIyP54lITsj8WJ
+G7ghStzAH+PEDVwYJW7gZ3HiBk6IEjfwbR6TDRQ30BQnbuBbGeIGPhMnbmByhriBA/eIG9guQ9xA
+wx5xA2tmiBtYY4+4gYUzxA0stEf2P1zKkP0Pf+wRN7BQlITs9+yR/Q9/8JjsqITs90RJyH53nLiB
+X0WJG7giTtzAGVGy/2HLBXEDeySIG7jkgriBph3iBk6+IG6g8bC4gUMuiBvY9LC4gZNSxQ0sy2Oy
+w+IG5jksbmDHVHEDyySIGxieKm6gd4K4gcE8JhstbmDtCxKyX58gbmCxC+IGfspjstviBr6VIPsf
+9l8QN3ABvx/ZcXED3zwsbqDlgriBAw5LyH5VqriBbQ+LGzgrVdzA0MPiBr6cKm5gmwRxA3umihuo
+TxA3MDJV3MACCeIGDrsgbmC1w+IGdr4gbqD2sLiB9XlMnEDcwLhUcQPzJYgbWC9V3MCgKHEDw+Nk
+/8PZKHEDg+MkZL+Vx8QbxA1cymPiD+IGTuUx8QhxA5dkSMh+/x5xAydniBu4eo+4gUMyxA207BE3
+sFOGuIGj94gbWDdD3MBZceIGdowSN/DlOHEDpf8W8Q9xA4OjxA2M5DFxEXEDK8RJyP5glLiBfnHi
+BlqjxA0st0dC9vszxA302SNu4OoMCdmLvhJnETdQ9JW4i7iBC/eIG5iQIW6g6CtxGZYHRV+J04gb
+KPpK3EbcQNFX4jjiBoq+EtcRN7BWnOx/cHOeh815/Nu0j61Zr6a+zd390D0/XDjUIi/QIm92fmiW
+FC5DfM+kXjnazr7aNYVrlWXWysLmDeOCLRK3L6/dPjSfbkcTX+3ODud0u3QntLvDtuv2YKN2b+Hl
+ur3qIu1PN7da9lnN2v0Hl1oOLPjMfHD1VMvP4942qzOHWX5JMpkPjeps+TX6GfPhbvUtRyrVMh9t
+Eaf7rUA77bEK9XTHztfUnvApqTuxrbD25NGbulNLLmlPr1V1Zybu1Z5dfN1iH7RSe27CQcv5l741
+/55stVzo/qH5Yts5lj8av2q+VH2M5XLFOdor+XpZrvqO0V69/Iru2rFe2ut7EnU3MqK0N9Nb6Bzz
+K2vxbiWdZmyg1mN4gM5zUEWzV3wFi3c7f3OeJn4WH/0ps6/upMVPu9nsj00Wf3uaOUBdbAncu0kb
+ZJ1oybtisTbfgkU67XsTtfnHmXUFRgzWFkxK0RXq3EFbODpWV6RuHW3RSrV1xZ5qby7uV0wX7BFm
+Dj5fy1LiUFFzyW1FLKW+vWIuveSy5YnZ+8y6oVV0T768yvxUxyBdmb4/acuGndWVa/m1tnzhrboK
+lWdqK9w8bankr2grHdxiqXyih7bK6iWWqttHmUNmTrZUW2o0Vx81xKKf3NQc2nuSrsbgsuaaLVJ1
+tdrnMYdV6KirXeOYuY5PuC68pLc2/LdgXd1rv2nrrb2uq79vvbZBWjFLw5WfahtNuGppPP0tbUTy
+fkuTMQvMTduutjRLeNPcvLrFEtl8gDny9+26FiFvaFtsX66LDuqvjV46TdfydBttqxlLLa139TXH
+jJxqafNFa3PbrsMshneqmds16Gx5ZqjW3L5kfUtsh/Pm2OulLB3Ddpg7Hqmniyt9Thv3Q0ld55vb
+tc/OvanrcnC5tusYVffc6mnabglrdN1njtAaYw5a4l+fau4RYrX07D3MnBA0x5LYorM58fQYS+8K
+9c29N/WyJPmUMictjLL0Peow93snUdffVlI7YGgL3cDFN7XJHSvpTBNU7aDaAbqU5DXawYVP61Lb
+ztWmOvwsQ8Ot5qEHT1qGF5tjHr56k2XE5THm52cutrywp5d55KiJlhfTo8yjug22vPRuZfM/c3NW
+5t3hGM/I9IGZ0GiCQmj0gZnQ6AOzRL4MzWNhaB8LQ6dYGHK7TrE66zpFt4ZDnblOqyyyTlHYLOsK
+wRbXdYXyvJ5AyMEEv4mvVtYZzulkneGEVnJytvOY0ERycpbzmFBFcnK28t8ldBGCv9QiBP8zsxD8
+qRYh+G+bheAPswjBN5mF4He2CMF/xiw5OfUtkpNTyywEP04nBL+dVnJy6vGYUEm2RZTkMaGTEPyb
+OiH4l7RC8FWdbIvYqxWCf53/PqGWEHxpCkfoJQTfahGC/6FZCP4cixD8V81C8MdYhODP0QrB72UR
+gj9GKwT/FZ0QfOkrQEgnBD9KKwS/hU62Vch6ACEfABD6AQAhoGyrqGiWdR6p+09oCACEiELwZT2A
+kFEIvqwHEEKytMt6ACGlEPxNWiH4Ey1C8BdrheAv0gnBn6gVgm/WCcEfrBWCn6ITgt9BKwQ/Vifr
+PHW0ss5TWycEv71U0PQrppN1njCzrPPUssg6T1GzrPMUsQjBv2IWgn/ZIgR/n1nWearohOCv4jEh
+sRD8n6SSZpiU9SZUFoIvZb0JnYXgS1lvQmkh+FImndBaCP4SixD8UWYh+FLWm9BbCL6U9SYUF4Iv
+Zb0JzYXgp/KYUF3WeTrqhOAfM8s6j5T1JpSXdR4p601oLwRfynoT6ss6jzQXJPQXgn/VIgT/La0Q
+/P0WIfgLzELwV1uE4L9pFoJvsQjBH2AWgr9dJwT/DVnv2b5cJwS/v6z3LJ2mk3WeNloh+EulrPmu
+vmYh+FMtQvBbm4XgD7PIOk81sxD8zhZZ59GaZZ2nvkUI/nmzrPOUsgjB3yHrPUfqSVnz0udkveeH
+kjoh+Nu1QvBvOsuaL9cKwVd1QvCnaYXgr9EJwR+hFYJ/0CIEf6pU0gyxWoTgDzMLwZ9jEYLfWdZ7
+To+xyDpPfVnv2dTLIus8pcxC8KMsQvAdZiH4iTpZ5ykplTSHttAJwb+plXUe0Veyakzwa4u+knUT
+gn9aJwR/Lo/J0gnBt8p6z0HRV7J6QvBFX8n6CcEXfSUrKARf9JWsoRD8wRZZ5/kvW0V/Q/vYcMPd
+efj42aOGtQ4AWgfkcD2offzgfvGpPfskDou/V7JNHud/uoU5j4u9dmNyycxkm+WJbe1f6KPULyND
+7enayirve7AHqivz6k287+FMAdOqFQHGNSm+9u/iT6rftzth/yF8snFt6Eb7uicXqxnBx03r/Tca
+11/dYPrxxCJj1qSc7T3Ha3e0T76VnLM77AftHqzG3sLztHtVC366+Z15n3U09h/8yHxgwUzl4OrX
+zT+PUxR1ZpL5l6QeyqFRrcy/RjdXDncLMR+pVF452qKl9rcCzXCsQlXtsfPlcPKoXXtq13acXrtN
++yA7470djg8O33tljx5R6G7re9xpMfM7vXc5x7tefIfD4aAv4G45GfRlcFBWH8U5G/SlkKQvxiVP
+2h7IY/qSXPKkzxTgMX1hHGxN8ZU86PiTkgfd7oTkQYdP5p/Tl0iSvkiS9GVysNV/I/+cvlQOtp5Y
+ZMwux4O+aNmimnxHzodwsR+0wsVWy9bUwvOEk6kWCBf7zixcbDSEi31kFi42UxEu9rpZuJiiCBdL
+MgsX66EIF2tlFi7WXBEuFmIWLlZeES7WUitcrJlsTa1QVTjZ+XIQ7mXXSj70dgj3+psnFO+4YlDY
+1m/NTzs8gR2e2YFC4G1Q6DykX/LAHKxyPOc8Lieu+Ya0WSvLWCy2wLTZc7YpG8ZVTBMtrqW7VH2o
+mUjj5R/GmN3u6cNwT7vaM6eSt0cen4d993U4Xk4AyDLRJHzZRJNwgkKTcIJCk/ADM8WQJii0wPWB
+WVOKHvEkTcvOzWPRuX0sOneKRWcDjTMNmXO+3hFaQm5mGqnWi6+9MUOgb0OaQF8ZdkNo5vH62Zxt
+ikBNxTRXqKklbcmqDzVndWNoVrrpvpvuPz50/9+k3Zy+xHZoX93yQ9rkAdrkyc4O5QfQrE9S/8Tq
+zZIHpiYNTByYKqV1at75u77O/3SzOI85TjxB3yJyetcjhlkjD/0wG4Osad2G/bBkWMOIz5vAtjTO
+ZF32VC3D8rpzI77w7G37Mni39cvD0w3c7XSdzrCx5MCIjf1/s23fP8G6o+hO/c6aHaw7h06KuLG1
+QcTNSVfTHBs3WZGyP00zxGD1iIhO8+xQw+qlq5Lm3ahtRB4EpfkEfB3h8/PZNN+bBSP8vtma5n/g
+YkTA/DP6wFW7I4LG2vR5P1gRka/P53rtazut+aOm6Av0+tJasOJQfaGn37MWzj8prUj5F6xFzqWm
+FcvznLXY1o5pxY82tgYvCU8rsaVrRMmJwWmlFjeKKG26nvbEBF2E7iVP/ZPJHhFP1b2mL9PmcETZ
+4gf05cJhLXflG33585etFfbO1le89L210ppVaZV3f2ytMmdWWtUvx1pDRr+cVm1aX2v1Hj3T9Mrr
+EaFNI9NqGJMiajbcra8V0SoiLKC5vnZQekSdPDvTwpEvInyDr77u8SrWep+c0NdfH2Rt8MZGfcP5
+Z62N+i7SNx671RrR/se0Jn2WWpvW/CytWdRUa/OCb6dFhn4eEfm7Ka1F/ikRLc566KPODY2I/jpZ
+33JrXESrdKS1XlIvImatj76NYbS17YxFaYame63tRprTnqnyR0T7rilpsQF7IjrVOarvtPiUtXPR
+DH3nCZutz176RN8lOc3adUHvtOdSR1q7jYtO6x7bzWpMqpIWXyvC2iM6KK1nqeciEiqeTUu44GXt
+VSBQ3+vAkxG9z53R91nlGZG01abvO+NIRL8ln+v7j1wXMWDiFP3Argsikp9PSzM1eDNi0LOT0lLK
+zbMOrpealuo9zjokuGPakCP9rEOvhqcN+yHGOvyn4LQRH1W3Pv9dHf0Lr+a3jvywuP7FgdUiRo2+
+pn+pjTaC+jfRFhCNl0fm3dPD09vLw/Phq/ED3vM4HKtWAvyYtY9rYOZWDyqelflIz5Pet+cOU2HO
+Dq+ZmqjRZMrc6KfD4XAkvV7qB5KkpyRJV9mUjzzEz5POkiS9JUm6S5L0lyTpMEnSY/Z66s6VEpqe
+vfnnpNMsD083uPTxXafjMek4y/6/8e+TrrP3UXQnl7wknWc5dBL/Huk+SdZ/fuEmK+iWsj8NAAgL
+AIDwAAAIEwCAcAEACBsAgPABAAgjWP58lseEFQBAeAEAhBkAQLgBAIQdAED4AQCEIQBAOAIAhCUM
+nlFTeEyYwpSm4lAeE7YAAOELABDGsDyXymPCGpZbO/KYMAcACHcAgLAHAAh/AIAwCAAIhwCAsAgA
+CI+Y0iR78JhwCQAIm5jSFD/AY8Iolle+4TFhFQAQXgEAYRYAEG4BAGEXABB+AQBhGAAQjgEAYRkA
+EJ6BGzO+zu9HuAYAhG3gXkC7pSFjRCseE84BAGGdpCjs5N8nzGO5wZd/TtgHAIR/TGnWB/GYcBAA
+CAsBgPAQAAgTAYBwEQAIG5nS1PyMx4SRAEA4CQCElUJpTDwmzGR51oP/HmEnABB+MqXZGsdjwlEA
+ICwFAMJTACBMZUozYxH/nLAVAAhfmdJU+YN/n3AWAAhrAYDwluXiU/z7hLssJ2zmMeEvU5jkNB4T
+DgMAYTEAEB4DAGEyABAuAwBhMwAQPgMAYTS4T73Mf8JqACC8ZnngSf454TYAEHaD+9TL/CcMB/ep
+l/lPWA7uUy/znzAdAAjXAYCwHQAI31lty83j9yOcBwDCegAgvGd5pB+PCfcBgLAfAAj/AYBsAACQ
+HQAAsgUAQPYAAMgmgPvUy/wn2wBnf7//on1wdiXkhplVY+pWC61Wu3qNWtLWno6YH+bNGzz1Fz/g
+l2yT2Qvc4odSBiZ6cGYu+10MUNYKa+Oz1l5csS5irJvQuQldjgidVzattB520abs73kcjveGA/zI
+tZc0vHCAwMzHW1WaZLcuq2VthOjrsHqySmo0TpEbnRHS1tRXSFTEWDcpcpMiNylyk6KHRor+pRhL
+xKd2o9oNQvRht4lPjVvEpwYTn1be186v8AVWZNtI0QtAsyE94rPpV5u5UjjDeaxVAHzWY42yZPsU
+w+epz0VkdOlly9jeLuLH+lG2H78eYN1YNtGwcUZb69bvAwzb6l+0bv/wtGFHid3WS0Zv2yXHixFX
+Gja3Xfm1vfXqvvq2a/Nei7j+wwLbjdf7RNxMh80x71crph2yaV7/weox7Hubp+lQhJfxF4N3WCFr
+nojvDD5FoiJ8dR8ZfC+9YPXH6wZ/e9+IADXJELj3NWuQtZUh74o+1nyfzzFoX5wekb/dCRvTptCN
+NqZNXUbbCI6L1k+wEQwXL/G0jWnTtYo2pk37/G1Mm55vb2Da9GyYgWlTs3Y2pk1latrKVVsbUb7w
+G7YKRQ9ZK9ycZ6h4aZut0sFxhsq7l9mqrO5nqPrlO7aQmTGGatOG26qPqm7QD3vWFtq7la1Gpwa2
+mi1CbLUiOhvCSu00UPn+2je+NEjLm+02aXmz3CYtb7YZpOXNMgO3vOnVxSYtbsbbWpQtamixf4Eh
+2vOKIXqpwdby132GVjMGGFpvvGSLeXWcrc03bWxth6k2aXFT0MAtbkpcNEiLm90GbnFz+HebtLjZ
+aZMWN1/apMXNezZpcfOCTVrcvGuQFjfPG6TFTVeDtLhpZJAWNzqDtLjxMEiLm9I2aXEDm7S4OWST
+Fjff26TFzcc2aXHznUFa3HxkkBY3rxukxU2SQVrctDJIi5sQA5Wd1/z/vUm9st69H+Ld4fg8A3A4
+PreCy8VLHy8EZj7SM6TQUVmKWeJ+HaLv0DghShV5QxFpHkevtk/hqBNpIEnSQpbb2/GYtJHl1wM4
+6kRayXJGWx6TdvICVv2LPCYt5WhVCYlykbaydLzIf4+0luWv7fnnpL0kSYNJkhYzgXu9jxC4dImq
+sUbTbdohm2y8+cEqG2++t8nGm0MRsvHmFwMAkKbLxpvveEwaL3l5H/GYNB8ASPtZ2vsKgVOTDJKX
+95pV8vJaGSQvrw+PCRUAgJABAAgdXAhcqDTQuEXguoy2ZSVwhBpZCRyhhwuBu1bR5kLg9vnbXAjc
+89JA4xaBe1YaaNwicM2kgcYtAldGGmgQ4shC3Rs8JuRheXOeQQjcNmfjkXEGIXDLnI1H+hmEwL1j
+k4W6GIMQuOE2WairzmNCKFmoa8WSkEoW6kJsQuA68+8RaiFL4xFCL7g0HpGGMrcbjyy3waXxiDSU
+ud14RBrK3Go80quLDS6NRcbzmBCP5f4FLAn5WC418M8JAWUhbgBLQkIAIDQEAEJEJmjDVBtcGosU
+NCBrY5ESFw1waSyy24CsjUUO/y4NRW41Ftlpg0tjkS9tcGks8p4NLo1FXrDBpbHIuwa4NBZ53gCX
+xiJdDXBpLNLIAJfGIjoDXBqLeBjg0liktA0ujUVgg0tjEdHH241FRB9vNxb52AaXxiKij7cbi4g+
+3m4sIvp4u7GI6OPtxiKij7cbi4QY4Gws8u9EeEqWCbtdnrj6ray66qHM0Jr0G37C5AeYsg1NeQKI
+jLxXm2sv53++4s5jP2ruT439qak/NfSnZv7UyJ+a+FMDf2reT437qWk/NeynZv3UqJ+a9FODfmrO
+T435qSk/NeSnZvzUiJ+a8FMDfmq+T433qek+NdynZvvUaJ+a7FODfWquT431qak+NdSnZvrUSJ+a
+6FMDfWqeT43zqWk+NcynZvnUKJ+a5FODfGqOT43xqSk+NcSnZvjUCJ+a4FMDfGp+T43vqek9Nbyn
+ZvfU6J6a3FODe2puT43tqak9NbSnZvbUyJ6a2FMDe2peT43rqWk9NaynZvXUqJ6a1FODempOT43p
+qSk9NaSnZvTUiJ6a0FMDemo+T43nqek8NZynZvPUaJ6azFODeWouT43lqak8NZSnZvLUSJ6ayFMD
+eWoeT43jqWk8NYynZvHUKJ6axFODeGoOT43hqSk8NYSnZvDUCJ6awFMDeGr+Lo3f/647UFIHb6Cy
+XoPMR3omksu4RcZC47zlZtqy1Z7bU6omvRks2fRjInlM05itdkIFljSd2WrHNOffo2nNVrvMSZY0
+vdlqe24S+ftqljTV2Wpvt7CkKc9W++tV/Hdo6rPVnjGLJakAW+2RL7MkVWCr3bUnS1IJttoNIlmS
+arDVLlmBJakIW2vvZvw+pCpstY+UY0kqw1b7Bx+WpDpsteceZ0kqBACkRgBAqgQApE4AQCoF3i4r
+1XtItdhqP/OOVqz2B86qScNF3njJWTVJqveQyonVluo9pHpitaV6D6mgWG2p3kOqKFa7pFmsdhln
+1SSp3kOqKek1Ur2HVBQASE0BgFQVAEhdWZ45qhWr/q3IzRlaseofsiQVFqsu1XtIldmqfybVe0il
+AYDUmq16pzdYknoDAKk4AJCaAwCpOlvzqpVEXtKzJLVnubsAj0n9AYAggK36Tqm2RVDAVn3ZKZYE
+CWzVp2xmSdDAVv1FqbZFEMFW/bmNPCaoYGvecBGPCTLYmpcyizw2jSXBBwAQhLBVPxDLkqCErfra
+QZJNP7YhjwlW2Kr3eYLHBC9s1ds1YEkww1Y9tDRLghsAIMgBAIIdlvZDPCb4YWn7nscEQ6ysaR/z
+mOCIrfp7a1gSLLFVHzGX5T8EnvwjI2NDatDTZKTDqumr16waE6rnI1k/6hKk8Uj1AVJ97muk28dm
+49NlvWkA5yoYvQgP9ioujcmnGOXx5u4UACnI7hR95BSpmmZuuvzP/Li09Dd4DabZ728yZu0d8KA1
++X3eKs41+f0WTrG7a/L/92ryP6Yd2VekORk+Mnm+rE3T83cpUatBdnss7qpMnGD8cWnXmvmvwSQ1
+8zcZs6v1/qhrk5OSIkttclJWuGuTu2uTu2uT/+trk/+rADq7GuJlK5QcnccPyJNtCMYbQGTvEabU
+bJhapjf7gfM4SAGQMXmZmnH1Bdv662fVH9cds23YsMi48edKtk2f/aJuXnvBtmVCL9W2YIRt6xij
+uu2NhYZjtXsaj10IMZwIWGE8sdNoO3nqqv3U8gE4veUl+5lp53E2banJPqKccm7iUfv5Zzvj98HB
+pgv1xysXO8wy/VE6v3Kp9jb7JUckrhTLY7ryy4+4eqWu/dqaG8r1favtNz4ardz8+rzJ8doXwMxy
+dk3v44rHS51NntGl4BV/0ORdZQ7yNMtv9wncqfiWjTT5nvGDv/dwu/+WBkrAbzfsgUu+VYLWh5ry
+TrqIfJ8k2LWpFZX8b75rKtChKwoOLGUqVOcXFG5rsBcpXkgpaplsL3o1CsVNH9qL73tBCb7QwF5i
+NZSSO02mUrNqofQXH9qfUHorumm7TU/GT8dTz3c1lWmuQ9keFUzlyrVXyjf4zlQhz+uoWOoPe8Xf
+vlIq4wV75R8bK1V+WWyq+ulghKw5ZK82/mOluqWgqu8XaAt96aJao+UZW83uu9ValW22sMYr1Nr+
+n9vqVNxlrPP7FkNd33Rj3e1LDPWOvW+sv3SyoUHGSGPDyUMMjeZ3MzYe3MkQMfF5tUnXwbamg7qq
+zRp0sDVv10iNLHDD8HSoTn1a7WuIyu+hRn2Z3xZtP6y2fGuFodVPGmPr+FW21uPi1NZzrxraDwlX
+2382ztb+p6+NsWVHGxJa2o2J9bYbelXeZuxd5qytj/8yY5LnVlvSiXeMfX9dauu3/XO1/3dTbQOW
+TlEHfjjMljx5qGp6pbNt0OA4NaX/UMPg9vXU1NZxhiENOhmHVq1nGFayrnF4YEnD8OsljCNO3jQ8
+v++G8YUNqmHkyp+NLy67bhs1/VvjS1MO2v6yrcX3i77m5G8ApTpA3DEvdsf85JjXuzMds2wqdNxV
+y3jr1qqYT0mStrG8+gKvPJHW8QrYumM8Ju0jSRpIkrSQJGkiSdJGkqSRJEkrmYG9sZBXxkg7WV4I
+4TFpKcudRmn1f+qqdK9ZPkB2a255icekuSRJe0mSBpMkLSZJmkyStJkkaTRJ0mqSpNkkSbtZOiL5
+90nLWf7yI49J25khrrnBv09azwzxo9E8Zu2n22tfyFWcWU4YYu/jCgAQGgAAIQK4UsZBHhMy0I3Q
+AQAIIQCAUILlGT/QjdCC5ZYG/HNCDQAg5AB9WetDhSFOugi6EYpIbK8i/5zQBAAIUehGqAIAhCx0
+I3QBAEIYACCUYXk1CnQjtGG57wX+OaEOABDyAAChDwAQAtGNUAgACIkAgNCIGWL8dNCNUAkACJno
+RugEAIRQzBAbCCMkpKIboRXL377inxNqsfyxMY8JvQCAEIxuhGIAQEgGAIRmAECIBgCEagBAyAYA
+hG4AQAgHAIRyAEBIBwCEdix/32IAAEI9ltuX8JjQjxni0sk8JhQEAEJCACA0BABCRAAgVAQAQkZm
+iIO68pgQEgAIJQGAkBIACC1ZqrIiRKjJ8sv8/PuEnswQ31ohK3Y/afj9CElZjovjnxOiAgChKsvP
+ZEWK0BUACGEBgFAWAAhpAYDQFgAIcQGAUBcACHlZnhCmRAgMAITCAEBIzIxs6RQeEyIDAKEyABAy
+MyMbLOdHCA0AhNIAQEgNAITWAECIDQCE2gBAyM3yegkeE4IzI9t3g8eE5ABAaM6MbNl1fj9CdQD4
+9yB71nBbrWph1cKq653htlqZ+drnXuvZvLsf0N3vfmlLkUkpiTnY5XvYeSzd8csvnTh9V0D+GVv0
+lg/mfmeZOeMDy6xpwRbLq1/pZg+9bpmTOEP34QsZlrlt0i0fNb6m+7icUTevwlrd/MpVdfNPXrZ8
+gu2WT3YW1n163K77bF1/3cL123SL5rXRLbaMsKS9rtctmWSzfG5qbVma8rllmaGaZXn0s7ov9FrL
+lzWGWtLznbesKBBnWXF2h+Wr49Ct3HNO9/W+TrpV6dt1q5fctHzz7nKddXoJ3bfDp+nWPH9D913c
+CN337eZafgjvoltb71vdurhdlnU/+Vl2RKu6naWftOxq85lud9WKuj3V3tbtuTJV91Nek+6nk6d0
++44UtezfsFl34PsrloPLTlh+XlREp07ZaPklJcpyqF+q7tdnKlsOx5otR2oEWo42XKj7rUQl3bFC
+sZZj1wJ0x28M0p346bTu5IH2ulNfbdGdXnrVcub9JbqzL/e22Ge9qDsXH205/1J33e8te+kudG+i
+u/hkkOWPxmV0l3DWcvkJb91ldavlys3fdFetSy3Xjnlarr/wqeXGupUmT9NanVelOJ23Yb4uj189
+nY/+DZ3P8ZI6P21/nV/GTZ2/vY0u4PNgS6BNrwuaeN2SN62ALt+ggxbte9Us+dtZLQVGaC0FQ+dY
+CsWdt/DqZfhaXr0sVmy+rF5efkNWL3+ZJ6uX346T1cvZ/WT18uUYWb2Mry6rly1bmXn1snIIr15W
+8s8nq5fHz8nq5frtsnq5YLmsXo6b5l69fNirl1lLZXo/7LvvY7HvxeG4mg/gRy/A9wmNL5BXqwkE
+3bR87AsAGj6GrzwSbjaPiUV0+1g0bR+LFjGx0vdYk7n5BRoNcoN91P+/01b/c9z/v/zSiSx3BeSX
+CuJ6i1QQ/44lYSIHKKdJgTvCRg5QDpVCb4SRJAknSRJWkiS8JEmYyXHICmt1UpetqsiTl/n3CENZ
+7izMzxOWkiQ8JUmYSpJwlSRhK0nCV5KEsSQJZ0kS1pIkvCVJmCvFarQ8JuwlSfhLkjCY5dkdLAmL
+SRIekyRMJkm4TJKwmSThM0nCaJKE0yQJq0kSXksxm7kWKWbThceE3SQJv1n+5MeScJwkYTlJwnOS
+hOkkCddZXpnKkvCd5clTLAnnSRLWkyS8J0mYT5JwnyRhP0nCf5JkA0iSHSBJtoAk2QOSZBNIkl1g
+d6dQLD9P9oEk2Qh2e346zZJsBbs9X21hSTaD3Z73l/CYbIdUAH+Rx2RDpEBgdx6TLZECgU1Ykk2R
+AoFleEy2hSTZF5aqFEgkO8Nuj1UKJJK9YbfnhU9Zkt2RgPdaHQCQ/ZGA93yWZIck4P2GyONSWYFs
+EssMqaxAtgkAyD4BANkoACA7BQBkq9idGSSF1MhmSYKhFFIj2yUJhlJIjWyYS6pC+FqXVAWyaS6p
+CpffcE1V+GWea6rCt+NcUxVm93NNVXg5xjVVIb66a6pCS2k0dCtVoXKIS6oC2UaXVIXj51xTFdZv
+d01VWLDcNVVh3DR3qoI7VeHxTlVwc5H/i4v4R7ePDQltEROb6c/pM/MpJNVx0qEnJswNAuYG3TdF
+IbVn9RYxucqkkEM6sQd7lT+dN5/mx7W6fXr/TAq/26dZRf9POdHQ3GWZ0FpaiD4UD/Yq2qAU2ki+
++hsz/N9NBZCa7an6Zz1Vfe7edbARCAnV48Fe5d8gJFTfKFSua8mYKnWGABiS85PN5YVNNNDbhuLB
+XkUnG9ooVK5sgyJTg3J5sjVy97adIuhta+DBXkUnW6NRqGxHe+qi5577n2zWGZvLc/1VpblXAw/2
+KpqxNRrJqabW/LTB/Wds1lOtmbs3pY0DVNDgwV7lzwUPavKpat98f0XuTrVW7t50nRVcf+HBXuWs
+0MCnWqHve4tyd6phuU+Mo72SD/Yqf95NKQl1FZZW3pe7U62duzf91ALe3vlgr3Ju/+RTXVTyzZdy
+d6p1cvemxJVoF+qDvYpOtU4jqdT249yvrubuVMNz96YTFHrTcDzYq+hUwxuF86laD45vl7tTrZu7
+N33ZRG9aFw/2KjrVuo3q8qkuGxiyPVenGvJPoSwhobl7y7xaoEouKcvtV/k3qKIPbRQilnXRH0c9
+cmVZQ0JzeVXHW4AqueQst1/l36BKqL5RiJO0DLn5fNOhAIbm/GxzeWnfT6P3DcWDvYrOli6tXNvG
+i3/0y+XZ1sjd+8630vvWwIO9is62RqMQJ23ZEduwRy7Ptmbu3vcLG71vTTzYq+hsazYKCRU6cLHs
+1ja5PNtauXvf71V631p4sFfR2dZqFBIqjOBHR8qI+59tVkTI5USgeF4VfQ082KsIEWo0CpF5sGlX
+3+65oq8huZwGlfX0rjXxYK+ic63ZKERmgbnN5tO5O9dcToLwCHrXWniwV9G51moUInMg4J2Uw7k7
+17DcvevTBnrXMDzYq+hcwxqFCC2crKz1yd251s7du8Ya6V1r48FeRedau1GI8EK7peWzuTvXOrl7
+1x4metc6eLBX0bnWaRQixHBh+JljuTvX8Ny9q0mhdw3Hg72KzjW8UYgww7X5a0bn7lzr5u5dR5np
+XeviwV5F51q3UYhQwz2/995w/3P1vX2ujzUzDMo8z5TExIHDknr2eZxPNjOS2aHZA0QyO8Q2w4O9
+yr9DbDM5zat9q9XOxWnGNnucTzPzq+8wMGloYsrg+P4P9raP5mQz9enZIf2HPM7nqQUQOSQl2ZRY
+vckAqnmZED/gHu8c5PxPt2XO4yc5nSqw2qnpO3d1nbE3PfCDud8EzpxXLnDWtCKBFnOT8bOHXg6c
+Yyoz/sOOPwXONXiP/6j214Efl+syfl6Fb8fP7/br+AU+H45fcObZwE9+e3X8ZxUOBX62rvf4had7
+BS6aFz1+8eb3xqe9XmX8kiWlx39uigpcOumZwGWGyoHLB786/gt9YOCXsSsC0/OdCVwRMXz8irO2
+wJXFBo1fuefU+K+vfBS4Kn3z+NU/7Rn/zbtp462rnhv/7fBJ49fM2zB+zf7nun4Xl2r6/qXvTT+E
+dzStNV6yr3syxZ7R/HPDxt9aaTctKGrePKeZdsukWlrb6HLarSlFtNvaRJjdxRT+G+lI3Bohh3cf
+Lurm7+vH94DAv+sOeE0BgAJzNV587Ot67JWGQKCAVVMg8zGvFsXIxdGUdH2k57lzb/tYNImJRRW9
+voaeAiM19GgWGYtmkVLWhJf95N/t2z3LPNwVOBwOh8OjQDvuyk8AwnLnLu7qT0AiuUjfsCRAkVyk
+IiwJWCQX6TKPCWBIEsiQJKDhXKTaXwdKLlKX8ZKL9C1LAh6SBD4szzzLv0cgRJKAiOW63jwmQJJc
+pGgeEzBJLlIVlgRQkosUxb9HQCW5SJVZEmBJLlIgjwm4JBfpDEsCMJZnbTwmIGO55xRLAjTJRdrM
+YwI2yUVKY0kAJ7lIk1gS0LHc/1xXyUlK5ZR/Aj3JSerIksBPGmylsCQQJElASJLAkCQBIkkCRZIE
+jCQJHEkSQMJdtsNdtsOdC/OvyYVxG8L/wxD6N4uMDQltdrugyK1yIhKb++LGtWXdAoBuAffraZ/J
+zQcmJKekxOegykB/53F+BcCOJvvgZp7/lET4HOvb/30HNP0hN6/MNK+SOk3g7WSvTjzJs0707Bje
+XSegw+FwjKq9dIq0Qd8Ht4V2W2i3hX6Y2ar/AMC4nyU8uGJ6u815gM15chqlGpyaEt+vzz1bSiPL
+xpcmzmPtJ8UiU5fPDTAUrvCetUixzfqivpsiil7VpBU7AWvxfWH64I2vWkt8PUkt+dkraqkZqWrp
+8YnqEyM7qrr+LdQnu4arT7WupJZpEKyWrRqglivpby8fcM1ewbuCqcLFYqZKO04ZK39/0lRl2X5T
+1bmbTCFTVpuqjVlsqp5qMekTJppCn/vaXiNlmr1mw5n2Ws+MsIeVUuy1a3Sx177Rwx5eYrgp/EBz
+e91rz5rqrSpvr7+vganBx81MDVeWNjV6rZyp8XSYInr5mJqMKWlvGnnc1KznTXvzchtMkc1U+9Pe
+C00tyqyxtziz3h7tOdcevflTe8tfX7O3WvSWvfXGD00xbyfbY1uXMna8VMjEZfBv1UjNzEimfGCv
+nKUNe/l4eQEhgYylelkb0/jyal5g5mPmM5rAzN+h51sze6rJ62i07pfJmjS5+f7YOS8WmcrO9NwA
+AwDQdwkA9H0CAH2nLK9qpMXGCUiF5n1h/HP6jsVZnKSKKXpFFVOUqoopSlTFFHVUxRS1UMUUyf5j
+mgNiioJVMUUBqpgifzubooBrdjFFsj+c5gaboB2njHzZvj9pEhO03yQmaJNJTNBqk5gg2RdO80ZM
+0ESTmKCvnRWCpFINzSMxQSPsYoIUZ4WgLiJv9HBWCJJKNTS/xARJpRqaZ2KCpFINzTcxQVKphuad
+mCCYxAT5OCsESaUamocAQHNRTNAGk5ggqVRD81JMkFSqofkpJmiuyM2f2sUEvWYXE/SWXUyQVKqh
+eSsmpZRU+LlUiJ9/HOewyyJ2cr/okLe8gLe8soOyoCxQltonceDge+iBv/M/3d5wHgdztF15Ulkx
+8ri6Yu1i2/rzPdUftfMlcv1Ja/vmOcXULZOTTD8XnGn8+Ut/g9+pbsaAYheMAZsWIXDVLATN7aDk
+Hf+ckm9MHUXb97o2f8IL9gLPfGIv+PRoY6GaawzuKPa/xJfg1iZe3l6et7q/sd44PfRHeAeKNGCU
+biCJKhomDghi4pCfjjWFXZ+/fdw6RpzvW8UDsvILpwreN/h8Vz1yOByO1MbvNidJ+sTB15HHuc4L
+6RVJ0i2SpF8uQdFPWtslKFqMf076RpJ0juWX/gYAIN0DANI/lpsWAdxaapYAwdwOCgCQPkqwtA5L
+0ksJlkrdENJPACAdlWDpGoM7WOp2xdyu2GPkiv0H8fb+RZOf6T15/i5fYJdvDqOcTRP794offK/6
+elk7Pp93HueXci4LLdN3eev+yeVcfixS3vzjtM7mDVtLmzcOq2/etATmzcY47RbzIbNtYDczLbVt
+vVZDuy3ppm57z0q3yr/sbHrDvPNnVUtlA3bd9LS4y8C4y8D8ExmrV65rVnneusso56/09rnzyUe/
+E5tCGQBvuPbKxDcJqclxXi28sv60dcztrddNmxMoa5ytALMnwHeFTYfD4Qh+zm+DVIJZyJUyCELd
+lWD+vkowZAJYTuvMkkwBOx7D6rMkk0CSzAI7HuZDPCbzkDUbg8wESTIVJMlc3K3SDJkOlj+r2qyV
+Z8iMuCvPuCvPuCvPuB1ItwP5aBxIN/35v+kPb85oetsbDc30RtkX1V3Kt8yQFzDkzc4Xzefii/ZO
+iU+4V23RrJ3shzmPudj7jx5h6l0TyWeP50Ryd0j9HxpS9/pbOoYDBcOB2+ogW1M1vq6P9HzrGJeA
+ze2ktHt6Bnedww6Hw9GtgT2KmahHmJqrfODZ413ygd0haDeDcDOIRxSC/gfj0/2ShDatbfmd4gMo
+PjlcWW+amNI/aWAOSuNOcB4XkH1sRY3Td/5kn7F3kfmDucvNM1eYzbPGTDNn+A0y32tv2NYv3sG2
+uNXKttlttdt7ljDvaFXGvLPuVt3O3r+Zd84fjF2Vlml3Vw3W7vF7R7vX41vz3uPDte49Y//mPWPe
+GnrMw49ePr6P/g4EjgVY9UQl/VyPK+s1QZmPt58X9WyfqaMxmUl8WQs85kahHA6HI3lO/GXZ31WU
+l8ZJuWR/1yKzRBiXsyRF4wjjmGksSeEeZB8SKSPzj7jVvKRPSikRsBL8elJOkqSgLHv/JuP5g/l1
+pKxSezmYJSktSVJclsfFrrv3Lbl5kJsH/RP2Lf2Lwfh+pKnWxHf0V32Bq9muvufPQppS4lOTBveP
+Hxqfg5WkCS4L8A9AnLb18NFuV0qad0Rv0+6YvEy30yNFt7N1uNaFMGlMWsJc92b7/8hme2/n+O+p
+jQ0EZaurQDZa2iKLkuaCL5mdx4UdDoejdPQk5kv9t5x4pLyJdJF5klJSeFL0Nh6TXpIk3WTZOlyb
+LU/SmLRZeZJ7v7ebN7l50z8nhdG1X0Ee59jH9+8hTgLGQWNvh5TyahHkegxo8md9hiDZcBdYzorL
+OeBOR+p+ar/hC9zwzelqUcqQwYMT+99rM0eA8z/d0p3HRRQA7zaPVqYHljPdjT3N2nxZbzF+ZJ89
+aqVpTrUC9g+fb22fG/ay/aNuZUwflwuzz6sw3T6/ssa+wOdF+4IztUyf/Nbd/ulug/2zdU3sC9fP
+sy+aV8a+2NLWlPa6t33JuH727FIY0x2/SgrjrjclhTHjkKQwzhrnmsLYZ6ykMDaufkcKY0bL4ro7
+2J4zdZFWBLZPjNCSB+4upfRf2dAudC43fU9uU0E//7/rDngFQ2532et6+1iTP/N3MivG3nM/bKfb
+m2I1rlnU9+KIgc7/dFvhPOZtKx2/+PkoyQHHMkCSEES4YjlTbrgioQtJQhiShDIkCWlIEtqQJMTh
+bL5uZUySzRdml2y+6XbJ5tPYpZbSiyLP1DJJLaXuPCZEkmy+JnbJ5ptnl2y+MnbJ5mtrkmw+bx4T
+Uv0/2XyEZC7ZfLvedM3myzjkms03a1z22Xx9xrpm8zWunqNsPkLDHHHxP2XvEVIyF58Yoc2MWcJd
+W8nNtd1c+99UuUHItV8uGPFtYu4f8HfdM02jVzC8siRqBbLx0/75WFM483dupXc5f4eTue5lJO+w
+ktDcn7HH7/lk/5AAYEhADutINB3Ss098SmKOdhuNzRrs/DJtm++KRr8bV5ydYfzq57eMK7eMMn6d
+sUBdlf6+unr+m+o3745UrWMHqN8O76au6dNW/S4uQv0+KlT9IfwpdW3Fguq6Yl5q/oVbjQXaq4aC
+MQG2QjVtxsJlDihFCm5Winp+oxT9PU0p9utspfj2SUrwd68oJb5ejJKfWVBqxkSUHj8aT4wcDF3/
+BDzZtQOeav00yjSog7JVK6JcyeIoH+iPCt5hSoWL/saKh68ZpTbFAWOVZZuNVed+YwyZkmasNmay
+oXrqJKM+YYgh9LlFao2UF2xuyvuQKK/3nzunk77eu6+609H+CyKZxYazUR8Or3vt9vvzzr+mrm3a
+c723ZGzWvSX9t6i+zM7StrEkhRFWNoMlKQ6zsi2jjMLKFqjCyt5nSYokrGwkS1IoYWXdVGFlbVVh
+ZREsScGElT3FkhSN2VgxLxV0Ugu3GgGAlE7YUYBN2JHNKOzogCLsaLMi7Ogbkb+nKcKOZivCjiYp
+wo5eUYQdLYawIwuEHU2EsKPREHY0GMKOEiDsqAOEHT0NYUd1IOyoIoQdFYewI38IOwpThB3583mS
+UsOlqMgBo7CjzUZhR98YhR2lGYUdTTYIO5pkFHY0xCDsaJEq7OgFm5sdudnRY8WO/q0gev8d046v
+zpQf6wuMzXHccUhCvOneJCZrhu+0rFnq91qxtbz/vXZ2wgjzHFOw9sOYd7Trf19uvOsK7nvD1W1v
+rDdtN3xs3tGqsLKz7neyclv8I5dUt0Nz5tt/bXfeeHj0PO2R0Jvmo6k3tb/lV83HYlXtsXNrzAVj
+KtgL1TxoKlxmi6lIQaupqOcSU9Hf55iK/TrZVHz7GJM75vcPXNF1birhMJ6GNdTT69azt+6PNks1
+/+HsVnbvsaabteaBRpMbHXM4HI5+RSfOeJBVXNJBjsgljDBLdXNZZSV9JEk6matV3veGMxcifSVJ
+OiuRpcKKZMN957qqW/yje2a/kSSdJkl6zfs3R8/j50m/SZKOkyQ9J0m6zvLcGmdkqoJduNdBk3Cv
+LSbhXlaTcK8lIn+fYxLuNdkk3GuMyR2ZcnMvN/f6i7Pn/qX4fb8QUutNi1pE+AERfjkmX4OTBvZO
+HJiDosjDnMeyRbBoX/x4aBA2XGqNjWvaw73g+a8hP4+yJm/mPTMEk1OFyI7M5HH+p9tw5zEHdMra
+TzBZGLQjz2u8NbBoX7A8NIglzWUmJWvaw73c5DbqbqP+mFWn+1Ny16O4ZwJTnqv3TuHKDJpkhSjB
+qBxY7BEbNR8M8QGG+OTQYjfrkzQ4aWB8dh35tE75lvO4vAJgZr9ZgQ9j3Sfj68nGH4e9eGenuTZm
+Y+EK82xFiu2PKOq7z1D0aj5rsRN5bcX3NYsI3jjZVuJri73kZxPtpWaMtpceP9j+xMgEu65/B/uT
+XZ+2P9W6jr1Mg4r2slWL2zNrk5dv1lqVdaDySsUjRRVZB9pikHWgJYZ7rwMtRK1nxiKs1ATUrtEX
+tW8MQniJ15TwA+1R91ofpd6qMNTf11Jp8HE7peHKqkqj12oqjafnVSJ6FVaajKmCppGXlGYJQWhe
+bq8S2ewsnvZeqbQosxUtzuxBtOdSRG/+Ci1/nYpWi2ag9cYlSszbo9Dms8lK24FGGMYPUdp1Gqk8
+07+T0r5ONyW2dV2lQ9EIpWPVEkrHS08pcYXDEbfbS+l8MRjPfnFU6bLjOrpaPPHcsoPo9tIRdJ9i
+hbH7OsS/uF/p0XgBej63Wkl44k0kNrQovXznKb1LjVZ6Hxun9LmRoCRl9FP6Hnha6Tc/Rum/ticG
+jK2uDPw4Esl98ium1ypgULtqSOnlh8GhWqRGnsQQ7XkMre6jDLXvwPC8x5Xhti8w4swG5fm0d/HC
+5oXKyPeWKS8umqCMGvGO8tLbgxSNh/efrXQeXuvNXEkNDLp9z0M//QvZM1DCl03sBXhxNNOXo5a8
+UIv8mY/yTF4tCrN6Fs98DI/QlKaf4qnMx8xnMv8CM4w/xz5vrerGtG+OmPatkDUaSg+5UUHJe8rg
+nHhSxcdlZYkkqTQToWEv5qx3WhuzrDhVmGcDl6/fL2XrffcZWF7NZwWXr8/LPycI4G9v42SbECSL
+XQjSRLsQpNF2IUiD7UKQEuxCkDrYhSA9bReCVMcuBKmiXQhScTvuUr6eoMN15YkREAQjritPWwyu
+K09LDA+28rQQQpTGQojSBAhR6ivyxiAIUXpNEaIkBJPgSIhSGIQotVSEKLVThChVVYQo1VSEKOVV
+hCgVVoQoVQG4nP0lRYhSEIQo7VWEKJ2FEKWVihClrRCitAdClJaK3PwVhChNhRClGRCitEQRojQK
+QpQmK0KU+HIw3AlRGqkIUeqkCFHqpghRqqsIUYpQhCiVEHnpKUWIUjiEKHkpQpSCIUTpKI8JHoUo
+eUKI0kEIUToCIUo8zRgyhSjtV4QoLYAQpdWKEKU3IUTJoghRmqcIURot8tg4RYhSgiJEqZ8iROlp
+RYhSjCJEqSeEKFVXhChFQogSZ1WAYFeIUjUIUfKDECUthCidZElQLETJh19HkCxE6bgiROkLCFHa
+oAhRehdClBYqQpSWKUKUJihClN5RhCi5YTsHsH3HGtafKFniW/l6d/QDOvrlcNdhs2RT4sA+8fcO
+o2SNr7/qsoZVerxx+s7VtoxmnUz33GVYVmvePuJV7Y4SC807uy3UUq3D3S3Wm3d/s8ZdhuFfGH5x
+Ud5HWwlF7kDAxj8HY7KGZP6flaas2TavZc22SXptyzKSgwLP1iJJmsFy52puZUAa8kD7AstqJRd5
+xKuSi1xiIY9Jk7JWDiWNYvnNGnd9BHcwyB0MetyDQS5xmr9nq7XAZMDGP4eGsgaIXMNEze4ME2XJ
+rblPqOj7Np2e6uwLdPbNYQmp5kN65KyE1GHncSFpRbB04vRdAfn/ya0IMlsLUD3o3VUr6twtBdwt
+Bf6Z+w+zVpZ42Hffv6dGxZ/uDsfVfAA/egG+TwheOqvoyiYMXwBwbrzwvb0/sXlMLKLb366p6+xo
+d3uPNHKDfQ6Hw9Fpq/856SewdCLLXQH53f0E/r5+An+u959Z358w3V3f313f313f3+11ub2uR7Xj
+081F/h8u4h/dPjYklCr8Z/p1t8r8s3s36dATE+YGAXODsnPvsmz/bJHUIyW+f2p8Sg5ibWudx8Hu
+ZnN/TbM5d+Td3bztjrv3o+9IcpWXTLPpSHKrgSYd39GR5Nbi2QN0ZruFMU5Pysvdmc3dme2f0JnN
+vZ7j9izcnsW/rHPYv8j23m9h6JPoc47rAcD1gBzmELcYkpgycHDiiBwUbjvnPA6e+vHEX/4NjkPG
+6Fn3TLXZOjtedXeodi8n/VudHM9cA20eZ3SGBz65eKFfwJ1PPvpiXw7HjVl/xuQ7S37deiycBZ/v
+2bnxFkxntnDkf7fxOlt/6a7Q6nA4HASvbn/p8fGXyEw8UP+e2fGqu4O1e4XLvcLlXuFy+6H/LD/U
+TY8eGT3KvtP16J/zNp+SF5iS007XUYn9BycN7JeUg5IAQ53HvGVn7uDkEhs2tzNutB65SwFT90rO
+PzJnzqWm3qO6A0UTAX70yq66XpRrdb3bG8VzM3UdDoej/VflpzPBH5xcgiRNY5I0lZGjcqDuALeb
+WLiJxaOrXvGPg6T7F/u8cnTBuv4+QP9sq1cUuG2oowf3T+ye3Kt7THxO9kqedx7nd2etuAPQ7gD0
+v6S82MNe6bvH3SvP3RYFH/Xd4bi26e6rgtk5UZm+062ObxpNbuDS4XA4um7ZU9MdUHYn4LgDy+7A
+sjuw7Pb/3P7f31qS2E17Hoz2ZB8oLqUor0bkBSJyGiiOHpwaP7DHkP45aHKb6jwurgAwv3nxXWk4
+sdo2J7Gn+uELow1z2yjGjxovs31croI6r8JLtk8qVjR+8ssN26dXxqmffRdqyHi5sbpeU9CwvuVF
+44+bFcOGDYuM2/r5GbbPqWvc0czDsLN1kLqr0gu23VXOqnv8nrPt9Zhm3Hu8se2nk0vVfdu6Gvbv
+sBkPfN7IcO7CCuOFHmcNF/vkMf7xRLgtz8DJJp+675t8rg2BX/GRJj/bBvhf6WYKWHJTCdwbYQqa
+20HJ+/FCU74xdZR8l/Mbio9LMxXfn6aWOGqzl1x71l5qeqgpJxUYK3hXMFW4WMxU8YifqdKOq6bK
+3580VVm231R17iZTyJuv2KuNWWyqnmox6RMmmkKf+9peI2WavWbDmfZaz4ywh5VS7LVrdLHXvtHD
+Hl5iuCn8QHN73WvPmuqtKm+vv6+BqcHHzUwNV5Y2NXqtnKnxdJgievmYmowpaW8aedzUrOdNe/Ny
+G0yRzVT7fR2lCQfMd3WUwldrbzlKjWayo9RpSzXDSw6HQ+PpkZu7l7eXZ27yAH397rh7eQElKgJA
+iT53xmKQnytbFc6uQYukC0ZnjdRU0ddEFX2tW0sqLs3mkJsJ7qxNuIckTXTXjg9Sf4cmvbgBow3i
+BihGcQOW2cQNqKCKG/ASj0kZWP5yg8ekFEz7vwvl15NykCQFYdnyotQg3KzwmJSFaW4/Px6T0jDN
+beZhkD7+Qap0fHiB/z4pkXR8eM4mHR+m8e+TQgmtXco/J8ViWrvDxj8nBWPaeGEFj0nRmCb2ycNj
+UjgAIKUDAFI8lteGgG6kgCxtG0A3UkS+0EtuKgBACgnufdqBx6SYjElj6vCYFBT0JYxL4+dJUXmi
+HLVJjcS1Z1mS0uIh1kgk5RYaVswkYXg/lqTo4NqIJ01Cw/abJAy/iSUpvoThF5uEhllMEoafaBIa
+9rVdaNg0u9CwmXahYSPsQsMUu9CwLiJv9LALDRtuEhrW3C407FmT0LDydqFhDUxCw5qZhIaVNgkN
+K2cSGgaT0DAfk9Cwkvw6AhQAIFARGrbBJDRMtT8UGjbhgDlXNCx8tfauNKzRTBcaRmAFAP8ZwPJv
+UEVfs1GIZDSvuVBn1XQfYLpPDkvdtExMyWU+878lpOzOZ3aHk935zH99ws5d3svf81HfHY6bef7y
+hB1NloQdzYPmM4/o0xDu8LM7n9kddnaHnd1hZ3fY2R12/nfnM//H6VH2YepOC96qcP985ixpUq3i
++ycNTBrYOyU+IZsmI/mc0uA8fiq7tsgZfoPM9/QTRw5Tt04dY9z2xkLD9s5fqA+nj09xbgHv0sNn
+p69S+fsrSpVlJ5Sqc/cpIVM2KtXGrFKqpy5S9AmzlNDnNqBGysf4O3r4xLauYnLtfZfHO4+vj9z9
+/J33gMCc34MCgYLhyKbnY2W9hgsF01cfHqEpQs8gOPMx85nbv1NZj9Jy7FJ5/VaEJbPjAUVasuTx
+0S0308jhcDiS53SJepDOvzTNHohnjhwmPHPqGIn7vrFQ4r6dv1DxWPWaKQ7hFf5Adj1mdvoqEke9
+ogivOKFIHHWfIrxioyJx1FWK8IpFisRRZynCKySeTOqA/1CPmX+5GkpKLRuFH7pVabnTG9jpnZ1R
+CMhiFJISh95DjfPhthqPyGoNPik+L2H53OLGHzdVs2VF/82b5pseare2t3RqzhHeHQF7eAWiPYmz
+efrIPWsQ3z+LCuR96HegJCtwyRlS/V5a+0AW+IVeFcp8DI/QFM/8aeaxptTtV7kek061isnUpFau
+/XxYx3C7o4/LKkK2kaq7qoisfK5LIEmqQpLUhVciN1WzZWfJSIXwOHdBe0un/jUWye3puj3dx602
+rRsG/wSD99+8821U6LIoXyAq234igVkJSEryvRiIv/M/a6zzuNhs1FWWzw0wFK7wnrVIsc36or6b
+Iope1aQVOwFr8X1h+uCNr1pLfD1JLfnZK2qpGalq6fGJ6hMjO6q6/i3UJ7uGq0+1rqSWaRCslq0a
+oP45K0n6wWbJSJqy2vRoMpIWmlqUWWNvcWa9Pdpzrj1686f2lr++Zm+16C17640fmmLeTrbHti5l
+1GRZjr/dpCaHnWp8fYBq7wI8XbyApw0aX5o0CMx8zHxGo+Xf4emFQnJMU0cW28N4wT2Tj0rYQuMM
+YMgtN18nr8ugriJWMsAg1u89q1i/zXqxfpvECl7VpIn1g1WsX5herN+rVrF+k1Sxfq+oYv1SVbF+
+iapYv46qWL8Wqli/cFWsXyVVrF+wKtYvQM1Jfsvtnp/3yGuZstr078prkTwjmqpi/dbbxfrNFbn5
+U7tYv9fsYv3esov1+9Ak1i/ZLtbsHzOV/WPat3Lmjwwpk95mthcw2ys7aMvqW41IcvtWbt/K7Vu5
+fSu3b+X2rdy+ldu3egS+VZYE1tZJg3sk36smQpDzP91+cR5zK/VJR99WprcaYJp+c50yY8Y15YP1
+r5hmjqqOWZ98Ybd076HMfvOEaU6T35QPByab5pYpgY/azrF/4n3W9Mlv3e0L18+zL5pXxr7Y0taU
+9rq3fcm4fvbPTU+ZlqaMNS0zeJm+rNHalJ4vw5TuWGFacfYT09cZX9pXpc+3r57V3fTNu2/Yre/b
+7d8O729f0+c5+3dxbezfN/Y2/RCut6+t9459XdxnpnV7fzat99xmWn9OZ/rxUA/7huXP2Dec+lDd
+qLRSN+6fY980tZZ903etjJtT5qibf/jGtGVIEfuWz3qrtmfGqLaPZpu2xl62bx0frW6r0Uvd9uor
+pu2dv7fviF5u31l3q25XpTW63cWX6vb4zeXE1b3HX9P9tHeYbt/6Prr9KzrrDnw+xnJwzhDLz5N6
+WdTRnSRxtUddCyeuNi0hiatP3rBw4mpAsI4TV09cl8TVHw9K4uonVh0nrr4xR2d/frLuXN8xuvM9
+F1t+b2+xXGg20XKx5mjLH2UGWy4VTLBc9uxgufz705Yrv9axBH5aGUHTSyDvm4HINybSrE2tbc6f
+UMFcILaY+Xa/7j/XmpqtFN8+SQn+7hWlxNeLUfIzC0rNmAh3ranHmyLCZbPdnxvv/+nu/af/Ob37
+3OUP++bJcncuirk895DuDselni4ZFX6uxyV1mqDbz6NA5k/luKROUyTr7xPudiJ47RRJ/7NmV9xa
+IbsNtwK9mtxgosPhcHRrGFiKJGEjScJHljfX8ZhwkiRhJa9gj6oOXsH+5Ate4SbcJEnYSZLwkyRh
+KEnCUc5AbTuHf5/wlOVv3e2SUTrPLhmlZeySUdrWJBml3jwmnJWM0qdMklE61iQZpV4mySBtbZIM
+0gyRjhUmySD9hCXhsGSMzmdJeCwZo2/wmHBZMkb7syR8lozRNiwJpyVjVG+XjNF37JIx+hk/T7hN
+krCb5TkdS8JwkoTjLE99yCv2hOcs98v1IFxn+V0r5vqE7yx/+Ib/DuE8y8968/OE9yw/ms0/J9xn
+OT6anyf8Z/mqfF9kByTzdDlLsgeyE2wNS7ILshNsrkumKdkIkmQnSJKtIEn2QnaCjZFM0zlDWJLt
+IEn2wyXTtEddi0umadMSrpmmT96wuGSaBgTrXDJNT1x3zTT98aBrpuknVp1Lpukbc1iSDSJJdogk
+2SKSZI9Ikk0iSXaJM03LDGZJ9okk2SiWvz/NkmwVAJC9ohvZLLqR3QLvUIs0AwDZLwAgGwYAZMdw
+1w759ys4N5sl2TnJ/HxFEV9tMcRX49Nhuwd3wTm3z+b22R7YZ3Pzkr+el/h3iozVd4q8V85n887b
+o9cFAety2s6xdd8hPfr3jR8Yn4NKT8Ocx0EKgB89wtR7ZXZuaxPhbkn4T40/ezmXXR5t6Q4gaIao
+FrwyH0vqNL6uj/R8a1dFyWW1sltzmEl7g4uHmWR6hKkPkj5K8xzuqrZukuEmGY8uMPwPxqf7tVT7
+7fVPn1V8ACWnBQhaJw9MuGf8NmuX1PPOY3dNW3cRAncRAnfn6FwVd/O+y568R98mnxbykX2nyxyU
+eMtdc+lbsOlwOBzBz/ltcBcXcNe2dRcZcBcZgLvIgNsLdHuB/67m3f8t+pN98QDdpXzLDHkBQ7bF
+A/JncUWHDE8c0CN5SErve/CqvM7/dPvSeVxkzJyFX08vPV5qB5yYp34w9z1TZu2AWer3JovxI/vs
+UUcsc6oVsH/4fJJ2btjL9o+6NTZ/XC7MPq/CJ5hfWWNf4PO+dsGZWqZPfhuIT/fMMX22zgBJM6oB
+STMqBEkz0iuSZnSnS5ru+FVc0l1vikuacUhc0lnjXF3SPmPFJW1c/c6aeE31ujsi4slptyLi2ydG
+aHe0KmMOmtvTnPfNYO2903ROmosU3M8uWtHfV7sj6f/ktiOeXncPKPn43u0eQEtXvMAVGPR33QHv
+75HNvvW8Wk3QrcohcC5yBWVZ5ArK8uh8lcuO9VtrXreyHW/HyO6X831XEHE4HA4CEnbKSo93rRxx
+Yp4qztl7prtVjiCQIUlAw07aqCPipFUrwGMCHXbSwl7mMYGPOGlhdnHSPoE4aRoeExixPFPLmZ8z
+kH9OwCROmgGu+To14JqvUwiu+Tp6xTVfJ2dOGgGai5O2601XJy3jkKuTNmtc9k5an7GuTlrj6jmr
+ANdUnM77Lq0kp92xtMLO2MQIHhNwgisa9zRLvkiw9sHyRZwkvuB+FxJPQOteynGTeDeJf8QNKtwG
+8sEM5P3WktqlfVd9dAAwOiA7Ap9lLSkmPiElKSEHFfznOI95L8DMr36wz7LUhcVcwj775f6K5PkP
+vAsBfx63CXgBZc36U/bv4p7D91GN7d8PKKH+UDfVuO7JLsq6i2/bN1w8aNw4dZDk6Vt2mrZ8GGK0
+KV+atr6Sz7jN+J5pe49zxh0RL5j2ln5a3Xu8i903aJPqe2GSye/0YtV/R6opYGVJNfDTEvaguXWN
+ed+4YXcT7f/aWoinxuOuqOLje6uY0yO9OxzXx/85cSxrslhJnYZhAkXvdXy3ZDEnXACazI3J4EF2
+BPquiuxwOByjai9n4kkKzcTYUpfHpNhMjF/ur7gmng/MIZF9HncnsgV4TEAgxPI5/j0CBJYDSjBx
+J2BgQvlkF/59AgiO9l88yM8TULgkflt2SsL3hyE8JuBgYvlKPh4TgDCx7HGOxwQkJAlMWB6XrfQE
+KiwvTJJWG6cX85gAhi/kypKqJDKXsAsxrWvkr/iNG3Y3MXUTUzcx/TdFl90G5b6Ec8RXB36s7Q/U
+9s+OcAZmJZz9U3OSdrwga9rx9PDLpuk7N6ozfthg/+BLGGeOu2maZSmpSqB4oXFO1zqmD1sHGzP6
+h9ldgrFZ8oK2td5m394o1Lwjept2Z6lLpl2Vptl3nVTMe/ze0V6pudx+Ze/36rWS20zXViyzX7++
+zHTjvXfsN4/Y7I7RS0344XO7psdUk8eMZ02eTYeZvF4daveuMtmeJ7imyce/rMm3aBGT70+1TH6X
+Cpn91wQpAbv+MAfOPqsEfbHHnPflrUq+d74ya+OXKvmHzjAXaDJVKRjZRVuo8udwc9F/X69p3pyg
+8XBuUvD487aFv7dXYmZCo6vnetuXvYdvmrWHkkaTG+11OBwO06rtXJGDtJjlzo1MukibSZJGMwkd
+d9MkJLSk6hqdXcg/Jy3n6GzrYB6TtmcbZbxH6gchAZPBRqFmSfnYxs8TKsjuvmn8c0IH2d33Dv+c
+UILl3u/5/AgtWK5Yxs8TapAk5CDJ6EE3QhC6/fC5HQAISQCA0AQACFEAgFAFAAhZAIDQhS+sf1mW
+hDIsf6rFktAGAAhxAIBQBwAIeQCA0AcACIGYnL7zFY8JiQCA0AgACJGYnEZ2EXJa+XO4yambnLrJ
+6ePZ1vffb2HuxzrVrxoeb+APNPDP4Ya3mPiUpMQ+8QPulTWfx/mfvzbnMWfNzx2cXGLD5nbGjdYj
+xjsrabjZ2j9ys5uzxuCtEoOP5A4UTQT40ete3SxJJaKy9rFElpruuZm6DofD0f6r8tNJ0hTm2Nnm
+dhI7sx4xIkeb6N3m3W3e3eb9kexv+2dC0v2Lk105umBdfx+gv09O40NJAwf3y6aDTGYdnkjncXkp
+j/pJOJdH7Way3ZGM5+9hfFglUqVF/ePdXIi7OEsdPe+7FNLzD8jZPTAQKL6H8T/j/6+Jh6doHsVk
+WwfP2cLk1uK3JjezQCqA/lhRKoB+Eu5SAbSbyZajbCR/D+PjWAn0dt90d78f/B/9fv49muHSwLzc
+wgMdKnkDlbxzmvORPDC+Z3IOlopXOI+fUABMHB7QdnqL4fbpu6uaZnw90fSBNcoucfjKdo7DK4F2
+SdhubZeE7TImSdiebp8fV960wOdFuyRsd7d/uttg/2xdk/vWhVweXd3+RfWjd9SH/GqX0b5yz1p7
+rutE0h7iol3sPx6KVTcsTLLfYSvGnbNnJm4HrKwLySdxJ27/J7zCPB6eubvfJlK+f9eKIN2BPKcA
+wCPUuRfEN1Ph5bikTpM/85nMakQokfWYEMiQ7cqgJgvtQ45TTW7hh8PhcAzMP2sKScIRkoQlLHdX
+5ag5YQpJwhXX1YDKdpfVACXQ7pqr3drumqtdxuSaqz2dJWGQ5Gq/aHfN1ZZaioRJkqvd5KHUViTs
+Ikn4lZNai4Rpkqu91v5Iai9mbqgt2oWfJ0zkMMHCJHuO2NK4c/a7lcUh3HRNkXHnbrvDFO4wxb8i
+TOG2kbmykfdbx7B+Mq+lEgAoOU7XTh7cM3nYPUxvfud/1ibnMYdHpncvve6DRudHzKx85srMS3WT
+Zo3pdMWyp/9xarA0u8HHEXO1A6xzG9ZI+6hwn7SPGnpa55WONJDz/DA7y2T20uLwyfBvlEo7rpoe
+955aXh5elOzlk8cvcz+uiycYGJRDlzGvP1CGrWKZuvACQl+nOVdmGB03nqYRzzA/e4OFM4+fNpCv
+WFKHUq7PV9Zrnsx8Pjwi6/MonOlJxpDz2Lx91u6wYexe3uo3gCx9YjW3n3DecjO/JPBiXcdUrntp
+ljTXmMJVPnOF5aW6SbL9rhOPae5lbfBFc5ApnHaAlWXDGmlM5Qr3EdnQk5+neZkZ2MFj3LLlzw3B
+bgVuhssKC819uBuEPXCDsP+qXmbpOtZzyeyxDm/AkW3QJ+9ty9EmqWfy4KR7ZV56O//T7SXncdBH
+w0qn8+6aA5NsAVcT1MDVaw2yu+YXW74xJYzaIW1t+XveMBZorxoKxhRXC9VcYyhc5ltjkYJzDUU9
+PzQW/f01Q7FfXzUW397HEPxdb2OJr8fYpP1iL5u0X4yySfvFyjZpvxho4/aLJc7YygecVit4Bxgq
+XPQ3Vjx82iAtGLcYqizbbKw6d4khZEqasdqYyYbqqZOM+oQhhtDnFqk1YibaajY0q7VCBtvCSqWo
+7mjIw8lozMk9F7Wcc3IHnohihNRLzgj8Mh/pmdZ/Wo/SaLKLSNx1brNZGVY6HVk3eRyYZAMAmusA
+QPMdLps8frGJB1uCxzT/AYB0AABID8SDLa6KB7vGIB7st0bxYOeKefL80Cge7GsG8WBfNYoH28cg
+Hmxvo5ipMTa49K3sZYNL38ooG1z6Vla2waVvZaANWftWljhjEzN1WhUzJX01ScfYTB0+bYBL/8ot
+BjFPm41inpYYxDylGcU8TTaIeZpkFPM0xCDmaRH/fdJHMU9mHpNeinlKUd0erNuD/bvz6P4puHb/
+VfZXdnqt3OYDbPPJYQ/StoP7J+cgjfstl00YFX7F9B0vGDO+jDHeswZ8Dx/tycU686n5rbWn322o
+PTO2mvbs8Ce09j5a7bk4jfZ8i/Pa3+v8qr1QYYf2YtEftH9o7eZL1fNoL9m3uRdU/oUU4u5NIPI8
++jvgXwiPYOPDW1k3PqScKduf/eIKv4Lljhdk48KXMcYHqjzfw4claRhJ0jKu5fhuQ5akbSRJ40iS
+1pEkzeNaji3OsyQNJElaSJI0kWs5au38d0kjWdq3uUPy7pC8m9A85oTm34Wy9wuaz3vPq8syX2CZ
+bw5TCg3xKUmDc9DaL915LOkuRsO/It0lo3+FOzv2tP1Fdae6/IdTXbxzvYznS1FWPy8/f98sjZgf
+9Z3X77JZxcs8Rn6GmsJZHoNdn6fjO1b0biXe8dLerabMcK3CpNHkBkocDoej3/rpW0gSpLgzXx6f
+zBeCxhzR8La/qO6MFze9dtPr/2DGi9tU/j+m8n5c/kqX356aFABMCsjhRl5DckLv5JSkng+vc+Xs
+8e7Ole7Olbm9AwXD8eg7V9qjHqhz5ezx7s6VbgLhJhD/pc6VDwGf7me9N61t+V2uOlcaUuJ7D0nM
+QQu2CS6dKwOLSquQvYvMH8xdbs5sFZLhN8ic3ULkdqUkV3vbMXmZbqdHim5n63DtrkrLtLurBmup
+ptNejUm79/hwrTvM9a9dgHSNiXs7x4++jw/dgaCxyKaCOJBNdfAWMbFw0c570wc/53+6mZ3HhR0O
+h6N09KTLJPtvOcGS9Mqla8beRWbpmrHcfLeuGaRv/8+yJelj1nprpJckSTdZtg6XFnuVlrEkPc1a
+f430leVxMePuOIqbBrlp0D+nwIlr8ZE8zvHdWz781fdMMA4ae5sZ5dUiyPUY0OTP+kxmHOTPsJwV
+l3PAm47U/dR+wxe44ZvDdK1nknrfK+CRz/mfL7jzmDf9zFzx+s1ZY96+aRn79CjLhtE3F/527mDG
+4gTjjz0SDXdjTTbzONPD2fBTHOUD/VHBO0ypcLG8kuN6KTFf417Mx814chgMkWwAH/YBvHzlfmsX
+3J9jhEF5H+4dKDKH7e0ceAFlPxc/A4G8CULLGiRlMYplPoZHaEpl/jTzWPPk7VdlHpPePRMTi9bt
+syuTkamJyLJ94r6rdnfVH9nUtKmbsKDXbwoLepsl6RPLDaN5THrF7GhxglSb6ZFoyA1LIr3DY7WZ
+qTiErfhD2EqYImylvOKymelhVaGJ+RoPwlbc7MTNTh4aO3Hj5j1x8/7Z51MWjn33HV/gnRzTmeQB
+iY+sBQDRm7+yBcDAySafgCF238h6dt+Tnez+1TqZ/DfUtQfmrWvinKrDqp3bUW26YdKmBpvyL/zZ
+VKD9dZM72PTvol7eOS/QfPv+N6RoegMBy7LL0bx9nPtM+Kwh3E+cx1yLtlHFY61Jmr5+2fS3tALI
+JF3/1FYAAyfz84Q0fKEj64k82YkloQ7LDXV5TOjjkpJ0WHZi3+pTtemGSVKSglkSKoG3MF43uUNp
+7lCam6z+Q4rwcAzNw9MZU/O8d7Hfv2PJI9PUBCz7c5DtzlAbHWfS1axG57bVyUFgbWaTX35q5w+0
+88/hgmT7+AHxKfE5KGXXxHmspZoly+cGGApXeM9apNhmfVHfTRFFr2rSip2Atfi+MH3wxletJb6e
+pEqhg1RVCh10VKXQQbgqhQ6CVS50cLfaOFgHKXZwwCjFDr4xSrGD2UYpdvCKkYsdpIxTudjBM/1U
+KXYQo9a+EauGl+hrDD9QW617rbWx3qpiav191YwNPg4zNlypNTZ6raix8fvnjRGJV4xNxuRVm0bu
+MzbraVebl1tljGy2TX3ae5axRZllaoszK9Voz3fU6M0fqC1/Ha62WvSSmlkbx1lE9U/Vnejr9vXx
+yEnV6Tw+Xl5AtQvgbbDwIg8GvlxYI/DPxxrfzN+RDbPyDP1O6xgpi1pFX4uraEBzu2zGbQ8mN19s
+1oI09AVLTOY9q8RkNuslJrNJYjNXNWkSk4FVYjJheonJvGoVszdJhcvO/VQVLjv3O6pw2bkfrsJl
+536wiqw793NaYAbrAJcd/AeMcNnB/40RLjv4ZxvhsoP/FSOy7uBPGaci6w7+Z/qpcNnBHyPyRqwq
+5q+vUcxfbVXMX2ujmL9iqpi/akYxf2FGMX9ao5i/oixpcgIATVAxf3n5dTRRwQVm7KqYv1VGMX/b
+VDF/s4xi/papYv5WqmL+3hG5+QNVzN9wVczfSyruUmDmHzW5qQhwrUYh4oO/2/2F1G+8gG+8skO+
+/FmRb2B36ooyMNntibs9cbcn7vbE3Z642xN3e+JuT9ztibs98UfkiefLykdT4vsmDk1+aPt6nHvb
+3SzOva8nF3cgf3M8+n095xs80L6eP21Edtt+t+132/5/+b6eh4BP9zPeqxoMeun++3ryuhrv1OSh
+OdiQ0MR5/JDi6HevMS/x88e3tnxcszYmDjW6cEdv576UHG5P8eJQY5Uat0ONTxucAcTAzMfMZzSB
+WcKRgbfDi2EcYrwVXrwVXMzNF/l4xc1zV5j9dpzcXYgduSzEzgalWRvTrbj54zqZXeLkppsVEmZ5
+AbNyHCdPGtArMSXZlNz/Hkqhdf7n6+U8fkqaks7VUaeCDV5v3dGUdMuscPWhddd4q5r9bgn2IWvW
+2lxRz2KTpqSPi4fSTeGK/vduyejBZfrlmcCg+93z+gJPXGacUP//xoy3j2X6VdbLZGsfc79cyiyp
+lBrXXMp7IutdJ5FkoX/vKz1N5+qy9jSlSZUTV4EmGh7HVhlvVbPnJoucJnP2SGyxwaWnqdtVcHUV
+uimZyP2fUrgsPTNCXnwhaJU3sCqnjVLb90s29U10R6TcEam/1eMrVOxviEid7eOOSLkjUu6IlDsi
+9Sjw6X4RqR9N9l/uH5HKUvO5fXKveza7yhrGeNF5XEQBcHerXVaXv+dZQ4EOjdWCMQG2QmFPqoWr
+nrYVKWg1FQ3cYiv6e5pS7NfZSvHtk5Tg715RSny9GCU/s6DUjIkoPX40nhg5GLr+CXiyawc81fpp
+lGlQB2WrVoTrNulcNLlKecHmtugPa7s0qYvGS+53TG0vze37X1GBoPB+yf5x6o3oiuzfy5/5vBzf
+fqa1kwzf0qPILMqUpQTBPaa+v/M/nLud6ThY/MzNZUmmfPdrsdwZ/LI6cA+sswYAIDWRtJUAGwCQ
+ugAAqYykrVhNAECqw/L3NEWIwmxFiMIkRYjCK4oQhcUQomCBEIWJEKIwGkIUBkOIQgKEKHSAEIWn
+IUShDoQoVASy3d38F/XASnnB5iYKbqLweO125pRHD2+53xGN9fa4fc8SG3hI90zcK9HndsggrxaB
+dKzRMtYVzhIUKJz1GUK/6Hsg4J8gMEc7l4dmzGxTyhco5ZvD8rPtU5N79uuT3H9ADnoNDnMeS9J0
+6fFcw86dbPyvq7jykFvE5egOaE/dJt33Idr36Y+Zx/mfbsOdx5wqHLtz1hSSIzsM7kGS5nBmvTh3
+aqo7GOC28Y+1jffw8n7091vAVPrPXo080jMc1I90hSjBqBxEAlJ+m1R0iA8wJKe5KbHx/fsnDRyY
+g/JPLziPefV2ZueQjZmFZmejimnu4OTWGWnL1R/XTruzfFrqBvVvLZ3Gq7oLUeuZsQgrNQFZDbrb
+gN8/qq/xzuNDkYA8vnK/Z+WfgMCAoLzeD6vwD1C0FsCP90jKxq3m8/LTW0tohe9YQst/+/c1pUjD
+YrOmb2eun2VZNrsVe3uwWmm3lEWiBxs38mahziEb71Y5lhSIJCkRSVIkXl5YOy1ntdJSNzxmq9iP
+uEbarVXthRCGMRbCMCYgO4bhZhBuBvH/LCe4gfFPwHj/kEKXF/OaEnyBBN8cJhnEJqXED0zMQTzh
+Vedx0Duf1OqTMeB1UyYN2ZR+3Oxbsa7d92wjo7/3cLu/bZAacLytKXBZlDFoWz973imVjfnSrpu0
+qYHG/OaDpgLtzxgLmqymQvVXGAtHrTAVKfi5sWjoTnvR65vV4phiKr6vuzF442K7Ow7xD6v8etf7
+IwxLAAXe/XNU4vZx7qISd535xAVo9jOHGPC66W7cgbQBAEgjWJ5tZAQA0gyWtkEqAJCGAABpCeiP
+b+vHPydtYbKTdt25MTeQx6Q54I25Z3hMGgQApEUAQJok0Y/PeUwaxfL6Zn4/0izhJt2Nwk0W293R
+D3f0w81d/qJ6rv9INLxf+OPb8SfeKusLlPXN4b5aoheD7529jiyJx285j6V8fL9ZgV+mbfNd0eh3
+44qzM4xf/fyWceWWUcavMxaoq9LfV1fPf1P95t2RqnXsAPXb4d3UNX3aqt/FRajfR4WqP4Q/pa6t
+WFBdV8xLzfh6svHHYS/eGTNpYzY+tAz4gGv28s1aqy5xkxznUmSNm/RF7RuDEF7iNSX8QHvUvdZH
+qbcqDPX3tVQafNxOabiyqtLotZpK4+l5lYhehZUmY6qgaeQlpVlCEJqX26tENjuLp71XKi3KbEWL
+M3sQ7bkU0Zu/Qstfp6LVohlovXGJEvP2KLT5bLLSdqARhvFDlHadRirP9O+kSDZ8XaVD0QilY9US
+SsdLTylxhcMRt9tL6XwxGM9+cVTpsuM6ulo88dyyg+j20hF0n2KFsfs6xL+4X+nReAF6PrdaSXji
+TSQ2tCi9fOcpvUuNVnofG6f0uZGgJGX0U/oeeFrpNz9G6b+2JwaMra4M/DgSyX3yK6bXKmBQu2pI
+6eWHwaFapEaexBDteQyt7qMMte/A8LzHleG2LzDizAbl+bR38cLmhcrI95YpLy6aoIwa8Y7y0tuD
+FI2H9581II8f5TkE+Ms9a+JwHvrpX1pZpYQv29gL8AKKDXeuJQbe4RBIRFLciOKZj+ERmtL0UzyV
++Zj5TOZfYOWNiUXTGOfyo8uCJPkS5FP8uS9mblRQoioZ3KeHVJEkqSNJUknpnzyDJakmSVJP6Z+8
+QJX+ye+zJFWV/skjWZLKSv/kbqr0T26rSv/kCJakwtI/+SmWpMrcN7mYF0tSaY7eDHsxZ9GbNmYj
+Hsc9CM5dYQQd2UZxHnouyL2iOH1F3hgEYUqvKcKU2kOYUh9FmFIYhCm1VIQptVOEKVVVhCnVVIQp
+5VWEKRVWhClVAXiX2CVFmFIQhCntVYQpnYUwpZWKMKWtEKa0B8KUlorc/BWEKU2FMKUZEKa0RBGm
+NArClCYrwpT4cjDcCVMaqQhT6qTAZW9CXUWYUoQiTKmEyEsckQfBoTAlL0WYUjCEKR3lMcGjMCVP
+CFM6CGFKRyBMiacZQ6Ywpf2KMKUFEKa0WhGm9CaEKVkUYUrzFGFKo0UeG6cIU0pQhCn1U4QpPa0I
+U4pRhCn1hDCl6oowpUgIU+K2diDYFaZUDcKU/CBMSQthSidZEhQLU/Lh1xEkC1M6zmOCZmFKGxRh
+Su9KiHbzQkWY0jJFmNIERZjSO4owJTds5wC274j5/ImSJb6Vr3dHP6CjX3aULEsSSYf+I+IHJg8d
+3O8eBiHQ+Z9uzZzHhf7yuqPDv1Eq7bhqepz3TXe8VOiu+6adJRr9fHKz2TREMD3Hm02lSGNJnbPn
+QuDdt59W0dfIWq7RZUNSbr7uf0U10uHfOO3nVRPcu6wfeJc1TXvcY5f1P2Tqu+zBHn422fi7F/C7
+Vw592A7P9+mdnJKckINlyRFZ1/A/KT4vgTbM/ripmssO7M2b5psequ/5lk7N+Vq9O+j9EPP3aSPr
+Xfax+vn/de16eIGq5AyAHx/ittfMBapWOdzwigdbuR/hunK/LkH2l89LyLq/nFQmO9+OVOix9une
+0ql/zUq8O9rtjnY/drl+bhjM7Tr9t1Ghy6J8gSjfHO4o7BifMOT53Kb9/1i0L348NAgbLrXGxjXt
+4U77/9e0ln+ka0nOO+B7CvdsIX9nlfCcLrAPy7rAPmiHF6f909xleWgQS5rDJGkew53u76YAbgrw
+2FCAfxIe3W+J+2QvTRujD2DMaYZ/x/jUpJ7xA919TNxbC919TNx9TNx9TODuY+Jmj2726GaP7j4m
+j7yjaMekxIED43PARd904aKBRbk8xIy9i8wfzF1uztx5OscUrP2weRdzht8g871qSm59KVK7vWcJ
+845WZcw7636n3VVpmXZ31WAtWZaCMRXshWrajG5O+K+JO92N9mk8Hj3tA/K1u7fvl70fmJu6lG9m
+rUuZPKd7dWZ5gUVvlaJgtrd3EbMp0p27bUAlPWJ217wLj0mfHqSuJekas7ueJYTdtSrDkvRO2N0y
+lqR/mexO2E8Fu7Afm9HNftzsx81+HqvY2b8KU+9Had5M3frJu77Au745ja8l9R+YNGTwPbC6gPM/
+fw3OY86fntH2CcPsV1u+O+ec3v5hr+HGjM+2mH7sXPmOXgg2fcPHqHqGKz9x85B7Zt54eXr7Eif3
+9pO7/90yhuWeN19ebf6HcgeKfs32NA1eQJE+WYvHgYvHodDtZzTFMh/DIzSlMn+aeax5MvNV8juu
+f0fzJKlYJ+c6dNat4neuQ2etQP+npWh+Njea4yyn0ZxZTdsneIMFaRGzmHN6ZjukTSRJo3iFrnPl
+HDWDIE2Du4zGXTZgZM8+3GzCzSaQm+KabmTMITLeP0/n1JF316X4Aik5LdHZMbl/7+TeKfEJ2Wy3
+88/SxIyOi82++PT+/2o3ui47Ttu7//a5UZMZAnyQBl6+Pl4+QKW5txPLwyPgy8nkgX8+1mhvTzgU
+kuPbyeS1OKE8y2Yc5/TJzdfJRvPi0/vdPen+e7slaDqzcXr8p3SW3kTh205OXeAFLPDKYZy5U3zK
+4Phh91CKAOd/un3kPA5WALw3v7LtXrFmy/tfYHbiKnz84khjxtCD2jv8tDFfYevUSaZtb6w0bu/h
+MHG8udtCl3jzXo9vzXuPD9cei92GY+eWKcd/+Ug5sfUd5VShd3Dqq6U4/clcnHl/Ks7ueBb254fh
+3LIGOP9sZ/w+pTQu1KuPiy/WU/4ILoWguR2UvG8GIt+YOoo2taKSP6G4UiDWXykYUxuFap5SCpc5
+oBQpuFkp6vmNkrsODO549mPlR4pu+lKZMVLQ26qaw/utimR/4Z1J1S1q9eclpszjWz0aitx+zPxp
+JmHKUnbMufs0EwyQhR3d09bdVa3FYbSxo0jq/SDhcVJ9tpmJq1gSBJAkGMiRYznmK34dwQNJgggO
+k/dwmFzC5N0W3jNMTpLgg+Xx4TwmGGF5bhnvhCQ4IUmQQpJgheVXS1kSvJAkiCFJMEOSoIYkwQ1J
+ghySBDskCXpIEvyQJAiiG8EQABAU0Y3gSJIcKrIkWAIAgiYJ89eGhPlPKRLmP6BImH+zImF+2RH5
+13W9cIf53Y652zG/q2PuNjV3MzX3Wy34KP2LZt38gW45TYB4Nr53SmIPd9NMN8H7W5vS5b2MR980
+88JYd9NMNytwswJ308xHgU/3M91Tnu+59/5NM/NnNd2m5JTk5/uMSHRX2nCbcXelDXelDXelDTdb
+cLMFd6WNv77SRtYIwpCUpJ593KU23NTj793aDjykUhv37LBZ1n5CkZIbeV5zl9xwRw7cXOCftZ6Q
+2yWEh3HPBKY8V++9RNDaWeT4T9secxY2GLFR88H9O2z6AHg6vmdqcsqIbDKzst40ALzkMESvx4O9
+yr9BiF7fSM8nelxb/MtU+raR3Yl6AGjRNAflBc47j7k49/TyCy3Td3nrZmzRWz6Y+51l5owPLLOm
+BVssr36lmz30umVO4gzdhy9kWOa2Sbd81Pia7uNyRt28Cmt18ytX1c0/ednyCbZbPtlZWPfpcbvu
+s3X9dQvXb9MtmtdGt9gywpL2ul63ZJLN8rmptWVpyueWZYZqluXRz+q+0GstX9YYaknPd96yokCc
+ZcXZHZavjkO3cs853df7OulWpW/XrV5y0/LNu8t11ukldN8On6Zb8/wN3XdxI3Tft5tr+SG8i25t
+vW916+J2Wdb95Gf5sUh584/TOps3bC1t3jisvnnTEpg3G+O0W8yHzJkFTLZeq6HdlnRTt71nJcuO
+aFW3s/STlp1Nb5h3/qxqd7X5TLfrpqdlT7W3dXuuTNX9lNek++nkKd2+I0Ut+zds1h34/orl4LIT
+lp8XFdGpUzZafkmJshzql6r79ZnKlsOxZsuRGoGWow0X6n4rUUl3rFCs5di1AN3xG4N0J346rTt5
+oL3u1FdbdKeXXrWceX+J7uzLvS32WS/qzsVHW86/1F33e8teugvdm+guPhlk+aNxGd0lnLVcfsJb
+d1ndarly8zfdVetSy7VjnpbrL3xqubFupcnTtFbnVSlO522Yr8vjV0/no39D53O8pM5P21/nl3FT
+529vowv4PNgSaNPrgiZet+RNK6DLN+igRfteNUv+dlZLgRFaS8HQOZZCcectzBzD1zJzLFZsvjDH
+y28Ic/xlnjDHb8cJc5zdT5jjyzHCHOOrC3Ns2crMzLFyCDPHSv75hDkePyfMcf12YY4LlgtzHDfN
+zRwfMnP0uncboHvcPW/dZZTzV3r73Pnko98fT2ElCStrvDLxTfouy3FmuDnzp2wn2seiBcnm5Otp
+bpW+z2lVlvNZq7IEP+e3gSTBJ8td3jqSBKOSgPQdS4JTkgSpJAlWSRK0kiR4JUkQS5JgliRBLUmC
+W5IEuSQJdlmevMy/R/DLcmdhfp5gmCRBMUmCY5IEySQJlkkSNJMkeCZJEE2SYJokQTVJgmuSBNkk
+CbZJEnSTJPhmeXYHS4JxkgTlJAnOpUXLdpYE69KiZTmPCd6lRcs0lgTz0qJlBEuCe2nR0oXHBPsk
+CfpZ/uTHkkwAy2mdWZIpYIdiWH2WZBJIklngpUnzIfPdqtqQmeAlyqSbOtn/XMki1W1UHpPZYNn0
+hiR6/axKolebz/jnZEZIkilheWUqSzIpLE+eYkmmhSSZF5JkYkiSmSFJpoYkmRuSZHJIktkhSaaH
+JJkfkmSCSJIZIkmmiCSZI5bXAnhMZokkmSaSZJ4ksWwLSzJTkli2hMdkrkiSySJJZoskmS6SZL5I
+kgkjSWaME8sal+ExmTOSZNJYqlt5TKaNJJk3kmTiSJKZI0mmDgDI3AEAmTwAILMHAGT6AIDMH8vj
+JVmSGWSZcZMlmUMAIJMIAGQWAYBMIwCQeQQAMpEAQGYSAMhUAgCZSwAgkwkAZDZdHNTwtS4OKplR
+Fwf18huuDuov81wd1G/HuTqos/u5Oqgvx7g6qPHVXR3Ulq3MLg5q5RAXB5XMsYuDevycq4O6frur
+g7pguauDOm6a20F1O6iPtYPqpj//N/3xbxETq296O7Qdmukqs/+pu5RvmSEvYMibnf/pw/5nSGRS
+SqLbCXU7oW4n1O2EulHY7YS6nVC3E+p2Qt1OqNsJdTuhbifUTX/+WifUE6A3yP2aK53Vg72KT5tP
+8eNa3T5NAZCS7Tqtt7yuiv4fcJIhj/VJesnrHutz9KPXpSQmDhx271S/x+JEPQFEtc+V5jgcg438
+IjzYq/yj2seGyjlOLFCg2WAAg7M9R3ouKnlg737JA3vnwFmKdB4XeTWpp/nHmFD9j/sm6ze0GjR8
+m+WgeXu/3cqOyXHpO5umhe8aXC99d6Mm6Xuq1Ujfc6Vu+k95C6X/tLdE+r7Tf6TvT7+RfmDTnvSD
+c4LDf/7iYrj68vXwX95ZX/FQ26TwX4d+WvFw9VbhR7qvm380X0j4b40XzP/tcnT68SfenH98T5X0
+E44B80+mB6Wf+rnt/NPTzqafyehf8eywren2+W0qnuu0NP38WH3F3yNs4Rf6FKh4Ufd5+B8tLlS8
+VG5U+OXC8RWveBvDr1xsVvHqkabh1w52n399c7f0G6ubzL+5KCLdMbPMfLz9VLpmlPd8j4Fe6Z7P
+/TbfK+ZouncLr4p56niG+5Q/WtHnlYbhfnkyKvr9sS7c/+gnFQN2LQgPXDu+Yv7pdcMLJPefT7mG
+PrIbyCPz7pPbO9DPHwBMCryAUWaNLzBsrCaIjlHA9Xk6JhsQ1Yr+t6eHTrFoyYYgM/MWfMvNV+tw
+OBz09bLfFROqZ7lvMkv6qtmPshzkn9NXzn7U5Lh08Z/SwtlfGlyPxzQFxG+qIfJK3XTxmwqJ3FuC
+JU0L9pvSb7Ck6cF+05xg/ns0TUjSVGG/6Z31FdlvapvEY5o27DdVb8Vjmj7sN+UL4TFNI5aXo/nv
+0nRiuacKj2lasd+UHsRjml7sN007y2OaZuw3DdvKY5pu7Dd1WspjmnbsN0XY+P1o+rHfpPucxzQN
+2W8qN4rHNB3ZX/I28pimJftNR5rymKYn+02bu/Hfp2lKkqcq3Wi60u3tp9IBgKYtANDUBQCavgBA
+UxgAaBoDAE1lAKDpzPKVhjymac3yj3U8pukNADTFAYCmOQDQVAeAx3y6+0e1ig0JZxj07dz42lYv
+YKvX/SLt0T0T+8cPTMgB8GoAeADwnXR0UpTG4bge7vwBn14ODcytP0Jf5z3/0G2bM2BRsbFTAEzJ
+Fs8L0gcZmJAUP7B6k4Gp8QPjB8anJA1NzuZ8vLJYKy92oX/fdOFQTav10KcrIjQenkDV8chSx0oj
++wf4DCOb0H/n7sLcvAd9Znof1uGaVivLXL6ff2STWGcJrJ8z9tffB2Af7rdPwHltmvWJ7508OAff
+kqfzf+C4UZdm6I8eSONO6h4eQLPDAFXxghcQaxQyLv3MayM3f5g+Ov1xAKA3QGa79vu9CTVGr90o
+pDZfgaHttqWvA7AO96t1eOsKpCQNTh0Qn5OL4OH876tknpsG6FmSHVSTfDNV9HWQm79En3pwkQWH
+ke1fpI9Yp1FIHf6IVQv98vlHzvJF2bddzfyIyT2Tc/Px/LN+vGf3A0CXPpknU7umPjcf0J8+4MgO
+5efiPn+Tv8Wa+kYhtevVFEWfXP6PLxYBWJTjyZw8IDklOTeQM/H3rXYNUNGcqWiZypVb3LrnH8qi
+nP6r8381FcDUnE7NVokpvYck9k8cmJsJ9VN8DyXzOgOZWkN5v6QvuZ2a9NeyfnN3/kX63sIahYTx
+R5y3Ne79+0/NgNsfMSa+T2JuzmncSz5jMs+l5nWAgDFzFtXK9aejv5b10935F+nT1WoUIruwag/d
+OPr+ny5f1k/XPyFpaGKu8FUBcLr6d+/egr7mxwB+9OILz9AX44S/3OJrxy9mcWFPegMXfM3uTVy+
+4aQr7XbmCl9j4oekJKXeu/Z91lueW0bmJe93i3mfjii2L0Uf7fmOGr15go3OleNRHkDYMZmGGmdF
+ydvmoBZy8z4yCbz5WtD7sdyXogeHUt9RWW6eYMu8Vjl5f5cpEznffvkcgHO4X6OAW5drRHJqamLu
+QMxWTANU7vP/g9g9/lAWEOuyes65+4NYlg/0TOKQgUnJuYKwiRvr3oKwWvh/lZz+WlYlv/Mvunxj
+JZd6RN1fyb3oA6bE5+RjRTn9yZLv938x4xtsjAqa0jw878KC6flGDI7QVvzRql1ww5p/4ddRBSJb
+phescyqjUEjh9Iq6oRkV932QXmnDjIzKq59Kr/KZLarqrI7hIW97ZlRTxqVXT64TpY9fFR5q6JtR
+o6k9vWaNsxm1yg1JDytUJqp2ngXhtS92zKjz2/708F1vRNVdrw2vtyJfVP1Pp4Y3eL9pRsPxGemN
+Xhga1XjA9fCIrgsymrStnt60cbWMZqHL0lscWh8VtbNtePQPbaJafrkjvNXHL2e0fs8nPeb15VFt
+nq8f3rbPsQxDl4Hp7VoNyHim4e/p7avOjop9okJ4h6AdGR3xbHrHs75RnX55Ozxu61tRnb8vGP7s
+59aMLh89nd518oWo5157PrzbkAoZ3XsvTDd2+iwjvmWN9B7halTPKr3C+cvhomKeHv/n3RPQhwP8
+6AU0jtL4AqHhyMsKK+Vli2V9nqZBrEyFmmTTatbU5wZMbn2/NNnoO+b1X2yk50HfNQDQ983GYcRg
+Bhn63lkuuMGSvn8AoDnA7kudUxkAQHMBAGg+sNz3AY9pXrCWrha/l+YHANAcAQCaJwBAcwUAaL7w
+NYlfxT+neQMANHcAgOYPANAcAgCaRwBAc4nlxY78c5pTrD673uCf09wCAJpfAEBzDABongEAzTUA
+oPkGADTnAIDmHQDQ3AMAmn8AQHMQAGge8sXd2ZZ/n+YjANCcBACalwBAcxMAaH4CAM1RAKB5CgA0
+VwGA5isA0JwFAJq3AEBzFwBo/gIAzWGWZ3355zSXAYDmMwDQnAYAmtcAQHMbAGh+AwDNcQCgeQ4A
+NNcBgOY7ANCcZ3IfrvLv09y/BU6P4fwnaKxJrLxmJitXemRsOeMFnMk2jpAHQPTglPjE/qRBNe8e
+vPZzHk91HhdWAMzUR47N6LwFGVc/xvrwGcrGIyeVTZ/dxOZ1T2DLhFdgWzAXW8e8iG1v7Fa2J6Yp
+O/oHYPezemX3z3HYU/07ZW/pnvhJewk/nbRh37Ja2L+iIQ6ob+Hgss3Kz3O/UdQ3h+KXji/i0Ope
+yq+1u+PwzCjlSJEmOJpqx2/5tynHyi9Ujh17Hr4j31R9b6TZ/P44YfT/uKat4PHrxkJPdjEGX11r
+LPH2QGPJ1ZfVUsNG2kpvdBifML5n0I1eYnwyerntqW4z1TK65wxln9Uby6GxoXzAabX8rqO2Cie3
+qBWtngYuZb/gCVuVlYlq1XEaW8j0Fmq17gsM1V+opOqjfrCFRr2j1mgw0FAzdKmxVvBNW1j+qcaw
+cx8b6nj5KnW2aEzhf3RX6u6/otaznTbVX9TF2OBDi6mh5XNjo1dGmxqPnKVGDK5tamKYa2zaegKa
+RbU1Na86CJGl/7A/HdgeT5/qaW9xKgxRv100RW8siparZ9hbfXoFrcf72WOmFlbavD7d1Db1kmLo
+1tTeLnav8kz/aFP7WiuV2NZVTB0KfaB0rBpk6njhJSWucCV73I54pfPFAPuzy5opVC+86wdGPLds
+i73bi03RfcoSu/G5soh/cbOpR8M86Plcmimh5DEkNpxk6pXHS+ldKtXU+8hRpc+NjqakHzKUvgfC
+Tf0++kTpvzbWPuDV8crAj2vbkxMHKqbXitkHtXkTKYlX7YOrDUBq5H77kLxtMbTaFdPQM6EYnnef
+afjmghhxZpXp+YUX8cLmWaaR0/IrLy562TRq6O/KS2/3NLk05vT0upvGUOjOx9fH91HWrnoyDwDo
+LsOLa0jwAiey1EG//cztY1LUljGxiG4ei+j2JJtLBEhzq0cEnDHunGqcOAVrrnIoVR/JtfdI+1he
+/ZglaSFJ0kSSpI0kSSNJklaSJM0kSdopFYwlJk5ayrHx/gH8PGkry5/jeExaS5I0l31O7SWRJ20s
+SYs5Jr6iIUvSZskl2syvI60mSZrNMfGO8v6k4RwTr92dx6TpHBMv0oTHpPEkSetJkuazPPY86EYI
+wPJGmo0v4B8njABAaMA2+vh1HhMqAAAhAwAQOgAAIQQAEEoAACEFABBaAAAhBgAQagAAIQcAEHoA
+ACEIABCKgKsFneafE5oAACEKABCqIGvXgwVP8M8JYQCAUAYACGkAgNAGAAhxAIBQBwAIeQCA0AcA
+CIEAgFAIAAiJWJ77mH9OiMRyi0a6HvzRnceETgBACMWcYJFcH0IqACC0Yk7wymgeE2oBACEXABB6
+AQAhGN0IxQCAkIxuhGYAQIjG8lRPHhOy0Y3QDQAI4ehGKAcAhHR0I7QDAEI8ACDUY86QeonHhH4A
+QAgIzoGJ5p8TEoJzYKrwmBARnAMTxGNCRnAOTCV+PSEkc4aLATwmpESW7gqEmOAcmC08JuQE58As
+4TEhKDgHZjP/fUJScA5MGo8JUcE5MJN4TMgKzoFJ5TEhLDgHpiOPCWnBOTDhPCbEBefAxPL7EfKC
+c2Bq85gQGJwDU4zHhMR0IzQGAEJkcA7Mfh4TMtON0JnlmVCAc2D28ZiQGpwDs4rHhNjgHJhZPCbk
+BufAvMxjQnBwDsx/EcX9o9vHhtSIbs45K7Wq1apeo7ZL0srp747UjPUDYv2y42E+AFrGD4hP6hmf
+A1emiNOz1SoAJudbdtPnreImP+1co9/CKXZ/+2vGAPVVU+DeOWqQtbcp74oxar4Fr9i17/VS849L
+tBcYEaUWTGphL9S5slo4upK9SN1AtWilAHuxpyoZi/uetgd7BBg1Wb+wW3eHI90D4Ecvh2NFGnwd
+jnVWTeCtukpUfbl5LHLzCaj/f9LrZUaRpE8CAPRpAIA+EcuFU6RPv/01HtOnAwD6hABAnxIA6JOy
+87bgFf59+sQAQJ8anEQZxWP69GwQOlfmMV0FPqm6gTymqwEAdEUAgK4KG47/+8r4R7aPlfjclapT
+WhzSAIc02U0Lb54WpnvGL7yc/zN5g1dmZHJ3Iw/T7tOd7XsuXzXt3VTfvs8H9n0LS9n3Hz1kPzDe
+Yb+Vawa84gkA4y0aXreErzzy7G/uXK10rlfm5iTo+0xcM9cki+oeIk935hYNdFJMJDbV5zGdHMuF
+pVjSSTKBeNAT9W/ZPjakLl/nwKijQWEaIExzvxSdVsPi+8b3T0zKSazIx+k6FVMAfHs6zLb+hL9x
+y9Mv2x2vlTBUHZo3QgK2nhovb29gBs+q97nO+XiL88QDHY5OEdAC863O/jmhoagSSi5dXYSE1kCV
+0BrIzanQFRtVu5iksZ8O40KLdFpM/ejU6PZaCQMA0Cnejiv/36fp36BKaI1GIaE1+JJ7jhx3voEG
+aHDfqd06qceInOCdzjmr8s8+/23ZAyuXaA+WWWj8Zfg27S+/JapHqjc2HVmwUT3ZZq29yKSmxqK+
+l0xFr1ZRi52obC++r7sxeONie4lVh40llz1vLzWrqFp6coDpidFnjDpTS9OT8aPUp57ZYirT3FMt
+W8vDXq5cHWP5iC/tNdqcM9ZsXttUK6yvavjgPaYYzyQtU5xz0fOO3EtPDw39AwK74o5KWlKkJkuV
++ljk5kPzPo3z35ZllVi5hCGTLgBz6OHbeEwXgjlz9casWnRBSNJFYWib1NQIrqR5ycTyahX+tuki
+McTt6y7ceONiHtMF4w+y7Hke04UDALp4AEAXkE/S1JLHdCGZGz+zhcd0QZkb1/KQSprl6vDv08UF
+ALrAAEAXGQDoQgMAXWwXTvcwL7rU7+M5umb98qqDPIBBHtnNUU8AMZGxOUgUGOs89rtXxynqwp5d
+0f+guT3Ned8M1uYbE2nWptY250+oYC4QW8xcMKa5tlDNq2Z3ib9/SXVheHg+DndWFG9WGmQ+0jMx
+XA8zMscZMrcm/oP0YiKl+H+6B5DSgHsWBWuZ+I2J5DEpELhnUQWWpEhM/GKa8++RQsFdY9C9e8a9
+e+bxqTH4TwJG/xiqKxhzz7qCF3fVW1jNF6jme196kdt8e7pyMbnOt898lX9M+1jJThtVpv6F+28J
+8JFTrBPTPDYHRYqnOo+1Mz+uZ5610kexvFbSPPv948qGcYHmbWUumLbFF8PVy0O0147F4fqeTtob
+GfVwM72u1jG/JPBuCa3m9ZvwGHZD6zkoWPGKL272NlxX8kRcM/voDyo+b4WZ/bRWxW/hEq2/fY4S
+oM42B+79BkHWV8x5V8xGvgUWrfa9V5B/3GhtgRGJKJiUoC3UuQUKRz+tLVK3EopWqqgt9lSkUtzP
+XxvsUUEJPl/eXOKQn1Jym6+51LcnldJLTpifmL1J0Q0tpX3y5cXKUx0c2jJ9N6Js2C/aci0XoXzh
+77QVKptR4ebP5kr+Kah08Ftz5ROxqLL6Q3PV7SYlZOar5mpLn1Gqj+pt1k+upYT2HqOtMbiIUrNF
+L22tZy4rYRWitLVr/KTU8amsDS9xCeG/BWrrXtuLemvPaOvvW4kGaf7mhis/QKMJp8yNp7+EiOTN
+5iZjpitN26aZmyW8qDSvPskc2by7Evn7D9oWISPRYvs8bXRQN0QvHadteToCrWZ8ZG69q6sSM/J1
+c5svGiltuyaZDe/olHYNWpmfGeqhtC8ZYo7tcFiJvZ7P3DFsrdLxSFVtXOlfEfdDXm3nmz/g2bl2
+bZeD89B1zDbtc6vHoVvCMm33mf1gjLGZ419/XekR8rm5Z+8kJSFoijmxRSsl8fRQc+8KIUrvTXHm
+JJ98StLCeua+R88p/d7ppO1vy4sBQ+tqBy62I7ljCa1pwjYMCruhTUlehsGFf9amtn0HqTevmYeG
+f64MPXjAPLzYFGX46m/MIy4PVZ6fOdv8wp44ZeSoV8wvptdTRnVLNL/0bklFA4IIaB6rO2uWl8Px
+qYWOP7VofPkxUJ6PaR7LWhfTKRYxhlvxCWiQG2Vi5vRxPWFOK3147YMUi/3C94/zmBSMmVGZC9KV
+Mr4YSJKyyb5mWcMhpSNJikeSlY9u80vKu74rlpgUkW6kjABACgkApJR8oobrPCblBO9/PqhI6C7M
+LKE7qyKhuyVaCd3NUSR0J5aYFFdCd2KJSYEldGfRSujuFUjobrRWQneJkNBdgjC4zi0goTuxxKTg
+EroTS0yKzszNTywxKTzL8+WFwR3y4zEpPzO4b0/ymECAGdzsTTwmMGAG9/JiHhMoAAABA90IHJjB
+tVwEuhFIgPc/859hsADvf04By4PfCoM7EQu6EXgAAAEIABCIAAABCQAQmAAAAQoAEKgAAAELABC4
+CIO7zGMCGWFwP8maj09lJ4O7BJa/BToZ3F7QjcBHGNxK0I1ASBjcB6AbgZEwuJdANwIlYXDT+e8T
+OAmDe5HHBFIAQEDF8vcfhLmFjATL7bLvnICL5VLZd95ScvoYxACAgAwACMwAgAANAAjUAICAjRlc
+A9l3TgAHAARyAEBAx/K67DsnwGN5pKowt9K/guUPeXlMAEg3AkFmcAfngW4EhszgVo8D3QgUmcHN
+7Ae6ETgCAAEkM7iQz3lMQAkABJYAQIDJ8rQwZwJOlpuEOROAslwozJmAlBncO534/QhQ6UagygxO
+wjQMrgBAAEs3AlkAIKClG4EtABDgsrwp+krAy/LgAWFuxabwmECYGdzloTwmMGYGtyeOxwTKAEDA
+DAAEzgDwrwNofyfrIX5Xg/ldaLVQ55LOsJJFU/P4AXn87pdbG5M4PKlncvWm8X3j2ySnpOakKtZ0
+53Gxj744Zlowoq1p8bm6yhe9EkxfltAi3f+8fcOJtxTmUh8ssu8u0Rl7Ji6z74MP9lkH2/cfXGo5
+sOAz88HVUy0/j3vbfGhUK/Ov0c+YD3cLMR+pVMt8tEVL7W8F2mmPVaiqPXa+pvaET17tiW2FtSeP
+2rWnllzSnl67TXtm4l5tgLrYErh3kzbIOtGSd8Vibb4Fi3Ta9yZq848z6wqMGKwtmJSiK9S5g7Zw
+dKyuSN062qKVauuKPdXeXNyvmC7YI8wcfL6WpcShouaS24pYSn17xVx6yWXLE7P3mXVDq+iefHmV
++amOQboyfX/Slg07qyvX8mtt+cJbdRUqz9RWuHnaUslf0VY6uMVS+UQPbZXVSyxVt48yh8ycbKm2
+1GiuPmqIRT+5qTm09yRdjcFlzTVbpOpqtc9jDqvQUVe7xjFzHZ9wXXhJb234b8G6utd+09Zbe11X
+f996bYO0YpaGKz/VNppw1dJ4+lvaiOT9liZjFpibtl1taZbwprl5dYslsvkA89P5RltalG1rbnF5
+li7aK9QcvedlXcvDBc2tZiy1tN7V1xwzcqqlzRetzW27DrMY3qlmbtegs+WZoVpz+5L1LbEdzptj
+r5eydAzbYe54pJ4urvQ5bdwPJXWdb27XPjv3pq7LweXarmNU3XOrp2m7JazRdZ85QmuMOWiJf32q
+uUeI1dKz9zBzQtAcS2KLzubE02MsvSvUN/fe1MuS5FPKnLQwytL3qMPc751EXX9bSe2AoS10Axff
+1CZ3rKQzTVC1g2oH6FKS12gHFz6tS207V5vq8LMMDbeahx48aRlebI55+OpNlhGXx5ifn7nY8sKe
+XuaRoyZaXkyPMo/qNtjy0ruVqdmLh5eHdx4Pr8fu7nBMao1bajxBgW/m8QdmUWONlh8LyU85I759
+LAz0v3ksDJ1iYTDE3lLs+zCwu6oiV0L64hgzK1JJkqSWJEk1SZJ6kiQVZUZ24i3FhZF9sIifJ5Ul
+SWrLi17wkewZ62BZ/DoolWhIjUmSKpMkdSZJKs3ZMtHPsCTV5oh/pVosScVJkpqTJFVneb4mS1J5
+ltsKsyTV5x2kSy6xJAggSTAgjG2xVKzZu0krjG2iRRjbYom5LVikE8Y2USuMzawTxjZYK4wtRSeM
+rYNWGFusThhbHa0wtto6YWztJdbmV0wnjE2YJEGJMLaiZmFsRSzC2K6YhbFdtghj22cWxlZFJ4xt
+FY8JaoSx/SQxt7CzOmFsX0vMrfBWnTC2mcLcbp62CGNTtMLYtliEsfXQCmNbYhHGNkpibjMnW4Sx
+Gc3C2IZYhLE1NQtjm6QTxlbWLIwtlccEW8LYOuqEsR0zC2ML5zHBmDC2YJ0wtt+0wtiu64SxrdcK
+YytmEcb2qVYY21WLMLa3tMLY9luEsS0wC2NbbRHG9qZZGJvFIoxtAI8JAgGAYJDl5Vn8fgSHLPe8
+zGOCRWFsSy3C2PqahbFNtQhjay0xt67DLMLYqknMrUFnizA2rVkYW32LMLbzZmFspSzC2HYIczsi
+FZYIToWxSYUlglVhbFJhieBVGJuqE8Y2TWJuCWt0wthGaIWxSYUlgl1hbFJhieBXGJtUWCIYFsY2
+xiKMrb4wt029LMLYSpmFsUVZhLE5JOb2TqJOGFtJibkNbaETxnZTYm4dK+mEsakSc6sdoBPGtkZi
+boVP64SxzRXm5vCzCGOzCnM7eNIijG2OMLfVmyzC2MZIzG2m6CvBvDA20VeCe2Fsgy3C2P6TsO9v
+aB8bbrg7r0u3pWS87we873e/PTNZeF37ISk5cOYbOo+1ZEYWn6ti+qJXR+OXTRqa0gNrG9NvvGbf
+cGKQMccRo4sFzPW/2JrjiNHT+VLNLco2UVpcnqiN9iqjRO8ZrG152Ftpld5B23rTU4h5t462zUIv
+tB1eXGsYfxTt4sPMz/TPQPsmRc2xrT9BB90Vc8eq49EJ+8xxhecrceoqc+eLbyjPWmeZu+zsr3Rd
+8LX2ueVtlG7jZmq7T9UrxiRFGz8qBj2ie2h7dqsOjYeXp5fHAzancjhmNEAWGg8XAp/5tTcjEt88
+y9eN3HwpWe07fTli1zvyWhZ9SWzXA2vzmL4sse+DjH+JR35R1hToC/4rPHKaDILvTRTBd7HbNDEE
+38Vu0wQBAJokAEAThW40WRjfF3qBbjRpZE3lqKzcx4c511QyQDeaRLKm8gnoRpNJ1lTGg240qWRN
+Zb5kZaqrnGsqb/CYJpmsqfTnMU02xvflbXhMk47xfaqexzT5AIAmION7tNjtv20i3g6517l4aE9Z
+L6CsVw7xpUXiwMSU+P45oKrNs3qNd8OXHw6mGddOq2VY3+Gcuv7EAcMWj7Xqli87Gn56r7C6b+gr
+ttDeQ1FjsK+pZos41HrmhCmsQj3UrrHRVMenJMJLHLOHH72Jutd+tNe76KXU/2KNvcHi60rDlW/b
+G004qDSebrJHJFuVJmPeMjVtO0dplpBsal59jBLZvJ3p6Xy9lBZla5paXH4F0V6FTdF7EtHy10um
+Vukt0HpTQXvMu5XQ5rOL9rbDA2AYv9veLr6C8kz/Ffb2TfyU2NbT7R10J5WOVV+0d8ImJa7w+6Y4
+dbHS+eJI07PWiUqXnd1MXRcswnPLI0zdxpnRfepTJmNSCuJHNbL3iI5Fz246u3zlVOojj4f3A94d
+jg8D7/3NU74f2aIVaZpC8tM/z4Vm9L9T5pxAborcNL+bC5JTiKLvnCR97yTpu2d54gCPaQ6w/LIj
+j2kusCsy9BVJNO89FAJtviaBtjgItJ0wCbTVg0DbRpNAmwSRab6wPCpBZJo3Am2S+Ezzh6FtsQSR
+aR4JtEkQmeaTQJsEkWleCbRJEJnml0DbGAkyNm9nEmjrJZBWtqZJoE2CyDTvBNokiEzzT6CtBQTa
+CtoF2iSITPNRoC0AAm27JdE8voIz0XyFXaDNz5loPt0u0HbSmWj+ol2gbZMz0fx9fj+auwJtI00C
+bRMl0XxnN5NAmwSRaT4LtJkh0PaUSaBNgsg0vwXaYiHQ9njPc/9m7WOl6kequke71gtY63W/NmZt
+ns1mgdPHKWc4jwsqANKWfX5sSbvX4z4ffqTP0mZHjMvi1vVZXmad8YvwBX2+9FxgTC/2Zp/0Uz+o
+Ky4P6PPVxnnqyj1t+3z96zF11aI/jq3+7kf1m7f3HLN++Jn67cCvjq155W31uzYzjn3f06T+UG3U
+sbWt3zKuy2s8llE12Zhx6cU+2356P8Kv2A3V77OPVP+zHxkDZl5XA/d8qwaNOqjmTf9QzdfNqmrf
+fVXN32iOWmB4b7Vg6TFqobhotdDNXmqR8Cpqkd9eMRZ7MspYbF2iMVhT2Rg8r4WxxC+BxpKvVzKW
+sp4xlu4dYHzCYjPqnj5tfFL53PiU3k8tk7RZLZvvpFqumsZY3qOpWiHoV2OFQ2XViqd/MFZak0et
+vGmescqnZYxVF44zhrzpbaz2Vj9j9b6/GfUDYoyhLdcba3RMUmtW/tRYq3YrNcz/LWPtIiFq7d8X
+qHX+yKeGb39TrbvznFpv6QC1/jd5jQ0mt1UbzrQbGw0OVRuP2maM6NrG2KTbMmPTBnpjs0bvGJuX
+LGCMLD3cGHntgvHpm88aW1x6VY062MAYvbu32nJ1aWOrL6PV1jNhjJlWRW3z4iFj22FBqqHXTbWd
+sbLxmadVtX1EoDG2/Bq1wxNnjB3zzFU7OmzGTkdfU+N+/tzYeW0f9dlvphi7LH7V2HV+mvrchN7G
+bmMnqd2To43GPqlqfNsqxh5RHdWe1YOMCRXD1cS8Z4298ncw9rocoPY+V8fYZ/dpNWlrcWPfL7eo
+/dKuGftPW6IOMB8wDhw2WU02fWM0GdOMgzrvU1MiJhkH112lpupSjUOKz1KHoqNx6JWX1WFquHH4
+3p7qCGuw8fkVkeoLC2qrI+f0ML44rpg6anRz40t9rqoemnsnEOR59H0SXe7Ah3sAYHGGxguYnQ4/
+St6l4y9smrx0DC95JP1t82x7euCj5reaJ+ZG9xwOh6PJl6253BfpIEnSQ5KkiyRJH0mSTpIkvWQL
+Fr6Ax6SfbLmKvclj0lOSpKskSV+lnHdbHpPekiTdJUn6S5J0mCTpMUnSZZKkzyRJp0mSXrOFrDaK
+x6TfJEnHSZKes7z0Ir8f6TsAkM6z/OwjlqT7AED6DwCEAQBAOAAAhAUAQHgAAIQJAEC4AACEDaCL
+WFp2LhBGsLwpOxcIK1j+9gq/D2EGy3WJLAk7WM5rwZIwBAAIRwCAsAQACE8AgDAFAAhXAICwBQAI
+XwCAMAYACGcAgLAGAAhvAIAwh+WhsrKl7fQPPCb8AQDCIAAgHAIAwiIAIDwCAMIkACBcAgDCJgAg
+fAIAwigAIJwCAMIqACC8AgDCLJa/L2BJ2AUAhF8AQBgGAIRjzDS+yStb2ia35TFhGgAQrjHTGLWN
+x4RvAEAYBwCEcwBAWAcAhHfgLW3DRV67wJKwDwAI/wCAMBAACAeZaawuzWPCQwAgTAQAwkUAIGwE
+AMJHACCMZKZhlO+VsBIACC8BgDATAAg3AYCwk6VDvlfCUAAgHAUAwlIAIDxlprH4VZaEq8w0JvTm
+MeErM43kaB4TzgIAYS0zjaiOPCbMBQDCXQAg7AUAwl+WlwP4ecJhACAsBgDCYwAgTAYAwmUAIGwG
+AMJnACCMBgDCaQAgrAYAwmsAIMwGAMJtACDsBgDCbwAgDGd55WUeE5YDAOE5ABCmAwDhOgAQtgMA
+4TsAEMYDAOE8APwXsN6fng4JrUHPVY2pK9lstaqF3kpm0zcKT3/VD3j1vjvY2jwb0iyqSXYJbZmh
+l9HOY46ZbPvpna/dbOq/y6Z4F5LX35z46QF8eh0APmsOL+Dbrhp/YGlraOVRtj7VCA1DldCateix
+Rq2w+5KlO6a7kKXmY4RUvPO1m1S4SYWbVPz3SAUyN1/+c2GPN33WCqNtn/VqhTXgnzdyEojq9NQt
+FlGvlux5fuEDfYdCvkAh3/uVJGoTPzS+770qhOZx/qfbTOdxIS5J9Kes8zmJN80fjsmDuZGqee6E
+j12y0C9VH2q+XHEKruSLM1/1HerOSndnpT9QVvqt/ele3h6ax+7ucHwwBZL3KJtAfDMfJa77qUXD
+Kn7XPEiNPOZGCx0Oh6ObV2BaTlLWSTtJkoaSJC1lOeHjbFPaSXNJkvaSJA0mSVrsTnl3p7y7U97d
+Ke94RCnv/370zy4PfuYvI0vO8ANm+N1vl6PhmWY52K9YKmttbcu0OcrsEV72jM7dlPV+V0zrt6/C
+Bu837BtGRmBb/Ub27W++oOxoutC+M/Ip7Gr9jX13g2+wJ08v095S55W9TUbaS3/1jO6JpOlm3agx
+liejXzQ/1Xu2rkyl7uayLV7RlSswUlu+QqKu/Plu2oo+LXQVt0Vos72SwKDd/N2bNV7c14PXPnm9
+M2dbMUtlrWQ9qnazSiTpI7JFHOFll4J+3XhMH5fl9lVssehjsxwZwWP6+CTpEpCky0CSLgVJuhwk
+6ZKQpMtCki4NyyYjWZXoEjHyJ02X1NlRkupIl4uRv/dsSZ2tJNu66dIx8hcYKamzFSTVkS4jO+4+
+kur4f15OWi52NkMZMeaXpFAPINTjfqFHZ1pf7japRllmrSxstrxW2TL7/UvmDeOCLeIelNdevfyK
+7tqxXtrrexJ1NzKitDfTW+gc8ytr8W4lnWZsoNZjeIDOc1BFs1d8BYt3O39zniZ+Fh/9KbPPW80s
+ftrNZr+F3+j87Wnm/+pGisjft+tahLyhbbF9uS46qL82euk0XcvTbbT/+o0Uj+ceqAmKM3OWszs0
+vplZtPS8obkzazYzY/b/2KQqzYFJqYTxS3NgUi5h9NJ8+jajL68Vxv6KThh7L60w9kSdMPYorTD2
+Fjph7MLsSAkBgBQRAEgZhbFLXw9SSj7RdsLsSDmFsQuzIyUVxi7MjpRVGLswO/eWh//WlgcCK2Hs
+bwhz375cJ4y9vzD3pdNky8PpNlq4tzz8s7c8/JsAOrvNDGm/1/3w/ptUAwEY4nsm9UrqWb2JKSkn
+xfhKOBkXR0cmel/HTz28wlvPqpCWuTzZ5sabloe5RClFCikH+27VXb2BNAVwOLqDHrtFSXtRjb/D
+kWhAXloJRwHge1VThEhmSGhoTT1CQuk/FTKsiSqhtZCbj+1wOBwDG65nZk0fnyRdAgCgy3C3pRi6
+JI/zkswjuMS0XFCzUUio5BScMs+ZnOABJHjcbwv1rdk5pGe/+7Q7dKeFuhMZ3Gmh7rRQd1qoO4PD
+ncHhzuBwp4X+/WmhhbJQuKbJQ3rHJw0cmtS/f04aJ3pn7XKwsVlt47Yq/e2xM3dLz0EPjacnMJE/
+53gLbtWEfz9NWjdk1iineuU5bDXpfbvTzjPc3Tzl9FypT9tMNtbRCQAAncRt2uzp4eWVeSbj7M7q
+6X58PkF8PvnpfAwxfzopIsShjUJCQ/lqPrn5u8uBGiBQc7+epZlXs1mf+NQ+8QPcybZujupOtoU7
+2dZN1dxUzU3V3Mm2uUq2DXIhFEOG9MshVbrVaOjd/B+qlrpX7NRpZlv+vHYnN/PQaByOBhv/TM7o
+OCs5y2F/G+/MzIlCv3xTSzIoyi8jSe9Oks4ga78bOpOsBM3TwyPzdN5ufb+TomutbxQSKnH0NouG
+Nw/SAEGa+xXtyLyOkfGDUxNT7tHd0cf5n0/CeczJyyvWRYy9sbVBxM1JV9McGzdZkbI/TTPEYPWI
+iE7z7FDD6qWrkubdqG1EHgSl+QR8HeHz89k035sFI/y+2Zrmf+BiRMD8M/rAVbsjgsba9Hk/WBGR
+r8/neu1rO635o6boC/T60lqw4lB9oaffsxbOPymtSPkXrEXOpaYVy/OctdjWjmnFjza2Bi8JTyux
+pWtEyYnBaaUWN4oobbqe9sQEXYTuJU/9k8keEU/VvaYv0+ZwRNniB/TlwmEtd+Ubffnzl60V9s7W
+V7z0vbXSmlVplXd/bK0yZ1Za1S/HWkNGv5xWbVpfa/UePdP0yusRoU0j02oYkyJqNtytrxXRKiIs
+oLm+dlB6RJ08O9PCkS8ifIOvvu7xKtZ6n5zQ118fZG3wxkZ9w/lnrY36LtI3HrvVGtH+x7QmfZZa
+m9b8LK1Z1FRr84Jvp0WGfh4R+bsprUX+KREtznroo84NjYj+OlnfcmtcRKt0pLVeUi8iZq2Pvo1h
+tLXtjEVphqZ7re1GmtOeqfJHRPuuKWmxAXsiOtU5qu+0+JS1c9EMfecJm63PXvpE3yU5zdp1Qe+0
+51JHWruNi07rHtvNakyqkhZfK8LaIzoorWep5yISKp5NS7jgZe1VIFDf68CTEb3PndH3WeUZkbTV
+pu8740hEvyWf6/uPXBcxYOIU/cCuCyKSn09LMzV4M2LQs5PSUsrNsw6ul5qW6j3OOiS4Y9qQI/2s
+Q6+Gpw37IcY6/KfgtBEfVbc+/10d/Quv5reO/LC4/sWB1SJGjb6mf6mNNuK+QPbo+urRPY/D8d5w
+gB+9MgusfsB9o+RRykhQ6QjuUk/rA/raCNHXQYjeCW7OjnoaTW50RhrHNeXW8aQ7JEl/SLIO0Y30
+iG4p+9MAgPQJAEinAID0CgBItwCA9EsQLojHpGcsfz7LY9I3ACCdAwDSOwAg3QMA0j9G1bE2HpMe
+AgDpIgCQPgIA6SQAkF4yeaw4lMeknwBAOgoApKcsz6XymPSV5daOPCa9BQDSXQAg/QUA0mEmj4sb
+8Zh0mcnjBB2PSaeZPCZ78Jh0GwBIvwGAdJzJYzj475OuS6LXZR6TzjN5vPS9VcjjKv77pP8AQBgA
+AIQDAEBYAACEBwBAmAAAhAsAQNgAAIQP4L4ku/nvE04AAGEFABBeAABhBgAQbrDc4Ms/J/wAAMIQ
+Jo/rg3hMWMLkcf5ZHhOmMHkcu5XHhC0AQPgCAIQx4I6mU3lMWAMAhDeSMGDiMeEOy7Me/PcIfwCA
+MIjJ49Y4HhMWAQDhEQAQJgEA4RIAEDYBAOETk8eRZh4TTgEAYRUAEF4BAGEWy8Wn+PcJu1hO2Mxj
+wjAmi8lpPCYsY7KYOpLHhGlMFmO78ZiwDQAI35gsRsv8J5wTsijzn/AOAAjzWB54MkLIosx/wj8h
+izL/CQeZLC6R+U94CACEiUwWuy7gMWEjABA+Mll8VuY/4SQAEFYCAOElABBmsjzSj8eEnQBA+AkA
+hKFMFj+qzmPCUiaLr+bnMWEqABCuClmU+U/4miOy+A/GWP8GIfrajWo3CNELgQytVrt6jRpCH+mI
+SU8r72vnV/gCK3JMHiN7xd+zvHXWhLFizuPASRfXLPMZ8V6Ez9WNEcW3XbIFr/nJWuLzvbaSc762
+lpr+h6H06JnWJ17YY9D1WxnxZJevDE+1+iCiTP0ZhrJVXoooV2KUoXxAfET5a0ZDhZPNIioeHmmr
+9HK7iMrHo2xV4muyjfTSeNytipsX8AHzQImHvp8G36zHTgrOATq+ZvyQm8/lcDgc9NkAgD4fy6sb
+WdLnZLxe85OV8frzvTymz8x4Pf0PA+P16Jk8ps8PAHQNGK+7fMVjuhaM1/Vn8JiuCeN0iVHODtTx
+PKbrw3h9shmP6ToxXr/cjsd0vQCArlnmvH+Y1801xGl8zdYn2AMIznbNP3/WSTUwITGlx5CUETnw
+EzK7kGq/q7pPKdDRy1rdu26ak/97yq9wK0svTlrw5aSFwBC9nptYZiYq5OZ9eOGw6j6FDXlHAUZ6
+zyx+R47f1zU5ovGOSTgB8P+cxYKfju8Xn9wrOQen75k1x77Njfecl8jD4ejOEJNoAC8TyFeai+vi
+6ZLhXqfDWDjf4Pb1uM+buF6EjOCU1zIAZCCn+UtPJ/VNysGJls480fcLLrHWrl9Urb37qjG8bC1j
++Jf7ja3yV1VbLcpnbL3mhDEmpYnaZs5GY9vg71XD6EVGw9WP1Wf6/ag+89PzttjY34yxX/VVO9Za
+b+z4fms1rtQRNe75amrnG+vUZ5/Vql0OLFC71j2vPrd2rbFb8R1q94M+RqPHOSNdiGxT4z0AsXlf
+2DSyRuPUrZo5bQhbOusXQZ8RAOhzstx91QgA9HlZfrmfJX1ulovy8Zg+PwDQNeAvcs5GCXMFf89j
+uh4sr37MY7ouLH96nhGFrg/Lr6SvJ10nlu+3lvBWqSMin6/Gkq4bANC1Y+ZyQMKDdA2ZuayVnv10
+LZm5HPThMV3TzAn2/1xX1w62kcknjzX1AJrmODvp6SED43sNSc3J/PNw/vdVMhVDA8z3EmzNjETk
+6Ju+9ZckJBJ4Hdn+RdfPqBY99NXHAD5GTtG4RXz/eFN87+TBuVi7C/xibmuldLHvDVWK+qtVBl0w
+8Ol5enh6OhyfASDW4iwt6HW7qCCxFmIwuXkjzhuZ21q2NBb73gAA9KYsB10w3Lo2OX1zJ1Hii7Vu
+sE13BsCZHCNyi/gBPZISU3LzLU4xRHnJt+dwvDwScDheNsm3F5KzUJnLfKC/dns+3O0v0ges20ja
+ZH9RcunMuQDmZvsBC2b9gEPiE+L794wfeM/6tHf/kG1rjs+colMvwNlwOrOxdK4/ZNua47NO+jv/
+oisFaRBzqe/9J32g68cckIOzKpS5fKwA2FBnqmqrNs5+qs5qnK192X5tOnCzcYXxDk1pOJ6Ls2Na
+VWieHmLyGJYXnuU7mXxM0+Czcas90HciAke+XjXomylK3sQPTfWadTPSOvef7l4OR+1auGPZWxPI
+z7AL0KJ9LIcyW9CWqj4526JW2BnaLSyB1h82CqqEtCZJH4kkfSyS9NFI0scjSR+RJH9MutFHpRt9
+XLpNq8qCPjZ4k3Ve0I0+PgDQJWC5cSv/Pl0KliNf5xfSJQEAuiwAIJfG846y8d6Z1+b1Zbm8Qv70
+mBn0fXfHsY5nNMAZTU4NQFTywOT+Q/oPycFueZ+sNStWejeLWvnkoLgN4xpmyOaz6PCdbw2Oou/d
+S+PtcPT3AByO/nHwcjie70Oe3/N9KDP8+T4aLWXhylSPah+LqOaxiOoUiyiDM4nD+ZibU6KvLFU1
+rSZJp8byyUFxsn2nYYbr9p3ocJIP+XT9o9rHOr+GXekj06I1QHSOv4b/sfce8FFU3fv42RTSCCw9
+VFdQemDpoUkgxBAgEEgIVbKTZEmWbHaHLSFByiJIUZGhCAgISxVRYGlK0RhQECkSihRpC0hvo6hU
+yf9z7s1mZ/YuMbzl93+/7+vmE04m4Zly597nPPfccnoaMwxmi9Hw92v4f/4apEMgvTjD81/C/3Sv
+raK0lHQmnUWXxZVKNrqeoKIN/xnwKzT3b2J33XFhod4GUFg4EujUfAjG6fhQjnav1K3pdPxSrnQo
+vhLtyfWgZTXgV6Bl1cQuLavSXxlLq/Vr4S1ak9KalLlv/l8rKlmdMppNXGnmjQVJG/aCCh8U4Ojg
+rjttClxjlIVTasa3fTc90jWPzEfhhwopprdLPEhGBssSDa2Uiwo6hUtdWp0eLB2zqHxxyxbqWWu1
+pkOYHxRIhzDxRj2HMtHiTQMA4I2DdO6Zr4+/j4/r7hcc/EeeQS6S5qRZyiUrAJIVpe0Z9BrNjeT0
+Wp2hlOO5ZQAgzAYArrdy+PWJ5K00zS5X9FZ8FX7+/gCLne7nec8OgeSpypKmqSRPVbn4eYpGb8Nb
+tCzteym+Ffo+wlTeyp/cmqT88RYl5f/P3qa8U+Y7dvqvnRQAnUrNtHHcSKupNIGfwCKF6rVVHO4c
+w+MDSlqEH4Dd9vzK9A82heK7oEVe2VaaJkDuTvIK/rm7lJd4/uBePm0VAG1LXdnjONMoq9bMmV+g
+sxI8N753D1dPbFwEAHri4p5YK/WLdHyCae+udw9p787bOUn/rpX6tfYdWlE/vn/Fro/WAcC6UnNu
+nC5tNJdbyuhf8Vzdq9Z3HFfvWFUKH9/CwsHbXBE/smYskPxc1hX3Q+eQULr+ia/UhY9vN5SIIrwU
+sS92ueCEhMQitvMzlDt4GgBOQ2mHOvpwVpP1BUITFVdca+J3ULix72hUA7HCy2uuS+YvLx4LQGZo
+08obTKptuaJq28o1Wbjli0RCiNvGS5IBcOEGFYtRDURSkV9ec13ahF7gFuTtZkhSbedTAHha6p50
+H51V+wJx5HIXlo3s/fvqbZri2LFmC0BhocZeJMqCi15qS7rO8EVOTfIpLhtJerZ4CZCGqEu+THCn
+8BYtXiuqO0mj+q8/AAAHSh0W6mM0jTDqM0uxkKpJ0c91bABwtutDW1D5/RlBW69lJJW/mvHGgg3i
+8Gl2MTlnlqjJeEvkBpnFlB5pYmrEADGt4euiNqydOKJCKp+uSOTTf4nmMy625XVHGvAjd4XxmRuD
+eL39MZ8l3OINtrO8kT/E87qH4qjBN0RT7E+iuf0B0dLoS9FafZ2YHbREzH40Uxx9wybmnB4l5n6f
+Io7ZniC+uWkCP3Y5z4+bzfHj3+rPTzBF8UUO0a+UY5oAH+UAAHxkA7pGIZisV1C6fl7ogKpkhKlG
+UVVs0dJVIVu0bKUucqakEBUKheJFSpT6nmpkygiWLAAAli6xW68Ri6UMAIAlDWR+pZ1YLHEg8yvf
+IhZLHsj8yjRi8Q0AGTJ/nVh8E0DmV6byAAD4Roj9JZpYfDNAhswbEItvCMiQeRCx+KaAzK+8RSy+
+MSDzKw8Ri28OyPzKG8TiGwQyv/IAsfgmgcyvXEcsvlFiH80kFt8skPmVo4jFNwxkfmUCsfimgcyv
+5InFNw5kyLw/sfjmJXLo/6e3XyxgCUc1jWuhxsmQ8vUq5wx9g3lfAN63pMZaTtZYrVlarhQzzgPd
+g9rfWspd3RFfPmlnvnLv4vgK7e5FtrT3EluFdeeLhyjIYOrislC6wVSAEis3cwt0/PlbC3mYq3S8
+GG8HAABviXiBdvciAQDw1gAA8PZAOory4rco70IkzOq7Sq0AUJdaVcVz6cZk/KeUSsjXNYIyy/9J
+oMIHYCMZWUx+6HL/eIelVBbF56NL+/cF0qX9JZ9XIiFyx844uwEANpRaQsRzes76j40VFRY2nUsa
+YFHSuVJOo/WVPmPli4f1lATb3pb5vqJTV/a8QHCnJur2r4XTsYKGy5JXbAGALVDaqFy8zpLK6UyG
+F/HRNgBo071bYPG9TSkLUFg4LacoHyj1y+oI3GpAHfHC7n98u2a/QdElZEVQ8mVQU0e8Rjcn2/50
+x6H9ALC/1N4/3phh4LW6F5j1rHxeDMPdB1IUFnY77r0L5K2/X8pOWhmX0q58cXtTWldUn5U2XuER
+n/Bx3eHcxNLfp5xQkj5+6XhzBUDzUneM440Gjtf+tw1NVZY9ocmSHGc0ac0puf/ouPP0hwDyGfAv
+0JBC6VrJIYGutZKShuQ6tXtBpGwRpHyOvaaXf/sdALCj1GPR/TmT0WI0pHOl3HuxOPJxYZlmn/Kz
+mg0rdNkaVjF3oqrSS/PDKg9OVVUuzA2r2j5aVfX6BwvC6nL2sH2jF9TwibLXWDN4Qc1Lr9prTeu4
+oPbuAHudjNoLXlp2w66KKVzw8sQD9rotaoXVG3ld9YryWdirPferXhWdYQ0af6ZqULA7zL2rivcv
+X4CdpLs6MsIdrccQKonTu0Korr1UShsnqC0NsYztfXYf7e1o9gEA4KOTsuyyNQzIlmU0mzcWA6lf
+g1PJMRYHkC3LoskxFguQLcs4O7H7RpNjLCJi1wwmx1hUAABYXORmdgeQYyw2IFuW3SDHWHxAtiw7
+QI6xGIFsWXadXA+LE8iWZfvJMRYrkC3LPiPHWLwg27jmX1bE2MNTv1ZUM/e2z48Z6gMw1Ke0vJPA
+6XiuNJ6uknTIuKDCtH/NcDEzV9WvsLDdKy5/TkZBi2nXNRbqGgfFMdHSjhZXlo8Wf3uW+oimw9Di
+0/znjBIztcLfVSTTVr5gwcgGiX/vdtjkVAA4FaWVfQlclpH7rxW40laQyGXoXmw21Nz4AdtcCte8
+D8A9ShneQv0PzH4ZsA0kmpk9o6yZBxW0q7sKAFa9wAOauNHcP+p2l7eGf3q6V8WDUOIZ5fG6lWf4
+V15sulciulcLx5fUT3ENXIcU/VzxaPTiyHsVnMp2T2IKIo6NK+jgFxjfwbQtsuPlm/Gd+i+MHFT7
+ZMGgMaqC4nABSTzrA7AuEgBgHV80MZSMIirKSX8ueq6W6uItxf5yRThze0SgRi8mi4TwNgEA8FaB
+rEAeRyzeMrEmutgEbx0AAG8fyNTFk+T/4WPIIh///KPIB5RXtDrR0KIAsChKG2JNNJVqAef/eSUo
+feaBXKb2H33mxf+CZhh2EEo8o7wZKnZdh79uhuVkz6fX68z/6BN+Nuiff8LANVDiGeVPOH7J8TV/
+/YQhkicczPH/C5W2DP7VWMIWhiFF31DkiEJcm2x+uKZxwaKy1TSLfvxJXHx6vfDRyq3Cku2CsHTy
+fMG+8HNYpv0KVo8bq9mXfV558Fov5aFPqgk/LI9SHp7dWlkweQcc+WA2f/SdnZpjKYX88V71hB+H
+r1OeaLRFebJpDeWpoHnK0z67hNM3cpTXE4/C9V+22G5cXGW7eWSe7XbleXB7x2a4s3Yl3F34Adw7
+PgTEMaPhly2d4Nchg+H+3DrwW4eO8Pu4DrY/atSG0JUDbOXeLQvlJ7ezKS0NbRXSqtsqJgbbKsW1
+hcqtbtuq1Dtnq1rpB1s1369t1e47bGGXl9mqH5ttq/HNJFvNLzdArc/sUHvxLKjz3lvw0lgzqPRp
+8PKwAVC3d6KyXqdo5StN2ypfrdVAWb9smLKBf5TQ4PfWQsMrrwqNfqwqNN4TIDTZ8lBouvKGED73
+J6HZ5ANCc8uXgjptndDijdPKlqYtyladdypb95+nbFP7I2XbljnKtn9OUEbUnCtEnOOU7Z9kCx2+
+ilJ2PDNI6LQ6Wei8s4Pw2pSuQpdFtYTIEfWErpPbK7u97i9EpdVUdn/1mhAd9afydf/vhZh6F5Qx
+d68qY313KWN/2KfseXmFstf6tcreB78W4t5/T9nns2VCX4NBGf/eJKHfwHeE/nqtkNBOLyT2jhEG
+VOsjJDVtJCQ9UAuDqryuHHSyojD494bKIZ//Jgz9MVg5zF5B+caW28rhE+4rk+f+oNQk/6jkxt0U
+Urp8oUx946CQ9tKHSm3n9cKIwK1Cem1BSL8+X8j40yTo9uUKI88lCplrhgr6vaOUWdM6C4bVCUpj
+xksCP6WNclS/TkrTiGpKc4s6Skv0I6W1Aiizm1cRssVLypxyD4Scgm+VuXdPC2Mcq5Vv/rBTGPvh
+bmHc+o+E8bkrhQnvTxCwk+Hn7xdYJsDXB7eboocv8BUU/O//AqjkIN0sEm2qpVIEApRTQqj858Zq
+RQX8DVR1/+v6K7b4gZiXIBrlf3QiROMP0dGuLbKKVtUVfZ4bpffarCn1FMSjxeaNFps4sT/+RHpL
+2NTRYnNHi00eLTZ7tNj00WLzR4sUgBZpgIz9XutFLNIBWqQEtEgLpJc2eQfBIT0QYfTOToJHmkCL
+VIEW6QItUgZapA20SB1okT6IvZFDjpFGiP1liw0t0glapBTSK6w8j/wdqQUt0gtapBgizI4PIRap
+Bi3SDVqkHLRIO2iResgI7rgO5LxIQfhBGiJe9N2ygB+kIwAApCQAAKQlAACkJlJD4trSLm2r2+QY
+aQoAAKkKAADpitj7DmKRtgAAkLoAX+Y3k4hFCsMP0hh+kMrwg3SGH6Q0/CCt4QepDT9IbwAASHEk
+9tG0LbFIdQAASHcAAEh5xP7emlikPgAApD8AAKRAAACkQQAApEIAAKRDAACkRAAApEUAAKRGAACk
+RwAApEggq693FiVYmkcs0iWQ7dpzqP1zQlGCJZroB+kTyHbtNNEP0igRzmdooh+kUyDbtdNEP0ir
+QLZrr0Us0iuQ7dppoh+kWSDbtdNEP0i3AABIuQAASLsAAEi9xN69SixSMLE/7CMWqRjImqa1xCIl
+AwAgLQMAIDUDACA9AwAgRQMAIE0DACBVAwAgXQMAIGUDACBtAwAgdRP7QE0sUjixJyuSY6RyAACk
+cwAApHQAAKR1AACkdgAApHcAAKR4AACkeQAApHoAAKR7AACkfAAApH0AAKR+AACkfwAAdAHEXp9P
+LLoCAAB0BwAA6BIAANAtAACgawAAQPcAAIAuAgAA3QQAALoKAAB0FwAA6DIAANBtAACg6wAAQPcB
+AIAuhFjxEjlGV0JswbfkGF0KAAC6FQAAdC0AAOheAADQxQAAoJsBAPjb1TzX1QRHRSeGt8D/jVu/
+k60ZW6ibtSke61617fOo4cEAw4NLUqSBRJGaLNb0567hCS36xs/Fop9r2gBg9tX3bYt6ZfGLnn1n
+W7z4ie2j7yfxS8Y3h6VrPxftySm2Ze/e5Jd3vWZbYTDyK+vVhFV9l4tr/e/xa68li+u+/1hc/3E9
+cYO9L++Y6i9unJ4pbuLr8ptN0/gt8X78Fy1789vK7+O3FW7nt99by3+57wvxq21rxLylyfzXC94R
+8xeK4q4cvbg74w3xm0F9xG+7+PN7ItTi3g7zxO8GfcZ/d/oC/73vUf77X1T8/ksp4oGt/cUDt1c4
+D9p6OQ+eXS4e+qC1eOibXpofTMudP+z5mj9srSoe/izdWdB/srNg1TL+SOJD8ch7sc6jLUc4j749
+iT82+FvxeOxW8cf2R1QnGu1Wnay+WXUqaKXq1KMPVKdvTFH9dHq06sz3Gaqz2werzm2abD+/3Gq/
+MHuE3fnWQPtFUw/7pZT29sv9G9t/7lbTfqVlWfvVl/+0X6vZSHU9pIbq+pMQ1Y2bT1U3f7qjurX/
+vOr2jsOqO2vzVXcXblTde2e5ShwzR/XLyMmqX1M32O8n2O2/Rc2y/97qLfsf9cz2B5XS7A99B9gf
+3n/d/uhyO3vZTxtD6KKaQDV4tKC0tBUqpDUQKiaGCZXiuisrt3oslF6DC8qXxk5UqvQm5cvDUpV/
+a/D/LA0OZaSrW339Svzy9/gu7VeAlxMHlpF8FTGg7Hf/oq/CwgepAEiPCj/AT5D851oqRaj791DR
+9Vf6cy2Voqr0/xOCRU4dGI3fcZRlCd0WESz9FBEs/c2LcCLJfNq5bG20yI1EyPfKIkIaOZII+sVP
+iEWuJIJ+fHMggn7t50TwI28SQf/uTfJ35E+0yKFokUfRIpeiRT4l9loyOUZeRYvcihb5FS1yLFrk
+WbTItWiRb9Ei56JF3kWL3Ets4XZikYPRIg+jRS5Gi3yMFjkZLfIyWuRmtMjPaJGj0SJPo0WuRot8
+jRY5m9jTF4hF7ib2FxWxyOFokceJvU13fUM+J/YsLQ/kdWK/6UU6MsjvxO75mpwHeZ7Yz9LJ75Hv
+iV21jPwdeZ/Y92LJ75H/iX2bvi/0A6RjFLuVWPQHtGO0m1j0C7RjtJLaRx+oaMdoCrHoJ9Cir0CL
+/gIt+gy06DfIYO3sEcSi/0CLPgQt+hG06EvQoj9Biz4FLfoVtOhbSAcspAa1T0KIRT9DOmI/3SEW
+/Q3tiB1W0Y5Yvop2xDYSi/6HdsTmEIt+iHTEUjeQ66A/Ih2xqFnEol8iHbF6ZmLRP6FFH0Xs/deJ
+RV8FAID+ijSoRTVB3mGLFmiHra1AO2wNBNphCxNoh607zYjb6rHw7+mwCUraYZuopB02k5J22FKV
+f3fY/u6w/d1he36H7W9d8u/XJcEDoxPVA92dv+YtXN0/0vnrPvhY7HehAN+FltT58wWA/n1Lk5a3
+kXQu4jcDYm1HEwfbSky/e+1D8afC+nBm3yH+rHOw7dxaEM+bzHBhaxrvHPs2XJw3X7z0xjbb5dEH
++J9fuw1X1Cr+6suj4FrFVfx1n5W26/dPiTcun4KbP5blb+0Jsd3+vIt458FTuLvgW/He6eY2Mfch
+H/A0CQLvvMMHnZtuC/7hK7HCmWW2ionneC/7JXj/Ahh1HQCAt7lnI44XFGXpb0g+2oRE6JlA89K+
+SDGRxds/iGRVIRYXkRCJg23/VMreax+Sv2OxEgmx7xD5OxYvkRBrgfwdi5lIiK1p5O9Y3ERCzJtP
+/o7FTiTE6APk71j8REKoqdTC10AkRMVV5BhfB7H3TxE8vhYiIX4sS/6Or4dIiM+7kL/jayISYgGV
+Svi60OIrAwDA14YffHUAAPj6AADwFRJXf2ZZUWz23/YqJemFv6vh2+2RD8Ajn79uKb1KOfUuAACU
+754Uc2ZfrfoQl12d1M7kT979SHPq4WP+9KFw508n3xHPrCvvPHv1knjuvV+cl/o2En/O/OLhlUph
+GVdTfHOuVeh9/XpzPuf6td8ybpbvn3PzuxMZt+61zrn98baMO4er5tydujBDbDMqR7VCI7xsSBfq
+Tuom1OsTK0gmOin8JV/kNwCjyaONniade6so65r99PYCujmGgiyV61VUXL26J8KLPHZhYWEhPjrt
+BFV96Fp5Rmq2diapMVgUpIY/fExr+KFwIrKxWEjNXleeHGPxkJr93i/kGIuJ1NjML8h5sbhIjU3x
+JdfDYiM1tTlPjrH4SE0t358cYzGSGnuvNTnG4iQ19nBVcozFihaLFgAAi5eIPkM6sVjMQPZI/bcV
+dXCvhMSiOfaXqz45p/MB0JVYM4MAIEFnSOd4o+l5kyACi75d/x9/VtkAYMqkt1fsSO8j5Af/rNm/
++YrmwKzkwKM9ssSqs+5KV/MEBABwbwCQf/0AUngIBEhX4UOkq6AsgC4HKpKHCyMNrhaZQEZmz7XD
+b5zlom7nWkiojnDdmMJlX+TG6YyEhBVo8QHQ4kOgxQch3cXNNKsYPhCpgT2yRAAAfDAA+H/7cLhy
+IuK1Ir6p/+X6hksVAEsVfzVRINFqytTmlmJE0VL0MxnSEt79fQGdKJBXsFyb6lzx5lvxK/vYNKu6
+bClY/WoD58cNJhSsbdhQs/binwWfPpru/OybFvH7JnZxfq+oFP99z981+3+wxR84sF5zNDMo/tjy
+9prjUT7xP/YOdZ5o9GbBySb3nKeC3ig47TNfc/pGl4Kfbm12njk6LP7s8QLNuU2vxf/y23bNbyn3
+4n/PKKP546WIgjKGOXxA+4V8wBMrBFUfywcVHIDgR8P5kI3PbGVPR/JkssDqdTyOzpV/WCG++nQH
+X/2sw1nzaoFYa+89sfaiFnyd98ziS2PTRJV+gPjysNfFur3bifU6NRRfaVpdfLVWsFg/5InYwL8B
+3+D3ML7hlSC+0fHHfONvb/FNtpzlm648xIe/O0lsNnkD39xi59Vps/gWb3wptjTNF1t1XiK27p8r
+tqltE9u2HCq2/TNFjKiZw0ec6y62fzKE7/BVfbHjmU58p9VRfOeddfjXprzKd1kEfOSIAL7r5Fpi
+t+gbfFTqM7H7qwf46Cin+JdByJnnBK9ByIg8ZXEQ8rUlJAg58HCz4k3SXuALx0bKBJT+KzCI+fLz
+A6Czw2tmgB8ZsAgkQxVk8AIqAEREKqp4/l7+f7B5xMZJxi+aqFtBE3Xr4pEL2TAGvEgFp0Pm+06h
+xYouHzLPI0PoWOlJxOzNt8jQOlZ+EjHrsoX8HRsBWmwIJHLWsCH5OzYItNgo0GLDQIuNg0SiFJXI
+MTYSQjE/2MgxNhZCMZlB5BgbDZF3UT7kGBsPjRC9Sc6PjYhGiN4ooEPn8zU0QtSFHGOjIk7w6DCC
+x8ZFI0SvkWNsZCTiknKPHGNjIxGXlyIKAACw0QEAYMMj9omV8mf1seQYGyF+sCGSgt74zAYk03kk
+Lxs6X72Olw6dYwMFfAnTHeT32FBJRblaIAIAYIMlSmBRC55GUOiqWGy8NIJCV8ViI6YRFLoqFhsz
+jaBUF2kEJZhYbNg0gkJXxWIDpxEUuioWGzoAADZ2GkGhq2Kx0QPJVjNJpBGUDTyNoNh5GkGZxdMI
+Cl0Vi2RAIyh0VSySAo2g0FWxSA40gkJXxSJJ0AhKd5FGUIbwNIJSX6QRlE48jaDQVbFIHjSC8ipP
+IyjA0whKAE8jKLUIDgkFAABJhUZQDvA0guIU/yURlJnnhBeKoETkKb1GUF5bIougIFmBZGPF/3rC
+Qnfe6rVwOsq6+7d2Xy0KAFgUUJI798Xyi0oshY52CQc/+uOAxCj4x1DBAxKjaCzg8chmbU0AYIK/
+0pEDEpp31XPmzOfNaQ8q+saPUPQzmU9zYHrbfLplWjf146Mx9mfbUiML17yuhgXRkYppDdU+OQ0i
+fUd1d/hxUfn+/eo7ynR9NT+gRaAjYEZyfpDypiNo3ZnIYPGgI8R5IL/s6Rvq0Px1+eW2H1CX/2R/
+pPLDdeoK0z+LrJg7U11J935k5cGj1FVi+ciq7RPU1Rr1jwyra3RUD2odWcOnn6NGk8rKGr92c5DV
+WkdfcZDVWpvKOMhqrez2arJaK6mmmqzWavOnGpcW1a9yQU1Waz174mgU/L6q0flzjsY3eVWTvK8d
+TY+9Zw9fsszRbLPB3nz8JId6Try9Rbpd3dLc0t4q5i1164TK9jYN0tRtW/5hbxfwujqiViVVxLWG
+6vZPfld1+C5Y3fHMSVUnR31H553bVa8JgY4uixapIo03HV0nf2Hv1vegIyrtQ3v35usd0d3ftEff
+v6iOCV+gijn2jTo2dIwqdvMqdc87w1S9Fu9y9D6RY48bu8LR5/Mh9r7D3nbEz+tk79cp3dE/u449
+oVasIzEJ7IlPmziS2lyyJ13poR5Up1A1aE9j9eBnF1VDVpVVDz3/jWrY5LvqN/JWqYanFaiTl0xV
+aeJuO7ipK+wp4T84UtPftqeFOhzamHS79s5sR3qDWHv6IYtDF9DErluX5Bh5LdSeOc+s1hc0VmVl
+D1AbHGVVxqR2an7mXdWottXVJmOBylzlidrSd5PKUljNkR3xgz37/CNHTpjDnpN3xpH7cLZ9zJKv
+HG+estjHjl/qGLctyT5++ETHhAURdrqBUklfZQID/h9+AXz70LVQBDdLVQSSf8uS3yjJz1XwX6iB
+i4MVdcjP9ZBDuiYkQteBidA1PhG64r56XXFjvcH4A3btuvZKSJT2C4p+eE5bCy76xs+sop9JQsou
+u/88gnbc1K496F6AbfPlewF2U6PFdoiWtEX8YHvEz4LoSHL1aQ3JMbZNAABsnwAA2EYBALCdAgBg
+WwUAwPZK7Ixkcoztlth1Zwge2y8AALZhqidukPNjWwYAwPYMAIBtGgAA2zUAALZtIMsQZ5JjbONA
+liGOIsfY1oEsQ0wgx9jmgSxDNNIEKEGtyTG2f2KbVFYS+2s3cly8HPHoK+S4eDnipjLkuHg5YnZ7
+tWw5YlJNcly8HLHNn+TYtRwReQOkyxGfPSHnQw4h9vw5coxcAgCAfAIAgJwCAIC8AgCA3AIAgPwC
+AIAcAwCAPAMAgFwDAIB8Q/RJQmVyjLxD9ckf5Bj5BwAAOYjYa/T9IhcBACAfUX1ykhwjL1F9sp0c
+Iz9RfbKIHCNPUX3yBTk/8hUd4fmQHCNvAQAgdxF7/yI5P3IYsce+IcfIZcRuXkWOkdMAAJDXAACQ
+2wAAkN8AAJDjAACQ5wAAkOuIPulEE5gg5wEAIO8BACD3EfuUJjBBDiT2Sg9yPeRCYvc0JsfIiQAA
+yIsAAMiNAADIjwAAyJEAAMiTAADIlQAAyJcAAMiZAADImwAAyJ0AAMifAADIocTeoQlMkEuJPUQT
+mCCnEruOJjBBbgUAQH4FAECOBQBAngUAQK4FAEC+BQBAzgUAQN4FAEDuBQBA/gUAQA4mtrAaOT9y
+MbHnH5Fj5GRi886QY+RmAADkZwAA5GgAAORpAADkagAA5GsAgCLO9vuLBCgBQYH/D7+KSTvn30rd
+oAA5eQfjX9rj/8FxmJZkEl4L3HCGKK8Pqy7io4IAooL+ah0MUV5aq0X33GXD3rTXy1R7dS+g2is+
+8vHRAY5n20bFF65JjIQFCfGKaW0jfXLaxPuO6p/vx/Ur8O/XOr9M11YFAS2q5gfMyCoIUj7MD1p3
+Iz5Y/Ck/xHm6oOzpB5Gh+TsLym0/HVn+k1Pxyg93RlaYviO+Yu5HkZV0i+MrD54QWSV2fHzV9lxk
+tUaa+LC64/KrB3WLr+GTnF+jSV1VjV/j82teauWodbRlfu3dVRx1NlXOf2nZA4cqOyby5YmnHXWT
+GkXWG/mH+pW2IZGv9jylrl/lTmSDxjvUDQqD8hsFL1Y3On8rv/HN8eomeYfymx5b6AhfsiG/2eax
+jubjZ+Wr5wx3tEhfH9nSHOloFSNEtk6o62jTwBTZtpWfo11AYmRErZfVEdfaRrZ/6qvu8F1YZMcz
+V9SdHK3zO+/8Tv2aUDW/y6JP1JHGh/ldJ+9xdOv7U35U2seO7s2/zI/uPt0Rff9eZEz4anXMsSOR
+saHT1LGbN0f2vDNS3Wvx4fzeJ6Y44sZuzO/zeYaj77A5+fHzejr6dbLm989u6kioNTA/MamcI/Fp
++/ykNqIj6UpS5KCXQtWD9kREDn52Tz1kVY3IoeePqIdNfhr5Rt5m9fC085HJSz5Qa+Ie53NTNzpS
+ws/mp6bPcaSF5uVrY6wO7R17fnqDgY70Q2/l6wLaO3Tr0vJHXqvpyJw3MVJfEKHOyk6NNDhqqI1J
+0ZH8zKfqUW0bRJqM59XmqkGRlr75akvhq/nZEWcd2RcC8nPC8hw5eTfycx/aHWOWHMh/89RbjrHj
+1+WP25bmGD98Zv6EBa87/vO016eXPPfuM2uwAWvjgWxarKiM/0IYacy1yc/VSQJzzF8+MBH6xCdC
+t4RE6Na9qCH36P5Piq6X5aJryHAquroXyEVXfCQVXQMcVHSNiqeiKzGSiq6EeCq62kZS0dUmnoqu
+/jT7HNevgIqu1vlUdLUqoKKraj4VXVkFVHTR7GvYaKnoolmEsPFS0fWAZp/L31lARdfpSCq6aBYh
+bNBUdNFdobBhU9FFd4XCBk5FF80ihA2dii6aRQgbPBVd3eKp6KJiEBs/FV00ux6SABVdNLsekgEV
+XZXzqeh64KCiK4ZmNZp42kFFV6NIKrr+UBPR1TYkkoquU2oquu5EUtG1g4qvwiCafS54sZqKrlv5
+VHSNV1PRRbP/IZlQ0bUhn4qusQ4qumblU9E13EFF1/pIKroiHVR0CZFUdNV1UNFlIsdIOlR00feL
+5ENFF32/SEJUdIXRBcRnrqip6KLvF0mJii76fpGcqOii7xdJioou+n6RrKjoolmikLSo6KK7eCF5
+UdF1JJKKrmlUfG3eHElF10g1FV2H86nomuKgoovmhkFyo6JrTj4VXT0dVHRZ86noauqgomtgPhVd
+5RxUdLXPp6JLpOLrShJdIP1SKBVfeyIiqei6p6aiq0YkFV1H1FR0PY2komuzmoqu85FUdH2gpqLr
+cT4VXRsdVHSdzaeia46Diq68fCq6rFR83bHnU9E1kIqvQzS7HpIpFV1p+VR01XRQ0TUxkoquCDUV
+XamRVHTVUFPRRTtLSLZUdNHOEpIuAAASLxVd+VR8FdLOEpIwsRcC8qnoyqPiK+9GPhVddgcVXbSz
+hORMRRftLCFJU9E1M5+Krtcd/4Gii7L1kJR/D2ezaovsR9/Du9jKuKcMbx0E0LpEsRVcJLZMujFG
+A1eKpG4h0pkvS1Z3EJbuDLDZp9QSli28YTswvaxwuNqFWodnXvmsYGKNWo8fWpVPrg8CPzLxycfX
+R+FTWDjvLgDJ2OdHcvUFkp9p3j6yh15c90SIS0iEuIGSp1a8yK2Ricd+ZYnnwVtEi7eJFm8VLd4u
+9VxlyTHeNrEzr5A9vfD2iQd7aCXn+xc9RnBcQmI78n6qvZF3IUMBkKEoxfuJ0hospueuSJEOC98u
++jmMvp+mtqU7HvH2KeVsyxae4ZcfsMCKSQ/EldFHbatST4urE07Dx1Xm82ta7YQ1zzaLayt9BGvP
+fyB+en8CfJY3Wlx3jIP1SwaLGzZHgWN8R3Hj4mTbpvRB/OaxXW1bYjrwW4fVs33eoBb/RSd/27Yy
+z/jtNa/Ztl918jv9/WDn3t38lz9fha82nBfzvt0HX8/MF/OHnIBdjk787snvwTd9J4vfphlgT/MR
+4t64d2zfle8h7gvX2/Y91PL7Q/vY9p+K4Q9Me2ajWj5IPGa9D8cjb4k/DvgRTqgOiSfbfAGnYIN4
+usqHcNo5S/zp2VbbmXyzePb8fNu5TwT+fF6u7cJ0E+9cMtR2UZfIXxrf2XY5ti3/8/CXbFcGdhKv
+xnSCa+3qiNcb1IEb1UC8GQBw849L4q2rl+D2iW/FO3u/hbtfX+TvbXDaxKXf8L/M3G37dcIq/r5x
+pe235Kn8732n2P7oouMfNM+wPWz4tviofE/b48B08fHDdHhyPVZ8eioW/tzXRHy2rQkUrgkVYUEo
+KKbeE31G3wPfUWV5Py7E5h9/ly8TeccWoC7gA2Y0sAUpN/FB61ZAsDiXD3HOsZU97RBD8622cttn
+i+U/mQ3KDy1ihekWqJibJFbSJUHlwRFildgIqNq+hlitUQ0Iq9uOrx74FGr4VOdr/Bpmq3nxCV/r
+yGNb7V3n+Dobz9peWvY1r8ouDy9PXMbXHfAL1Bv5lfhKm2Pwas+lYv0qW6FB44lig2dHbI2CU8VG
+5zfbGt+MFpvkfWBreiyFD18y2tZsc3e++fjBNvWc+nyL9GxoaQ7kW8UMgtb9b/JtGnSAti0P8u0C
+akFEzetixNVn0P7JfrHDXid0PPOZ2GnDU1vnne+Lr808b+uyiBcjjfm2rpNn8N36LrdFpRn57s0n
+26K79+Oj738BMeFZYsyxDyE2tK8Yu/lN6Hmnhdhr8Xxb7xN9+LixubY+n6v5vsOG2uLnVeT7deps
+62/9jU+o9ZItccAJPvGpwpbUZhufdKUODKpzXBy0B93t5+KQlZdg6PkF4rDJ38IbeWPE4WmrIXnJ
+MFETt9vGTc3lU8JX2lLTh/JpoVNs2pjOvPZOhi29wUt8+qGeNl2Agteta2obefUynzkvFvSHC8Ws
+7CZg2HBRNCaFAj/zG3FUm3tgMq4SzVWOgKXvVNHy7I4tO2Iln33+sC0nbAqfk7fRlvswgx+zZI7t
+zVM9+bHjrbZx25ry44cPtE1YUI73Vfj89Zef5Gf/Mj6K/6NfhYXrjtNcY64sY+6ErVCW/F5J/q3s
+zkEW1Z3OWSPLAQcmQlS8qyOjKLYvwo/ESfg+s1Mn0ZQ4A+RK6iTK2aiTOENXnRywABlDn/RARIv8
+ScbQU0+TY+RRMoZeZT75/8inxD7bTFelVPqIHCO3kjH1+xPIMXIsWuRZtMi1aJFv0SLnokXeRYvc
+ixb5Fy1yMFrkYbTIxWiRj9EiJ6NFXib2qpOn+XP8yPmRo9EiT6NFrkaLfI0WORst8jZa5G60yN9o
+kcPRIo+jRS5Hi3yOFjkdLfI6sQ+1dPVKaB9yjBxPnPC0ZzZ59zGI4JDz0SLvo0XuR4v8jxZ9AFr0
+A2jRFxDrnEWnhj7bSs6LfgEt+ga06B/Qoo9Ai34CLfoKtOgv0KLPQIt+g0y0G/4SOUb/gRZ9CFr0
+I2jRl6BFf4IWfQqxf9CJe+hb0KJ/QYs+Bi36GbToa9Civ0GLPgct+h206HvQov9Biz6IzHXooiPH
+6IvQoj9Ciz6JiJjAdJGKmXSgYiaWHKOPQot+inbTmwDtpoeKtJseSgz6LSCbz90D2k0vy9NueogN
+AAD9GAAA+jIAAPRntJvewEa76Zt42k1fAbSbPpen3fQ5RXMtHCLtpltttJs+W6Td9NlAu+kWkXbT
+LUC76Uki7aYnAe2mR4i0mx4BtJteQ6Td9BpAu+nt6ByNwKdAu+nVedo9D6OrXi4+IcfoK0n3fNc5
+cow+k3bPv+Zp97w80O75MnKMPpR2z+lUXfSltHu+lM7ZqLIVaPd8IjlG30q756ki7Z5vttHuebRI
+u+cf2Gj3PIXO2Vgy2ka753QzavS9tHten6fd82yg3fNAnnbPBwGds3GTp93zDkDHRA7ytHteC+ic
+jet07sbVZ0DHRPaTY/TVtHv+GTlGn0275++TY/TdtHvOi7R7nm+j3fMZPO2eL7fR7rmRp93zyTba
+Pe/H0+75F0C753T6Ifp42j3vK9Lu+ZtAu+ctRNo9n2+j3fM+PO2e59po91zN0+75UBvtnlfkafe8
+MzlGPUC75y+RY9QFtHuusNHu+Taeds/rAB0TOS7S7jk5JHoBAAA1A+2eLxBp9/xboN3zMSLtnq8G
+2j0fJtLu+W4b7Z7n8rR7vtJGu+dDedo9n2Kj3fPOPO2eZ9ho9/wlnnbPe9po91zB0+55U3KMuoN2
+z2MBP6g/aPe8CeAHdQjtntP2inqEdM/b0PaKuoSOiRwB2j2fSo5Ro9Du+UpyftQqtHs+hafd8402
+2j3P4Gn3nLZX1C+0e07bK+oY2j0faKPd87/1zL9Cz+C8/LZR3vv3C2d88iSwHEBguZL6j+Vp/zGa
+M1vCYw1pOu65nXypSEot+rmWt07k/oF1/62ds+u/ViWdsWfbaomFHxc6Yf4zUTH1orN782w+unuU
+Jvr+GjEmPNkZc+wdMTa0qzN2s17seaees9fi6XzvE5GauLGZfJ/P62r6Dovj4+f5afp1as73t17V
+JNSqwCcO2KdJfHKfT2qzVpN0pbw4qM5e56BvfxEHP1vjHLLymDj0/DvOYZO3im/k6Z3D0+aLyUv6
+ODVxW3huaqYmJXwen5oep0kLzeG1Mc012jtD+PQGFTTphzrxujL3Nbp1dfiRV3/UZM7rIOoP/+LM
+yq4lGjYccxoHPBP5mVudo9o4RZNxvtNcZbdo6ZvrtDy7wGdHzNNkn9/F54TlaHLyVvC5D4doxix5
+m3/zVCfN2PHp/LhtdTTjh8fyExaApqhB+fl7qYBlfF5k3thzvwoLN3R4Xj1WSOoxVC4s/C5fESat
+x646TCaNuQYZXJkTFQAvUu1eRJtjdfy/rCWxuku1Iqn2+MGqj5/5dP4jNgHqU7OpL+0epaE+dQ31
+peHJTupT36G+NJSmKcfmQX1qPSf1qdN56lMjNdSnZvLUp9bVUJ8ax1Of6qehPrU5T33qVQ31qRWo
+Lx2wjxxjc6I+da2G+tTy1JfWoWICmxf1qWuc1KceE6lPfcdJfepWkfpUvZP6VDr/FJse9albeOpT
+MzXUp87jqU+N01CfSuefYpOkPpXOP8WmSX0qnX+KTZT6VDr/FJsq9akdROpTf3FSn0rnn2LTJT51
+AC1/bMLUp9L5p9iUqU/dTX1p31wn9akXqC+NmKehPnUX9aVhORrqU+lGzNjUqU99m6c+tZOG+tR0
+nvrUOhrqU2N56lP/z1NAcHRCYpto7+6s+fTr5tllAGaXKcmdBbvdmdZUmoy2t6WJL5esrsMv3XFL
+Y58C/LKFhzTLtZf4FZNuOFeOXM+vavOxZnXCfvHjKtM1a1p9Jq55tsq5ttL74trzU52f3ufFz/J0
+znXH+ovrl/RybtjcWnSMD3duXNyP35TeU7N5bCt+S0xTzdZhVfjPG5TTfNHxAb+tjKjZXvM0v/3q
+Uc1Ov9/FnXu3aL78+aT41YYCZ96328WvZ25y5q9cJO4yznXunjxO/KZvtvPbtGRxT/NBzr1xY/nv
+yndw7gsfzu97OFCzPzSS33+qvebANJEnHlfzxHnM+rN4PPKc88cBe8UTqq+dJ9usEU/BMufpKu+I
+p52TnD89+5g/k691nj0/nT/3yVua83mZ/IXpaRrnkjj+ou51zaXxzfnLsQ01Pw+vwF8Z2Mx5NSZc
+vNZO6bzeoLx4o+qvzptlfhFv/nHceevqMfH2ic+dd/ZuFe9+fUxzb8NRXly6VfPLzC38rxPma+4b
+5/G/Jedqfu+bw//RZajmQfMh/MOGo52PynfiHwcOdj5+OEh8cr2j8+mpDuKf+2o7Pb26z2in6Ms/
+0/hp/uT9452aMpEX+AD1bk3AjOp8kHKlJmjdXDFYnKIJcb7Nlz293Bman86X2z7ZWf6TSaLywxHO
+CtO1YsXcHs5Kuhix8uDGziqxjcSq7cs6qzUKEcPqNtJUD7wj1vAJ0dT4NZivefGOptaR23ztXYc1
+dTb+wL+0bKNGlQ3iyxPnaOoOuCTWG7nB+Uqbb8VXe85y1q+yWmzQ2Oxs8OwbvlHwAGej86v4xjfb
+OZvkTeWbHkvUhC/R8c02t9U0H9+LV88J07RITxdbmh5rWsXEiq37n9W0adBEbNsyT9MuIFSMqPmT
+M+LqPbH9ky+dHfYeETueWeLstOEu33mnzfnazAK+y6IUZ6RxE9918gRNt75z+ag0TvO32nlBDe7n
+/5w//Od/FRbuWAJA+NSPcGug69/v8pFzv8tXkL33kVMJtw5MhOh4Cb8qnjs+5pULqbwS6Q6aq+vw
+VF7d0lB5BTyVV4c0dPnQJZ6GPm840SJXktBnm481NPS5X6Shz+kaGvr8jBwjf9LQ5/s0BHp+qpOG
+PnmRhj51Thr6pBvYIK/S0CfdsAb5lYY++/E09NlTQ0OfrXga+myqoaHPKjwNfZYjx8i9NPQpamjo
+8zQNgV49So6Ri2noc4uGhj5PijT0WeCkoc/tIg19biLHyNEk9Gmc66Shz3EiDX1mO2nok240hLxN
+Q59j6cY95Ts4aehzODlGHqehz0gqW0/RZVHI6TK5qnnipHL1Z7qxTuQ5J5Wre0UqV792UrlKNxxC
+zqdyla4VRu6ncvVjnspVLV07fH46T+XqWxoqVzN5KlfTNFSuxvFUrr6uoXK1OU/lKl0Ohn6Chj6b
+OWnoM1ykoU+lk4Y+y9PV8FV/JcfoP2jo87iThj6PiTT0+bmThj7phkHoU2jok25whL6Fhj638DT0
+SZeDoZ+hoc9cDQ195vA09DlUQ0OfQ3ga+hztpKFPGqpGH0Tsw0E038b1jk4a+uwg0tBnbWdpZDf6
+KQAA9FUAAOivaOjTqaGhTyr70HfR0CcNMaIPI3bdXJGGPqdoaOiTyj70aTT0SWUf+jYa+qTLtdDH
+0dCnVqShzx5OGvqMEWnos7GThj4biTT0WdZJQ58hIg19NtLQ0OcdkYY+QzQ09BnM09DnHQ0Nfd7m
+aejzsIaGPn/gaehzo4aGPoEuV5s4R0NDn5dEGvrc4KShz2/pcrWes5w09Lmahjwbm8kx+lEa+hxA
+jtGf0tBnOycNfU7laegzUUNDnzqehj7bamjosxdPQ59hGhr6TC9arvZYQ0OfsUXL1c5qaOizSdFy
+tTwNDX2GFi1X+4lcD/0xDX1+6aShzyNFy9WWOGno827RcjWbk4Y+C4qWq6U4aehzU9FytQkaGvqk
+IW303X930/7upv2tXUqvXUrqF95//P5XzlAAZ2gpVuv14EZzOl0ppFCAtFu40z+qx86XRw06ML3z
+PhrQjI34cYa5B2624qfwLyzU+7gTZY3JUASSf8uSf5XuDDqumVo9BiZCj3jpjK3nDkx7vSWyV46T
+z6MDtVFkURPeHp2t1HmfPLgVG0HUwb/2dunEMlL+J7aNdcQqAGL/Mm3pgITmRRHm8AQLZ8osze4b
+C0uarSQNMP87Zv24As03jzwscdYPjrb+t8zqod3K/91ZNv50C7a/ILV/YCSqzD9PpOv3ewbepCE3
+12/AyxiSNPCGn+LN0UoXd1/4j8yJeV6c/f/KHA3PuDuywD8zR8M1J+PvORb/WXMs3EL87zkI/2tz
+EP732L6kGQPLx6Y9fC8I4L3SLL+M06Vm6NK50oyxzCj6Oeztr+oO/bDTXJCOU7jGJZ60Et6TjgN4
+xvmDYQX/d5z/fy/OT1fx/Id8FRZuepWu6gA/thtL/q3s2jtT2pmN7v7C3b3iRoNSABsOWmw8JQWF
+PYO+2KieF9QsTVASG93fQcm/g5J/ByX/+4OS/5VEW1Lk0LeD7Y3PAwE+DyyN3DFaDRZOVxq5s0Q+
+pUS+AnK59pmwYnIZWBntFFbOXE1WRNIYVhg8aJ4tPGw4Fx6VHyQ8DswG1+rIp6cGKv/c1wGebWuv
+LFxTC2BBTaVi6jPwGf2n0ndUDZsfV13wj39qKxP5RAhQn7cFzGgjBCnzbUHrNiqDxeW2EOcyoezp
+ryE0f5JQbvsyKP+JXan8cBJUmP6WsmKuFirp0pSVB8dAldjXlVXbN4JqjRoqw+pG26oHBStr+DSw
+1fi1vlDzUpCt1tFAofauW7Y6G28KLy07ZFNl11a+PHGDre6AQmW9kQfhlTYXla/2XA/1q3yjbNBY
+gAbPLgiNgk3Q6PwuofHNRGiSt0Joeoy3hS95W2i2ub+t+fh0QT2nta1F+mRlS3NVW6uYEcrW/R/a
+2jTooWzb8idbu4DGyoiaDyDiWlll+yenocPeu8qOZ3ZCJ0ew0HnnR/DazNtCl0UTINL4g9B18iJb
+t74OISptnK1789lCdPdkW/T9PcqY8LEQc+xjZWzocIjdPF3Z804k9Fq8Suh9YpgtbuxUoc/nr9n6
+DtMJ8fNUtn6degn9s31sCbXChcQBP9sSn5YXktrstSVdaaocVOcyDNpTTjn42R4YslJUDj3/MQyb
+fFT5Rt50GJ62RZm8JBM0cQUCN3WqLSV8k5CarrOlhc4VtDG9bNo72UJ6g3Bb+qFBgi6gvE23roMw
+8uovtsx5A5X6gnKQld1eadgggjGpppKfeRRGtflTaTJuAXOVC0pL33lgefZEyI7YZMs+f07ICZtr
+y8n7Wsh9mG0bs2SZ8OapQbax4ycJ47Z1sI0frhUmLKhl8/2PHjYoLPxorufKWOn62E/tCqXn+ti4
+F5/MsEQ2maGUi32xdZLJDJPLAF3H5STH2FKli4HdMa0woIPL2QIdXKb6DFswHVzOBs9FwlSHDVRS
+HdahaE5neyWN+dQCACAtnMZ8ngGN+dCsINjaAQCwxdOYD11/gS2f6ji6/gIZgOo4uv4CmYDqOLr+
+AhmB6rivgeo4uqclsgPVcXYl1XGTgOq4t5RUx2mB6rg0JdVxMUB1HM0KguxBdRzNCoIsAmT7i2Al
+1XE0FoWMAmTbiyByjMxCddwtG9VxNCsIsgzVcbVpNpmJG2xUxxUqqY47CFTHXaTZZHquB6rjvlFS
+HScA1XEXBKrjTEB13C6B6rhEoDpuhUB1HG+jOu5tgeq4/jaq49IFquNa26iOm0yzyZir2qiOG1GU
+Teahjeq4HkXZZH6yUR3XuCibzAMg9lrZomwyp4HquLtF2WR2ApBtL4KLssl8BFTH3S7KJjMBqI77
+oSibzCIb1XGOomwy42xUx80WqI5LtlEdt0dJddxYoDruYyXVccOB6rjpSqrjIoHquFUC1XHDbFTH
+TRWojnvNRnWcTqA6TmWjOq4XOUb2pDouXKA67mcbjWmVF6iO20tjW1ea0iwydS4DjWmVU1Idtweo
+jhOVVMd9DFTHHaXZZPKmA9VxNIsPsi7VcQUC1XFTbVTHbRKojtPZqI6jWXyQjamOyxaojgu3UR1H
+s/ggO1MdR7P4IEtTHTdQCWTbi3JAdRzN4oOsTWNatL0ie1MdR9srsjjVcXQPWmRzquNoe0VWpzqO
+7jWL7E51HN2DFlme6jjaXpHtqY6j7RVZn+o4rUB13P8C+5M9EuK8i7olF8fWWhwEsLg0u1oUZdMv
+hTfZIdd0PexLd1YR7FMa25ctfCAcmF7DTjVcfeWJZ5Zhp2zrhJ+efSOcyReUZ8+vEs598plwPm+q
+cGH6+4JziU64qOOFS+N7CZdj+ws/Dw8XrjRqLVyN6am8VrGf8nqDpsrrv7ZS3gwop7x5tIry1lVR
+eXvjA+WdvUeVd2edVt7bcFcQR+1U/jKzQPh1wi7hvnGT8FvyCuH3vnOFP7q8LVANuVxJNeRk5eOH
+k1RPro9QPj2lVf25r4fy2bYYVeGaxkpY0EilmFZW6ZMTovId1VDw4xrY/fsFC2W6BtkD1LeFgBlR
+9iDlD0LQuq9VwaJDCHFusJc9fUgZmj/LXm77BmX5T9arlB/OUlaYLqgq5pqVlXQmVeXBA5RVYhNV
+Vdu3U1Zr1FYVVjdBqB4Upqrh00ao8Wtre81L1YRaR6vaa+96JNTZ+ND+0rIzgiq7ierliV8JdZNC
+VfVG/qR8pc091as9v1TWr3JE1aDxEmWDZ3fsjYJtykbnD9sb30xRNsnbaG96bLwQvmSOvdlmjdB8
+vNWuntNNaJE+W9XS/IrQKsaiap1QRmjTIEnVtuV1oV1AhCqilr8y4loNVfsn15Qd9j5VdTzzvbKT
+I8zeeeenytdmPrZ3WTRDGWk8a+86+ROhW988e1Tau0L35nZ7dPcsIfr+MVVM+DvKmGNbVbGhemXs
+5vmqnnf6KHst3mzvfWKkEDf2A3ufz3sLfYeNtsfPayb06zTY3j9bKSTU6mhPHPCrkPi0tj2pzXEh
+6UoH1aA6vygH7amlGvzsmHLIymeqoee3KodNdqreyJuvHJ62W5W8JFepiTtv56Z+IKSE59tT00cL
+aaHL7dqYwYL2zmR7eoOOQvqhEXZdQG1Bt66HfeTVQiFznlalL6ilzMqOURk2PFMakxqp+JlO5ai2
+ISqTcbfSXOWOytJ3pdJSGGTPjsgXss/fsueELRdy8g7Zcx9OFsYs2WB/89QIYez4WfZx23oI44eb
+7RMWNBZKFxlX/EcyzKz6AMgqyDAzbZRh6M4rCiX9DTJMfPdEiE9IhPiBiRAfL42UKxQvwghUX9bI
+p/qSZh1EdqD6kmYdRJag+rGGXa4f69M08c8sw0jczrZOoGOe3wh0zFNQ0jHPVQId8/xMoGOeUwU6
+5vm+QMc8dQId8+QFOubZS6Bjnv0FOuYZTiwyDR3z7KmkKYv6KemYZ1Nqf22lpGOe5ag9WkVJxzxF
+YpGJ6JjnUWKRkeiY511yXmQmOuZZINDJmTTNPbIUHQNdIdDJmXMFOjnzbUGun5cr5fp5spLq50kq
+qp9HKKl+1qqofu6hpPo5RkX1M9VZyG4AAMhwAADIclQ/NxSofm5gB7J9HNVZyHpUP1OdhexH9TPV
+WciCVD9TnYVsSPXzISXVz7PsVD9vUFL9vF5F9fMsJdXPgorqZ7OS6meTiurnAUqqnxNVVD+3U1L9
+3FZF9XOCQPVzmIrqZ6rrkU2pfq4mUP1c1U718yOB6ueHdqqfzwhUPzdRUf38FTlGtqX6+SeajbHN
+PRXVz18qqX4+oqL6eQnV0c/u2Kl+timpfj5sp/o5RUn180Y71c/jBaqf59ipftYIVD9b7VQ/dxOo
+fp6tovr5FYHqZws5Ruam+jlJRfXzdYHq5whyjExO9XMNFdXP15RUPz9VUf38vZLq5zA71c+fKql+
+fmyn+nmGkurns3aqnz8RqH7Os1P9/K5A9bPdTvVzFtXR94/RPXrD36E6+thWFdXPeqqjN89XUf3c
+R0n182Y71c8jBaqfP7BT/dxboPp5tJ3q52Y0l0CnwXaqn5UC1c8d7VQ//0p19NPadqqfj1MdfaUD
+3aO3zi9UR++ppaL6+ZiS6udnKqqftyqpfnaqqH6er6T6ebeK6udcJdXP5+1UP38gUP2cb6f6ebRA
+9fNyO9XPgwWqnyfbqX7uSHX0oRF2qp9rC1Q/97BT/VwoUP2sVVH9XEtJ9XOMiurnZ0qqn2l7Rc8F
+ZNs42l7Rg1H9fEdF9fNKqqMLaXtFj0b18y071c/LqY7OO2Sn+nkyzca4hLZX9HRUP9P2ih6P6mez
+nern/3XPFxyfkBgR711bR6gznK+EALwSUpK2DqTaOoHLMnKlSMXgAwC+ABBoA4BZ/k8CFT4AG8m2
+xMkPXfvkkQxSCYnwIudDd2DovI/YvzpvcEJCYosW5CFzx844uwEANsBfJqhIjPqPT1Bh0GVrTebn
+bgz3H3GjfgAwMDxhwHOuVqHoGwCgT9HP9W0AsCi5zncfvfZr7pLGdx8tedBet3TywEf2U/oby6C9
+bVmn1ZErlVn5Kzu3dKyqkuFY1dk3/+M60fFbV1bXVGnwcUHVsLOR1QLPxFd7XD4/7Ga5gupnoiJr
+HJxTUPNLu1jrs1li7cVviS+WSauarVHO1zYmm9bcPP7/TTatdXxMvd1izN3vxVjflWLsD5+KPS9P
+EXutnyH2PriCj3vfKCb2rq3BhDN+Pn7+ZQICA8oEuRb1BodIvsqGyg6f/1UuGKBeewDyrx9Ai6mK
+QIB6o/HnLvMVZUkqmQokZUwV18+vxyuqk1QyteW/b6xWvOz6fUSk9PdQhYQD4orCAd3xn4TuEJfQ
+C5qo25D0M9GuiS5QRGLk4/4FAMDz9zD2Wr9o4qz879BiPUOLdY2I/MZ3HxH7oL2OiP3JA8kx1j0i
+9qE9nQjZaTXZ4xjrIbGdW5IgNNZHYjv7kt9jvUSLdRMAAOsnAADWUQAArKfEPi6fDwCA9RUAAOss
+AADWWyCpue0ikNTcs4jFOgz/1sRS1ehEuhyaOvwvE0zNzaOD4/81CaZowi9sd8Te/Z5YbH/E/vAp
+HRy/PIVYbItAEkzRQVpskwAA/+vtMjguoVdRdqjUjcumFfoDFPqX5DB8ATD383Pas1/RNwDAmKKf
+g0JXpgrl3q2hxDT5mCIf0+NjanxMi48p8avUuyVUrXRWqOZ7SKh2P08Iu7xBqH7MLtT4ZpZQ88uv
+lLU+W6+svXipEtPcY4p7TG+Pqe0xrT2mtMd09pjKHtPYYwp7TF+PqesxbT2mrMd09ZiqHtPUY4p6
+TE+PqekxLT2mpMd09JiKHtPQYwp6TD+Pqecx7TymnMd085hqHtPMY4p5TC+PqeUxrTymlMd08phK
+HtPIYwr5v8yu+P57Sq/ZFdvpheLsik0bkeyKmPId071jqndM844p3jG9O6Z2x7TumNId07ljKndM
+444p3DF9O6Zux7TtmLId07VjqnZM044p2jE9O6Zmx7TsmJId07FjKnZMw44p2DH9OqZex7TrmHId
+061jqnVMs44p1jG9OqZWx7TqmFId06ljKnUF/P/1BQAA/iRPObj+xd8UZyovqv6KF6m2QPILptKx
+uXdr0NjC5GhyjNUYAACrMpD0z2HEYpUGAMBqDQCAVRsAAKs3AABWcWLv5xGLVR0AAKs7AABWeepO
+vlJSd7JeSd3JUiV1J4KSupOJSupOTHRsbliqkrqTRDo21ymaxhaatqVjc7Ua0NhC2TAldSdRAnUn
+rQXqTl6lY3Q/ViUWmw11Iw/p2NzKGwJ1Iz/RsbnJBwTqRr4UqBtZR2MLb5ymY3MmOkaETYu6kXlK
+6kY+Khqby6H2zwlFY3N0jAibHHUjdIwImx51I3SMCJsgdSN0jAibInUjtQTqRuoVjc3RMSJsmjS2
+QMeIsIlSN0LHiP7pPIXvv0eOS52nsJ1eAG95Cps2kuUpxGZP7MmK5BibPwAAUgAAANIAAABSAQAA
+0gEAAFICAADSAgAAUgMAANIDAABSBAAA0gQAAFIFAADSBQAAUgYAANIGsdfnE4v0AQCAFAIAgDQC
+AIBUAgCAdAIAgJQCAIC0AgCA1AIAgPQCAIAUAwCANAMAgFQDAIB0AwCAlAMAgLRDrHiJHCP9EFvw
+LTlGGgIAQCoCAEA6AgBASgIAQFoCAEBqAgD4P0JPwQOjE9X4W4wAtGmmbt6iaVwLNf5EPPRblSal
+jQoAGBXwV126IVa99T+52xkMALzRrMsxWfVa899bBf29VdDfSwj+3iro7+X2f28V9PdWQX9vFfT3
+VkF/r8r5e1XO36ty4O+tgv5LtwpS+FQGd88wa33YtLkAgN/lwPPTdYRJl8o175qiSxvJGTyxrWa9
+/+wVPwD8LivDFUiwqakmzhM5cc8nju0AgN8VZchm/m5kWprOnNw1hUth8JOnPlp4wQfggo/nXTco
+48br03Vak9kTa60cJBwEAPwOlWG7Bbmx5iyOve3gPbWrBykAghSe0NhgKVTLQoe2mxhbUFQycuiM
+kGJoNy6LyzR6Qv8Mrl/pAwD4gIEuKCuBGtKtOk/ohaHvTj8FAKcYaEaoFDrSqveEnhjQY/1uANjN
+QN8s54bqzGbO6gn9oFJW5hEAwO/yMmhOeTdUzxksuSatJzjx5OFurqeVV40pSjfYxI0Zw2Xr9HoG
+P25Ly1ouvBKkn6EV3HjrSGtWipV9Tb9MSe2eHASQHORZpZtVLEZHcToT85aO9wqvPC4IYFwQHUp1
+f76rKUGauRQ9Z0hlLqx41mO4XyCAX6DnhSe96oZrrRYG2f1Bj/uumiVvC7vC3Uijgcs05Xpij507
+1uJzAPgcPK96qFkxtjuXyZmY+uy8fehHAMDvyjLkV80lSFOy1pycwOk5LsvzDD/uuX7L9aLkteS4
+2n2GkboUo9XCVOzZbZac8d4mVrdwg41WTs+UV/lXAw8+CAR4EOh53eyWxdBofXJXTmdlKG+pes2B
+AgVAgcITvLZjMfh1k1ZrMY5mwFemX/O9BQC3mCf+onMxOIZLMZqMBqZet7iy4Kz3J77wWjG4B2fi
+2Ca1cYF60x8AgN+VZNANXYqhPY0ZnMGgNadYTemeJ9h5JUzxsQ/Axz50Xaj7czXSfQIry9OLhr5f
++QkAPGHq5t6oYmAvLov38p4mHEza5LqovLT+7O7GZnAmi9HKVK4vG1a65b20Lsa4wbp0Ts9UrSaz
+cvK818uNPSRQgzmDMzM3nXOQm+h6xR7NOLYY3JtLNzJuKbz+yguuy8r5Y01PN1KXYtJ6pb3EPaPv
+uvy4/BWN7eWGG7MY4OztyXE7AWAnU1Kxvd1AK2dIYx42/VGDoV8AwBfMLXeJk0BTrFkpnDmDKejX
+/Lec9P6O2vSRwM1cJluvlL7bXFeWQ/37FkPjOD2XwvD06KaZp71f9YEUylstDNSx64s2+QCQz0C/
+i5dAzVoT4xJrO9JrLgCABUxD2N3PDUWpw7b86qs3Ol1UK/do8/q7scZ0Lk1nzmCuPPZKlNrlHuTV
++ZMECdpgMmbrShQ88tv+PLEY3AfdYQrzeldUfG3tdwDwHXPhJwPc2LSRXJbWwFx4ZJWHw04DwGmm
+qAuT3GAdl6VlfFpiwgdtXA8sr5WVBrmhRiuXmZphtFiY+vHFaz1cDUmuP+oOLob3tXLpXJrRmm5k
+yvubSQ1bem8Uf7jx8UaTxRjex5jN1DHjqkYrnwHAM6bUbg8phidwxuREL21ZKubl4AbDisGJuqwU
+a6aFufHd329tOsoHYBSjqR9KwCYdb2Q5c+5XKV02+gBs9PGkvVXJbqzVoGNo7+KghoE7fQF2+nre
+spBSjByoM6RlGLWZnmBpKmf5ZaeNAOiapS3qR3AM8sOqi/ioIIAoRq39YZQgDakZRhOXzhS0/Wzk
+E1dBy5tkTq4Unm7V6VnPtrDOTxNdqkv+zD3HSNEWXbqVAS8Z3j/S4gtg8fW8885vSsAmLt3K6diG
+FdSo06bWfgD4XUcGF8dL4elag0VnQKWsNRjNyV11Ji3z7tZ2SclznasmSD/8JG/niuIsXBZnSuVK
+OpEKpJ87U72eyJhlTOuvy+bSOC+k9fnGJ0rX2aqD9GN8z/vZTGlGVrv8Xtd+IcwPAL+rgfRzU/B2
+mp7WkVaGjJat+9C/mx8AftcA6SfmA28n6c0l99cZRzI3U+NMl7e9P9ORD72dJ05rSDOOYU7TL/d+
+sPe333uJt9P01xmTYzi9XutFsOiWN/nQe/kcXO7tXAmcnu28fLamxkLv5dPoY+8nMST3tLKhiJhu
+68r38QPow5xn86fPO09vK0tHo0yv/hjvBxDPlHOsw9t5Eq2p1iz2dpa0Xvmu99fl3OLtNAPMGVaO
+rcma9zd13AEAOxgvOHS79DRelPfjn+KDx5QBGFPGk6Hid0igZqshVWdk7j+6T9/T8QqAeIUnOnCX
+BG3RZRozOUPpaXnebima1XUdet9u7poRJn9gv4NuZDcugy2rwJWXavb2A8DvqnIxWuABTe7GGdK0
+Jo55+6fudzm5RQGwhXnsw8el5zClcGlsgwj5dEVBpA9ApI/nze86IUFr9VqmuzJDn+rfpwxAnzKe
+vrfTaRlUN4bxRffn/LC2sQKgscKzJ773ggSLUYfwBC5Fz77tdTOV5XN8AHJ8PF1Ky4uSMxi55CSd
+mW3D3ze80t7VvZTf/I3LUni6kcXyn8ZwI4MARgZ5llnNK1KszqwtyZPJnzv/rgRagv9yLojY90Mg
+AH5XAemn7X33GaK4rBSTLi1dm9yNY/h9jn5n0kp/gJX+njcR81R2Ct6YHGPCaud5hnerXV2u8QXQ
++HqWXriPQnIGQyobjTi0YlxIoAIgkIlGfO8vxZq4VK5E7y1/6+UCpODn+OyGOct+2wsAexkRMzFE
+Cs/VGtguTUB3v9EuBSR/6MllZWAv3CrN7+kRvwmVYDN0qVw6I69vf3floMtPyB96Z00ZOMPKZbDi
+a8Bvbab6lAHwKeNZY3qoJHCdNY1LQz9l0o7xPMUCTRWD69Hlp5hRX3IKo4nTJ/fgTClGq6kkgSMv
+gSkNZKfwJmuMT3d83kkB0Enh2b950EQKNltQirDv/cc9NQ3DAgGGBXqWoDlcis/N4HSjrMybX/nZ
+jmjv3dFybSVwk9ZsYYnqnRWr3pnmDzDN37Pa1GwnAVt1XoYM6lWdbXL5UvmFL3SSYrGtMNVG3a/L
+1zt8AHb4eDbzE53d4O6cIYszZZozuGzm1nPTCyd5Z/ijkdIzjDazj73pVnjHGihsGKYbFuOJTY4y
+admOknR3EPnVt/WSnEFryNYytU26XZxHD22AFGsxGXVMb3rEtbDO3jtKk4ZLwMYsncFLXYsN1x+t
+FQRQK8gTPSDZjY5OyzIavNSWOe2mLBV8AAQfT/SGDAlaZ7IatDxTUZO7VQ1wAoCTCZqOy5Sg9Rjd
+zubSjEy5bSsw7VsYBLCQufkleskJDGatgWNja2n6u+vyAwHyAz0vn53tRr9uNFmS+2j1XiqNNG+k
+vJ2PnuBxgoFcLkvRhyD46UEfgIOMKIidIsdzei3b09i7oFN9IRBAYGgicbobHqPnUr361fpTu+82
+BgAYAzwrXb3ZErgxzZLBpTBlN21C63fKArxT1vPahz+Ugo1mr9fusb/jxcYBAI0DPEtuwWoJHL15
+cqLVxDS2kx/1DfQehfFZL8V7fe+Vrlzf7z38dEwKtnJpWr3Rylbb4Le/mB6mAAhTeD77xxtkeIs2
+y0vQPd1ybpBLy8nhzxwyeC43yqpjBg0/f299cBkFQBkmXthqkxSey7GRiet9u9/XhAJoQj2LzbrZ
+je3B6XUjuBxPcNfMnJt8EADPEFzFXVJwtpcL3255ct5kBcBkxiPm7ZdgtaYso1mn1xtLamm1QPp5
+66AbX5QCxmUxisZIMulG5HKiH36MPVMvg5EpB0XmUtP+MgD7y3h2ypueY88Qx5m0BlYk7Vpt0NgC
+AGwBnp3pjj+zJ4nXWrQmr8NG97Mz/lxZBmBlGc9esO9t9jyJWr0+OUpnYdrjlPhu7eb5A8zz91RM
+d39lT5OkzWab9In0l++sLwOwnrmT7x56OYXOkIqqlR2jeXbhe58AAJ8Az9L9spA9zUCdgcviUkuq
+LvKX7Bvg43kSr9XEb2metUYgQI1Az8p+MlR6Bmu2jiGnsN5bv10YCLCQ8ebhVSXYUZzeynrzR03n
+xlxSAFxi+ht+L7nBPbksTpdaYjxN3tWr97IE7C2KNmCpkW8RBNCCad3Zr0qhBi07GeFAxp/pPwcD
+/BzsGaS83MyN7aU1WKypmbnNexutOrPXEbYf4l9qGhkEEBnkeSJlBy8nijMaLLpUrRe+kLZyeSl+
+GCU5kcGYkxzbh+l2NW5130XQcrJKiZOATZxea0jTjWTef7nPotOvA8B1pue1oI8b35tLjueYrosu
+9+KaeQqAeQrPuM6XfSVYXRbz7qU7aMjVzHv9JEjsqBvStXqtuaS3KH/sX4ZJT/C8lyctNjmHVMmQ
+4kdrTcnxJmz/zC3kmVKU130ArjPjI3107lPEcalaHfPKTxoPrlqvAFjPNByTXoo1cF4i/o0/6PTT
+MB+AYcyF3zDIwVZzSc8tv/DvvBRr0qUbmeY+/5P2u111RV7oDU0ysEVn8NbXa/SJds87/gDv+HuK
+iXizFG/hsowmNp7W7vdLp1zTy+QCunquFD6Gs+g5Q0kRc/mTvzlOgvYeJ1/fM+7wpUCAS4x6vT1R
+BsaOi5Z58LV1Pvv2ti/AbSamE/quFG7SsQJwi33h3pn+ADP9PQu9zUwp1qLnMvHBPfEW5ynlXj+A
+vX6eTc3vAyk+R5dq9OpuQ4e2O/hWGYC3mMhxyELJCfCFe4klztv9a8X+ZQH6l/Us9gN2CdpoSPXS
+Y/NZOG7hED+AIUx0Zsg6GdiiNZnYkd8OQ4+or/oDXGWK7v5GD3i2Lk3LtNKeR5Z+tDoUYHWo55NX
+3ybHm7Qco303bZ4y3rty/3OPHG3Wmkwc09o+nf9KamIQQCLj5I7sdeP7cN5m3ElneMrvnCuQYLWj
+kwcbTZklPbf8nU04L0HreF06+85GVQhe0CUIoAsT7E+5LQF7m3nSKKaCq4cpv+yZ36VIk9GQwVTz
+H9ZejhsWBDCM8cY/P5SBLRnJ3blMowXD51Y9l+F5oiPVlvLeTzTdx9f7iaK0WAGZ2SEXa4uuE8nH
+1pqVfc6J8IUkcF7GA6TdX3mhnqgiOZfVyrzLgFlfD/jNH+A3f89CnVDbjew7Umfg0rmSYpPyKuio
+5wbHc6hrSxKWct5Z/ooMm27QmSxWAzvJbGXiLlffUd5+xoVLT2DCeLSOndhT7Y28CxkKgAzGx37T
+TILPMGoNOqbf9CC4/PmF/gAL/T1HjzLUErDRZAnnrOFUIXie4/DYVg1dBCDXGK+3lZ8j2TgiOYHn
+dExjunr3l68tPgAWH88yqN1OfgpjctdUdqZfk5dPBLuGoOQv4Uh7T3ySVp9hLEkvyE/Qv5PkBFYt
+nqG/LpUVt1/FTRkbADA2wLN3M72z9AQGC5fcFUMgjNtPe//+p61DAFozWjEm2n2G/pzOkJvcX+cl
+YKk6nbWjYiBAxUDPW/gyXnoCQ6bOkBxr0GsZKvY9NMDHxUzydqAcLDmDNlU3gnkFRzN3/3TcF+A4
+4/vDhkmx6V4mZ+wcO2Ss687lNN5QI8WajXqrRVv6ypOTIUHrjMndTJwh1Vj6YYWmmRK80cyZdMaS
+op3yQv/I6AYncPjeY81cipbxn/k/NP5tiA/AECZcm5vrcQYTy5jlytX44GIQwEUm2lphrByt8zIs
+ZDrQcfN+BcB+hWfTVU+Ro43JJFjNniKuamBj10igx7ycadJTGJPjOSvbKdx6ccSpjwIAPmLajep9
+CTzVaNKaU3LNVkNaSTF+eQkUfiA5Q4aONxlTGb8x6vf7UdWDcMdVz9756aUStM7LoP3z+1bhn0ig
+luRunMmSgcPfjHI7tee3SzHlAGLKed77iLWyU/Q0ZhgYxmj5o/or74MMt7bJ0L10FguDXhNc/7bL
+6cnRX22XoXtbU9lpByFNGs9zXVv+2jfukKETM4xZLNlFRc+Y7bq4vM1O3imD05gUw1S5htlZah8A
+tY9n2Y/5UoIfrRthSY6ymkxeTvHJyVmrngLAU4by7+S5T5GoTbem4nxpnikCqDBz4Xl/gPP+nlWn
+cb7kBBlWvbYk5Sm/dtReGRTna3iL1UtpR85ZjY9LTqAbafUS+H2+7lWcl4BRgLKTgcXjrzf1HuX/
++ZYMbDGynbT3lo0f3TsEoHeIZ63ZetsNTkKWtnrxcNIWJ/cxkQ8lcJ0pnZUYZ9rXt7oGIuU1zvTI
+jR2YobNoM4wms7Yk9yxvLgB+brzOYNDxWkbkVU4O+koRBKBg3pcpxI0ezGVaLZylpOFAeW1xVJaA
+MeY2OtPgxTdfnby42VYFwFZmfk9oHT/oip4l1VK0ToftXi5f2eaLRgqARgwaVDJ0dy6bDdo2bbhs
+lGs9lTwEnf6yHG3F4czuA0xeA1nTo2bYjgcCHGfEDV9Xdpo4LnWUlTPpmDMM1qsauOZly+velCYe
+Z/A2GL12S3Ds9wEA3zOjY+81lcNT46ymNKbdnG+6rqz3oNAHrWX4eE6fxVb9WRlV964EgJUMYQ7v
+IIP3N1oyvKzDU78Wse3tIIC3gzwJs1tHGT7BaLVkJMcb2VegXjn5+CoAWMU4jOBo+SlyjaOZG9ix
+2G/IO34A7zBRrTtydKLJqGdE0s3Q16ZdBoDLzMvb2lOGTjKaLUbGy7eeNU/9OBDgMdNJO9nLD7oS
+bPPeRkN6rpYzpeRqmbe/oMqtW65nDwLpp+IAP+hq1uF0axb2+hTHp1d8Aa74eq5OuVsM02dxbDDK
+Mrtqz4/9AT72pxsXuT9lh7iAWV4mCV3suKT+Sj+AlX6eF7yV7MIZuLRcpn69snlD2ElfgJO+nhfc
+l+YCjrKwEZhDKdem3PcFuM884ckMN86YwtQmRYQYm+kDkMnMGvTNcgHNGelcCmcpCSp3A58Y3dDM
+DC6FY9TiscF3q17wBbjA3G+8yYW15JrYB434pGqWq4DkoYmM7CJgNy49I429ZDW1s9IdALjDrI3K
+HVOMzDB56RZ/r9rsf8AP4ICfZ62zv1mMzGRuVfj2cmMX08kvuNxWDDOkZ7LtZNxcXY89fgB7mJXN
+KyYWI00Gjl02W1Z/ufnIQICRgZ7lunyKC6nVmazMy0zICDrqvVyjZ7qAOnNGJju55/J8vxsHAOAA
+s/QraY4LiXNcmFUb066++1ltBUBtZjnzkLlFwChOn2q1sPM2rYMqjnIVkLyZbPrABc3QsbivGndr
+/qYvwJvMco8NC4txRl0KpzezjTp38kVdCx+AFkx9T1/iBhvSR+kM6aXHHrcXY62G9Ewv2G6+Dx9b
+FAAWZtyr6woX1qg3ZrExqVFVB3TtpwDop/Aspnsri5DduVR2rHT1Wxv0yQEAyQGebyZndTEuizOn
+Ws2lv2TF9S5ohpcldb/MDnKWVwCUV3i2sUUbXDgdu/Ln/br+c10OQX65iw4XzJrCMbjOlUbV6OsD
+0JehvbyNxThzBmdgGbNL57CI2wEAtwM8X2WPLUXQ17ksLt3qZa6ydbHuhF95AL/ynk/Jf1mEjeHG
+cCXVHnkT+/RAEQznarLM9dqunjdU5QFU5T2BVX5wAbUpJlZmra+WOzxZAZDMiE3DORfQmByVoUuO
+0xmYoHbg4C5PjvgBHPHzLKKQC8VoQ3pyLyNb28ufmKl0NVF5EYX9XIzNZth95MGT1874AZxhKHP/
+tSJYrCnTajEz/LX7t3ZfLQoAWMRU9gu3XUizhTOksGy7wzdp4gQFwASmZW79rQjaE1evsxXB/8yt
+lHMAcI5ZWmH4oxiZy/Fe9gi4882VVt4HaqY8cEG1JqvZW2B/+cUxd1zTX+XNparCn2J7cV4e9KNu
+y95+3w/gfeaF1vEpxmWlZnBe4jNHd7eJeVMB8CZTSNfLFGNNXCq7Qnd0hy0/uhq3HPlRYDESxQkj
+oxo9fjjyOAAcZ3qoY4OKkZaMLM6QZi0JK3817wW7sV6hi0/fS3vgB/CAmWOyKMQFzeAMabnpXEnO
+0GMrBKULatRncmxV6tvs+KAv/AC+YBT9KxVdSBNnNhhzORNb+7fMbpG7VAGwlJmwV72aC43bGCT3
+tmbx7Fzw2Sv9m1xWAFxmbvtBWDE8NcOLV6s7514z18uVU1PlmsXI0Rw7C6j9/VmLXGpMXod/dQHj
+vE2ifj7ud5UEx7zUnRET57tGtOWP+PDVYlw6l8YKhjfX+Z91LaaVV4eHjYuRmTiiypTr6i+vzF6t
+AFit8CydFk2LoQYdG9pZ/rpqi/diVTZzAa3mVFbNT/rRb+fRAICjzJjfXRewjy7VaGZjkFF72myY
+4Qcwgxk2f9zWhTRmGzOtYwxaL/w7bUHjb1xaTl5/93aUwM26FJ2X+ivM+0Z01X65u/g+sgjdN4uF
+hWx72uWOL8Adxst8Ee2CmdhB9vFrN1VqoABowAzynYktgsVnGIxZyfFa1iu+mW8/zikAOGZ2apde
+LjDO2uIM7ILhY3XbLnV1XuTYs71d2FzsOHNsS4ts8vVAVz9EXvH9+xRh+3EWtg7+oa/3XnkcjGFX
+w/V14XCdhoGNiwZ3uJ+s8ANQMP6iT4ILmjsmV280sTNQamusP5ZFOmEo5d2BRdj+nCHdyIoW8+xO
+M73X/SODXEhdLpeWUZLakQM3DC4CJnDeZh+0+OiCYpMfwCamiGKGFgMzMzg9K83+fNro+64+AF2Z
+HkFrTTEU13Nw7FjL5apPzul8AHQ+nm90Y4oLqzVa9SXpSPnt9te6cBmcIT2DFcz1v1zf0OUn5Leb
+l+6C6gzpHG9kh4WffRu3cpsfwDY/z6DZhzoX1qRNM2gzjfpcL431uxq+3R75ADxi5O8sQxE+kdPx
+bA/z7tenbntX+d+MKgZip9bLsMTgxGohfgAhDOvHWVzQFJ1eZ2YuakvZd/iuH8BdJvYzO8eF1GaY
+OENJwk5+t9vHFQP1yV2zddnMfjNdcud474P3mOqCZuDGCyUB5Y/Ze5oEyLMbe5TtcTW0jQKgDdPR
+6zfdhTRm5rIjjbsKynrvuue9U4zzUgmkzlReiXbOLMINGGk1pCfH414xhvSSuv5yN/NIcOH1HGdI
+4bxx4fMDB5vmutGG5G6cxWgqScfK387IBS6wyZo1iqlKpthr7/3sB/AzQy89PnQBzZbwPl4Cza+0
+GP5llAIgSsEGK4qgSTot8TZMe13c1drY1V7lRXV1qQur59J02V6DvEtnfKxzeWR5lTq7sghNBljM
+mSUxv7ycDn1SjDR4oeErzX5+6ur8yetGxU9dQG0mZ9GadAZvKwXGPNlaYYIfwATmlsMdxXiTNptt
+s2ubnZ5vCwWwMWN4V7f4Q1ecGYox7a5jjF4WAC8/vu8115QteTmv2C0Bd9OasqysnxR298nXBQDo
+mDVKnQ9K0FGcgWN3XutjnvxZOawaCs9I/OVjMjCvTU7Smth1w5E3xy2ohusQmKu3/FFygtc5rclY
+ItijC3tWBvaClY4hyN/16csSbE/OkBzHeRlCuO2I2dQiFKAFM1ex0h0JPo5L0+rYZhU+eWMj1/CJ
+/OpvPZag+2tzM0dyXtY/LA77uKpr/Eg+0at3oQRPR39itEZTOqu3N+7/qbL3QfvWUEZyDktyD63e
+y+ZGCSO+rZ/nB5DHNO/GChmeM+jZYcj3x58Z9H4gwPuBnpxUv0wZ6Go1W0ycHsP3UYme0Lfe+uaG
+PRDAzry6e+Vk0DStntOxdW5S/zLvrFAArGCm6B2uJsV3M+nM3naygm6fGLYGAmxlFtxvqi7HGzO1
+huQeOr2+pKeX38LautJTRGHk0MTWn8qaT5+0DQJoyzT5nCYyvNXkZey0xgnzoWgFQDQTlbvdWoru
+zplGs4pz2rLqj08oAE4wnRdjWyk62pqqf4EbfytCCu5hTOFMjJp6OXtV4+4BAN2ZGNsfXaXo3j1i
+mZ6P8e3OZxQAZ5iXbo2VQXWGNK2XoTjppeVMJ/SS4Y2mtOQextFMoSvPVY1xvXL5CaokSE8Qp9Xj
+anmDtqQ6I3/4n4dKT9AnYWBJL1z+ytalyaA4rdcTHN/8+sQ8BUAeA24+QgqO13oBS9taZTlJZEjB
+/axarcGs99JJkbZ2+eXLj5SegXBdSWXmUdNNMnBumkGbW1JlldeZbW9K0YmcOYsz6LiSXrnH/sBv
+S/FJulSL0aTjSip5uX97OkOKH6g1W0oiKXnB/zFTih2Ms3NG6wwGrqSJmHIZdWdBGcC9a3X65t6m
+70pnxMsLfeGiYmB37XOmxEtnIMovu3JJMTqaM1tKWmAkB05cWQz0VlStlvz5TrNAgGaBNDmh+1P1
+4zIQFc04oLS+6sVlggDwO0DOJRvKQFRCYtuo7omlXxjs2FYGUF6lcc1dXrOk6UrymhC3txiME/q9
+BJuk88Pk2K+OFGOxQLUmQ0lzleQ3vfRCMTbOiPOgdYaSpkPKGe/h9WJwH+3oEUarIc1b05fOL5Pf
+ue1B8QniuVTdCF1qSROY5RcP9A1wYRM4cyZnSc3QjuZKnGEmF/O1A4pPMNiaaTSUNIlXHshcFRoA
+URk6vRZ3MrboDFqDhX1fvfyf/Lo9EGA7wxp7qrng9HXFeiVM6SJyP3nPWBUAUV522RoaqvCxBABY
+AjwbgKZxAOYC9QT08Hn3pAkATOAJ8G8ZgJu7M93IBrXe8t5i1lAAbvpe0i7W/vLORccAiE7P5Zmm
+PPvSSzNXhgKsDPV8dmt0AETrWLJa3Xr4p65Hkd9Zr4QAiLakNo+JSywJJA/7/uoGNVEzsbrFwQss
+AGBhYIsS3bAWnrBacU3aWQHAygReXx8ggTGX61T1g1DvuPtSHHO9ur/7nvKOsydJcC09cZZWn3by
+/nhxA904BqZ8d+F277CHElgrT1iDkR+u9w5bMcgNa83ANjc+4x3WZ7Ab1oYJ7dZ6d4J32CMJrK0n
+bP/KHY+9w1YOccPaMYsIzr/Xzzus71A3LIIZhDOEH/MOeyyBtS99bV41rBgWzlSv9X9c9XFVE4+S
+fMMNY2qX9dmYbtkAkM3UrsdSGHO5Lhv2B3nHrRkuwTHXO57YOcU7LilZgmOq5e+vHOnjHeenkeCY
+erm/0JTrHbdJimMq5qETI5O9F6eGc+OY2xT6/HDHO6xsihvG3GXIPNPP3mHbJDDmJufY9gZ4h6Wl
+umFM6xHtPYd4hynT3DCm9ayLuHvdO+xLCYxpPXsrtIr1DsvQumER7LaJ6Qe8wyqPcMNKbD2BMli+
+G6YuCeWR0iK9CGXSag2jdalMn0aaH1PusapkUOiAqMTSg750gRKjSgLJbzJVVwQy4NI1M6cvCSov
+FeVICsUEo8zarT+fbBkeAjCcmZW/DVFWk5HXNu+ahdonjU3FcH77on4/lAH4gdmcLDHbjTakGb0E
+VIyZseGuwWf5lYePc2Ox05SZwWrF/ulz1pwIBDjBdM0bveVGWzK07Kof1YPyW+LLAcSX87zpDu8W
+Q7tp9SO89HgO7e35jWuHG7k63/uhFJtu4tJKDIDK73nSMgnY5GXM8Ur7T8U/AwH+ZPYV2LbWDTVx
+Fp1Zz2UzZc2dWnvWGgJgDfG87d82S+BWs1mrZ8qrcMfd+tMCAaYxPfrVX7vB1tQMzuSlq9f70PoY
+1+4n8kvr90nQaRzvBZx7UPGRNQDAyhT3jSMSsFlnSGfjxdoZ5dOTggCSmCsvOl0MjsrQmXUGdu7I
+t30G1h0cCDCYKfBml91gI681ZHBeri1VxfJXvfdWMby7NcXLq14b+0vh0xCAp0yLXPWoGBqjSzHh
+tsjMiNVbF8p1n1sOYC6zyeUF/8BitFVrMnhZqPLo6iff6QMA9ExxOyoXg3to9WadIZMZ8qpts70d
+WQ4gspxnJ2pwrWJwrFmvxUXMcZyhpNl88oufbizBe5/PN/CTGQ1cjy0v740ti8E9tSYvD71neJOe
+P/oD/Mhs/mDrWgzthRMBdAZs1symVz1abOkRCNCDmbj9QYwbr9MyQ7/Wetv6LPMDWMaMXVWNlwBN
+xuzSX/KXRDcylx1t7j74WOx3oQDfMdXS9EYxsLfOnMJ2sa9N/XSIi/nk1dKS6YaOtKboR3pZMSfl
+XPmFW5rdaKMhjb1wP8c3zd8KAXiLWfL21UQ31JqjzUoxehkhzN1xbn/bYIC2wZ5XHj+jGB7HpZl0
+zJt17uh8o1MwQKdgzxd0b74E6mV3cmlDkheWdrkEadJpM7gs5olfXXduQCN/gEb+ntctv9aN1hnY
+cdj8tR/3tIUA2EI8H9a0wY00Gjh2EXfqxmXTCv0BCpnFmOE7JVBzqnF0SRPQPEasdxVDnzMF7eMP
+/YZuCQTYwlRl6/fF2L5mdt3zo6HX6s4OAZgd4llIKUeLgfGcSWcuyYfLX06z826kMS3daPKyG5nU
+E8vL6fYVNxqzKDD+f+66aQvmBQLMY571lXvF0P46dorpkq4Xf+oXDNAv2BN4/4Eb6GWnlAXJb1q+
+9gP4mpmGMM4nyAUkk5VKvKa82Y0OkEBxrNakMzCv56tOoyZ4F0oBFSVwEzdSy+Yy4Z81SFvqB7CU
+mYT2bXUZ2MJyY/i4N0O/8gf4ilkmuLSOG6vLGqE1GXkj40j28+JF1317TAR+xQ3PNPIjmcLO3rek
+T+1AgNpMJrakpm6ocQTbCEzXZldzyRx5jVzX2o20GFMzM4x6RoQPHVeOTwsESGN29dN0KkYncnq9
+zsBQza73bs54JRDgFaY2Q7QbqzN5GZN9vrxKjpNBzV6KOeeeUXPfD+A+I/5/TSoGD9DncgZjtjmz
+JP/nscnrMDd6TEa60WRkWP3WCEUfTQCAhpkuuyatGJzEpVnHlNQk5AXdTS9B4jJKpqDftRxZuyAQ
+YAFT0Iez3Vid1suo0O0rC74zBQKYmPe7fIIEqjfo2EU9EUdvffCJH8AnTDnHT3Njjfp0ozdps2rb
+51HDgwGGM76z3Yxi9EDOZOYYnzB3TOpp7+2oYH4xdAiXbtKmlPR25S343aUSKG80Gcdk5GpL6jPI
+r1xxjRtuNXnp599QVv/CFc2Td9l3bwiC1zkcM8wtSdr4yECZjiCI6VbS/5df5FYe/v/wv4qZy8P/
+/KEg+It4uTySX+mHIPAaK38+ZBuFhJcIkQf/3zhMIOrSRz4VBUHw3AjMrIoVo8wAYGaef21BEPRI
+SCxpIVOgDNDnSBDgGqZML0uYpHmw5O9mwIkgiE3Vehv9ubDvbMczAHCG2QejzskgoPuxYlImzoAT
+unSsu8vud3Sba5q3vMZ2OFV8gqgMb8n/mla+uMn7kvJOpyVQk85s8bKJyJz6f3y+HgDWM3NIX/rJ
+jTamstcNzquww3syvIAzEiRuz8goviODFnq/5ZNuaC+tKd2Kc6A80W2zD77lfUbmjrPF6Dgug2lB
+ukf9fvSeVW7BOQlQn6bLZuf7Ra8RH/4CAL8w97z4vARsNeksXkh4aN7yX1yFJSfwAxck6Fyjhd2b
+qdZmnx6u55VjFzuLsf21VoOXZDzS+czy1jnuYhDE/sVE5jIyxEdXgyDWbOK0+pL29pW3mVfFIHjO
+nr7SOchyrun0K4J49t58x07/1ZV/wmNg534Q9BrNjeT0Wp2hpOx08itV+z0IeutSctkNh090WOd9
+dsLOB0EQxw7Ojq/X8Tfv7FwDgiGOZafRtapZvA/OfkUB7eK6J5a0ZY28Ej4MCga6MWfzbtxIDucU
+aUvaFFVekQoqydAJVlNJW4PKseqwYmyM1qD1MhdCum+G3D22rB0MfYYws8U/Ug+oHAhQOdCzaK7V
+x/8fHtWja2JJW1jJ6626eTD04bK5kcaSllTIXxq0D4b4/kxs3nG//QrvLy2lYzDEJyRGxLMv7baw
+fE6aD0Aak+Dwo+7BUDSTonlXXlfihiPy190xRoK0pmZ6c0ov//DNQ9eM7Moy9LN4N7qb0ZrO6Qxe
+N4yRvgg5Yfbu7z5DVAZnyWAHBvqsz+keqgAg3/LHHiIFe9mIUjoZQ17Zrgx1Q+l0DCbv2ZSCjBo+
+ADWYZSM/pUmwIzi2fXQ5PhtuApBvuepsni6B4n5SKVZWBe6rYZqyDy3jXgZluOGvc5mccQRTFaON
+t6538wHoxlSSN3QSsG4kE3R1Vru0YzUArGYc0+lMCdJq4EZ4SYb9nblAdRcA7jLP/JXejY7h9Bzv
+TXx8XmvzEteUaPkz38ySwrNSdOyr6hT3YKTrzuWq6YBBArZyaZw+lTOwxLLg+PWkuwqAu8xqs01G
+2QmYynli21hHrAIglpmM+9MoN7KH0WDUexkxez6aM7vRZL85LzsVS6uZvHZft7jRvThveWkmZe6b
+73pd8kh1uWwJVocqJItjX/ecNEs512I5j2RxoyV4I7r5kryvvKqczJFgn+eF8wf38mmrAGjLXLr/
+GDc8jhtpZQXj/hW7PloHAOuYWvrtm1KsaZRVa2ZFrp+h3EFXRmCPvWnHSuC6tNHsasghSbWdri3u
+5HyyZ5wb24ezsombk0b1X+99fdbT8RKojg0TnjP0DeZ9AXhmxZLCJkEaTSOMeoY9E2b1XaVWAKiZ
+gl4/SQq2ZmnZzSrGzji7AQA2MAVd4203Np5Lx60o2Y0sGy5LXrEFALYwhRU4RQrXs6Nt25/uOLQf
+APYzNPZACtVZUjkdO2Uy6eOXjjdXADRnpksXTpWgjRkGLysNpTwkL7DM6VKwgWNz5mh6+bd3pQaT
+O9m4d6RYkyU5ju6/yUycaJ8fM9QHYCibLOld9xn6cyajxWhgA8O/dztscioAnMzbvjzDDU/gdF70
+tPRte2TKninFZhmZywYVtKvrvVNyQ3BDE7kMHUtCK8/wr3gv7/2zpFgTx26BtqLViYau/XTkpfXZ
+bAkWS8rC8daSXpe8SSbPlcBNrCZR7LoOrpuWIxM+cCMHcplMFRm/5Pga74/bap4UqfeSrkV6vx57
+wMx3YwdzfElxO7kcDlwQDPFGb4JROjQYKMMsXI4Yk8WazulLWuArF9HzHcHQv29USQug5YBdmxHQ
+q6Tly/Ju39DPg+G5K5elo8nyImixLRgw+5Y2t6QZNPJ7g/xg8DLFR5oM3GNCJAISmnfV43TnktLc
+yt9r7f1FMK0V13eWtFO5fKLbO8co0KQbYzRwJWW+lOMa/0hwz5nHLs04JCfWkZcJDlV4eFHGoZJS
+C8gv2v5mMdjLBHipvpIXar+HBNeDG83pdCUlx5Fzw5FHBFZ0m+EJFs6UqS0pWaH8nSz2DUF4nC41
+Q5fOGUrqesqB75alwOfM1ZemtpGXj6YqAT5nmr2UtwNluC9fJjivlP38ur2sbgj8xUw0+WvoXi8E
+njsLTTqMLI853akXAgPDEwYwM1YqTUobFQAwipkKX6dxCAyMLnFinfwS+S1CwNskt+dXxbSWIcAb
+zbock5UkD/IvAwAw0Gegz8/vAYyNDAGA/28AF5CiI3bNCwA=
+`
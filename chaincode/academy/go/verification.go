@@ -0,0 +1,152 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	sc "github.com/hyperledger/fabric/protos/peer"
+)
+
+// Verification codes use Crockford's base32 alphabet: nine data characters
+// (45 bits of the transaction hash) followed by a Luhn mod 32 check character.
+const (
+	verificationAlphabet    = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+	verificationDataLength  = 9
+	verificationMaxAttempts = 16
+)
+
+type VerificationCode struct {
+	Code          string
+	CertificateID string
+}
+
+func GetCertificateByVerificationCode(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	if len(args) != 1 {
+		return shim.Error("Incorrect number of arguments. Expecting 1")
+	}
+
+	Code, err := normalizeVerificationCode(args[0])
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	verificationCode, err := getVerificationCode(stub, "VerificationCode-"+Code)
+
+	if err != nil {
+		return shim.Error("Verification code does not exist - " + args[0])
+	}
+
+	certificateAsBytes, err := stub.GetState("Certificate-" + verificationCode.CertificateID)
+
+	if err != nil {
+		return shim.Error("Failed to get data in the ledger")
+	}
+
+	if certificateAsBytes == nil {
+		return shim.Error("Certificate does not exist - " + verificationCode.CertificateID)
+	}
+
+	err = checkGuestAccess(stub, []string{verificationCode.CertificateID}, "GetCertificateByVerificationCode")
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	return shim.Success(certificateAsBytes)
+}
+
+// newVerificationCode derives a code from the transaction ID and retries with
+// a counter until it finds one that is neither indexed on the ledger nor in
+// reserved, the codes already taken earlier in the same transaction.
+func newVerificationCode(stub shim.ChaincodeStubInterface, ID string, reserved map[string]bool) (string, error) {
+
+	for attempt := 0; attempt < verificationMaxAttempts; attempt++ {
+		sum := sha256.Sum256([]byte(stub.GetTxID() + " " + ID + " " + strconv.Itoa(attempt)))
+		bits := binary.BigEndian.Uint64(sum[:8]) >> (64 - 5*verificationDataLength)
+
+		code := make([]byte, verificationDataLength)
+		for i := verificationDataLength - 1; i >= 0; i-- {
+			code[i] = verificationAlphabet[bits&31]
+			bits >>= 5
+		}
+
+		Code := string(code) + string(verificationCheckCharacter(string(code)))
+
+		existing, err := stub.GetState("VerificationCode-" + Code)
+		if err != nil {
+			return "", errors.New("Failed to get verification code - " + Code)
+		}
+
+		if existing == nil && !reserved[Code] {
+			return Code, nil
+		}
+	}
+
+	return "", errors.New("Can not generate a unique verification code!")
+}
+
+func putVerificationCode(stub shim.ChaincodeStubInterface, verificationCode VerificationCode) error {
+
+	verificationCodeAsBytes, err := json.Marshal(verificationCode)
+
+	if err != nil {
+		return errors.New("Can not convert data to bytes!")
+	}
+
+	return stub.PutState("VerificationCode-"+verificationCode.Code, verificationCodeAsBytes)
+}
+
+// normalizeVerificationCode accepts codes as people type them: any case,
+// with dashes or spaces, and with the letters Crockford maps onto digits.
+func normalizeVerificationCode(input string) (string, error) {
+
+	replacer := strings.NewReplacer("-", "", " ", "", "O", "0", "I", "1", "L", "1")
+	Code := replacer.Replace(strings.ToUpper(input))
+
+	if len(Code) != verificationDataLength+1 {
+		return "", errors.New("Verification code must have 10 characters!")
+	}
+
+	for _, c := range Code {
+		if !strings.ContainsRune(verificationAlphabet, c) {
+			return "", errors.New("Verification code contains invalid characters!")
+		}
+	}
+
+	if verificationCheckCharacter(Code[:verificationDataLength]) != Code[verificationDataLength] {
+		return "", errors.New("Verification code checksum does not match!")
+	}
+
+	return Code, nil
+}
+
+// verificationCheckCharacter implements the Luhn mod N algorithm over the
+// base32 alphabet, which catches every single character typo and most
+// transpositions of adjacent characters.
+func verificationCheckCharacter(data string) byte {
+
+	factor := 2
+	sum := 0
+	n := len(verificationAlphabet)
+
+	for i := len(data) - 1; i >= 0; i-- {
+		addend := factor * strings.IndexByte(verificationAlphabet, data[i])
+		addend = addend/n + addend%n
+		sum += addend
+
+		if factor == 2 {
+			factor = 1
+		} else {
+			factor = 2
+		}
+	}
+
+	return verificationAlphabet[(n-sum%n)%n]
+}
@@ -0,0 +1,158 @@
+package main
+
+import (
+	"encoding/json"
+
+	"github.com/hyperledger/fabric/core/chaincode/lib/cid"
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	sc "github.com/hyperledger/fabric/protos/peer"
+)
+
+type WaitlistPosition struct {
+	ClassID         string
+	StudentUsername string
+	Position        int
+	Length          int
+}
+
+func GetWaitlistPosition(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	if len(args) != 2 {
+		return shim.Error("Incorrect number of arguments. Expecting 2")
+	}
+
+	StudentUsername := args[0]
+	ClassID := args[1]
+
+	class, err := getClass(stub, "Class-"+ClassID)
+
+	if err != nil {
+		return shim.Error("Class does not exist!")
+	}
+
+	if !containsString(class.Waitlist, StudentUsername) {
+		return shim.Error("You are not on the waitlist of this class!")
+	}
+
+	positionAsBytes, err := json.Marshal(getWaitlistPosition(class, StudentUsername))
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	return shim.Success(positionAsBytes)
+}
+
+func LeaveClassWaitlist(stub shim.ChaincodeStubInterface, args []string) sc.Response {
+
+	MSPID, err := cid.GetMSPID(stub)
+
+	if err != nil {
+		return shim.Error("Error - cid.GetMSPID()")
+	}
+
+	if MSPID != "StudentMSP" {
+		return shim.Error("Permission Denied!")
+	}
+
+	if len(args) != 2 {
+		return shim.Error("Incorrect number of arguments. Expecting 2")
+	}
+
+	StudentUsername := args[0]
+	ClassID := args[1]
+
+	Username, _, err := cid.GetAttributeValue(stub, "username")
+
+	if err != nil {
+		return shim.Error("Error - cid.GetAttributeValue()")
+	}
+
+	if Username != StudentUsername {
+		return shim.Error("Permission Denied!")
+	}
+
+	keyClass := "Class-" + ClassID
+	class, err := getClass(stub, keyClass)
+
+	if err != nil {
+		return shim.Error("Class does not exist!")
+	}
+
+	if !containsString(class.Waitlist, StudentUsername) {
+		return shim.Error("You are not on the waitlist of this class!")
+	}
+
+	class.Waitlist = removeString(class.Waitlist, StudentUsername)
+
+	classAsBytes, err := json.Marshal(class)
+
+	if err != nil {
+		return shim.Error("Can not convert data to bytes!")
+	}
+
+	stub.PutState(keyClass, classAsBytes)
+
+	return shim.Success(nil)
+}
+
+// getWaitlistPosition returns the 1-based position of the student, or 0 when
+// they are not waiting.
+func getWaitlistPosition(class Class, StudentUsername string) WaitlistPosition {
+
+	position := WaitlistPosition{ClassID: class.ClassID, StudentUsername: StudentUsername, Length: len(class.Waitlist)}
+
+	for i, Username := range class.Waitlist {
+		if Username == StudentUsername {
+			position.Position = i + 1
+			break
+		}
+	}
+
+	return position
+}
+
+// promoteFromWaitlist fills free seats of an open class from the head of its
+// waitlist. Students who no longer meet the registration rules are dropped
+// from the waitlist. The promoted students are written here; the caller
+// stores the class.
+func promoteFromWaitlist(stub shim.ChaincodeStubInterface, class *Class) ([]string, error) {
+
+	var promoted []string
+
+	if class.Status != Open {
+		return promoted, nil
+	}
+
+	for uint64(len(class.Students)) < class.Capacity && len(class.Waitlist) > 0 {
+		Username := class.Waitlist[0]
+		class.Waitlist = class.Waitlist[1:]
+
+		keyStudent := "Student-" + Username
+		student, err := getStudent(stub, keyStudent)
+		if err != nil {
+			continue
+		}
+
+		if containsString(student.Classes, class.ClassID) {
+			continue
+		}
+
+		if checkClassRegistration(stub, student, *class) != nil {
+			continue
+		}
+
+		class.Students = append(class.Students, Username)
+		student.Classes = append(student.Classes, class.ClassID)
+
+		studentAsBytes, err := json.Marshal(student)
+		if err != nil {
+			return promoted, err
+		}
+
+		stub.PutState(keyStudent, studentAsBytes)
+		promoted = append(promoted, Username)
+	}
+
+	return promoted, nil
+}
@@ -0,0 +1,29 @@
+package main
+
+import "testing"
+
+func TestLeaveClassWaitlist(test *testing.T) {
+	stub := newTestStub(test)
+	seedAcademy(stub)
+
+	stub.mustInvoke("CreateStudent", "st2", "Student Two")
+	stub.mustInvoke("CreateStudent", "st3", "Student Three")
+	stub.mustInvoke("CreateClass", "K1", "K1C", "R1", testClassSchedule, "S1", "1", "TM")
+
+	stub.at("2020-08-01 00:00")
+
+	for _, StudentUsername := range []string{"st1", "st2", "st3"} {
+		stub.as("StudentMSP", StudentUsername).mustInvoke("StudentRegisterClass", StudentUsername, "K1")
+	}
+
+	// chi chinh sinh vien moi roi duoc hang cho
+	stub.as("StudentMSP", "st3").mustFail("LeaveClassWaitlist", "st2", "K1")
+	stub.as("StudentMSP", "st2").mustInvoke("LeaveClassWaitlist", "st2", "K1")
+
+	var class Class
+	stub.getState("Class-K1", &class)
+
+	if len(class.Waitlist) != 1 || class.Waitlist[0] != "st3" {
+		test.Fatalf("Unexpected waitlist %v", class.Waitlist)
+	}
+}
@@ -2,8 +2,10 @@ package main
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/hyperledger/fabric/core/chaincode/lib/cid"
 	"github.com/hyperledger/fabric/core/chaincode/shim"
@@ -179,8 +181,8 @@ func CreateClass(stub shim.ChaincodeStubInterface, args []string) sc.Response {
 		return shim.Error("Permission denied!")
 	}
 
-	if len(args) != 9 {
-		return shim.Error("Incorrect number of arguments. Expecting 9")
+	if len(args) != 7 {
+		return shim.Error("Incorrect number of arguments. Expecting 7")
 	}
 
 	fmt.Println("Start Create Class!")
@@ -188,12 +190,15 @@ func CreateClass(stub shim.ChaincodeStubInterface, args []string) sc.Response {
 	ClassID := args[0]
 	ClassCode := args[1]
 	Room := args[2]
-	Time := args[3]
-	StartDate := args[4]
-	EndDate := args[5]
-	Repeat := args[6]
-	SubjectID := args[7]
-	Capacity := args[8]
+	SubjectID := args[4]
+	Capacity := args[5]
+	TermID := args[6]
+
+	schedule, err := parseSchedule(args[3])
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
 
 	CapacityInt, err := strconv.ParseUint(Capacity, 10, 64)
 
@@ -216,7 +221,36 @@ func CreateClass(stub shim.ChaincodeStubInterface, args []string) sc.Response {
 		return shim.Error("This subject does not exists - " + SubjectID)
 	}
 
-	var class = Class{ClassID: ClassID, SubjectID: SubjectID, ClassCode: ClassCode, Room: Room, Time: Time, StartDate: StartDate, EndDate: EndDate, Repeat: Repeat, Status: Open, Capacity: CapacityInt}
+	keyTerm := "Term-" + TermID
+	term, err := getAcademicTerm(stub, keyTerm)
+
+	if err != nil {
+		return shim.Error("Term does not exist - " + TermID)
+	}
+
+	if term.Status == TermClosed {
+		return shim.Error("Term " + TermID + " is closed!")
+	}
+
+	err = checkScheduleInTerm(term, schedule)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	var class = Class{ClassID: ClassID, SubjectID: SubjectID, TermID: TermID, ClassCode: ClassCode, Room: Room, Schedule: schedule, Status: Open, Capacity: CapacityInt}
+
+	err = bookRoom(stub, Room, class)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	err = syncClassSessions(stub, class)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
 
 	classAsBytes, _ := json.Marshal(class)
 
@@ -228,6 +262,12 @@ func CreateClass(stub shim.ChaincodeStubInterface, args []string) sc.Response {
 
 	stub.PutState(keySubject, subjectAsBytes)
 
+	term.Classes = append(term.Classes, ClassID)
+
+	termAsBytes, _ := json.Marshal(term)
+
+	stub.PutState(keyTerm, termAsBytes)
+
 	return shim.Success(nil)
 }
 
@@ -260,7 +300,7 @@ func PickScore(stub shim.ChaincodeStubInterface, args []string) sc.Response {
 		return shim.Error("Failed convert string to float")
 	}
 
-	_, err = getStudent(stub, "Student-"+Student)
+	student, err := getStudent(stub, "Student-"+Student)
 
 	if err != nil {
 		return shim.Error("Student does not exist - " + Student)
@@ -272,14 +312,28 @@ func PickScore(stub shim.ChaincodeStubInterface, args []string) sc.Response {
 		return shim.Error("Class does not exist - " + ClassID)
 	}
 
-	if class.TeacherUsername != Teacher {
-		return shim.Error("Permission Denied!")
+	err = checkStaffPermission(class, Teacher, CanEnterFinalScore)
+
+	if err != nil {
+		return shim.Error(err.Error())
 	}
 
 	if class.Status != InProgress {
 		return shim.Error("Can not entry score now!")
 	}
 
+	err = checkTermPhase(stub, class, TermGrading)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	err = checkGradingWindow(stub, class)
+
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
 	var checkExist = false
 	var i int
 	for i = 0; i < len(class.Students); i++ {
@@ -293,34 +347,12 @@ func PickScore(stub shim.ChaincodeStubInterface, args []string) sc.Response {
 		return shim.Error("The student does not study in this class!")
 	}
 
-	SubjectID := class.SubjectID
-
-	keyScore := "Score-" + " " + "Subject-" + SubjectID + " " + "Student-" + Student
-	scoreInfo, err := getScore(stub, keyScore)
-
-	if err == nil {
-		scoreInfo.ScoreValue = ScoreValue
-		newScore, err := json.Marshal(scoreInfo)
-
-		if err != nil {
-			return shim.Error("Can not convert data to bytes!")
-		}
-
-		stub.PutState(keyScore, newScore)
-		return shim.Success(nil)
-	}
-
-
-	var score = Score{SubjectID: SubjectID, StudentUsername: Student, ScoreValue: ScoreValue}
-
-	scoreAsBytes, err := json.Marshal(score)
+	err = recordScore(stub, student, class, ScoreValue)
 
 	if err != nil {
-		return shim.Error("Can not convert data to bytes!")
+		return shim.Error(err.Error())
 	}
 
-	stub.PutState(keyScore, scoreAsBytes)
-
 	return shim.Success(nil)
 }
 
@@ -392,30 +424,77 @@ func CreateCertificate(stub shim.ChaincodeStubInterface, args []string) sc.Respo
 		return shim.Error("You have not studied this course yet!")
 	}
 
-	// kiem tra da du diem cac mon hoc cua course day hay chua
-	for i = 0; i < len(course.Subjects); i++ {
-		keyScore := "Score-" + " " + "Subject-" + course.Subjects[i] + " " + "Student-" + StudentUsername
-		_, err = getScore(stub, keyScore)
-		if err != nil {
-			return shim.Error("You have not completed all subjects in course yet!")
-		}
+	// kiem tra da du diem cac mon hoc va cac dieu kien cap chung chi cua course
+	txTime, err := getTxTime(stub)
+	if err != nil {
+		return shim.Error("Can not get transaction timestamp!")
+	}
+
+	eligibility, err := evaluateIssuancePolicy(stub, course, StudentUsername, txTime)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	if !eligibility.Eligible {
+		return shim.Error("Certificate conditions not met: " + strings.Join(eligibility.FailedConditions, "; "))
 	}
 
+	_, err = issueCertificate(stub, course, student, CertificateID, IssueDate, eligibility, nil)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	return shim.Success(nil)
+}
+
+// issueCertificate writes an eligible certificate, its verification code and
+// its score commitment. reserved holds the codes already handed out earlier
+// in the same transaction.
+func issueCertificate(stub shim.ChaincodeStubInterface, course Course, student Student, CertificateID string, IssueDate string, eligibility CertificateEligibility, reserved map[string]bool) (Certificate, error) {
+
+	keyCertificate := "Certificate-" + CertificateID
+	keyStudent := "Student-" + student.Username
+
 	student.Certificates = append(student.Certificates, CertificateID)
 	studentAsBytes, err := json.Marshal(student)
 	if err != nil {
-		return shim.Error("Can not convert data to bytes!")
+		return Certificate{}, errors.New("Can not convert data to bytes!")
+	}
+
+	Code, err := newVerificationCode(stub, CertificateID, reserved)
+	if err != nil {
+		return Certificate{}, err
+	}
+
+	MicroCredentials, err := getStackedMicroCredentials(stub, course, student)
+	if err != nil {
+		return Certificate{}, err
 	}
 
-	var certificate = Certificate{CertificateID: CertificateID, CourseID: CourseID, StudentUsername: StudentUsername, IssueDate: IssueDate}
+	var certificate = Certificate{CertificateID: CertificateID, CourseID: course.CourseID, StudentUsername: student.Username, StudentFullname: student.Fullname, IssueDate: IssueDate, Status: Valid, VerificationCode: Code, AverageScore: eligibility.AverageScore, HonoursLevel: getHonoursLevel(course, eligibility.AverageScore), MicroCredentials: MicroCredentials, ExemptSubjects: eligibility.ExemptSubjects}
 
 	certificateAsBytes, err := json.Marshal(certificate)
 	if err != nil {
-		return shim.Error("Can not convert data to bytes!")
+		return certificate, errors.New("Can not convert data to bytes!")
+	}
+
+	// cam ket diem cua chung chi de sinh vien co the tiet lo tung mon
+	err = commitCertificateScores(stub, CertificateID, course, student.Username)
+	if err != nil {
+		return certificate, err
+	}
+
+	err = putVerificationCode(stub, VerificationCode{Code: Code, CertificateID: CertificateID})
+	if err != nil {
+		return certificate, err
+	}
+
+	if reserved != nil {
+		reserved[Code] = true
 	}
 
 	stub.PutState(keyCertificate, certificateAsBytes)
 	stub.PutState(keyStudent, studentAsBytes)
 
-	return shim.Success(nil)
+	return certificate, nil
 }
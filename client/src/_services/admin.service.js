@@ -20,6 +20,7 @@ export const adminService = {
   deleteSubject,
   getClassesOfSubject,
   getAllTeachers,
+  getEligibleTeachersOfClass,
   getSubject,
   getClassesOfTeacher,
   changeTeacherOfClass,
@@ -33,7 +34,9 @@ export const adminService = {
   getTeacher,
   getClassesOfStudent,
   getCoursesOfStudent,
-  getSummaryInfo
+  getSummaryInfo,
+  getAllRooms,
+  getAllTerms
 };
 
 async function getSummaryInfo() {
@@ -268,11 +271,16 @@ async function createClass(classInfo) {
         subjectId: classInfo.SubjectId,
         classCode: classInfo.ClassCode,
         room: classInfo.Room,
-        time: classInfo.Time,
-        startDate: classInfo.StartDate,
-        endDate: classInfo.EndDate,
-        repeat: classInfo.Repeat,
-        capacity: classInfo.Capacity
+        schedule: {
+          daysOfWeek: classInfo.DaysOfWeek,
+          startTime: classInfo.StartTime,
+          endTime: classInfo.EndTime,
+          startDate: classInfo.StartDate,
+          endDate: classInfo.EndDate,
+          timezone: classInfo.Timezone
+        },
+        capacity: classInfo.Capacity,
+        termId: classInfo.TermId
       },
       {
         headers: authHeader()
@@ -291,10 +299,14 @@ async function updateClass(classInfo) {
       {
         classCode: classInfo.ClassCode,
         room: classInfo.Room,
-        time: classInfo.Time,
-        startDate: classInfo.StartDate,
-        endDate: classInfo.EndDate,
-        repeat: classInfo.Repeat,
+        schedule: {
+          daysOfWeek: classInfo.DaysOfWeek,
+          startTime: classInfo.StartTime,
+          endTime: classInfo.EndTime,
+          startDate: classInfo.StartDate,
+          endDate: classInfo.EndDate,
+          timezone: classInfo.Timezone
+        },
         subjectId: classInfo.SubjectId,
         capacity: classInfo.Capacity
       },
@@ -374,6 +386,20 @@ async function getAllTeachers() {
   }
 }
 
+async function getEligibleTeachersOfClass(classId) {
+  try {
+    let response = await axios.get(
+      `${process.env.VUE_APP_API_BACKEND}/classes/${classId}/eligible-teachers`,
+      {
+        headers: authHeader()
+      }
+    );
+    return response.data.teachers;
+  } catch (error) {
+    throw error;
+  }
+}
+
 async function createTeacher(teacher) {
   try {
     let respone = await axios.post(
@@ -501,3 +527,26 @@ async function getTeacher(username) {
     throw error;
   }
 }
+
+// Rooms Manager
+async function getAllRooms() {
+  try {
+    let response = await axios.get(`${process.env.VUE_APP_API_BACKEND}/rooms`, {
+      headers: authHeader()
+    });
+    return response.data.rooms;
+  } catch (error) {
+    throw error;
+  }
+}
+
+async function getAllTerms() {
+  try {
+    let response = await axios.get(`${process.env.VUE_APP_API_BACKEND}/terms`, {
+      headers: authHeader()
+    });
+    return response.data.terms;
+  } catch (error) {
+    throw error;
+  }
+}
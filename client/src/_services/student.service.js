@@ -10,6 +10,7 @@ export const studentService = {
   getClassesOfSubject,
   getSubject,
   getMyClasses,
+  getTimetableICS,
   registerClass,
   getMyCourses,
   getNotRegisterCourses,
@@ -198,9 +199,19 @@ async function claimCertificate(courseId) {
   }
 }
 
-async function getCertificate(certId) {
+// A verifier opens the link the student shared, carrying the verifier ID and token of the grant
+function verifierHeader(verifier) {
+  return {
+    'x-verifier-id': verifier.id,
+    'x-verifier-token': verifier.token
+  };
+}
+
+async function getCertificate({ certId, verifier }) {
   try {
-    let response = await axios.get(`${process.env.VUE_APP_API_BACKEND}/certificates/${certId}`);
+    let response = await axios.get(`${process.env.VUE_APP_API_BACKEND}/certificates/${certId}`, {
+      headers: verifierHeader(verifier)
+    });
     return response.data.cert;
   } catch (error) {
     throw error;
@@ -221,13 +232,28 @@ async function getCertificateByCourseId(courseId) {
   }
 }
 
-async function verifyCertificate(certId) {
+async function verifyCertificate({ certId, verifier }) {
   try {
     let response = await axios.get(
-      `${process.env.VUE_APP_API_BACKEND}/certificates/${certId}/verify`
+      `${process.env.VUE_APP_API_BACKEND}/certificates/${certId}/verify`,
+      {
+        headers: verifierHeader(verifier)
+      }
     );
     return response.data;
   } catch (error) {
     throw error;
   }
 }
+
+async function getTimetableICS() {
+  try {
+    let respone = await axios.get(`${process.env.VUE_APP_API_BACKEND}/me/timetable.ics`, {
+      headers: authHeader(),
+      responseType: 'blob'
+    });
+    return respone.data;
+  } catch (error) {
+    throw error;
+  }
+}
@@ -3,7 +3,8 @@ import axios from 'axios';
 
 export const teacherService = {
   getClassesOfTeacher,
-  updateScore
+  updateScore,
+  getTimetableICS
 };
 
 async function getClassesOfTeacher() {
@@ -25,3 +26,15 @@ async function updateScore(classId, score, username) {
   );
   return respone.data;
 }
+
+async function getTimetableICS() {
+  try {
+    let respone = await axios.get(`${process.env.VUE_APP_API_BACKEND}/me/timetable.ics`, {
+      headers: authHeader(),
+      responseType: 'blob'
+    });
+    return respone.data;
+  } catch (error) {
+    throw error;
+  }
+}
@@ -5,8 +5,11 @@ const state = {
   listCourses: [],
   listSubjects: [],
   listTeachers: [],
+  listEligibleTeachers: [],
   listStudents: [],
   listClasses: [],
+  listRooms: [],
+  listTerms: [],
   classInfo: {},
   studentsOfSubject: [],
   classesOfTeacher: [],
@@ -258,6 +261,28 @@ const actions = {
     }
   },
 
+  // Room manager
+  async getAllRooms({ commit, dispatch }) {
+    try {
+      let listRooms = await adminService.getAllRooms();
+      commit('getAllRooms', listRooms);
+      return listRooms;
+    } catch (error) {
+      dispatch('alert/alertError', error, { root: true });
+    }
+  },
+
+  // Academic term manager
+  async getAllTerms({ commit, dispatch }) {
+    try {
+      let listTerms = await adminService.getAllTerms();
+      commit('getAllTerms', listTerms);
+      return listTerms;
+    } catch (error) {
+      dispatch('alert/alertError', error, { root: true });
+    }
+  },
+
   // Teacher manager
   async getAllTeachers({ commit, dispatch }) {
     try {
@@ -268,6 +293,15 @@ const actions = {
     }
   },
 
+  async getEligibleTeachersOfClass({ commit, dispatch }, classId) {
+    try {
+      let listTeachers = await adminService.getEligibleTeachersOfClass(classId);
+      commit('getEligibleTeachersOfClass', listTeachers);
+    } catch (error) {
+      dispatch('alert/alertError', error, { root: true });
+    }
+  },
+
   async createTeacher({ dispatch, commit }, teacher) {
     try {
       let data = await adminService.createTeacher(teacher);
@@ -494,10 +528,19 @@ const mutations = {
   getClassesOfSubject(state, listClasses) {
     state.listClasses = listClasses;
   },
+  getAllRooms(state, listRooms) {
+    state.listRooms = listRooms;
+  },
+  getAllTerms(state, listTerms) {
+    state.listTerms = listTerms;
+  },
   // Teacher Manager
   getAllTeachers(state, listTeachers) {
     state.listTeachers = listTeachers;
   },
+  getEligibleTeachersOfClass(state, listTeachers) {
+    state.listEligibleTeachers = listTeachers;
+  },
   createTeacher(state, listTeachers) {
     state.listTeachers = listTeachers;
   },
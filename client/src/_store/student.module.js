@@ -158,9 +158,9 @@ const actions = {
       }
     }
   },
-  async getCertificate({ commit }, certId) {
+  async getCertificate({ commit }, payload) {
     try {
-      let cert = await studentService.getCertificate(certId);
+      let cert = await studentService.getCertificate(payload);
       return cert;
     } catch (error) {
       router.push('/404');
@@ -174,9 +174,9 @@ const actions = {
       router.push('/404');
     }
   },
-  async verifyCertificate({ dispatch, commit }, certId) {
+  async verifyCertificate({ dispatch, commit }, payload) {
     try {
-      let data = await studentService.verifyCertificate(certId);
+      let data = await studentService.verifyCertificate(payload);
       return data;
     } catch (error) {
       dispatch('alert/alertError', error, { root: true });
@@ -14,10 +14,15 @@ require('dotenv').config();
 const authRoutes = require('./routes/auth');
 const subjectRoutes = require('./routes/subjects');
 const certificateRoutes = require('./routes/certificates');
+const microCredentialRoutes = require('./routes/micro-credentials');
+const badgeRoutes = require('./routes/badges');
 const studentRoutes = require('./routes/students');
 const teacherRoutes = require('./routes/teachers');
 const courseRoutes = require('./routes/courses');
 const classRoutes = require('./routes/classes');
+const roomRoutes = require('./routes/rooms');
+const termRoutes = require('./routes/terms');
+const lifecycleRoutes = require('./routes/lifecycle');
 const meRoutes = require('./routes/me');
 
 // Connect database
@@ -57,8 +62,13 @@ app.use('/students', checkJWT, studentRoutes);
 app.use('/teachers', checkJWT, teacherRoutes);
 app.use('/subjects', checkJWT, subjectRoutes);
 app.use('/certificates', certificateRoutes);
+app.use('/micro-credentials', microCredentialRoutes);
+app.use('/badges', badgeRoutes);
 app.use('/courses', checkJWT, courseRoutes);
 app.use('/classes', checkJWT, classRoutes);
+app.use('/rooms', checkJWT, roomRoutes);
+app.use('/terms', checkJWT, termRoutes);
+app.use('/lifecycle', checkJWT, lifecycleRoutes);
 app.use('/me', checkJWT, meRoutes);
 
 // catch 404 and forward to error handler
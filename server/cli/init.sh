@@ -7,6 +7,9 @@ node registerGuest.js
 sleep 3
 
 node invoke.js --username=adminacademy --func=CreateCourse --courseCode=BC01 --courseName=Blockchain --description="Blockchain Basic, you will learn about architech of blockchain, consensus,..." --shortDescription=Blockchain
+node invoke.js --username=adminacademy --func=CreateRoom --roomId=F13 --building=F --capacity=80 --facilities=Projector,Whiteboard
+node invoke.js --username=adminacademy --func=CreateAcademicTerm --termId=F20 --termName="Fall 2020" --registrationStart=2020-01-15 --registrationEnd=2020-02-15 --teachingStart=2020-02-20 --teachingEnd=2020-05-31 --gradingDeadline=2020-06-15 --timezone=Asia/Ho_Chi_Minh
+node invoke.js --username=adminacademy --func=SetAcademicTermStatus --termId=F20 --status=Active
 node invoke.js --username=adminacademy --func=CreateSubject --subjectCode=ET01 --subjectName=Ethereum --shortDescription=Ethereum --description="Ethereum basic: you will be learnt about solidity, EVM and architech of Ethereum Blockchain"
 
 sleep 1
@@ -15,5 +18,6 @@ node query.js --username=adminacademy --func=GetAllCourses
 node query.js --username=adminacademy --func=GetAllSubjects
 
 # node invoke.js --username=adminacademy --func=AddSubjectToCourse --courseId=xxxx --subjectId=xxx
-# node invoke.js --username=adminacademy --func=CreateClass --classCode=ETH101 --room=F13 --time="11:00" --startDate="20/02/2020" --endDate="20/05/2020" --repeat="Weekly" --subjectId= --capacity=75
-# node invoke.js --username=adminacademy --func=CreateClass --classCode=Fabric101 --room=F13 --time="13:00" --startDate="20/02/2020" --endDate="20/05/2020" --repeat="Weekly" --subjectId= --capacity=71
\ No newline at end of file
+# node invoke.js --username=adminacademy --func=GrantTeacherQualification --teacher=GV00 --subjectId= --effectiveFrom=2020-01-01
+# node invoke.js --username=adminacademy --func=CreateClass --classCode=ETH101 --room=F13 --days=Monday --startTime="11:00" --endTime="12:30" --startDate=2020-02-20 --endDate=2020-05-20 --timezone=Asia/Ho_Chi_Minh --subjectId= --capacity=75 --termId=F20
+# node invoke.js --username=adminacademy --func=CreateClass --classCode=Fabric101 --room=F13 --days=Tuesday --startTime="13:00" --endTime="14:30" --startDate=2020-02-20 --endDate=2020-05-20 --timezone=Asia/Ho_Chi_Minh --subjectId= --capacity=71 --termId=F20
\ No newline at end of file
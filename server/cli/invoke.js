@@ -1,6 +1,8 @@
 'use strict';
 
 const argv = require('yargs').argv;
+const crypto = require('crypto');
+const fs = require('fs');
 const path = require('path');
 const conn = require('../fabric/network');
 const User = require('../models/User');
@@ -115,6 +117,49 @@ async function main() {
           await conn.closeCourse(networkObj, courseId);
           console.log('Transaction has been submitted');
           process.exit(0);
+        } else if (
+          functionName === 'SetCourseEnrollmentWindow' &&
+          user.role === USER_ROLES.ADMIN_ACADEMY
+        ) {
+          /**
+           * Set Course Enrollment Window
+           * @param  {String} courseId course Id (required)
+           * @param  {String} startDate YYYY-MM-DD
+           * @param  {String} endDate YYYY-MM-DD
+           * @param  {String} timezone e.g. Asia/Ho_Chi_Minh
+           */
+
+          let courseId = argv.courseId.toString();
+          let window = {
+            startDate: argv.startDate.toString(),
+            endDate: argv.endDate.toString(),
+            timezone: argv.timezone.toString()
+          };
+          await conn.setCourseEnrollmentWindow(networkObj, courseId, window);
+          console.log('Transaction has been submitted');
+          process.exit(0);
+        } else if (functionName === 'AdvanceLifecycle' && user.role === USER_ROLES.ADMIN_ACADEMY) {
+          /**
+           * Advance Lifecycle, run periodically from cron
+           * @param  {Number} pageSize classes and courses per transaction (optional)
+           */
+
+          let pageSize = argv.pageSize ? argv.pageSize.toString() : '100';
+          let bookmark = '';
+          let pageNetworkObj = networkObj;
+          do {
+            let response = await conn.advanceLifecycle(pageNetworkObj, pageSize, bookmark);
+            if (!response.success) {
+              console.log(response.msg);
+              process.exit(1);
+            }
+            console.log(JSON.stringify(response.report, null, 2));
+            bookmark = response.report.Bookmark;
+            if (bookmark) {
+              pageNetworkObj = await conn.connectToNetwork(user, true);
+            }
+          } while (bookmark);
+          process.exit(0);
         } else if (functionName === 'OpenCourse' && user.role === USER_ROLES.ADMIN_ACADEMY) {
           /**
            * Close Course
@@ -270,6 +315,143 @@ async function main() {
           await conn.pickScore(networkObj, score);
           console.log('Transaction has been submitted');
           process.exit(0);
+        } else if (functionName === 'RecordAttendance' && user.role === USER_ROLES.TEACHER) {
+          /**
+           * Record Attendance of a class session
+           * @param  {String} classId Class Id (required)
+           * @param  {String} date Session date YYYY-MM-DD (required)
+           * @param  {String} attendance JSON of student username to status (required)
+           *
+           */
+
+          let session = {
+            teacher: user.username,
+            classId: argv.classId.toString(),
+            date: argv.date.toString(),
+            attendance: JSON.parse(argv.attendance)
+          };
+          await conn.recordAttendance(networkObj, session);
+          console.log('Transaction has been submitted');
+          process.exit(0);
+        } else if (functionName === 'CreateAssessment' && user.role === USER_ROLES.TEACHER) {
+          /**
+           * Create Assessment of a class
+           * @param  {String} classId Class Id (required)
+           * @param  {String} title Title (required)
+           * @param  {String} type Assignment, Quiz or Exam (required)
+           * @param  {String} dueDate Deadline in RFC3339 (required)
+           * @param  {String} maxPoints Max points (required)
+           *
+           */
+
+          let assessment = {
+            teacher: user.username,
+            classId: argv.classId.toString(),
+            assessmentId: uuidv4(),
+            title: argv.title.toString(),
+            type: argv.type.toString(),
+            dueDate: argv.dueDate.toString(),
+            maxPoints: argv.maxPoints.toString()
+          };
+          await conn.createAssessment(networkObj, assessment);
+          console.log('Transaction has been submitted');
+          process.exit(0);
+        } else if (functionName === 'SubmitAssessment' && user.role === USER_ROLES.STUDENT) {
+          /**
+           * Submit the SHA-256 hash of a work
+           * @param  {String} classId Class Id (required)
+           * @param  {String} assessmentId Assessment Id (required)
+           * @param  {String} file Path of the work, hashed locally (required)
+           *
+           */
+
+          let hash = crypto
+            .createHash('sha256')
+            .update(fs.readFileSync(argv.file.toString()))
+            .digest('hex');
+
+          let submission = {
+            student: user.username,
+            classId: argv.classId.toString(),
+            assessmentId: argv.assessmentId.toString(),
+            hash
+          };
+          await conn.submitAssessment(networkObj, submission);
+          console.log('Transaction has been submitted');
+          process.exit(0);
+        } else if (functionName === 'GradeSubmission' && user.role === USER_ROLES.TEACHER) {
+          /**
+           * Grade Submission
+           * @param  {String} classId Class Id (required)
+           * @param  {String} assessmentId Assessment Id (required)
+           * @param  {String} studentUsername Student Username (required)
+           * @param  {String} file Path of the graded work, must match the submission (required)
+           * @param  {String} points Points (required)
+           *
+           */
+
+          let hash = crypto
+            .createHash('sha256')
+            .update(fs.readFileSync(argv.file.toString()))
+            .digest('hex');
+
+          let grade = {
+            teacher: user.username,
+            classId: argv.classId.toString(),
+            assessmentId: argv.assessmentId.toString(),
+            studentUsername: argv.studentUsername.toString(),
+            hash,
+            points: argv.points.toString()
+          };
+          await conn.gradeSubmission(networkObj, grade);
+          console.log('Transaction has been submitted');
+          process.exit(0);
+        } else if (functionName === 'OverrideScore' && user.role === USER_ROLES.ADMIN_ACADEMY) {
+          /**
+           * Override Score after the grading window closed
+           * @param  {String} classId Class Id (required)
+           * @param  {String} studentUsername Student Username (required)
+           * @param  {String} scoreValue Point of Subject (required)
+           * @param  {String} justification Reason of the late entry (required)
+           *
+           */
+
+          let score = {
+            classId: argv.classId.toString(),
+            studentUsername: argv.studentUsername.toString(),
+            scoreValue: argv.scoreValue.toString(),
+            justification: argv.justification.toString()
+          };
+          await conn.overrideScore(networkObj, score);
+          console.log('Transaction has been submitted');
+          process.exit(0);
+        } else if (functionName === 'SetGradingWindow' && user.role === USER_ROLES.ADMIN_ACADEMY) {
+          /**
+           * Set Grading Window
+           * @param  {String} daysAfterEnd days after the class end date scores may be entered
+           *
+           */
+
+          let daysAfterEnd = argv.daysAfterEnd.toString();
+          await conn.setGradingWindow(networkObj, daysAfterEnd);
+          console.log('Transaction has been submitted');
+          process.exit(0);
+        } else if (
+          functionName === 'SetCredentialIssuer' &&
+          user.role === USER_ROLES.ADMIN_ACADEMY
+        ) {
+          /**
+           * Set Credential Issuer
+           * @param  {String} publicKey path of the PEM encoded P-256 issuer public key (required)
+           * @param  {String} name issuer name shown on credentials (optional)
+           *
+           */
+
+          let publicKey = fs.readFileSync(argv.publicKey.toString(), 'utf8');
+          let name = argv.name ? argv.name.toString() : '';
+          await conn.setCredentialIssuer(networkObj, publicKey, name);
+          console.log('Transaction has been submitted');
+          process.exit(0);
         } else if (functionName === 'CreateCertificate' && user.role === USER_ROLES.STUDENT) {
           /**
            * Create Score
@@ -288,42 +470,175 @@ async function main() {
           await conn.createCertificate(networkObj, certificate);
           console.log('Transaction has been submitted');
           process.exit(0);
+        } else if (functionName === 'CreateRoom' && user.role === USER_ROLES.ADMIN_ACADEMY) {
+          /**
+           * Create Room
+           * @param  {String} roomId
+           * @param  {String} building
+           * @param  {String} capacity
+           * @param  {String} facilities comma separated, e.g. Projector,Whiteboard (optional)
+           *
+           */
+          let room = {
+            roomId: argv.roomId.toString(),
+            building: argv.building.toString(),
+            capacity: argv.capacity.toString(),
+            facilities: argv.facilities ? argv.facilities.toString().split(',') : []
+          };
+
+          await conn.createRoom(networkObj, room);
+          console.log('Transaction has been submitted');
+          process.exit(0);
+        } else if (
+          functionName === 'CreateAcademicTerm' &&
+          user.role === USER_ROLES.ADMIN_ACADEMY
+        ) {
+          /**
+           * Create Academic Term
+           * @param  {String} termId
+           * @param  {String} termName
+           * @param  {String} registrationStart YYYY-MM-DD
+           * @param  {String} registrationEnd YYYY-MM-DD
+           * @param  {String} teachingStart YYYY-MM-DD
+           * @param  {String} teachingEnd YYYY-MM-DD
+           * @param  {String} gradingDeadline YYYY-MM-DD
+           * @param  {String} timezone e.g. Asia/Ho_Chi_Minh
+           *
+           */
+          let term = {
+            termId: argv.termId.toString(),
+            termName: argv.termName.toString(),
+            registrationStart: argv.registrationStart.toString(),
+            registrationEnd: argv.registrationEnd.toString(),
+            teachingStart: argv.teachingStart.toString(),
+            teachingEnd: argv.teachingEnd.toString(),
+            gradingDeadline: argv.gradingDeadline.toString(),
+            timezone: argv.timezone.toString()
+          };
+
+          await conn.createAcademicTerm(networkObj, term);
+          console.log('Transaction has been submitted');
+          process.exit(0);
+        } else if (
+          functionName === 'SetAcademicTermStatus' &&
+          user.role === USER_ROLES.ADMIN_ACADEMY
+        ) {
+          /**
+           * Activate or close Academic Term
+           * @param  {String} termId
+           * @param  {String} status Active or Closed
+           *
+           */
+          let termId = argv.termId.toString();
+          let status = argv.status.toString();
+
+          await conn.setAcademicTermStatus(networkObj, termId, status);
+          console.log('Transaction has been submitted');
+          process.exit(0);
         } else if (functionName === 'CreateClass' && user.role === USER_ROLES.ADMIN_ACADEMY) {
           /**
            * Create Score
            * @param  {String} classCode
            * @param  {String} room
-           * @param  {String} time
-           * @param  {String} startDate
-           * @param  {String} endDate
-           * @param  {String} repeat
+           * @param  {String} days comma separated days of week, e.g. Monday,Wednesday
+           * @param  {String} startTime HH:MM
+           * @param  {String} endTime HH:MM
+           * @param  {String} startDate YYYY-MM-DD
+           * @param  {String} endDate YYYY-MM-DD
+           * @param  {String} timezone e.g. Asia/Ho_Chi_Minh
            * @param  {String} capacity
+           * @param  {String} termId
            *
            */
           let classCode = argv.classCode.toString();
           let room = argv.room.toString();
-          let time = argv.time.toString();
-          let startDate = argv.startDate.toString();
-          let endDate = argv.endDate.toString();
-          let repeat = argv.repeat.toString();
+          let schedule = {
+            daysOfWeek: argv.days.toString().split(','),
+            startTime: argv.startTime.toString(),
+            endTime: argv.endTime.toString(),
+            startDate: argv.startDate.toString(),
+            endDate: argv.endDate.toString(),
+            timezone: argv.timezone.toString()
+          };
           let subjectId = argv.subjectId.toString();
           let capacity = argv.capacity.toString();
+          let termId = argv.termId.toString();
 
           let _class = {
             classId: uuidv4(),
             classCode,
             room,
-            time,
-            startDate,
-            endDate,
-            repeat,
+            schedule,
             subjectId,
-            capacity
+            capacity,
+            termId
           };
 
           await conn.createClass(networkObj, _class);
           console.log('Transaction has been submitted');
           process.exit(0);
+        } else if (
+          functionName === 'GrantTeacherQualification' &&
+          user.role === USER_ROLES.ADMIN_ACADEMY
+        ) {
+          /**
+           * Grant Teacher Qualification
+           * @param  {String} teacher Teacher Username (required)
+           * @param  {String} subjectId Subject Id (required)
+           * @param  {String} effectiveFrom YYYY-MM-DD (required)
+           * @param  {String} effectiveUntil YYYY-MM-DD, empty never expires
+           */
+          let qualification = {
+            username: argv.teacher.toString(),
+            subjectId: argv.subjectId.toString(),
+            effectiveFrom: argv.effectiveFrom.toString(),
+            effectiveUntil: argv.effectiveUntil ? argv.effectiveUntil.toString() : ''
+          };
+
+          await conn.grantTeacherQualification(networkObj, qualification);
+          console.log('Transaction has been submitted');
+          process.exit(0);
+        } else if (
+          functionName === 'RevokeTeacherQualification' &&
+          user.role === USER_ROLES.ADMIN_ACADEMY
+        ) {
+          /**
+           * Revoke Teacher Qualification
+           * @param  {String} teacher Teacher Username (required)
+           * @param  {String} subjectId Subject Id (required)
+           */
+          let teacher = argv.teacher.toString();
+          let subjectId = argv.subjectId.toString();
+
+          await conn.revokeTeacherQualification(networkObj, teacher, subjectId);
+          console.log('Transaction has been submitted');
+          process.exit(0);
+        } else if (functionName === 'AddClassStaff' && user.role === USER_ROLES.ADMIN_ACADEMY) {
+          /**
+           * Add Co-teacher or Teaching Assistant to Class
+           * @param  {String} classId Class Id (required)
+           * @param  {String} teacher Teacher Username (required)
+           * @param  {String} role CoTeacher or TA (required)
+           */
+          let classId = argv.classId.toString();
+          let teacher = argv.teacher.toString();
+          let role = argv.role.toString();
+
+          await conn.addClassStaff(networkObj, classId, teacher, role);
+          console.log('Transaction has been submitted');
+          process.exit(0);
+        } else if (functionName === 'RemoveClassStaff' && user.role === USER_ROLES.ADMIN_ACADEMY) {
+          /**
+           * Remove Co-teacher or Teaching Assistant from Class
+           * @param  {String} classId Class Id (required)
+           * @param  {String} teacher Teacher Username (required)
+           */
+          let classId = argv.classId.toString();
+          let teacher = argv.teacher.toString();
+
+          await conn.removeClassStaff(networkObj, classId, teacher);
+          console.log('Transaction has been submitted');
+          process.exit(0);
         } else if (
           functionName === 'AssignTeacherToClass' &&
           user.role === USER_ROLES.ADMIN_ACADEMY
@@ -358,10 +673,12 @@ async function main() {
            * @param  {String} classId
            * @param  {String} classCode
            * @param  {String} room
-           * @param  {String} time
-           * @param  {String} startDate
-           * @param  {String} endDate
-           * @param  {String} repeat
+           * @param  {String} days comma separated days of week, e.g. Monday,Wednesday
+           * @param  {String} startTime HH:MM
+           * @param  {String} endTime HH:MM
+           * @param  {String} startDate YYYY-MM-DD
+           * @param  {String} endDate YYYY-MM-DD
+           * @param  {String} timezone e.g. Asia/Ho_Chi_Minh
            * @param  {String} capacity
            *
            */
@@ -369,20 +686,21 @@ async function main() {
           let classId = argv.classId.toString();
           let classCode = argv.classCode.toString();
           let room = argv.room.toString();
-          let time = argv.time.toString();
-          let startDate = argv.startDate.toString();
-          let endDate = argv.endDate.toString();
-          let repeat = argv.repeat.toString();
+          let schedule = {
+            daysOfWeek: argv.days.toString().split(','),
+            startTime: argv.startTime.toString(),
+            endTime: argv.endTime.toString(),
+            startDate: argv.startDate.toString(),
+            endDate: argv.endDate.toString(),
+            timezone: argv.timezone.toString()
+          };
           let capacity = argv.capacity.toString();
 
           let _class = {
             classId,
             classCode,
             room,
-            time,
-            startDate,
-            endDate,
-            repeat,
+            schedule,
             capacity
           };
 
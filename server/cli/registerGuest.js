@@ -66,7 +66,10 @@ async function main() {
         affiliation: '',
         enrollmentID: username,
         role: 'client',
-        attrs: [{ name: 'username', value: username, ecert: true }]
+        attrs: [
+          { name: 'username', value: username, ecert: true },
+          { name: 'role', value: 'guest', ecert: true }
+        ]
       },
       adminIdentity
     );
@@ -0,0 +1,118 @@
+'use strict';
+const crypto = require('crypto');
+const fs = require('fs');
+require('dotenv').config();
+
+const BASE58_ALPHABET = '123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz';
+
+// The issuer private key stays on the server. The chaincode only knows its public key
+// (SetCredentialIssuer) and checks the proofs made here before anchoring them.
+exports.getIssuerKey = function() {
+  if (!process.env.CREDENTIAL_ISSUER_KEY) {
+    return null;
+  }
+
+  return fs.readFileSync(process.env.CREDENTIAL_ISSUER_KEY, 'utf8');
+};
+
+/**
+ * Sign a credential returned by the chaincode with an ecdsa-jcs-2019 proof
+ * @param  {Object} credential unsigned credential
+ * @param  {String} verificationMethod verification method of the registered issuer
+ * @param  {String} issuerKey PEM encoded P-256 private key
+ * @return {Object} proof
+ */
+exports.signCredential = function(credential, verificationMethod, issuerKey) {
+  let document = Object.assign({}, credential);
+  delete document.proof;
+
+  let proof = {
+    '@context': document['@context'],
+    type: 'DataIntegrityProof',
+    cryptosuite: 'ecdsa-jcs-2019',
+    created: new Date().toISOString().slice(0, 19) + 'Z',
+    verificationMethod: verificationMethod,
+    proofPurpose: 'assertionMethod'
+  };
+
+  let hashData = Buffer.concat([sha256(canonicalize(proof)), sha256(canonicalize(document))]);
+  let signature = crypto
+    .createSign('SHA256')
+    .update(hashData)
+    .sign(issuerKey);
+
+  delete proof['@context'];
+  proof.proofValue = 'z' + base58Encode(derToRaw(signature));
+
+  return proof;
+};
+
+// JSON Canonicalization Scheme (RFC 8785) for the documents built by the chaincode.
+function canonicalize(value) {
+  if (Array.isArray(value)) {
+    return '[' + value.map(canonicalize).join(',') + ']';
+  }
+
+  if (value !== null && typeof value === 'object') {
+    let members = Object.keys(value)
+      .filter((key) => value[key] !== undefined)
+      .sort()
+      .map((key) => JSON.stringify(key) + ':' + canonicalize(value[key]));
+
+    return '{' + members.join(',') + '}';
+  }
+
+  return JSON.stringify(value);
+}
+
+function sha256(data) {
+  return crypto
+    .createHash('sha256')
+    .update(data)
+    .digest();
+}
+
+// A P-256 DER signature is SEQUENCE { INTEGER r, INTEGER s }, always shorter than 128 bytes.
+function derToRaw(der) {
+  let raw = Buffer.alloc(64);
+  let rLength = der[3];
+  let r = der.slice(4, 4 + rLength);
+  let s = der.slice(6 + rLength, 6 + rLength + der[5 + rLength]);
+
+  r = r.slice(Math.max(r.length - 32, 0));
+  s = s.slice(Math.max(s.length - 32, 0));
+  r.copy(raw, 32 - r.length);
+  s.copy(raw, 64 - s.length);
+
+  return raw;
+}
+
+function base58Encode(input) {
+  let digits = [];
+
+  for (let i = 0; i < input.length; i++) {
+    let carry = input[i];
+    for (let j = 0; j < digits.length; j++) {
+      carry += digits[j] << 8;
+      digits[j] = carry % 58;
+      carry = (carry / 58) | 0;
+    }
+    while (carry > 0) {
+      digits.push(carry % 58);
+      carry = (carry / 58) | 0;
+    }
+  }
+
+  let encoded = '';
+  for (let i = 0; i < input.length && input[i] === 0; i++) {
+    encoded += BASE58_ALPHABET[0];
+  }
+
+  for (let i = digits.length - 1; i >= 0; i--) {
+    encoded += BASE58_ALPHABET[digits[i]];
+  }
+
+  return encoded;
+}
+
+exports.canonicalize = canonicalize;
@@ -4,6 +4,7 @@ const USER_ROLES = require('../configs/constant').USER_ROLES;
 const Certificate = require('../models/Certificate');
 const { FileSystemWallet, Gateway, X509WalletMixin } = require('fabric-network');
 const path = require('path');
+const crypto = require('crypto');
 const mongoose = require('mongoose');
 require('dotenv').config();
 
@@ -348,17 +349,26 @@ exports.createSubject = async function(networkObj, subject) {
   }
 };
 
+function toChaincodeSchedule(schedule) {
+  return JSON.stringify({
+    DaysOfWeek: schedule.daysOfWeek,
+    StartTime: schedule.startTime,
+    EndTime: schedule.endTime,
+    StartDate: schedule.startDate,
+    EndDate: schedule.endDate,
+    Timezone: schedule.timezone
+  });
+}
+
 exports.createClass = async function(networkObj, _class) {
   if (
     !_class.classId ||
     !_class.classCode ||
     !_class.room ||
-    !_class.time ||
-    !_class.startDate ||
-    !_class.endDate ||
-    !_class.repeat ||
+    !_class.schedule ||
     !_class.subjectId ||
-    !_class.capacity
+    !_class.capacity ||
+    !_class.termId
   ) {
     let response = {};
     response.error = 'Error! You need to fill all fields before you can register!';
@@ -370,12 +380,10 @@ exports.createClass = async function(networkObj, _class) {
       _class.classId,
       _class.classCode,
       _class.room,
-      _class.time,
-      _class.startDate,
-      _class.endDate,
-      _class.repeat,
+      toChaincodeSchedule(_class.schedule),
       _class.subjectId,
-      _class.capacity
+      _class.capacity,
+      _class.termId
     );
 
     let response = {
@@ -424,10 +432,7 @@ exports.updateClassInfo = async function(networkObj, _class) {
     !_class.classId ||
     !_class.classCode ||
     !_class.room ||
-    !_class.time ||
-    !_class.startDate ||
-    !_class.endDate ||
-    !_class.repeat ||
+    !_class.schedule ||
     !_class.capacity
   ) {
     let response = {};
@@ -441,10 +446,7 @@ exports.updateClassInfo = async function(networkObj, _class) {
       _class.classId,
       _class.classCode,
       _class.room,
-      _class.time,
-      _class.startDate,
-      _class.endDate,
-      _class.repeat,
+      toChaincodeSchedule(_class.schedule),
       _class.capacity
     );
 
@@ -578,6 +580,29 @@ exports.updateSubjectInfo = async function(networkObj, subject) {
   }
 };
 
+// rule is null, a list of { SubjectID, MinScore } or an AND/OR tree of such rules
+exports.setSubjectPrerequisites = async function(networkObj, subjectId, rule) {
+  let response = {
+    success: false,
+    msg: ''
+  };
+  try {
+    response.msg = await networkObj.contract.submitTransaction(
+      'SetSubjectPrerequisites',
+      subjectId,
+      JSON.stringify(rule)
+    );
+
+    await networkObj.gateway.disconnect();
+    response.success = true;
+    return response;
+  } catch (error) {
+    response.success = false;
+    response.msg = error;
+    return response;
+  }
+};
+
 exports.addSubjectToCourse = async function(networkObj, courseId, subjectId) {
   if (!courseId || !subjectId) {
     let response = {};
@@ -653,8 +678,8 @@ exports.deleteClass = async function(networkObj, classId) {
   }
 };
 
-exports.updateUserInfo = async function(networkObj, newInfo) {
-  if (!newInfo.username) {
+exports.createRoom = async function(networkObj, room) {
+  if (!room.roomId || !room.building || !room.capacity) {
     let response = {};
     response.error = 'Error! You need to fill all fields before you can register!';
     return response;
@@ -662,20 +687,46 @@ exports.updateUserInfo = async function(networkObj, newInfo) {
 
   try {
     await networkObj.contract.submitTransaction(
-      'UpdateUserInfo',
-      newInfo.username,
-      newInfo.fullName,
-      newInfo.phoneNumber,
-      newInfo.email,
-      newInfo.address,
-      newInfo.sex,
-      newInfo.birthday,
-      newInfo.country
+      'CreateRoom',
+      room.roomId,
+      room.building,
+      room.capacity.toString(),
+      JSON.stringify(room.facilities || [])
     );
+    let response = {
+      success: true,
+      msg: 'Create Successfully!'
+    };
+
+    await networkObj.gateway.disconnect();
+    return response;
+  } catch (error) {
+    let response = {
+      success: false,
+      msg: error
+    };
+    return response;
+  }
+};
+
+exports.updateRoomInfo = async function(networkObj, room) {
+  if (!room.roomId || !room.building || !room.capacity) {
+    let response = {};
+    response.error = 'Error! You need to fill all fields before you can update!';
+    return response;
+  }
 
+  try {
+    await networkObj.contract.submitTransaction(
+      'UpdateRoomInfo',
+      room.roomId,
+      room.building,
+      room.capacity.toString(),
+      JSON.stringify(room.facilities || [])
+    );
     let response = {
       success: true,
-      msg: 'Successfully Updated!'
+      msg: 'Update Successfully!'
     };
 
     await networkObj.gateway.disconnect();
@@ -689,18 +740,18 @@ exports.updateUserInfo = async function(networkObj, newInfo) {
   }
 };
 
-exports.updateUserAvatar = async function(networkObj, avatar) {
-  if (!avatar) {
+exports.deleteRoom = async function(networkObj, roomId) {
+  if (!roomId) {
     let response = {};
-    response.error = 'Error! You need to fill all fields before you can register!';
+    response.error = 'Error! You need to fill all fields before you can update!';
     return response;
   }
 
   try {
-    await networkObj.contract.submitTransaction('UpdateUserAvatar', avatar);
+    await networkObj.contract.submitTransaction('DeleteRoom', roomId);
     let response = {
       success: true,
-      msg: 'Successfully Updated!'
+      msg: 'Delete Successfully!'
     };
 
     await networkObj.gateway.disconnect();
@@ -714,19 +765,39 @@ exports.updateUserAvatar = async function(networkObj, avatar) {
   }
 };
 
-exports.closeCourse = async function(networkObj, courseId) {
-  if (!courseId) {
+exports.createAcademicTerm = async function(networkObj, term) {
+  if (
+    !term.termId ||
+    !term.termName ||
+    !term.registrationStart ||
+    !term.registrationEnd ||
+    !term.teachingStart ||
+    !term.teachingEnd ||
+    !term.gradingDeadline ||
+    !term.timezone
+  ) {
     let response = {};
     response.error = 'Error! You need to fill all fields before you can register!';
     return response;
   }
 
   try {
-    await networkObj.contract.submitTransaction('CloseCourse', courseId);
+    await networkObj.contract.submitTransaction(
+      'CreateAcademicTerm',
+      term.termId,
+      term.termName,
+      term.registrationStart,
+      term.registrationEnd,
+      term.teachingStart,
+      term.teachingEnd,
+      term.gradingDeadline,
+      term.timezone
+    );
     let response = {
       success: true,
-      msg: 'Close successfully!'
+      msg: 'Create Successfully!'
     };
+
     await networkObj.gateway.disconnect();
     return response;
   } catch (error) {
@@ -738,19 +809,39 @@ exports.closeCourse = async function(networkObj, courseId) {
   }
 };
 
-exports.openCourse = async function(networkObj, courseId) {
-  if (!courseId) {
+exports.updateAcademicTerm = async function(networkObj, term) {
+  if (
+    !term.termId ||
+    !term.termName ||
+    !term.registrationStart ||
+    !term.registrationEnd ||
+    !term.teachingStart ||
+    !term.teachingEnd ||
+    !term.gradingDeadline ||
+    !term.timezone
+  ) {
     let response = {};
-    response.error = 'Error! You need to fill all fields before you can register!';
+    response.error = 'Error! You need to fill all fields before you can update!';
     return response;
   }
 
   try {
-    await networkObj.contract.submitTransaction('OpenCourse', courseId);
+    await networkObj.contract.submitTransaction(
+      'UpdateAcademicTerm',
+      term.termId,
+      term.termName,
+      term.registrationStart,
+      term.registrationEnd,
+      term.teachingStart,
+      term.teachingEnd,
+      term.gradingDeadline,
+      term.timezone
+    );
     let response = {
       success: true,
-      msg: 'Open successfully!'
+      msg: 'Update Successfully!'
     };
+
     await networkObj.gateway.disconnect();
     return response;
   } catch (error) {
@@ -762,19 +853,20 @@ exports.openCourse = async function(networkObj, courseId) {
   }
 };
 
-exports.deleteSubject = async function(networkObj, subjectId) {
-  if (!subjectId) {
+exports.setAcademicTermStatus = async function(networkObj, termId, status) {
+  if (!termId || !status) {
     let response = {};
-    response.error = 'Error! You need to fill all fields before you can register!';
+    response.error = 'Error! You need to fill all fields before you can update!';
     return response;
   }
 
   try {
-    await networkObj.contract.submitTransaction('DeleteSubject', subjectId);
+    await networkObj.contract.submitTransaction('SetAcademicTermStatus', termId, status);
     let response = {
       success: true,
-      msg: 'Deleted successfully!'
+      msg: 'Update Successfully!'
     };
+
     await networkObj.gateway.disconnect();
     return response;
   } catch (error) {
@@ -786,25 +878,47 @@ exports.deleteSubject = async function(networkObj, subjectId) {
   }
 };
 
-exports.pickScore = async function(networkObj, score) {
-  if (!score.teacher || !score.studentUsername || !score.scoreValue || !score.classId) {
+exports.setCourseEnrollmentWindow = async function(networkObj, courseId, window) {
+  if (!courseId || !window.startDate || !window.endDate || !window.timezone) {
     let response = {};
-    response.error = 'Error! You need to fill all fields before you can register!';
+    response.error = 'Error! You need to fill all fields before you can update!';
     return response;
   }
 
   try {
     await networkObj.contract.submitTransaction(
-      'PickScore',
-      score.teacher,
-      score.classId,
-      score.studentUsername,
-      score.scoreValue
+      'SetCourseEnrollmentWindow',
+      courseId,
+      window.startDate,
+      window.endDate,
+      window.timezone
     );
+    let response = {
+      success: true,
+      msg: 'Update Successfully!'
+    };
+
+    await networkObj.gateway.disconnect();
+    return response;
+  } catch (error) {
+    let response = {
+      success: false,
+      msg: error
+    };
+    return response;
+  }
+};
 
+exports.setCourseIssuancePolicy = async function(networkObj, courseId, policy) {
+  try {
+    await networkObj.contract.submitTransaction(
+      'SetCourseIssuancePolicy',
+      courseId,
+      JSON.stringify(policy)
+    );
     let response = {
       success: true,
-      msg: 'Create Successfully!'
+      msg: 'Update Successfully!'
     };
 
     await networkObj.gateway.disconnect();
@@ -818,28 +932,54 @@ exports.pickScore = async function(networkObj, score) {
   }
 };
 
-exports.createCertificate = async function(networkObj, certificate) {
-  if (
-    !certificate.certificateId ||
-    !certificate.courseId ||
-    !certificate.studentUsername ||
-    !certificate.issueDate
-  ) {
-    let response = {};
-    response.error = 'Error! You need to fill all fields before you can register!';
+exports.addElectiveToCourse = async function(networkObj, courseId, subjectId) {
+  try {
+    await networkObj.contract.submitTransaction('AddElectiveToCourse', courseId, subjectId);
+    let response = {
+      success: true,
+      msg: 'Successfully Updated!'
+    };
+
+    await networkObj.gateway.disconnect();
+    return response;
+  } catch (error) {
+    let response = {
+      success: false,
+      msg: error
+    };
+    return response;
+  }
+};
+
+exports.removeElectiveFromCourse = async function(networkObj, courseId, subjectId) {
+  try {
+    await networkObj.contract.submitTransaction('RemoveElectiveFromCourse', courseId, subjectId);
+    let response = {
+      success: true,
+      msg: 'Successfully Updated!'
+    };
+
+    await networkObj.gateway.disconnect();
+    return response;
+  } catch (error) {
+    let response = {
+      success: false,
+      msg: error
+    };
     return response;
   }
+};
+
+exports.setCourseHonoursBands = async function(networkObj, courseId, bands) {
   try {
     await networkObj.contract.submitTransaction(
-      'CreateCertificate',
-      certificate.certificateId,
-      certificate.courseId,
-      certificate.studentUsername,
-      certificate.issueDate
+      'SetCourseHonoursBands',
+      courseId,
+      JSON.stringify(bands)
     );
     let response = {
       success: true,
-      msg: 'Create Successfully!'
+      msg: 'Update Successfully!'
     };
 
     await networkObj.gateway.disconnect();
@@ -853,18 +993,39 @@ exports.createCertificate = async function(networkObj, certificate) {
   }
 };
 
-exports.assignTeacherToClass = async function(networkObj, classId, teacher) {
-  if (!classId || !teacher) {
-    let response = {};
-    response.error = 'Error! You need to fill all fields before you can register!';
+exports.addPrerequisiteToCourse = async function(networkObj, courseId, requiredCourseId) {
+  try {
+    await networkObj.contract.submitTransaction(
+      'AddPrerequisiteToCourse',
+      courseId,
+      requiredCourseId
+    );
+    let response = {
+      success: true,
+      msg: 'Successfully Updated!'
+    };
+
+    await networkObj.gateway.disconnect();
+    return response;
+  } catch (error) {
+    let response = {
+      success: false,
+      msg: error
+    };
     return response;
   }
+};
 
+exports.removePrerequisiteFromCourse = async function(networkObj, courseId, requiredCourseId) {
   try {
-    await networkObj.contract.submitTransaction('AssignTeacherToClass', classId, teacher);
+    await networkObj.contract.submitTransaction(
+      'RemovePrerequisiteFromCourse',
+      courseId,
+      requiredCourseId
+    );
     let response = {
       success: true,
-      msg: 'Assign Successfully!'
+      msg: 'Successfully Updated!'
     };
 
     await networkObj.gateway.disconnect();
@@ -878,18 +1039,50 @@ exports.assignTeacherToClass = async function(networkObj, classId, teacher) {
   }
 };
 
-exports.unassignTeacherFromClass = async function(networkObj, classId) {
-  if (!classId) {
+exports.advanceLifecycle = async function(networkObj, pageSize, bookmark) {
+  try {
+    let args = pageSize ? [pageSize.toString(), bookmark || ''] : [];
+    let result = await networkObj.contract.submitTransaction('AdvanceLifecycle', ...args);
+    let response = {
+      success: true,
+      msg: 'Advance Successfully!',
+      report: JSON.parse(result.toString())
+    };
+
+    await networkObj.gateway.disconnect();
+    return response;
+  } catch (error) {
+    let response = {
+      success: false,
+      msg: error
+    };
+    return response;
+  }
+};
+
+exports.updateUserInfo = async function(networkObj, newInfo) {
+  if (!newInfo.username) {
     let response = {};
     response.error = 'Error! You need to fill all fields before you can register!';
     return response;
   }
 
   try {
-    await networkObj.contract.submitTransaction('UnassignTeacherFromClass', classId);
+    await networkObj.contract.submitTransaction(
+      'UpdateUserInfo',
+      newInfo.username,
+      newInfo.fullName,
+      newInfo.phoneNumber,
+      newInfo.email,
+      newInfo.address,
+      newInfo.sex,
+      newInfo.birthday,
+      newInfo.country
+    );
+
     let response = {
       success: true,
-      msg: 'Unassign Successfully!'
+      msg: 'Successfully Updated!'
     };
 
     await networkObj.gateway.disconnect();
@@ -903,17 +1096,18 @@ exports.unassignTeacherFromClass = async function(networkObj, classId) {
   }
 };
 
-exports.studentRegisterCourse = async function(networkObj, student, courseId) {
-  if (!student || !courseId) {
+exports.updateUserAvatar = async function(networkObj, avatar) {
+  if (!avatar) {
     let response = {};
     response.error = 'Error! You need to fill all fields before you can register!';
     return response;
   }
+
   try {
-    await networkObj.contract.submitTransaction('StudentRegisterCourse', student, courseId);
+    await networkObj.contract.submitTransaction('UpdateUserAvatar', avatar);
     let response = {
       success: true,
-      msg: 'Register Successfully!'
+      msg: 'Successfully Updated!'
     };
 
     await networkObj.gateway.disconnect();
@@ -927,17 +1121,939 @@ exports.studentRegisterCourse = async function(networkObj, student, courseId) {
   }
 };
 
-exports.studentRegisterClass = async function(networkObj, student, classId) {
-  if (!student || !classId) {
+exports.closeCourse = async function(networkObj, courseId) {
+  if (!courseId) {
     let response = {};
     response.error = 'Error! You need to fill all fields before you can register!';
     return response;
   }
+
   try {
-    await networkObj.contract.submitTransaction('StudentRegisterClass', student, classId);
+    await networkObj.contract.submitTransaction('CloseCourse', courseId);
     let response = {
       success: true,
-      msg: 'Register Successfully!'
+      msg: 'Close successfully!'
+    };
+    await networkObj.gateway.disconnect();
+    return response;
+  } catch (error) {
+    let response = {
+      success: false,
+      msg: error
+    };
+    return response;
+  }
+};
+
+exports.openCourse = async function(networkObj, courseId) {
+  if (!courseId) {
+    let response = {};
+    response.error = 'Error! You need to fill all fields before you can register!';
+    return response;
+  }
+
+  try {
+    await networkObj.contract.submitTransaction('OpenCourse', courseId);
+    let response = {
+      success: true,
+      msg: 'Open successfully!'
+    };
+    await networkObj.gateway.disconnect();
+    return response;
+  } catch (error) {
+    let response = {
+      success: false,
+      msg: error
+    };
+    return response;
+  }
+};
+
+exports.deleteSubject = async function(networkObj, subjectId) {
+  if (!subjectId) {
+    let response = {};
+    response.error = 'Error! You need to fill all fields before you can register!';
+    return response;
+  }
+
+  try {
+    await networkObj.contract.submitTransaction('DeleteSubject', subjectId);
+    let response = {
+      success: true,
+      msg: 'Deleted successfully!'
+    };
+    await networkObj.gateway.disconnect();
+    return response;
+  } catch (error) {
+    let response = {
+      success: false,
+      msg: error
+    };
+    return response;
+  }
+};
+
+exports.pickScore = async function(networkObj, score) {
+  if (!score.teacher || !score.studentUsername || !score.scoreValue || !score.classId) {
+    let response = {};
+    response.error = 'Error! You need to fill all fields before you can register!';
+    return response;
+  }
+
+  try {
+    await networkObj.contract.submitTransaction(
+      'PickScore',
+      score.teacher,
+      score.classId,
+      score.studentUsername,
+      score.scoreValue
+    );
+
+    let response = {
+      success: true,
+      msg: 'Create Successfully!'
+    };
+
+    await networkObj.gateway.disconnect();
+    return response;
+  } catch (error) {
+    let response = {
+      success: false,
+      msg: error
+    };
+    return response;
+  }
+};
+
+exports.overrideScore = async function(networkObj, score) {
+  if (
+    !score.studentUsername ||
+    !score.scoreValue ||
+    !score.classId ||
+    !score.justification
+  ) {
+    let response = {};
+    response.error = 'Error! You need to fill all fields before you can override!';
+    return response;
+  }
+
+  try {
+    await networkObj.contract.submitTransaction(
+      'OverrideScore',
+      score.classId,
+      score.studentUsername,
+      score.scoreValue,
+      score.justification
+    );
+
+    let response = {
+      success: true,
+      msg: 'Override Successfully!'
+    };
+
+    await networkObj.gateway.disconnect();
+    return response;
+  } catch (error) {
+    let response = {
+      success: false,
+      msg: error
+    };
+    return response;
+  }
+};
+
+exports.recordAttendance = async function(networkObj, session) {
+  if (!session.teacher || !session.classId || !session.date || !session.attendance) {
+    let response = {};
+    response.error = 'Error! You need to fill all fields before you can record!';
+    return response;
+  }
+
+  try {
+    await networkObj.contract.submitTransaction(
+      'RecordAttendance',
+      session.teacher,
+      session.classId,
+      session.date,
+      JSON.stringify(session.attendance)
+    );
+
+    let response = {
+      success: true,
+      msg: 'Record Successfully!'
+    };
+
+    await networkObj.gateway.disconnect();
+    return response;
+  } catch (error) {
+    let response = {
+      success: false,
+      msg: error
+    };
+    return response;
+  }
+};
+
+exports.createAssessment = async function(networkObj, assessment) {
+  if (
+    !assessment.teacher ||
+    !assessment.classId ||
+    !assessment.assessmentId ||
+    !assessment.title ||
+    !assessment.type ||
+    !assessment.dueDate ||
+    !assessment.maxPoints
+  ) {
+    let response = {};
+    response.error = 'Error! You need to fill all fields before you can create!';
+    return response;
+  }
+
+  try {
+    await networkObj.contract.submitTransaction(
+      'CreateAssessment',
+      assessment.teacher,
+      assessment.classId,
+      assessment.assessmentId,
+      assessment.title,
+      assessment.type,
+      assessment.dueDate,
+      assessment.maxPoints
+    );
+
+    let response = {
+      success: true,
+      msg: 'Create Successfully!'
+    };
+
+    await networkObj.gateway.disconnect();
+    return response;
+  } catch (error) {
+    let response = {
+      success: false,
+      msg: error
+    };
+    return response;
+  }
+};
+
+exports.submitAssessment = async function(networkObj, submission) {
+  if (!submission.student || !submission.classId || !submission.assessmentId || !submission.hash) {
+    let response = {};
+    response.error = 'Error! You need to fill all fields before you can submit!';
+    return response;
+  }
+
+  try {
+    let result = await networkObj.contract.submitTransaction(
+      'SubmitAssessment',
+      submission.student,
+      submission.classId,
+      submission.assessmentId,
+      submission.hash
+    );
+
+    let response = {
+      success: true,
+      msg: JSON.parse(result.toString())
+    };
+
+    await networkObj.gateway.disconnect();
+    return response;
+  } catch (error) {
+    let response = {
+      success: false,
+      msg: error
+    };
+    return response;
+  }
+};
+
+exports.gradeSubmission = async function(networkObj, grade) {
+  if (
+    !grade.teacher ||
+    !grade.classId ||
+    !grade.assessmentId ||
+    !grade.studentUsername ||
+    !grade.hash ||
+    grade.points === undefined
+  ) {
+    let response = {};
+    response.error = 'Error! You need to fill all fields before you can grade!';
+    return response;
+  }
+
+  try {
+    await networkObj.contract.submitTransaction(
+      'GradeSubmission',
+      grade.teacher,
+      grade.classId,
+      grade.assessmentId,
+      grade.studentUsername,
+      grade.hash,
+      grade.points
+    );
+
+    let response = {
+      success: true,
+      msg: 'Grade Successfully!'
+    };
+
+    await networkObj.gateway.disconnect();
+    return response;
+  } catch (error) {
+    let response = {
+      success: false,
+      msg: error
+    };
+    return response;
+  }
+};
+
+exports.grantTeacherQualification = async function(networkObj, qualification) {
+  if (!qualification.username || !qualification.subjectId || !qualification.effectiveFrom) {
+    let response = {};
+    response.error = 'Error! You need to fill all fields before you can grant!';
+    return response;
+  }
+
+  try {
+    await networkObj.contract.submitTransaction(
+      'GrantTeacherQualification',
+      qualification.username,
+      qualification.subjectId,
+      qualification.effectiveFrom,
+      qualification.effectiveUntil
+    );
+
+    let response = {
+      success: true,
+      msg: 'Grant Successfully!'
+    };
+
+    await networkObj.gateway.disconnect();
+    return response;
+  } catch (error) {
+    let response = {
+      success: false,
+      msg: error
+    };
+    return response;
+  }
+};
+
+exports.revokeTeacherQualification = async function(networkObj, username, subjectId) {
+  try {
+    await networkObj.contract.submitTransaction('RevokeTeacherQualification', username, subjectId);
+
+    let response = {
+      success: true,
+      msg: 'Revoke Successfully!'
+    };
+
+    await networkObj.gateway.disconnect();
+    return response;
+  } catch (error) {
+    let response = {
+      success: false,
+      msg: error
+    };
+    return response;
+  }
+};
+
+exports.addClassStaff = async function(networkObj, classId, username, role) {
+  if (!classId || !username || !role) {
+    let response = {};
+    response.error = 'Error! You need to fill all fields before you can add!';
+    return response;
+  }
+
+  try {
+    await networkObj.contract.submitTransaction('AddClassStaff', classId, username, role);
+
+    let response = {
+      success: true,
+      msg: 'Add Successfully!'
+    };
+
+    await networkObj.gateway.disconnect();
+    return response;
+  } catch (error) {
+    let response = {
+      success: false,
+      msg: error
+    };
+    return response;
+  }
+};
+
+exports.removeClassStaff = async function(networkObj, classId, username) {
+  try {
+    await networkObj.contract.submitTransaction('RemoveClassStaff', classId, username);
+
+    let response = {
+      success: true,
+      msg: 'Remove Successfully!'
+    };
+
+    await networkObj.gateway.disconnect();
+    return response;
+  } catch (error) {
+    let response = {
+      success: false,
+      msg: error
+    };
+    return response;
+  }
+};
+
+exports.setGradingWindow = async function(networkObj, daysAfterEnd) {
+  try {
+    await networkObj.contract.submitTransaction('SetGradingWindow', daysAfterEnd);
+
+    let response = {
+      success: true,
+      msg: 'Update Successfully!'
+    };
+
+    await networkObj.gateway.disconnect();
+    return response;
+  } catch (error) {
+    let response = {
+      success: false,
+      msg: error
+    };
+    return response;
+  }
+};
+
+exports.setRetakePolicy = async function(networkObj, policy) {
+  try {
+    await networkObj.contract.submitTransaction(
+      'SetRetakePolicy',
+      policy.maxAttempts.toString(),
+      policy.retakeOnlyAfterFail.toString(),
+      policy.countedAttempt
+    );
+
+    let response = {
+      success: true,
+      msg: 'Update Successfully!'
+    };
+
+    await networkObj.gateway.disconnect();
+    return response;
+  } catch (error) {
+    let response = {
+      success: false,
+      msg: error
+    };
+    return response;
+  }
+};
+
+exports.createCertificate = async function(networkObj, certificate) {
+  if (
+    !certificate.certificateId ||
+    !certificate.courseId ||
+    !certificate.studentUsername ||
+    !certificate.issueDate
+  ) {
+    let response = {};
+    response.error = 'Error! You need to fill all fields before you can register!';
+    return response;
+  }
+  try {
+    await networkObj.contract
+      .createTransaction('CreateCertificate')
+      .setTransient(newSaltSecrets([certificate.studentUsername]))
+      .submit(
+        certificate.certificateId,
+        certificate.courseId,
+        certificate.studentUsername,
+        certificate.issueDate
+      );
+    let response = {
+      success: true,
+      msg: 'Create Successfully!'
+    };
+
+    await networkObj.gateway.disconnect();
+    return response;
+  } catch (error) {
+    let response = {
+      success: false,
+      msg: error
+    };
+    return response;
+  }
+};
+
+exports.setCredentialIssuer = async function(networkObj, publicKey, name) {
+  try {
+    await networkObj.contract.submitTransaction('SetCredentialIssuer', publicKey, name);
+
+    let response = {
+      success: true,
+      msg: 'Update Successfully!'
+    };
+
+    await networkObj.gateway.disconnect();
+    return response;
+  } catch (error) {
+    let response = {
+      success: false,
+      msg: error
+    };
+    return response;
+  }
+};
+
+exports.anchorCertificateProof = async function(networkObj, certificateId, proof) {
+  try {
+    await networkObj.contract.submitTransaction(
+      'AnchorCertificateProof',
+      certificateId,
+      JSON.stringify(proof)
+    );
+
+    let response = {
+      success: true,
+      msg: 'Anchor Successfully!'
+    };
+
+    await networkObj.gateway.disconnect();
+    return response;
+  } catch (error) {
+    let response = {
+      success: false,
+      msg: error
+    };
+    return response;
+  }
+};
+
+exports.anchorOpenBadgeProof = async function(networkObj, badge, proof) {
+  try {
+    await networkObj.contract.submitTransaction(
+      'AnchorOpenBadgeProof',
+      badge.username,
+      badge.achievementType,
+      badge.id,
+      JSON.stringify(proof)
+    );
+
+    let response = {
+      success: true,
+      msg: 'Anchor Successfully!'
+    };
+
+    await networkObj.gateway.disconnect();
+    return response;
+  } catch (error) {
+    let response = {
+      success: false,
+      msg: error
+    };
+    return response;
+  }
+};
+
+exports.revokeCertificate = async function(networkObj, certificateId, reason) {
+  let response = {
+    success: false,
+    msg: ''
+  };
+  try {
+    await networkObj.contract.submitTransaction('RevokeCertificate', certificateId, reason);
+
+    await networkObj.gateway.disconnect();
+    response.success = true;
+    response.msg = 'Revoke certificate successfully!';
+    return response;
+  } catch (error) {
+    response.success = false;
+    response.msg = error;
+    return response;
+  }
+};
+
+exports.setBatchIssuanceLimit = async function(networkObj, maxWritesPerCall) {
+  try {
+    await networkObj.contract.submitTransaction('SetBatchIssuanceLimit', maxWritesPerCall);
+
+    let response = {
+      success: true,
+      msg: 'Update Successfully!'
+    };
+
+    await networkObj.gateway.disconnect();
+    return response;
+  } catch (error) {
+    let response = {
+      success: false,
+      msg: error
+    };
+    return response;
+  }
+};
+
+// students are the usernames of the course, each of them needs a salt secret in case it is issued
+exports.issueCertificatesForCourse = async function(networkObj, courseId, students, restart) {
+  let response = {
+    success: false,
+    msg: ''
+  };
+  try {
+    let args = restart ? [courseId, 'restart'] : [courseId];
+
+    response.msg = await networkObj.contract
+      .createTransaction('IssueCertificatesForCourse')
+      .setTransient(newSaltSecrets(students))
+      .submit(...args);
+
+    await networkObj.gateway.disconnect();
+    response.success = true;
+    return response;
+  } catch (error) {
+    response.success = false;
+    response.msg = error;
+    return response;
+  }
+};
+
+exports.issueTranscriptCommitment = async function(networkObj, username, courseId) {
+  let response = {
+    success: false,
+    msg: ''
+  };
+  try {
+    response.msg = await networkObj.contract
+      .createTransaction('IssueTranscriptCommitment')
+      .setTransient(newSaltSecrets([username]))
+      .submit(username, courseId);
+
+    await networkObj.gateway.disconnect();
+    response.success = true;
+    return response;
+  } catch (error) {
+    response.success = false;
+    response.msg = error;
+    return response;
+  }
+};
+
+// The new version commits the scores again, so the student gets a fresh salt secret
+exports.reissueCertificate = async function(networkObj, certificate, reason) {
+  let response = {
+    success: false,
+    msg: ''
+  };
+  try {
+    response.msg = await networkObj.contract
+      .createTransaction('ReissueCertificate')
+      .setTransient(newSaltSecrets([certificate.StudentUsername]))
+      .submit(certificate.CertificateID, reason);
+
+    await networkObj.gateway.disconnect();
+    response.success = true;
+    return response;
+  } catch (error) {
+    response.success = false;
+    response.msg = error;
+    return response;
+  }
+};
+
+// Every score commitment gets a fresh random secret per student. The chaincode keeps it in a
+// private collection only the student can read back, so the server does not store it.
+function newSaltSecrets(usernames) {
+  let secrets = {};
+  usernames.forEach((username) => {
+    secrets[username] = crypto.randomBytes(32).toString('hex');
+  });
+
+  return { saltSecrets: Buffer.from(JSON.stringify(secrets)) };
+}
+
+// All verifiers share the guest identity, so the chaincode tells them apart by the ID and token
+// the student handed out with the grant. The read is submitted so its access log entry commits.
+exports.queryAsVerifier = async function(networkObj, func, args, verifier) {
+  let response = {
+    success: false,
+    msg: ''
+  };
+  try {
+    response.msg = await networkObj.contract
+      .createTransaction(func)
+      .setTransient({
+        verifierId: Buffer.from(verifier.id || ''),
+        verifierToken: Buffer.from(verifier.token || '')
+      })
+      .submit(...[].concat(args));
+
+    await networkObj.gateway.disconnect();
+    response.success = true;
+    return response;
+  } catch (error) {
+    response.success = false;
+    response.msg = error;
+    return response;
+  }
+};
+
+exports.grantCertificateAccess = async function(networkObj, certificateId, verifierId, expiry) {
+  return grantAccess(networkObj, 'GrantCertificateAccess', certificateId, verifierId, expiry);
+};
+
+exports.revokeCertificateAccess = async function(networkObj, certificateId, verifierId) {
+  return revokeAccess(networkObj, 'RevokeCertificateAccess', certificateId, verifierId);
+};
+
+exports.grantMicroCredentialAccess = async function(
+  networkObj,
+  microCredentialId,
+  verifierId,
+  expiry
+) {
+  return grantAccess(
+    networkObj,
+    'GrantMicroCredentialAccess',
+    microCredentialId,
+    verifierId,
+    expiry
+  );
+};
+
+exports.revokeMicroCredentialAccess = async function(networkObj, microCredentialId, verifierId) {
+  return revokeAccess(networkObj, 'RevokeMicroCredentialAccess', microCredentialId, verifierId);
+};
+
+// The token is only returned here; the ledger keeps its hash.
+async function grantAccess(networkObj, func, id, verifierId, expiry) {
+  let response = {
+    success: false,
+    msg: ''
+  };
+  try {
+    let token = crypto.randomBytes(32).toString('hex');
+
+    await networkObj.contract
+      .createTransaction(func)
+      .setTransient({ verifierToken: Buffer.from(token) })
+      .submit(id, verifierId, expiry);
+
+    await networkObj.gateway.disconnect();
+    response.success = true;
+    response.msg = token;
+    return response;
+  } catch (error) {
+    response.success = false;
+    response.msg = error;
+    return response;
+  }
+}
+
+async function revokeAccess(networkObj, func, id, verifierId) {
+  let response = {
+    success: false,
+    msg: ''
+  };
+  try {
+    await networkObj.contract.submitTransaction(func, id, verifierId);
+
+    await networkObj.gateway.disconnect();
+    response.success = true;
+    response.msg = 'Revoke access successfully!';
+    return response;
+  } catch (error) {
+    response.success = false;
+    response.msg = error;
+    return response;
+  }
+}
+
+exports.issueMicroCredential = async function(networkObj, classId, username) {
+  let response = {
+    success: false,
+    msg: ''
+  };
+  try {
+    response.msg = await networkObj.contract.submitTransaction(
+      'IssueMicroCredential',
+      classId,
+      username
+    );
+
+    await networkObj.gateway.disconnect();
+    response.success = true;
+    return response;
+  } catch (error) {
+    response.success = false;
+    response.msg = error;
+    return response;
+  }
+};
+
+exports.revokeMicroCredential = async function(networkObj, microCredentialId, reason) {
+  let response = {
+    success: false,
+    msg: ''
+  };
+  try {
+    await networkObj.contract.submitTransaction('RevokeMicroCredential', microCredentialId, reason);
+
+    await networkObj.gateway.disconnect();
+    response.success = true;
+    response.msg = 'Revoke micro-credential successfully!';
+    return response;
+  } catch (error) {
+    response.success = false;
+    response.msg = error;
+    return response;
+  }
+};
+
+exports.exemptSubject = async function(networkObj, exemption) {
+  let response = {
+    success: false,
+    msg: ''
+  };
+  try {
+    response.msg = await networkObj.contract.submitTransaction(
+      'ExemptSubject',
+      exemption.username,
+      exemption.subjectId,
+      exemption.evidenceHash,
+      exemption.reason
+    );
+
+    await networkObj.gateway.disconnect();
+    response.success = true;
+    return response;
+  } catch (error) {
+    response.success = false;
+    response.msg = error;
+    return response;
+  }
+};
+
+exports.assignTeacherToClass = async function(networkObj, classId, teacher) {
+  if (!classId || !teacher) {
+    let response = {};
+    response.error = 'Error! You need to fill all fields before you can register!';
+    return response;
+  }
+
+  try {
+    await networkObj.contract.submitTransaction('AssignTeacherToClass', classId, teacher);
+    let response = {
+      success: true,
+      msg: 'Assign Successfully!'
+    };
+
+    await networkObj.gateway.disconnect();
+    return response;
+  } catch (error) {
+    let response = {
+      success: false,
+      msg: error
+    };
+    return response;
+  }
+};
+
+exports.unassignTeacherFromClass = async function(networkObj, classId) {
+  if (!classId) {
+    let response = {};
+    response.error = 'Error! You need to fill all fields before you can register!';
+    return response;
+  }
+
+  try {
+    await networkObj.contract.submitTransaction('UnassignTeacherFromClass', classId);
+    let response = {
+      success: true,
+      msg: 'Unassign Successfully!'
+    };
+
+    await networkObj.gateway.disconnect();
+    return response;
+  } catch (error) {
+    let response = {
+      success: false,
+      msg: error
+    };
+    return response;
+  }
+};
+
+exports.studentRegisterCourse = async function(networkObj, student, courseId) {
+  if (!student || !courseId) {
+    let response = {};
+    response.error = 'Error! You need to fill all fields before you can register!';
+    return response;
+  }
+  try {
+    await networkObj.contract.submitTransaction('StudentRegisterCourse', student, courseId);
+    let response = {
+      success: true,
+      msg: 'Register Successfully!'
+    };
+
+    await networkObj.gateway.disconnect();
+    return response;
+  } catch (error) {
+    let response = {
+      success: false,
+      msg: error
+    };
+    return response;
+  }
+};
+
+exports.studentRegisterClass = async function(networkObj, student, classId) {
+  if (!student || !classId) {
+    let response = {};
+    response.error = 'Error! You need to fill all fields before you can register!';
+    return response;
+  }
+  try {
+    let result = await networkObj.contract.submitTransaction(
+      'StudentRegisterClass',
+      student,
+      classId
+    );
+    let response = {
+      success: true,
+      msg: 'Register Successfully!'
+    };
+
+    if (result && result.length > 0) {
+      let position = JSON.parse(result.toString());
+      response.waitlist = position;
+      response.msg =
+        'This class is full! You are number ' + position.Position + ' on the waitlist.';
+    }
+
+    await networkObj.gateway.disconnect();
+    return response;
+  } catch (error) {
+    let response = {
+      success: false,
+      msg: error
+    };
+    return response;
+  }
+};
+
+exports.leaveClassWaitlist = async function(networkObj, student, classId) {
+  try {
+    await networkObj.contract.submitTransaction('LeaveClassWaitlist', student, classId);
+    let response = {
+      success: true,
+      msg: 'Leave waitlist successfully!'
     };
 
     await networkObj.gateway.disconnect();
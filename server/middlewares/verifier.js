@@ -0,0 +1,10 @@
+// Verifiers read through the shared guest identity, so they send the ID and token
+// the student shared with them when granting access.
+module.exports = (req, res, next) => {
+  req.verifier = {
+    id: req.headers['x-verifier-id'],
+    token: req.headers['x-verifier-token']
+  };
+
+  next();
+};
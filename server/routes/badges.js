@@ -0,0 +1,155 @@
+const router = require('express').Router();
+const USER_ROLES = require('../configs/constant').USER_ROLES;
+const network = require('../fabric/network');
+const credential = require('../fabric/credential');
+const { check } = require('express-validator');
+const checkJWT = require('../middlewares/check-jwt');
+const verifier = require('../middlewares/verifier');
+
+const ACHIEVEMENT_TYPES = {
+  courses: 'Course',
+  subjects: 'Subject'
+};
+
+router.get(
+  '/achievements/:achievementType(courses|subjects)/:id',
+  check('id')
+    .trim()
+    .escape(),
+  async (req, res) => {
+    let guest = { role: USER_ROLES.STUDENT, username: 'guest' };
+    let networkObj = await network.connectToNetwork(guest);
+
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed to connect blockchain'
+      });
+    }
+
+    let response = await network.query(networkObj, 'GetAchievement', [
+      ACHIEVEMENT_TYPES[req.params.achievementType],
+      req.params.id
+    ]);
+
+    if (!response.success) {
+      return res.status(404).json({
+        msg: 'Can not query achievement!'
+      });
+    }
+
+    return res.json({ achievement: JSON.parse(response.msg) });
+  }
+);
+
+router.get(
+  '/students/:username/:achievementType(courses|subjects)/:id',
+  verifier,
+  [
+    check('username')
+      .trim()
+      .escape(),
+    check('id')
+      .trim()
+      .escape()
+  ],
+  async (req, res) => {
+    let guest = { role: USER_ROLES.STUDENT, username: 'guest' };
+    let networkObj = await network.connectToNetwork(guest);
+
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed to connect blockchain'
+      });
+    }
+
+    let response = await network.queryAsVerifier(
+      networkObj,
+      'GetOpenBadgeCredential',
+      [req.params.username, ACHIEVEMENT_TYPES[req.params.achievementType], req.params.id],
+      req.verifier
+    );
+
+    if (!response.success) {
+      return res.status(404).json({
+        msg: 'Can not query open badge credential!'
+      });
+    }
+
+    return res.json({ badge: JSON.parse(response.msg) });
+  }
+);
+
+// Sign the badge off-chain with the issuer key and anchor the proof
+router.post(
+  '/students/:username/:achievementType(courses|subjects)/:id',
+  checkJWT,
+  [
+    check('username')
+      .trim()
+      .escape(),
+    check('id')
+      .trim()
+      .escape()
+  ],
+  async (req, res) => {
+    const user = req.decoded.user;
+
+    if (user.role !== USER_ROLES.ADMIN_ACADEMY) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const issuerKey = credential.getIssuerKey();
+
+    if (!issuerKey) {
+      return res.status(500).json({
+        msg: 'Issuer key is not configured'
+      });
+    }
+
+    let networkObj = await network.connectToNetwork(user);
+
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    let badgeInfo = {
+      username: req.params.username,
+      achievementType: ACHIEVEMENT_TYPES[req.params.achievementType],
+      id: req.params.id
+    };
+
+    let issuer = await network.query(networkObj, 'GetCredentialIssuer');
+    let badge = await network.query(networkObj, 'GetOpenBadgeCredential', [
+      badgeInfo.username,
+      badgeInfo.achievementType,
+      badgeInfo.id
+    ]);
+
+    if (!issuer.success || !badge.success) {
+      return res.status(404).json({
+        msg: 'Query chaincode failed'
+      });
+    }
+
+    issuer = JSON.parse(issuer.msg);
+    badge = JSON.parse(badge.msg);
+    badge.proof = credential.signCredential(badge, issuer.VerificationMethod, issuerKey);
+
+    networkObj = await network.connectToNetwork(user);
+    let response = await network.anchorOpenBadgeProof(networkObj, badgeInfo, badge.proof);
+
+    if (!response.success) {
+      return res.status(500).json({
+        msg: 'Can not anchor badge proof'
+      });
+    }
+
+    return res.status(201).json({ badge });
+  }
+);
+
+module.exports = router;
@@ -1,8 +1,10 @@
 const router = require('express').Router();
 const USER_ROLES = require('../configs/constant').USER_ROLES;
 const network = require('../fabric/network');
+const credential = require('../fabric/credential');
 const { check, body, validationResult } = require('express-validator');
 const checkJWT = require('../middlewares/check-jwt');
+const verifier = require('../middlewares/verifier');
 const axios = require('axios');
 const uuidv4 = require('uuid/v4');
 
@@ -106,8 +108,116 @@ router.post(
   }
 );
 
+// Issuer profile the credential proofs are checked against
+router.get('/issuer', async (req, res) => {
+  let guest = { role: USER_ROLES.STUDENT, username: 'guest' };
+  let networkObj = await network.connectToNetwork(guest);
+
+  if (!networkObj) {
+    return res.status(500).json({
+      msg: 'Failed to connect blockchain'
+    });
+  }
+
+  let response = await network.query(networkObj, 'GetCredentialIssuer');
+
+  if (!response.success) {
+    return res.status(404).json({
+      msg: 'Can not query credential issuer!'
+    });
+  }
+
+  return res.json({ issuer: JSON.parse(response.msg) });
+});
+
+// Register the public key matching CREDENTIAL_ISSUER_KEY
+router.put(
+  '/issuer',
+  checkJWT,
+  [
+    body('publicKey')
+      .not()
+      .isEmpty()
+      .trim(),
+    body('name')
+      .optional()
+      .trim()
+      .escape()
+  ],
+  async (req, res) => {
+    if (req.decoded.user.role !== USER_ROLES.ADMIN_ACADEMY) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const errors = validationResult(req);
+    if (!errors.isEmpty()) {
+      return res.status(400).json({ errors: errors.array() });
+    }
+
+    const networkObj = await network.connectToNetwork(req.decoded.user);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    const response = await network.setCredentialIssuer(
+      networkObj,
+      req.body.publicKey,
+      req.body.name || ''
+    );
+
+    if (!response.success) {
+      return res.status(500).json({
+        msg: 'Set credential issuer has failed'
+      });
+    }
+
+    return res.json({
+      msg: 'Update Successfully'
+    });
+  }
+);
+
+// Look up a certificate by the short code printed on it
+router.get(
+  '/codes/:code',
+  verifier,
+  check('code')
+    .trim()
+    .escape(),
+  async (req, res) => {
+    let guest = { role: USER_ROLES.STUDENT, username: 'guest' };
+    let networkObj = await network.connectToNetwork(guest);
+
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed to connect blockchain'
+      });
+    }
+
+    let response = await network.queryAsVerifier(
+      networkObj,
+      'GetCertificateByVerificationCode',
+      req.params.code,
+      req.verifier
+    );
+
+    if (!response.success) {
+      return res.status(404).json({
+        msg: 'Can not query certificate by verification code!'
+      });
+    }
+
+    return res.json({ cert: JSON.parse(response.msg) });
+  }
+);
+
 router.get(
   '/:certId',
+  verifier,
   check('certId')
     .trim()
     .escape(),
@@ -120,7 +230,7 @@ router.get(
       return res.status(500).json({ msg: 'Failed to connect blockchain' });
     }
 
-    let cert = await network.query(networkObj, 'GetCertificate', certId);
+    let cert = await network.queryAsVerifier(networkObj, 'GetCertificate', certId, req.verifier);
 
     if (!cert.success) {
       return res.status(404).json({
@@ -129,7 +239,12 @@ router.get(
     }
 
     cert = JSON.parse(cert.msg);
-    let student = await network.query(networkObj, 'GetStudent', cert.StudentUsername);
+    let student = await network.queryAsVerifier(
+      networkObj,
+      'GetStudent',
+      cert.StudentUsername,
+      req.verifier
+    );
     let course = await network.query(networkObj, 'GetCourse', cert.CourseID);
 
     if (!student.success || !course.success) {
@@ -158,6 +273,7 @@ router.get(
 
 router.get(
   '/:certId/verify',
+  verifier,
   check('certId')
     .trim()
     .escape(),
@@ -172,7 +288,12 @@ router.get(
       });
     }
 
-    let certInfo = await network.query(networkObj, 'GetHistoryOfCertificate', certId);
+    let certInfo = await network.queryAsVerifier(
+      networkObj,
+      'GetHistoryOfCertificate',
+      certId,
+      req.verifier
+    );
 
     if (!certInfo.success) {
       return res.status(404).json({
@@ -197,4 +318,315 @@ router.get(
   }
 );
 
+// Follow the reissue chain of a certificate to its current version
+router.get(
+  '/:certId/status',
+  verifier,
+  check('certId')
+    .trim()
+    .escape(),
+  async (req, res) => {
+    let guest = { role: USER_ROLES.STUDENT, username: 'guest' };
+    let networkObj = await network.connectToNetwork(guest);
+
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed to connect blockchain'
+      });
+    }
+
+    let response = await network.queryAsVerifier(
+      networkObj,
+      'VerifyCertificate',
+      req.params.certId,
+      req.verifier
+    );
+
+    if (!response.success) {
+      return res.status(404).json({
+        msg: 'Can not verify certificate!'
+      });
+    }
+
+    return res.json({ verification: JSON.parse(response.msg) });
+  }
+);
+
+router.post(
+  '/:certId/reissue',
+  checkJWT,
+  [
+    check('certId')
+      .trim()
+      .escape(),
+    body('reason')
+      .not()
+      .isEmpty()
+      .trim()
+      .escape()
+  ],
+  async (req, res) => {
+    const user = req.decoded.user;
+
+    if (user.role !== USER_ROLES.ADMIN_ACADEMY) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const errors = validationResult(req);
+
+    if (!errors.isEmpty()) {
+      return res.status(400).json({ errors: errors.array() });
+    }
+
+    let networkObj = await network.connectToNetwork(user);
+
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    let cert = await network.query(networkObj, 'GetCertificate', req.params.certId);
+
+    if (!cert.success) {
+      return res.status(404).json({
+        msg: 'Query chaincode failed'
+      });
+    }
+
+    cert = JSON.parse(cert.msg);
+
+    networkObj = await network.connectToNetwork(user);
+    let response = await network.reissueCertificate(networkObj, cert, req.body.reason);
+
+    if (!response.success) {
+      return res.status(500).json({
+        msg: 'Can not reissue certificate'
+      });
+    }
+
+    return res.status(201).json({ cert: JSON.parse(response.msg) });
+  }
+);
+
+router.post(
+  '/:certId/revoke',
+  checkJWT,
+  [
+    check('certId')
+      .trim()
+      .escape(),
+    body('reason')
+      .not()
+      .isEmpty()
+      .trim()
+      .escape()
+  ],
+  async (req, res) => {
+    const user = req.decoded.user;
+
+    if (user.role !== USER_ROLES.ADMIN_ACADEMY) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const errors = validationResult(req);
+
+    if (!errors.isEmpty()) {
+      return res.status(400).json({ errors: errors.array() });
+    }
+
+    const networkObj = await network.connectToNetwork(user);
+
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    const response = await network.revokeCertificate(
+      networkObj,
+      req.params.certId,
+      req.body.reason
+    );
+
+    if (!response.success) {
+      return res.status(500).json({
+        msg: 'Can not revoke certificate'
+      });
+    }
+
+    return res.json({
+      msg: response.msg
+    });
+  }
+);
+
+router.get(
+  '/:certId/credential',
+  verifier,
+  check('certId')
+    .trim()
+    .escape(),
+  async (req, res) => {
+    let guest = { role: USER_ROLES.STUDENT, username: 'guest' };
+    let networkObj = await network.connectToNetwork(guest);
+
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed to connect blockchain'
+      });
+    }
+
+    let response = await network.queryAsVerifier(
+      networkObj,
+      'GetCertificateAsVerifiableCredential',
+      req.params.certId,
+      req.verifier
+    );
+
+    if (!response.success) {
+      return res.status(404).json({
+        msg: 'Can not query verifiable credential!'
+      });
+    }
+
+    return res.json({ credential: JSON.parse(response.msg) });
+  }
+);
+
+// Sign the credential off-chain with the issuer key and anchor the proof
+router.post(
+  '/:certId/credential',
+  checkJWT,
+  check('certId')
+    .trim()
+    .escape(),
+  async (req, res) => {
+    const user = req.decoded.user;
+
+    if (user.role !== USER_ROLES.ADMIN_ACADEMY) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const issuerKey = credential.getIssuerKey();
+
+    if (!issuerKey) {
+      return res.status(500).json({
+        msg: 'Issuer key is not configured'
+      });
+    }
+
+    let networkObj = await network.connectToNetwork(user);
+
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    let issuer = await network.query(networkObj, 'GetCredentialIssuer');
+    let vc = await network.query(
+      networkObj,
+      'GetCertificateAsVerifiableCredential',
+      req.params.certId
+    );
+
+    if (!issuer.success || !vc.success) {
+      return res.status(404).json({
+        msg: 'Query chaincode failed'
+      });
+    }
+
+    issuer = JSON.parse(issuer.msg);
+    vc = JSON.parse(vc.msg);
+    vc.proof = credential.signCredential(vc, issuer.VerificationMethod, issuerKey);
+
+    networkObj = await network.connectToNetwork(user);
+    let response = await network.anchorCertificateProof(networkObj, req.params.certId, vc.proof);
+
+    if (!response.success) {
+      return res.status(500).json({
+        msg: 'Can not anchor credential proof'
+      });
+    }
+
+    return res.status(201).json({ credential: vc });
+  }
+);
+
+router.get(
+  '/:rootId/commitment',
+  check('rootId')
+    .trim()
+    .escape(),
+  async (req, res) => {
+    let guest = { role: USER_ROLES.STUDENT, username: 'guest' };
+    let networkObj = await network.connectToNetwork(guest);
+
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed to connect blockchain'
+      });
+    }
+
+    let response = await network.query(networkObj, 'GetScoreCommitment', req.params.rootId);
+
+    if (!response.success) {
+      return res.status(404).json({
+        msg: 'Can not query score commitment!'
+      });
+    }
+
+    return res.json({ commitment: JSON.parse(response.msg) });
+  }
+);
+
+// Check scores a student disclosed against the commitment of a certificate or transcript
+router.post(
+  '/:rootId/disclosure',
+  [
+    check('rootId')
+      .trim()
+      .escape(),
+    body('leaves').isArray({ min: 1 }),
+    body('proofs').isArray({ min: 1 })
+  ],
+  async (req, res) => {
+    const errors = validationResult(req);
+
+    if (!errors.isEmpty()) {
+      return res.status(400).json({ errors: errors.array() });
+    }
+
+    let guest = { role: USER_ROLES.STUDENT, username: 'guest' };
+    let networkObj = await network.connectToNetwork(guest);
+
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed to connect blockchain'
+      });
+    }
+
+    let response = await network.query(networkObj, 'VerifyDisclosedScores', [
+      req.params.rootId,
+      JSON.stringify(req.body.leaves),
+      JSON.stringify(req.body.proofs)
+    ]);
+
+    if (!response.success) {
+      return res.status(404).json({
+        msg: 'Can not verify disclosed scores!'
+      });
+    }
+
+    return res.json({ result: JSON.parse(response.msg) });
+  }
+);
+
 module.exports = router;
@@ -4,6 +4,12 @@ const network = require('../fabric/network.js');
 const { body, validationResult, check } = require('express-validator');
 const uuidv4 = require('uuid/v4');
 const Status = { Open: 'Open', InProgress: 'InProgress', Completed: 'Completed' };
+const DAYS_OF_WEEK = ['Sunday', 'Monday', 'Tuesday', 'Wednesday', 'Thursday', 'Friday', 'Saturday'];
+const TIME_PATTERN = /^([01]\d|2[0-3]):[0-5]\d$/;
+const ATTENDANCE_STATUS = ['Present', 'Absent', 'Late', 'Excused'];
+const ASSESSMENT_TYPES = ['Assignment', 'Quiz', 'Exam'];
+const SHA256_PATTERN = /^[a-fA-F0-9]{64}$/;
+const STAFF_ROLES = ['CoTeacher', 'TA'];
 
 // Create class
 router.post(
@@ -24,32 +30,29 @@ router.post(
       .isEmpty()
       .trim()
       .escape(),
-    body('time')
+    body('schedule.daysOfWeek')
+      .isArray()
+      .custom((days) => days.length > 0),
+    body('schedule.daysOfWeek.*').isIn(DAYS_OF_WEEK),
+    body('schedule.startTime').trim().matches(TIME_PATTERN),
+    body('schedule.endTime').trim().matches(TIME_PATTERN),
+    body('schedule.startDate').trim().isISO8601(),
+    body('schedule.endDate').trim().isISO8601(),
+    body('schedule.timezone')
       .not()
       .isEmpty()
-      .trim()
-      .escape(),
-    body('startDate')
-      .not()
-      .isEmpty()
-      .trim()
-      .escape(),
-    body('endDate')
-      .not()
-      .isEmpty()
-      .trim()
-      .escape(),
-    body('repeat')
+      .trim(),
+    body('capacity')
       .not()
       .isEmpty()
       .trim()
-      .escape(),
-    body('capacity')
+      .escape()
+      .isInt(),
+    body('termId')
       .not()
       .isEmpty()
       .trim()
       .escape()
-      .isInt()
   ],
   async (req, res) => {
     if (req.decoded.user.role !== USER_ROLES.ADMIN_ACADEMY) {
@@ -65,11 +68,17 @@ router.post(
 
     const admin = req.decoded.user;
 
-    const { subjectId, classCode, room, time, startDate, endDate, repeat, capacity } = req.body;
+    const { subjectId, classCode, room, schedule, capacity, termId } = req.body;
+
+    if (schedule.endTime <= schedule.startTime) {
+      return res.status(400).json({
+        msg: 'Start time must occur before end time'
+      });
+    }
 
-    if (parseInt(endDate) - parseInt(startDate) < 604800000) {
+    if (schedule.endDate < schedule.startDate) {
       return res.status(400).json({
-        msg: 'Start date must occur befor end date at least 1 week'
+        msg: 'Start date must occur before end date'
       });
     }
 
@@ -77,12 +86,10 @@ router.post(
       classId: uuidv4(),
       classCode,
       room,
-      time,
-      startDate,
-      endDate,
-      repeat,
+      schedule,
       subjectId,
-      capacity
+      capacity,
+      termId
     };
 
     let networkObj = await network.connectToNetwork(admin);
@@ -106,6 +113,129 @@ router.post(
   }
 );
 
+// Grading window, days after the class end date scores may still be entered
+router.get('/grading-window', async (req, res) => {
+  const networkObj = await network.connectToNetwork(req.decoded.user);
+  if (!networkObj) {
+    return res.status(500).json({
+      msg: 'Failed connect to blockchain'
+    });
+  }
+
+  const response = await network.query(networkObj, 'GetGradingWindow');
+
+  if (!response.success) {
+    return res.status(404).json({
+      msg: 'Query grading window has failed'
+    });
+  }
+
+  return res.json({
+    gradingWindow: response.msg.length > 0 ? JSON.parse(response.msg) : null
+  });
+});
+
+router.put('/grading-window', body('daysAfterEnd').isInt({ min: 0 }), async (req, res) => {
+  if (req.decoded.user.role !== USER_ROLES.ADMIN_ACADEMY) {
+    return res.status(403).json({
+      msg: 'Permission Denied'
+    });
+  }
+
+  const errors = validationResult(req);
+  if (!errors.isEmpty()) {
+    return res.status(400).json({ errors: errors.array() });
+  }
+
+  const networkObj = await network.connectToNetwork(req.decoded.user);
+  if (!networkObj) {
+    return res.status(500).json({
+      msg: 'Failed connect to blockchain'
+    });
+  }
+
+  const response = await network.setGradingWindow(networkObj, req.body.daysAfterEnd.toString());
+
+  if (!response.success) {
+    return res.status(500).json({
+      msg: 'Set grading window has failed'
+    });
+  }
+
+  return res.json({
+    msg: 'Update Successfully'
+  });
+});
+
+// Retake policy, how often a subject may be taken and which attempt counts
+router.get('/retake-policy', async (req, res) => {
+  const networkObj = await network.connectToNetwork(req.decoded.user);
+  if (!networkObj) {
+    return res.status(500).json({
+      msg: 'Failed connect to blockchain'
+    });
+  }
+
+  const response = await network.query(networkObj, 'GetRetakePolicy');
+
+  if (!response.success) {
+    return res.status(404).json({
+      msg: 'Query retake policy has failed'
+    });
+  }
+
+  return res.json({
+    retakePolicy: JSON.parse(response.msg)
+  });
+});
+
+router.put(
+  '/retake-policy',
+  [
+    body('maxAttempts').isInt({ min: 1 }),
+    body('retakeOnlyAfterFail')
+      .isBoolean()
+      .toBoolean(),
+    body('countedAttempt').isIn(['Best', 'Latest'])
+  ],
+  async (req, res) => {
+    if (req.decoded.user.role !== USER_ROLES.ADMIN_ACADEMY) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const errors = validationResult(req);
+    if (!errors.isEmpty()) {
+      return res.status(400).json({ errors: errors.array() });
+    }
+
+    const networkObj = await network.connectToNetwork(req.decoded.user);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    const { maxAttempts, retakeOnlyAfterFail, countedAttempt } = req.body;
+    const response = await network.setRetakePolicy(networkObj, {
+      maxAttempts,
+      retakeOnlyAfterFail,
+      countedAttempt
+    });
+
+    if (!response.success) {
+      return res.status(500).json({
+        msg: 'Set retake policy has failed'
+      });
+    }
+
+    return res.json({
+      msg: 'Update Successfully'
+    });
+  }
+);
+
 // Edit class
 router.put(
   '/:classId',
@@ -123,26 +253,18 @@ router.put(
       .isEmpty()
       .trim()
       .escape(),
-    body('time')
-      .not()
-      .isEmpty()
-      .trim()
-      .escape(),
-    body('startDate')
-      .not()
-      .isEmpty()
-      .trim()
-      .escape(),
-    body('endDate')
-      .not()
-      .isEmpty()
-      .trim()
-      .escape(),
-    body('repeat')
+    body('schedule.daysOfWeek')
+      .isArray()
+      .custom((days) => days.length > 0),
+    body('schedule.daysOfWeek.*').isIn(DAYS_OF_WEEK),
+    body('schedule.startTime').trim().matches(TIME_PATTERN),
+    body('schedule.endTime').trim().matches(TIME_PATTERN),
+    body('schedule.startDate').trim().isISO8601(),
+    body('schedule.endDate').trim().isISO8601(),
+    body('schedule.timezone')
       .not()
       .isEmpty()
-      .trim()
-      .escape(),
+      .trim(),
     body('subjectId')
       .not()
       .isEmpty()
@@ -177,16 +299,13 @@ router.put(
     }
 
     const { classId } = req.params;
-    const { classCode, room, time, subjectId, startDate, endDate, repeat, capacity } = req.body;
+    const { classCode, room, schedule, subjectId, capacity } = req.body;
 
     let classInfo = {
       classId,
       classCode,
       room,
-      time,
-      startDate,
-      endDate,
-      repeat,
+      schedule,
       capacity
     };
 
@@ -206,6 +325,44 @@ router.put(
   }
 );
 
+// Teachers qualified for the subject of the class and free at its times
+router.get(
+  '/:classId/eligible-teachers',
+  check('classId')
+    .trim()
+    .escape(),
+  async (req, res) => {
+    if (req.decoded.user.role !== USER_ROLES.ADMIN_ACADEMY) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const networkObj = await network.connectToNetwork(req.decoded.user);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    const response = await network.query(
+      networkObj,
+      'GetEligibleTeachersOfClass',
+      req.params.classId
+    );
+
+    if (!response.success) {
+      return res.status(404).json({
+        msg: 'Query chaincode has failed'
+      });
+    }
+
+    return res.json({
+      teachers: JSON.parse(response.msg)
+    });
+  }
+);
+
 // assign class for teacher
 router.put(
   '/:classId/teacher',
@@ -286,6 +443,100 @@ router.put(
   }
 );
 
+// Add a co-teacher or a teaching assistant, the lead teacher is assigned above
+router.put(
+  '/:classId/staff',
+  [
+    check('classId')
+      .trim()
+      .escape(),
+    body('username')
+      .not()
+      .isEmpty()
+      .trim()
+      .escape(),
+    body('role').isIn(STAFF_ROLES)
+  ],
+  async (req, res) => {
+    if (req.decoded.user.role !== USER_ROLES.ADMIN_ACADEMY) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const errors = validationResult(req);
+    if (!errors.isEmpty()) {
+      return res.status(400).json({ errors: errors.array() });
+    }
+
+    const networkObj = await network.connectToNetwork(req.decoded.user);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    const response = await network.addClassStaff(
+      networkObj,
+      req.params.classId,
+      req.body.username,
+      req.body.role
+    );
+
+    if (!response.success) {
+      return res.status(500).json({
+        msg: 'Add staff has failed'
+      });
+    }
+
+    return res.json({
+      msg: 'Add staff successfully'
+    });
+  }
+);
+
+router.delete(
+  '/:classId/staff/:username',
+  [
+    check('classId')
+      .trim()
+      .escape(),
+    check('username')
+      .trim()
+      .escape()
+  ],
+  async (req, res) => {
+    if (req.decoded.user.role !== USER_ROLES.ADMIN_ACADEMY) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const networkObj = await network.connectToNetwork(req.decoded.user);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    const response = await network.removeClassStaff(
+      networkObj,
+      req.params.classId,
+      req.params.username
+    );
+
+    if (!response.success) {
+      return res.status(500).json({
+        msg: 'Remove staff has failed'
+      });
+    }
+
+    return res.json({
+      msg: 'Remove staff successfully'
+    });
+  }
+);
+
 router.put(
   '/:classId/status',
   check('classId')
@@ -399,12 +650,6 @@ router.put(
 
     let classInfo = JSON.parse(query.msg);
 
-    if (classInfo.TeacherUsername !== teacher.username) {
-      return res.status(403).json({
-        msg: 'Permission Denied'
-      });
-    }
-
     if (classInfo.Status !== 'InProgress') {
       return res.status(400).json({
         msg: 'Can not entry score now!'
@@ -440,6 +685,559 @@ router.put(
   }
 );
 
+router.get(
+  '/:classId/sessions',
+  check('classId')
+    .trim()
+    .escape(),
+  async (req, res) => {
+    const networkObj = await network.connectToNetwork(req.decoded.user);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    const response = await network.query(networkObj, 'GetClassSessions', req.params.classId);
+
+    if (!response.success) {
+      return res.status(404).json({
+        msg: 'Query chaincode has failed'
+      });
+    }
+
+    let sessions = JSON.parse(response.msg);
+
+    // sinh vien chi xem lich, khong xem diem danh cua lop
+    if (req.decoded.user.role === USER_ROLES.STUDENT) {
+      for (let index = 0; index < sessions.length; index++) {
+        delete sessions[index].Attendance;
+      }
+    }
+
+    return res.json({
+      sessions
+    });
+  }
+);
+
+router.put(
+  '/:classId/sessions/:date/attendance',
+  [
+    check('classId')
+      .trim()
+      .escape(),
+    check('date').isISO8601(),
+    body('attendance')
+      .custom((attendance) => attendance && Object.keys(attendance).length > 0)
+      .custom((attendance) =>
+        Object.values(attendance).every((status) => ATTENDANCE_STATUS.includes(status))
+      )
+  ],
+  async (req, res) => {
+    if (req.decoded.user.role !== USER_ROLES.TEACHER) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const errors = validationResult(req);
+    if (!errors.isEmpty()) {
+      return res.status(400).json({ errors: errors.array() });
+    }
+
+    const { classId, date } = req.params;
+    const teacher = req.decoded.user;
+
+    // the chaincode checks that the teacher may take attendance of the class
+    const networkObj = await network.connectToNetwork(teacher);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed to connect blockchain'
+      });
+    }
+
+    const response = await network.recordAttendance(networkObj, {
+      teacher: teacher.username,
+      classId,
+      date,
+      attendance: req.body.attendance
+    });
+
+    if (!response.success) {
+      return res.status(500).json({
+        msg: 'Record attendance has failed'
+      });
+    }
+
+    return res.json({
+      msg: 'Record attendance successfully'
+    });
+  }
+);
+
+router.get(
+  '/:classId/attendance',
+  check('classId')
+    .trim()
+    .escape(),
+  async (req, res) => {
+    const user = req.decoded.user;
+
+    if (user.role !== USER_ROLES.ADMIN_ACADEMY && user.role !== USER_ROLES.TEACHER) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    let networkObj = await network.connectToNetwork(user);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    if (user.role === USER_ROLES.TEACHER) {
+      const query = await network.query(networkObj, 'GetStaffPermissions', [
+        req.params.classId,
+        user.username
+      ]);
+      if (!query.success) {
+        return res.status(404).json({
+          msg: 'Query class has failed'
+        });
+      }
+
+      if (!JSON.parse(query.msg).includes('Attendance')) {
+        return res.status(403).json({
+          msg: 'Permission Denied'
+        });
+      }
+
+      networkObj = await network.connectToNetwork(user);
+    }
+
+    const response = await network.query(networkObj, 'GetAttendanceOfClass', req.params.classId);
+
+    if (!response.success) {
+      return res.status(404).json({
+        msg: 'Query chaincode has failed'
+      });
+    }
+
+    return res.json({
+      attendance: JSON.parse(response.msg)
+    });
+  }
+);
+
+router.get(
+  '/:classId/assessments',
+  check('classId')
+    .trim()
+    .escape(),
+  async (req, res) => {
+    const networkObj = await network.connectToNetwork(req.decoded.user);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    const response = await network.query(networkObj, 'GetAssessmentsOfClass', req.params.classId);
+
+    if (!response.success) {
+      return res.status(404).json({
+        msg: 'Query chaincode has failed'
+      });
+    }
+
+    return res.json({
+      assessments: JSON.parse(response.msg)
+    });
+  }
+);
+
+router.post(
+  '/:classId/assessments',
+  [
+    check('classId')
+      .trim()
+      .escape(),
+    body('title')
+      .not()
+      .isEmpty()
+      .trim()
+      .escape(),
+    body('type').isIn(ASSESSMENT_TYPES),
+    body('dueDate').isRFC3339(),
+    body('maxPoints').isFloat({ gt: 0 })
+  ],
+  async (req, res) => {
+    if (req.decoded.user.role !== USER_ROLES.TEACHER) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const errors = validationResult(req);
+    if (!errors.isEmpty()) {
+      return res.status(400).json({ errors: errors.array() });
+    }
+
+    const teacher = req.decoded.user;
+
+    // the chaincode checks that the teacher may manage assessments of the class
+    const networkObj = await network.connectToNetwork(teacher);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed to connect blockchain'
+      });
+    }
+
+    const assessment = {
+      teacher: teacher.username,
+      classId: req.params.classId,
+      assessmentId: uuidv4(),
+      title: req.body.title,
+      type: req.body.type,
+      dueDate: req.body.dueDate,
+      maxPoints: req.body.maxPoints.toString()
+    };
+
+    const response = await network.createAssessment(networkObj, assessment);
+
+    if (!response.success) {
+      return res.status(500).json({
+        msg: 'Create assessment has failed'
+      });
+    }
+
+    return res.status(201).json({
+      msg: 'Create Successfully',
+      assessmentId: assessment.assessmentId
+    });
+  }
+);
+
+// Students anchor the SHA-256 hash of their work, the file itself stays off chain
+router.put(
+  '/:classId/assessments/:assessmentId/submission',
+  [
+    check('classId')
+      .trim()
+      .escape(),
+    check('assessmentId')
+      .trim()
+      .escape(),
+    body('hash')
+      .trim()
+      .matches(SHA256_PATTERN)
+  ],
+  async (req, res) => {
+    if (req.decoded.user.role !== USER_ROLES.STUDENT) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const errors = validationResult(req);
+    if (!errors.isEmpty()) {
+      return res.status(400).json({ errors: errors.array() });
+    }
+
+    const networkObj = await network.connectToNetwork(req.decoded.user);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    const response = await network.submitAssessment(networkObj, {
+      student: req.decoded.user.username,
+      classId: req.params.classId,
+      assessmentId: req.params.assessmentId,
+      hash: req.body.hash.toLowerCase()
+    });
+
+    if (!response.success) {
+      return res.status(500).json({
+        msg: 'Submit assessment has failed'
+      });
+    }
+
+    return res.json({
+      msg: 'Submit Successfully',
+      submission: response.msg
+    });
+  }
+);
+
+router.get(
+  '/:classId/assessments/:assessmentId/submission',
+  [
+    check('classId')
+      .trim()
+      .escape(),
+    check('assessmentId')
+      .trim()
+      .escape()
+  ],
+  async (req, res) => {
+    if (req.decoded.user.role !== USER_ROLES.STUDENT) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const networkObj = await network.connectToNetwork(req.decoded.user);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    const response = await network.query(networkObj, 'GetSubmission', [
+      req.params.classId,
+      req.params.assessmentId,
+      req.decoded.user.username
+    ]);
+
+    if (!response.success) {
+      return res.status(404).json({
+        msg: 'Query chaincode has failed'
+      });
+    }
+
+    return res.json({
+      submission: JSON.parse(response.msg)
+    });
+  }
+);
+
+router.get(
+  '/:classId/assessments/:assessmentId/submissions',
+  [
+    check('classId')
+      .trim()
+      .escape(),
+    check('assessmentId')
+      .trim()
+      .escape()
+  ],
+  async (req, res) => {
+    const user = req.decoded.user;
+
+    if (user.role !== USER_ROLES.ADMIN_ACADEMY && user.role !== USER_ROLES.TEACHER) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    let networkObj = await network.connectToNetwork(user);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    if (user.role === USER_ROLES.TEACHER) {
+      const query = await network.query(networkObj, 'GetStaffPermissions', [
+        req.params.classId,
+        user.username
+      ]);
+      if (!query.success) {
+        return res.status(404).json({
+          msg: 'Query class has failed'
+        });
+      }
+
+      if (!JSON.parse(query.msg).includes('GradeSubmission')) {
+        return res.status(403).json({
+          msg: 'Permission Denied'
+        });
+      }
+
+      networkObj = await network.connectToNetwork(user);
+    }
+
+    const response = await network.query(networkObj, 'GetSubmissionsOfAssessment', [
+      req.params.classId,
+      req.params.assessmentId
+    ]);
+
+    if (!response.success) {
+      return res.status(404).json({
+        msg: 'Query chaincode has failed'
+      });
+    }
+
+    return res.json({
+      submissions: JSON.parse(response.msg)
+    });
+  }
+);
+
+// The teacher sends the hash of the work they graded, it must match the submission
+router.put(
+  '/:classId/assessments/:assessmentId/submissions/:username/grade',
+  [
+    check('classId')
+      .trim()
+      .escape(),
+    check('assessmentId')
+      .trim()
+      .escape(),
+    check('username')
+      .trim()
+      .escape(),
+    body('hash')
+      .trim()
+      .matches(SHA256_PATTERN),
+    body('points').isFloat({ min: 0 })
+  ],
+  async (req, res) => {
+    if (req.decoded.user.role !== USER_ROLES.TEACHER) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const errors = validationResult(req);
+    if (!errors.isEmpty()) {
+      return res.status(400).json({ errors: errors.array() });
+    }
+
+    const teacher = req.decoded.user;
+
+    // the chaincode checks that the teacher may grade submissions of the class
+    const networkObj = await network.connectToNetwork(teacher);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed to connect blockchain'
+      });
+    }
+
+    const response = await network.gradeSubmission(networkObj, {
+      teacher: teacher.username,
+      classId: req.params.classId,
+      assessmentId: req.params.assessmentId,
+      studentUsername: req.params.username,
+      hash: req.body.hash.toLowerCase(),
+      points: req.body.points.toString()
+    });
+
+    if (!response.success) {
+      return res.status(500).json({
+        msg: 'Grade submission has failed'
+      });
+    }
+
+    return res.json({
+      msg: 'Grade Successfully'
+    });
+  }
+);
+
+// Late score entry, only the academy can enter a score once the grading window closed
+router.put(
+  '/:classId/:username/score/override',
+  [
+    check('classId')
+      .trim()
+      .escape(),
+    check('username')
+      .trim()
+      .escape(),
+    body('scoreValue')
+      .not()
+      .isEmpty()
+      .trim()
+      .escape(),
+    body('justification')
+      .not()
+      .isEmpty()
+      .trim()
+      .escape()
+  ],
+  async (req, res) => {
+    if (req.decoded.user.role !== USER_ROLES.ADMIN_ACADEMY) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const errors = validationResult(req);
+    if (!errors.isEmpty()) {
+      return res.status(400).json({ errors: errors.array() });
+    }
+
+    const { classId, username } = req.params;
+    const { scoreValue, justification } = req.body;
+
+    const networkObj = await network.connectToNetwork(req.decoded.user);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed to connect blockchain'
+      });
+    }
+
+    const response = await network.overrideScore(networkObj, {
+      classId,
+      studentUsername: username,
+      scoreValue,
+      justification
+    });
+
+    if (!response.success) {
+      return res.status(500).json({
+        msg: 'Override score for student has failed'
+      });
+    }
+
+    return res.json({
+      msg: 'Override score successfully'
+    });
+  }
+);
+
+router.get(
+  '/:classId/score-overrides',
+  check('classId')
+    .trim()
+    .escape(),
+  async (req, res) => {
+    if (req.decoded.user.role !== USER_ROLES.ADMIN_ACADEMY) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const networkObj = await network.connectToNetwork(req.decoded.user);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    const response = await network.query(
+      networkObj,
+      'GetScoreOverridesOfClass',
+      req.params.classId
+    );
+
+    if (!response.success) {
+      return res.status(404).json({
+        msg: 'Query chaincode has failed'
+      });
+    }
+
+    return res.json({
+      overrides: JSON.parse(response.msg)
+    });
+  }
+);
+
 router.get('/no-teacher', async (req, res) => {
   if (
     req.decoded.user.role !== USER_ROLES.ADMIN_ACADEMY &&
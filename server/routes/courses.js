@@ -61,6 +61,45 @@ router.get(
   }
 );
 
+router.put(
+  '/batch-issuance-limit',
+  body('maxWritesPerCall').isInt({ min: 1 }),
+  async (req, res) => {
+    if (req.decoded.user.role !== USER_ROLES.ADMIN_ACADEMY) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const errors = validationResult(req);
+    if (!errors.isEmpty()) {
+      return res.status(400).json({ errors: errors.array() });
+    }
+
+    const networkObj = await network.connectToNetwork(req.decoded.user);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    const response = await network.setBatchIssuanceLimit(
+      networkObj,
+      req.body.maxWritesPerCall.toString()
+    );
+
+    if (!response.success) {
+      return res.status(500).json({
+        msg: 'Set batch issuance limit has failed'
+      });
+    }
+
+    return res.json({
+      msg: 'Update Successfully'
+    });
+  }
+);
+
 router.put(
   '/:courseId',
   [
@@ -443,6 +482,507 @@ router.put(
   }
 );
 
+router.put(
+  '/:courseId/enrollment-window',
+  [
+    check('courseId')
+      .trim()
+      .escape(),
+    body('startDate').isISO8601(),
+    body('endDate').isISO8601(),
+    body('timezone')
+      .not()
+      .isEmpty()
+      .trim()
+  ],
+  async (req, res) => {
+    if (req.decoded.user.role !== USER_ROLES.ADMIN_ACADEMY) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const errors = validationResult(req);
+
+    if (!errors.isEmpty()) {
+      return res.status(400).json({ errors: errors.array() });
+    }
+
+    const { startDate, endDate, timezone } = req.body;
+
+    if (endDate < startDate) {
+      return res.status(400).json({
+        msg: 'Enrollment start must occur before enrollment end'
+      });
+    }
+
+    const networkObj = await network.connectToNetwork(req.decoded.user);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain!'
+      });
+    }
+
+    const response = await network.setCourseEnrollmentWindow(networkObj, req.params.courseId, {
+      startDate,
+      endDate,
+      timezone
+    });
+
+    if (!response.success) {
+      return res.status(500).json({
+        msg: 'Can not invoke chaincode!'
+      });
+    }
+
+    return res.json({ msg: 'Update enrollment window successfully' });
+  }
+);
+
+// Conditions checked when a certificate of the course is issued, omitted ones are disabled
+router.put(
+  '/:courseId/issuance-policy',
+  [
+    check('courseId')
+      .trim()
+      .escape(),
+    body(['minSubjectScore', 'minAverageScore'])
+      .optional()
+      .isFloat({ min: 0 }),
+    body('minAttendance')
+      .optional()
+      .isFloat({ min: 0, max: 100 }),
+    body(['maxCompletionDays', 'minElectives'])
+      .optional()
+      .isInt({ min: 0 }),
+    body('subjectWeights')
+      .optional()
+      .custom((weights) => typeof weights === 'object' && !Array.isArray(weights))
+  ],
+  async (req, res) => {
+    if (req.decoded.user.role !== USER_ROLES.ADMIN_ACADEMY) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const errors = validationResult(req);
+
+    if (!errors.isEmpty()) {
+      return res.status(400).json({ errors: errors.array() });
+    }
+
+    const networkObj = await network.connectToNetwork(req.decoded.user);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain!'
+      });
+    }
+
+    const policy = {
+      MinSubjectScore: Number(req.body.minSubjectScore || 0),
+      MinAverageScore: Number(req.body.minAverageScore || 0),
+      MinAttendance: Number(req.body.minAttendance || 0),
+      MaxCompletionDays: Number(req.body.maxCompletionDays || 0),
+      MinElectives: Number(req.body.minElectives || 0),
+      SubjectWeights: req.body.subjectWeights || {}
+    };
+
+    const response = await network.setCourseIssuancePolicy(
+      networkObj,
+      req.params.courseId,
+      policy
+    );
+
+    if (!response.success) {
+      return res.status(500).json({
+        msg: 'Can not invoke chaincode!'
+      });
+    }
+
+    return res.json({ msg: 'Update issuance policy successfully' });
+  }
+);
+
+router.post(
+  '/:courseId/electives',
+  [
+    check('courseId')
+      .trim()
+      .escape(),
+    body('subjectId')
+      .not()
+      .isEmpty()
+      .trim()
+      .escape()
+  ],
+  async (req, res) => {
+    if (req.decoded.user.role !== USER_ROLES.ADMIN_ACADEMY) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const errors = validationResult(req);
+
+    if (!errors.isEmpty()) {
+      return res.status(400).json({ errors: errors.array() });
+    }
+
+    const networkObj = await network.connectToNetwork(req.decoded.user);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    const response = await network.addElectiveToCourse(
+      networkObj,
+      req.params.courseId,
+      req.body.subjectId
+    );
+
+    if (!response.success) {
+      return res.status(500).json({
+        msg: 'Can not invoke chaincode'
+      });
+    }
+
+    return res.status(201).json({
+      msg: 'Add Sucessfully'
+    });
+  }
+);
+
+router.delete(
+  '/:courseId/electives/:subjectId',
+  [
+    check('courseId')
+      .trim()
+      .escape(),
+    check('subjectId')
+      .trim()
+      .escape()
+  ],
+  async (req, res) => {
+    if (req.decoded.user.role !== USER_ROLES.ADMIN_ACADEMY) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const networkObj = await network.connectToNetwork(req.decoded.user);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    const response = await network.removeElectiveFromCourse(
+      networkObj,
+      req.params.courseId,
+      req.params.subjectId
+    );
+
+    if (!response.success) {
+      return res.status(500).json({
+        msg: 'Can not invoke chaincode'
+      });
+    }
+
+    return res.json({
+      msg: 'This elective has been removed from course'
+    });
+  }
+);
+
+// Students need a valid certificate of every prerequisite course to enroll
+router.post(
+  '/:courseId/prerequisites',
+  [
+    check('courseId')
+      .trim()
+      .escape(),
+    body('requiredCourseId')
+      .not()
+      .isEmpty()
+      .trim()
+      .escape()
+  ],
+  async (req, res) => {
+    if (req.decoded.user.role !== USER_ROLES.ADMIN_ACADEMY) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const errors = validationResult(req);
+
+    if (!errors.isEmpty()) {
+      return res.status(400).json({ errors: errors.array() });
+    }
+
+    const networkObj = await network.connectToNetwork(req.decoded.user);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    const response = await network.addPrerequisiteToCourse(
+      networkObj,
+      req.params.courseId,
+      req.body.requiredCourseId
+    );
+
+    if (!response.success) {
+      return res.status(500).json({
+        msg: 'Can not invoke chaincode'
+      });
+    }
+
+    return res.status(201).json({
+      msg: 'Add Sucessfully'
+    });
+  }
+);
+
+router.delete(
+  '/:courseId/prerequisites/:requiredCourseId',
+  [
+    check('courseId')
+      .trim()
+      .escape(),
+    check('requiredCourseId')
+      .trim()
+      .escape()
+  ],
+  async (req, res) => {
+    if (req.decoded.user.role !== USER_ROLES.ADMIN_ACADEMY) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const networkObj = await network.connectToNetwork(req.decoded.user);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    const response = await network.removePrerequisiteFromCourse(
+      networkObj,
+      req.params.courseId,
+      req.params.requiredCourseId
+    );
+
+    if (!response.success) {
+      return res.status(500).json({
+        msg: 'Can not invoke chaincode'
+      });
+    }
+
+    return res.json({
+      msg: 'This prerequisite has been removed from course'
+    });
+  }
+);
+
+// Explain which issuance conditions a student still misses
+router.get(
+  '/:courseId/eligibility/:username',
+  [
+    check('courseId')
+      .trim()
+      .escape(),
+    check('username')
+      .trim()
+      .escape()
+  ],
+  async (req, res) => {
+    if (req.decoded.user.role !== USER_ROLES.ADMIN_ACADEMY) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const networkObj = await network.connectToNetwork(req.decoded.user);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    const response = await network.query(networkObj, 'CheckCertificateEligibility', [
+      req.params.courseId,
+      req.params.username
+    ]);
+
+    if (!response.success) {
+      return res.status(404).json({
+        msg: 'Query chaincode has failed'
+      });
+    }
+
+    return res.json({
+      eligibility: JSON.parse(response.msg)
+    });
+  }
+);
+
+// Honours levels awarded from the weighted average, e.g. Distinction from 9 and Merit from 8
+router.put(
+  '/:courseId/honours-bands',
+  [
+    check('courseId')
+      .trim()
+      .escape(),
+    body('bands').isArray(),
+    body('bands.*.level')
+      .not()
+      .isEmpty()
+      .trim()
+      .escape(),
+    body('bands.*.minAverage').isFloat({ min: 0 })
+  ],
+  async (req, res) => {
+    if (req.decoded.user.role !== USER_ROLES.ADMIN_ACADEMY) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const errors = validationResult(req);
+
+    if (!errors.isEmpty()) {
+      return res.status(400).json({ errors: errors.array() });
+    }
+
+    const networkObj = await network.connectToNetwork(req.decoded.user);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain!'
+      });
+    }
+
+    const bands = req.body.bands.map((band) => ({
+      Level: band.level,
+      MinAverage: Number(band.minAverage)
+    }));
+
+    const response = await network.setCourseHonoursBands(networkObj, req.params.courseId, bands);
+
+    if (!response.success) {
+      return res.status(500).json({
+        msg: 'Can not invoke chaincode!'
+      });
+    }
+
+    return res.json({ msg: 'Update honours bands successfully' });
+  }
+);
+
+router.get(
+  '/:courseId/honours/:level',
+  [
+    check('courseId')
+      .trim()
+      .escape(),
+    check('level')
+      .trim()
+      .escape()
+  ],
+  async (req, res) => {
+    if (req.decoded.user.role !== USER_ROLES.ADMIN_ACADEMY) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const networkObj = await network.connectToNetwork(req.decoded.user);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    const response = await network.query(networkObj, 'GetCertificatesByHonoursLevel', [
+      req.params.courseId,
+      req.params.level
+    ]);
+
+    if (!response.success) {
+      return res.status(404).json({
+        msg: 'Query chaincode has failed'
+      });
+    }
+
+    return res.json({
+      certificates: JSON.parse(response.msg) || []
+    });
+  }
+);
+
+// Issue the certificates of every eligible student, call again while the report is not completed
+router.post(
+  '/:courseId/certificates',
+  [
+    check('courseId')
+      .trim()
+      .escape(),
+    body('restart')
+      .optional()
+      .isBoolean()
+  ],
+  async (req, res) => {
+    if (req.decoded.user.role !== USER_ROLES.ADMIN_ACADEMY) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const errors = validationResult(req);
+    if (!errors.isEmpty()) {
+      return res.status(400).json({ errors: errors.array() });
+    }
+
+    let networkObj = await network.connectToNetwork(req.decoded.user);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    let course = await network.query(networkObj, 'GetCourse', req.params.courseId);
+    if (!course.success) {
+      return res.status(404).json({
+        msg: 'Query chaincode has failed'
+      });
+    }
+
+    course = JSON.parse(course.msg);
+
+    networkObj = await network.connectToNetwork(req.decoded.user);
+    const response = await network.issueCertificatesForCourse(
+      networkObj,
+      req.params.courseId,
+      course.Students || [],
+      req.body.restart === true || req.body.restart === 'true'
+    );
+
+    if (!response.success) {
+      return res.status(500).json({
+        msg: 'Issue certificates has failed'
+      });
+    }
+
+    return res.json({
+      report: JSON.parse(response.msg)
+    });
+  }
+);
+
 router.get(
   '/students/:username',
   checkJWT,
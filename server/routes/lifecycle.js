@@ -0,0 +1,57 @@
+const router = require('express').Router();
+const USER_ROLES = require('../configs/constant').USER_ROLES;
+const network = require('../fabric/network.js');
+const { body, validationResult } = require('express-validator');
+
+// Start, complete and close classes and courses by date. Meant to be called
+// periodically by a scheduler with an academy account. A run looks at a page of
+// classes and courses; call again with the returned bookmark until it is empty.
+router.post(
+  '/advance',
+  [
+    body('pageSize')
+      .optional()
+      .isInt({ min: 1 }),
+    body('bookmark')
+      .optional()
+      .isString()
+      .trim()
+  ],
+  async (req, res) => {
+    if (req.decoded.user.role !== USER_ROLES.ADMIN_ACADEMY) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const errors = validationResult(req);
+    if (!errors.isEmpty()) {
+      return res.status(400).json({ errors: errors.array() });
+    }
+
+    const networkObj = await network.connectToNetwork(req.decoded.user);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    const response = await network.advanceLifecycle(
+      networkObj,
+      req.body.pageSize,
+      req.body.bookmark
+    );
+
+    if (!response.success) {
+      return res.status(500).json({
+        msg: 'Advance lifecycle has failed'
+      });
+    }
+
+    return res.json({
+      report: response.report
+    });
+  }
+);
+
+module.exports = router;
@@ -468,6 +468,44 @@ router.get(
   }
 );
 
+router.get(
+  '/courses/:courseId/eligibility',
+  check('courseId')
+    .trim()
+    .escape(),
+  async (req, res) => {
+    const user = req.decoded.user;
+
+    if (user.role !== USER_ROLES.STUDENT) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+    const networkObj = await network.connectToNetwork(user);
+
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    const response = await network.query(networkObj, 'CheckCertificateEligibility', [
+      req.params.courseId,
+      user.username
+    ]);
+
+    if (!response.success) {
+      return res.status(404).json({
+        msg: 'Query chaincode has failed'
+      });
+    }
+
+    return res.json({
+      eligibility: JSON.parse(response.msg)
+    });
+  }
+);
+
 router.put('/avatar', multipartMiddleware, async (req, res) => {
   try {
     const user = req.decoded.user;
@@ -620,6 +658,68 @@ router.get('/classes', async (req, res) => {
   });
 });
 
+// Timetable as an iCalendar file for calendar apps
+router.get('/timetable.ics', async (req, res) => {
+  const user = req.decoded.user;
+
+  if (user.role !== USER_ROLES.STUDENT && user.role !== USER_ROLES.TEACHER) {
+    return res.status(403).json({
+      msg: 'Permission Denied'
+    });
+  }
+
+  const networkObj = await network.connectToNetwork(user);
+
+  if (!networkObj) {
+    return res.status(500).json({
+      msg: 'Failed connect to blockchain'
+    });
+  }
+
+  const response = await network.query(networkObj, 'GetTimetableICS', user.username);
+
+  if (!response.success) {
+    return res.status(404).json({
+      msg: 'Query chaincode has failed'
+    });
+  }
+
+  res.set('Content-Type', 'text/calendar; charset=utf-8');
+  res.attachment('timetable.ics');
+
+  return res.send(response.msg.toString());
+});
+
+router.get('/attendance', async (req, res) => {
+  const user = req.decoded.user;
+
+  if (user.role !== USER_ROLES.STUDENT) {
+    return res.status(403).json({
+      msg: 'Permission Denied'
+    });
+  }
+
+  const networkObj = await network.connectToNetwork(user);
+
+  if (!networkObj) {
+    return res.status(500).json({
+      msg: 'Failed connect to blockchain'
+    });
+  }
+
+  const response = await network.query(networkObj, 'GetAttendanceOfStudent', user.username);
+
+  if (!response.success) {
+    return res.status(404).json({
+      msg: 'Query chaincode has failed'
+    });
+  }
+
+  return res.json({
+    attendance: JSON.parse(response.msg)
+  });
+});
+
 router.get('/courses', async (req, res) => {
   const user = req.decoded.user;
 
@@ -816,4 +916,583 @@ router.get(
   }
 );
 
+router.get('/transcript', async (req, res) => {
+  const user = req.decoded.user;
+
+  if (user.role !== USER_ROLES.STUDENT) {
+    return res.status(403).json({
+      msg: 'Permission Denied'
+    });
+  }
+
+  const networkObj = await network.connectToNetwork(user);
+
+  if (!networkObj) {
+    return res.status(500).json({
+      msg: 'Failed connect to blockchain'
+    });
+  }
+
+  const response = await network.query(networkObj, 'GetTranscript', user.username);
+
+  if (!response.success) {
+    return res.status(404).json({
+      msg: 'Query chaincode has failed'
+    });
+  }
+
+  return res.json({
+    transcript: JSON.parse(response.msg)
+  });
+});
+
+router.post(
+  '/transcript-commitments',
+  body('courseId')
+    .not()
+    .isEmpty()
+    .trim()
+    .escape(),
+  async (req, res) => {
+    const user = req.decoded.user;
+
+    if (user.role !== USER_ROLES.STUDENT) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const errors = validationResult(req);
+    if (!errors.isEmpty()) {
+      return res.status(400).json({ errors: errors.array() });
+    }
+
+    const networkObj = await network.connectToNetwork(user);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    const response = await network.issueTranscriptCommitment(
+      networkObj,
+      user.username,
+      req.body.courseId
+    );
+
+    if (!response.success) {
+      return res.status(500).json({
+        msg: 'Can not commit transcript'
+      });
+    }
+
+    return res.status(201).json({
+      commitment: JSON.parse(response.msg)
+    });
+  }
+);
+
+// Salt and Merkle path of one subject, to be handed to a verifier with the score
+router.get(
+  '/commitments/:rootId/subjects/:subjectId/proof',
+  [
+    check('rootId')
+      .trim()
+      .escape(),
+    check('subjectId')
+      .trim()
+      .escape()
+  ],
+  async (req, res) => {
+    const user = req.decoded.user;
+
+    if (user.role !== USER_ROLES.STUDENT) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const networkObj = await network.connectToNetwork(user);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    const response = await network.query(networkObj, 'GetDisclosureProof', [
+      req.params.rootId,
+      req.params.subjectId
+    ]);
+
+    if (!response.success) {
+      return res.status(404).json({
+        msg: 'Query chaincode has failed'
+      });
+    }
+
+    return res.json({
+      proof: JSON.parse(response.msg)
+    });
+  }
+);
+
+router.get(
+  '/certificates/:certId/grants',
+  check('certId')
+    .trim()
+    .escape(),
+  async (req, res) => {
+    const user = req.decoded.user;
+
+    if (user.role !== USER_ROLES.STUDENT) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const networkObj = await network.connectToNetwork(user);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    const response = await network.query(networkObj, 'GetCertificateGrants', req.params.certId);
+
+    if (!response.success) {
+      return res.status(404).json({
+        msg: 'Query chaincode has failed'
+      });
+    }
+
+    return res.json({
+      grants: JSON.parse(response.msg)
+    });
+  }
+);
+
+// The token is shown only once; the verifier sends it back with its ID on every read
+router.post(
+  '/certificates/:certId/grants',
+  [
+    check('certId')
+      .trim()
+      .escape(),
+    body('verifierId')
+      .not()
+      .isEmpty()
+      .trim()
+      .escape(),
+    body('expiry').isISO8601()
+  ],
+  async (req, res) => {
+    const user = req.decoded.user;
+
+    if (user.role !== USER_ROLES.STUDENT) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const errors = validationResult(req);
+    if (!errors.isEmpty()) {
+      return res.status(400).json({ errors: errors.array() });
+    }
+
+    const networkObj = await network.connectToNetwork(user);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    const expiry = new Date(req.body.expiry).toISOString().slice(0, 19) + 'Z';
+    const response = await network.grantCertificateAccess(
+      networkObj,
+      req.params.certId,
+      req.body.verifierId,
+      expiry
+    );
+
+    if (!response.success) {
+      return res.status(500).json({
+        msg: 'Can not grant certificate access'
+      });
+    }
+
+    return res.status(201).json({
+      verifierId: req.body.verifierId,
+      token: response.msg,
+      expiry
+    });
+  }
+);
+
+router.delete(
+  '/certificates/:certId/grants/:verifierId',
+  [
+    check('certId')
+      .trim()
+      .escape(),
+    check('verifierId')
+      .trim()
+      .escape()
+  ],
+  async (req, res) => {
+    const user = req.decoded.user;
+
+    if (user.role !== USER_ROLES.STUDENT) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const networkObj = await network.connectToNetwork(user);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    const response = await network.revokeCertificateAccess(
+      networkObj,
+      req.params.certId,
+      req.params.verifierId
+    );
+
+    if (!response.success) {
+      return res.status(500).json({
+        msg: 'Can not revoke certificate access'
+      });
+    }
+
+    return res.json({
+      msg: response.msg
+    });
+  }
+);
+
+router.get(
+  '/certificates/:certId/access-log',
+  check('certId')
+    .trim()
+    .escape(),
+  async (req, res) => {
+    const user = req.decoded.user;
+
+    if (user.role !== USER_ROLES.STUDENT) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const networkObj = await network.connectToNetwork(user);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    const response = await network.query(
+      networkObj,
+      'GetCertificateAccessLog',
+      req.params.certId
+    );
+
+    if (!response.success) {
+      return res.status(404).json({
+        msg: 'Query chaincode has failed'
+      });
+    }
+
+    return res.json({
+      accessLog: JSON.parse(response.msg)
+    });
+  }
+);
+
+router.get('/micro-credentials', async (req, res) => {
+  const user = req.decoded.user;
+
+  if (user.role !== USER_ROLES.STUDENT) {
+    return res.status(403).json({
+      msg: 'Permission Denied'
+    });
+  }
+
+  const networkObj = await network.connectToNetwork(user);
+  if (!networkObj) {
+    return res.status(500).json({
+      msg: 'Failed connect to blockchain'
+    });
+  }
+
+  const response = await network.query(networkObj, 'GetMicroCredentialsOfStudent', user.username);
+
+  if (!response.success) {
+    return res.status(404).json({
+      msg: 'Query chaincode has failed'
+    });
+  }
+
+  return res.json({
+    microCredentials: JSON.parse(response.msg)
+  });
+});
+
+router.get(
+  '/micro-credentials/:microCredentialId/grants',
+  check('microCredentialId')
+    .trim()
+    .escape(),
+  async (req, res) => {
+    const user = req.decoded.user;
+
+    if (user.role !== USER_ROLES.STUDENT) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const networkObj = await network.connectToNetwork(user);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    const response = await network.query(
+      networkObj,
+      'GetMicroCredentialGrants',
+      req.params.microCredentialId
+    );
+
+    if (!response.success) {
+      return res.status(404).json({
+        msg: 'Query chaincode has failed'
+      });
+    }
+
+    return res.json({
+      grants: JSON.parse(response.msg)
+    });
+  }
+);
+
+router.post(
+  '/micro-credentials/:microCredentialId/grants',
+  [
+    check('microCredentialId')
+      .trim()
+      .escape(),
+    body('verifierId')
+      .not()
+      .isEmpty()
+      .trim()
+      .escape(),
+    body('expiry').isISO8601()
+  ],
+  async (req, res) => {
+    const user = req.decoded.user;
+
+    if (user.role !== USER_ROLES.STUDENT) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const errors = validationResult(req);
+    if (!errors.isEmpty()) {
+      return res.status(400).json({ errors: errors.array() });
+    }
+
+    const networkObj = await network.connectToNetwork(user);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    const expiry = new Date(req.body.expiry).toISOString().slice(0, 19) + 'Z';
+    const response = await network.grantMicroCredentialAccess(
+      networkObj,
+      req.params.microCredentialId,
+      req.body.verifierId,
+      expiry
+    );
+
+    if (!response.success) {
+      return res.status(500).json({
+        msg: 'Can not grant micro-credential access'
+      });
+    }
+
+    return res.status(201).json({
+      verifierId: req.body.verifierId,
+      token: response.msg,
+      expiry
+    });
+  }
+);
+
+router.delete(
+  '/micro-credentials/:microCredentialId/grants/:verifierId',
+  [
+    check('microCredentialId')
+      .trim()
+      .escape(),
+    check('verifierId')
+      .trim()
+      .escape()
+  ],
+  async (req, res) => {
+    const user = req.decoded.user;
+
+    if (user.role !== USER_ROLES.STUDENT) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const networkObj = await network.connectToNetwork(user);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    const response = await network.revokeMicroCredentialAccess(
+      networkObj,
+      req.params.microCredentialId,
+      req.params.verifierId
+    );
+
+    if (!response.success) {
+      return res.status(500).json({
+        msg: 'Can not revoke micro-credential access'
+      });
+    }
+
+    return res.json({
+      msg: response.msg
+    });
+  }
+);
+
+router.get(
+  '/micro-credentials/:microCredentialId/access-log',
+  check('microCredentialId')
+    .trim()
+    .escape(),
+  async (req, res) => {
+    const user = req.decoded.user;
+
+    if (user.role !== USER_ROLES.STUDENT) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const networkObj = await network.connectToNetwork(user);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    const response = await network.query(
+      networkObj,
+      'GetMicroCredentialAccessLog',
+      req.params.microCredentialId
+    );
+
+    if (!response.success) {
+      return res.status(404).json({
+        msg: 'Query chaincode has failed'
+      });
+    }
+
+    return res.json({
+      accessLog: JSON.parse(response.msg)
+    });
+  }
+);
+
+router.get(
+  '/classes/:classId/waitlist',
+  check('classId')
+    .trim()
+    .escape(),
+  async (req, res) => {
+    const user = req.decoded.user;
+
+    if (user.role !== USER_ROLES.STUDENT) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const networkObj = await network.connectToNetwork(user);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    const response = await network.query(networkObj, 'GetWaitlistPosition', [
+      user.username,
+      req.params.classId
+    ]);
+
+    if (!response.success) {
+      return res.status(404).json({
+        msg: 'You are not on the waitlist of this class'
+      });
+    }
+
+    return res.json({
+      waitlist: JSON.parse(response.msg)
+    });
+  }
+);
+
+router.delete(
+  '/classes/:classId/waitlist',
+  check('classId')
+    .trim()
+    .escape(),
+  async (req, res) => {
+    const user = req.decoded.user;
+
+    if (user.role !== USER_ROLES.STUDENT) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const networkObj = await network.connectToNetwork(user);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    const response = await network.leaveClassWaitlist(
+      networkObj,
+      user.username,
+      req.params.classId
+    );
+
+    if (!response.success) {
+      return res.status(500).json({
+        msg: 'Can not leave waitlist'
+      });
+    }
+
+    return res.json({
+      msg: response.msg
+    });
+  }
+);
+
 module.exports = router;
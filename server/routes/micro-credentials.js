@@ -0,0 +1,217 @@
+const router = require('express').Router();
+const USER_ROLES = require('../configs/constant').USER_ROLES;
+const network = require('../fabric/network');
+const { check, body, validationResult } = require('express-validator');
+const checkJWT = require('../middlewares/check-jwt');
+const verifier = require('../middlewares/verifier');
+
+router.post(
+  '/',
+  checkJWT,
+  [
+    body('classId')
+      .not()
+      .isEmpty()
+      .trim()
+      .escape(),
+    body('username')
+      .not()
+      .isEmpty()
+      .trim()
+      .escape()
+  ],
+  async (req, res) => {
+    const user = req.decoded.user;
+
+    if (user.role !== USER_ROLES.ADMIN_ACADEMY) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const errors = validationResult(req);
+
+    if (!errors.isEmpty()) {
+      return res.status(400).json({ errors: errors.array() });
+    }
+
+    const networkObj = await network.connectToNetwork(user);
+
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    const response = await network.issueMicroCredential(
+      networkObj,
+      req.body.classId,
+      req.body.username
+    );
+
+    if (!response.success) {
+      return res.status(500).json({
+        msg: 'Can not issue micro-credential'
+      });
+    }
+
+    return res.status(201).json({
+      microCredential: JSON.parse(response.msg)
+    });
+  }
+);
+
+router.get(
+  '/students/:username',
+  verifier,
+  check('username')
+    .trim()
+    .escape(),
+  async (req, res) => {
+    let guest = { role: USER_ROLES.STUDENT, username: 'guest' };
+    let networkObj = await network.connectToNetwork(guest);
+
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed to connect blockchain'
+      });
+    }
+
+    let response = await network.queryAsVerifier(
+      networkObj,
+      'GetMicroCredentialsOfStudent',
+      req.params.username,
+      req.verifier
+    );
+
+    if (!response.success) {
+      return res.status(404).json({
+        msg: 'Can not query micro-credentials of student!'
+      });
+    }
+
+    return res.json({ microCredentials: JSON.parse(response.msg) });
+  }
+);
+
+router.get(
+  '/:microCredentialId',
+  verifier,
+  check('microCredentialId')
+    .trim()
+    .escape(),
+  async (req, res) => {
+    let guest = { role: USER_ROLES.STUDENT, username: 'guest' };
+    let networkObj = await network.connectToNetwork(guest);
+
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed to connect blockchain'
+      });
+    }
+
+    let response = await network.queryAsVerifier(
+      networkObj,
+      'GetMicroCredential',
+      req.params.microCredentialId,
+      req.verifier
+    );
+
+    if (!response.success) {
+      return res.status(404).json({
+        msg: 'Can not query micro-credential!'
+      });
+    }
+
+    return res.json({ microCredential: JSON.parse(response.msg) });
+  }
+);
+
+router.get(
+  '/:microCredentialId/verify',
+  verifier,
+  check('microCredentialId')
+    .trim()
+    .escape(),
+  async (req, res) => {
+    let guest = { role: USER_ROLES.STUDENT, username: 'guest' };
+    let networkObj = await network.connectToNetwork(guest);
+
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed to connect blockchain'
+      });
+    }
+
+    let response = await network.queryAsVerifier(
+      networkObj,
+      'VerifyMicroCredential',
+      req.params.microCredentialId,
+      req.verifier
+    );
+
+    if (!response.success) {
+      return res.status(404).json({
+        msg: 'Can not verify micro-credential!'
+      });
+    }
+
+    return res.json({ verification: JSON.parse(response.msg) });
+  }
+);
+
+router.post(
+  '/:microCredentialId/revoke',
+  checkJWT,
+  [
+    check('microCredentialId')
+      .trim()
+      .escape(),
+    body('reason')
+      .not()
+      .isEmpty()
+      .trim()
+      .escape()
+  ],
+  async (req, res) => {
+    const user = req.decoded.user;
+
+    if (user.role !== USER_ROLES.ADMIN_ACADEMY) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const errors = validationResult(req);
+
+    if (!errors.isEmpty()) {
+      return res.status(400).json({ errors: errors.array() });
+    }
+
+    const networkObj = await network.connectToNetwork(user);
+
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    const response = await network.revokeMicroCredential(
+      networkObj,
+      req.params.microCredentialId,
+      req.body.reason
+    );
+
+    if (!response.success) {
+      return res.status(500).json({
+        msg: 'Can not revoke micro-credential'
+      });
+    }
+
+    return res.json({
+      msg: response.msg
+    });
+  }
+);
+
+module.exports = router;
@@ -0,0 +1,260 @@
+const router = require('express').Router();
+const USER_ROLES = require('../configs/constant').USER_ROLES;
+const network = require('../fabric/network.js');
+const { body, validationResult, check, query } = require('express-validator');
+
+router.get('/', async (req, res) => {
+  const networkObj = await network.connectToNetwork(req.decoded.user);
+  if (!networkObj) {
+    return res.status(500).json({
+      msg: 'Failed connect to blockchain'
+    });
+  }
+
+  const response = await network.query(networkObj, 'GetAllRooms');
+
+  if (!response.success) {
+    return res.status(404).send({
+      msg: 'Query all rooms has failed'
+    });
+  }
+
+  return res.json({
+    rooms: JSON.parse(response.msg) ? JSON.parse(response.msg) : []
+  });
+});
+
+router.get(
+  '/:roomId',
+  check('roomId')
+    .trim()
+    .escape(),
+  async (req, res) => {
+    const networkObj = await network.connectToNetwork(req.decoded.user);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    const response = await network.query(networkObj, 'GetRoom', req.params.roomId);
+
+    if (!response.success) {
+      return res.status(404).json({
+        msg: 'Query chaincode has failed'
+      });
+    }
+
+    return res.json({
+      room: JSON.parse(response.msg)
+    });
+  }
+);
+
+// Bookings of a room between two dates (YYYY-MM-DD)
+router.get(
+  '/:roomId/schedule',
+  [
+    check('roomId')
+      .trim()
+      .escape(),
+    query('startDate').isISO8601(),
+    query('endDate').isISO8601()
+  ],
+  async (req, res) => {
+    const errors = validationResult(req);
+    if (!errors.isEmpty()) {
+      return res.status(400).json({ errors: errors.array() });
+    }
+
+    const { startDate, endDate } = req.query;
+
+    if (endDate < startDate) {
+      return res.status(400).json({
+        msg: 'Start date must occur before end date'
+      });
+    }
+
+    const networkObj = await network.connectToNetwork(req.decoded.user);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    const response = await network.query(networkObj, 'GetRoomSchedule', [
+      req.params.roomId,
+      startDate,
+      endDate
+    ]);
+
+    if (!response.success) {
+      return res.status(404).json({
+        msg: 'Query chaincode has failed'
+      });
+    }
+
+    return res.json({
+      schedule: JSON.parse(response.msg)
+    });
+  }
+);
+
+// Create room
+router.post(
+  '/',
+  [
+    body('roomId')
+      .not()
+      .isEmpty()
+      .trim()
+      .escape(),
+    body('building')
+      .not()
+      .isEmpty()
+      .trim()
+      .escape(),
+    body('capacity').isInt({ min: 1 }),
+    body('facilities')
+      .optional()
+      .isArray(),
+    body('facilities.*')
+      .trim()
+      .escape()
+  ],
+  async (req, res) => {
+    if (req.decoded.user.role !== USER_ROLES.ADMIN_ACADEMY) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const errors = validationResult(req);
+    if (!errors.isEmpty()) {
+      return res.status(400).json({ errors: errors.array() });
+    }
+
+    const { roomId, building, capacity, facilities } = req.body;
+
+    const networkObj = await network.connectToNetwork(req.decoded.user);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    const response = await network.createRoom(networkObj, {
+      roomId,
+      building,
+      capacity,
+      facilities
+    });
+
+    if (!response.success) {
+      return res.status(500).json({
+        msg: 'Create room has failed'
+      });
+    }
+
+    return res.status(201).json({
+      msg: 'Create Successfully'
+    });
+  }
+);
+
+// Update room
+router.put(
+  '/:roomId',
+  [
+    check('roomId')
+      .trim()
+      .escape(),
+    body('building')
+      .not()
+      .isEmpty()
+      .trim()
+      .escape(),
+    body('capacity').isInt({ min: 1 }),
+    body('facilities')
+      .optional()
+      .isArray(),
+    body('facilities.*')
+      .trim()
+      .escape()
+  ],
+  async (req, res) => {
+    if (req.decoded.user.role !== USER_ROLES.ADMIN_ACADEMY) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const errors = validationResult(req);
+    if (!errors.isEmpty()) {
+      return res.status(400).json({ errors: errors.array() });
+    }
+
+    const { roomId } = req.params;
+    const { building, capacity, facilities } = req.body;
+
+    const networkObj = await network.connectToNetwork(req.decoded.user);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    const response = await network.updateRoomInfo(networkObj, {
+      roomId,
+      building,
+      capacity,
+      facilities
+    });
+
+    if (!response.success) {
+      return res.status(500).json({
+        msg: 'Update room has failed'
+      });
+    }
+
+    return res.status(200).json({
+      msg: 'Update Successfully'
+    });
+  }
+);
+
+// Delete room
+router.delete(
+  '/:roomId',
+  check('roomId')
+    .trim()
+    .escape(),
+  async (req, res) => {
+    if (req.decoded.user.role !== USER_ROLES.ADMIN_ACADEMY) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const networkObj = await network.connectToNetwork(req.decoded.user);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    const response = await network.deleteRoom(networkObj, req.params.roomId);
+
+    if (!response.success) {
+      return res.status(500).json({
+        msg: 'Delete room has failed'
+      });
+    }
+
+    return res.status(200).json({
+      msg: 'Delete Successfully'
+    });
+  }
+);
+
+module.exports = router;
@@ -1,7 +1,7 @@
 const router = require('express').Router();
 const USER_ROLES = require('../configs/constant').USER_ROLES;
 const network = require('../fabric/network.js');
-const { check } = require('express-validator');
+const { check, body, validationResult } = require('express-validator');
 
 router.get('/', async (req, res) => {
   if (req.decoded.user.role !== USER_ROLES.ADMIN_ACADEMY) {
@@ -140,4 +140,146 @@ router.get(
   }
 );
 
+// Every attempt of every subject, with the one that counts and any exemptions
+router.get(
+  '/:username/transcript',
+  check('username')
+    .trim()
+    .escape(),
+  async (req, res) => {
+    const user = req.decoded.user;
+
+    if (user.role !== USER_ROLES.ADMIN_ACADEMY) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const networkObj = await network.connectToNetwork(user);
+
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed to connect blockchain'
+      });
+    }
+
+    const response = await network.query(networkObj, 'GetTranscript', req.params.username);
+
+    if (!response.success) {
+      return res.status(404).json({
+        msg: 'Query chaincode has failed'
+      });
+    }
+
+    return res.json({
+      transcript: JSON.parse(response.msg)
+    });
+  }
+);
+
+// Credit a subject studied elsewhere, the evidence itself stays off-chain
+router.put(
+  '/:username/exemptions/:subjectId',
+  [
+    check('username')
+      .trim()
+      .escape(),
+    check('subjectId')
+      .trim()
+      .escape(),
+    body('evidenceHash')
+      .isHexadecimal()
+      .isLength({ min: 64, max: 64 }),
+    body('reason')
+      .not()
+      .isEmpty()
+      .trim()
+      .escape()
+  ],
+  async (req, res) => {
+    const user = req.decoded.user;
+
+    if (user.role !== USER_ROLES.ADMIN_ACADEMY) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const errors = validationResult(req);
+
+    if (!errors.isEmpty()) {
+      return res.status(400).json({ errors: errors.array() });
+    }
+
+    const networkObj = await network.connectToNetwork(user);
+
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed to connect blockchain'
+      });
+    }
+
+    const response = await network.exemptSubject(networkObj, {
+      username: req.params.username,
+      subjectId: req.params.subjectId,
+      evidenceHash: req.body.evidenceHash,
+      reason: req.body.reason
+    });
+
+    if (!response.success) {
+      return res.status(500).json({
+        msg: 'Can not exempt subject'
+      });
+    }
+
+    return res.status(201).json({
+      exemption: JSON.parse(response.msg)
+    });
+  }
+);
+
+router.get(
+  '/:username/exemptions/:subjectId',
+  [
+    check('username')
+      .trim()
+      .escape(),
+    check('subjectId')
+      .trim()
+      .escape()
+  ],
+  async (req, res) => {
+    const user = req.decoded.user;
+
+    if (user.role !== USER_ROLES.ADMIN_ACADEMY) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const networkObj = await network.connectToNetwork(user);
+
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed to connect blockchain'
+      });
+    }
+
+    const response = await network.query(networkObj, 'GetExemption', [
+      req.params.username,
+      req.params.subjectId
+    ]);
+
+    if (!response.success) {
+      return res.status(404).json({
+        msg: 'Query chaincode has failed'
+      });
+    }
+
+    return res.json({
+      exemption: JSON.parse(response.msg)
+    });
+  }
+);
+
 module.exports = router;
@@ -197,6 +197,51 @@ router.put(
   }
 );
 
+router.put(
+  '/:subjectId/prerequisites',
+  [
+    check('subjectId')
+      .trim()
+      .escape(),
+    body('prerequisites').custom((rule) => rule === null || typeof rule === 'object')
+  ],
+  async (req, res) => {
+    if (req.decoded.user.role !== USER_ROLES.ADMIN_ACADEMY) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const errors = validationResult(req);
+    if (!errors.isEmpty()) {
+      return res.status(400).json({ errors: errors.array() });
+    }
+
+    const networkObj = await network.connectToNetwork(req.decoded.user);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    const response = await network.setSubjectPrerequisites(
+      networkObj,
+      req.params.subjectId,
+      req.body.prerequisites
+    );
+
+    if (!response.success) {
+      return res.status(500).json({
+        msg: 'Set prerequisites has failed'
+      });
+    }
+
+    return res.json({
+      subject: JSON.parse(response.msg)
+    });
+  }
+);
+
 // Delete subject
 router.delete(
   '/:subjectId',
@@ -129,6 +129,112 @@ router.post(
   }
 );
 
+// Grant a subject the teacher may teach, only qualified teachers can be assigned to its classes
+router.put(
+  '/:username/qualifications/:subjectId',
+  [
+    check('username')
+      .trim()
+      .escape(),
+    check('subjectId')
+      .trim()
+      .escape(),
+    body('effectiveFrom').isISO8601(),
+    body('effectiveUntil')
+      .optional({ checkFalsy: true })
+      .isISO8601()
+  ],
+  async (req, res) => {
+    if (req.decoded.user.role !== USER_ROLES.ADMIN_ACADEMY) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const errors = validationResult(req);
+    if (!errors.isEmpty()) {
+      return res.status(400).json({ errors: errors.array() });
+    }
+
+    let qualification = {
+      username: req.params.username,
+      subjectId: req.params.subjectId,
+      effectiveFrom: req.body.effectiveFrom,
+      effectiveUntil: req.body.effectiveUntil || ''
+    };
+
+    if (
+      qualification.effectiveUntil &&
+      qualification.effectiveUntil < qualification.effectiveFrom
+    ) {
+      return res.status(400).json({
+        msg: 'Effective from must occur before effective until'
+      });
+    }
+
+    const networkObj = await network.connectToNetwork(req.decoded.user);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    const response = await network.grantTeacherQualification(networkObj, qualification);
+
+    if (!response.success) {
+      return res.status(500).json({
+        msg: 'Grant qualification has failed'
+      });
+    }
+
+    return res.json({
+      msg: 'Grant qualification successfully'
+    });
+  }
+);
+
+router.delete(
+  '/:username/qualifications/:subjectId',
+  [
+    check('username')
+      .trim()
+      .escape(),
+    check('subjectId')
+      .trim()
+      .escape()
+  ],
+  async (req, res) => {
+    if (req.decoded.user.role !== USER_ROLES.ADMIN_ACADEMY) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const networkObj = await network.connectToNetwork(req.decoded.user);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    const response = await network.revokeTeacherQualification(
+      networkObj,
+      req.params.username,
+      req.params.subjectId
+    );
+
+    if (!response.success) {
+      return res.status(500).json({
+        msg: 'Revoke qualification has failed'
+      });
+    }
+
+    return res.json({
+      msg: 'Revoke qualification successfully'
+    });
+  }
+);
+
 router.get('/:username/classes', async (req, res, next) => {
   if (
     req.decoded.user.role !== USER_ROLES.ADMIN_ACADEMY &&
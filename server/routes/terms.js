@@ -0,0 +1,313 @@
+const router = require('express').Router();
+const USER_ROLES = require('../configs/constant').USER_ROLES;
+const network = require('../fabric/network.js');
+const { body, validationResult, check } = require('express-validator');
+const uuidv4 = require('uuid/v4');
+const TERM_STATUS = ['Active', 'Closed'];
+
+const termValidators = [
+  body('termName')
+    .not()
+    .isEmpty()
+    .trim()
+    .escape(),
+  body('registrationStart').isISO8601(),
+  body('registrationEnd').isISO8601(),
+  body('teachingStart').isISO8601(),
+  body('teachingEnd').isISO8601(),
+  body('gradingDeadline').isISO8601(),
+  body('timezone')
+    .not()
+    .isEmpty()
+    .trim()
+];
+
+function checkTermDates(term) {
+  if (term.registrationEnd < term.registrationStart) {
+    return 'Registration start must occur before registration end';
+  }
+
+  if (term.teachingEnd < term.teachingStart) {
+    return 'Teaching start must occur before teaching end';
+  }
+
+  if (term.gradingDeadline < term.teachingEnd) {
+    return 'Grading deadline must not occur before teaching end';
+  }
+
+  return null;
+}
+
+router.get('/', async (req, res) => {
+  const networkObj = await network.connectToNetwork(req.decoded.user);
+  if (!networkObj) {
+    return res.status(500).json({
+      msg: 'Failed connect to blockchain'
+    });
+  }
+
+  const response = await network.query(networkObj, 'GetAllAcademicTerms');
+
+  if (!response.success) {
+    return res.status(404).send({
+      msg: 'Query all terms has failed'
+    });
+  }
+
+  return res.json({
+    terms: JSON.parse(response.msg) ? JSON.parse(response.msg) : []
+  });
+});
+
+router.get(
+  '/:termId',
+  check('termId')
+    .trim()
+    .escape(),
+  async (req, res) => {
+    const networkObj = await network.connectToNetwork(req.decoded.user);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    const response = await network.query(networkObj, 'GetAcademicTerm', req.params.termId);
+
+    if (!response.success) {
+      return res.status(404).json({
+        msg: 'Query chaincode has failed'
+      });
+    }
+
+    return res.json({
+      term: JSON.parse(response.msg)
+    });
+  }
+);
+
+router.get(
+  '/:termId/classes',
+  check('termId')
+    .trim()
+    .escape(),
+  async (req, res) => {
+    const networkObj = await network.connectToNetwork(req.decoded.user);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    const response = await network.query(networkObj, 'GetClassesOfTerm', req.params.termId);
+
+    if (!response.success) {
+      return res.status(404).json({
+        msg: 'Query chaincode has failed'
+      });
+    }
+
+    let classes = JSON.parse(response.msg) ? JSON.parse(response.msg) : [];
+
+    if (req.decoded.user.role === USER_ROLES.STUDENT) {
+      for (let index = 0; index < classes.length; index++) {
+        delete classes[index].Students;
+        delete classes[index].Waitlist;
+      }
+    }
+
+    return res.json({
+      classes
+    });
+  }
+);
+
+// Enrollments and results hold student data, only the academy can list them
+router.get(
+  '/:termId/:report(enrollments|results)',
+  check('termId')
+    .trim()
+    .escape(),
+  async (req, res) => {
+    if (req.decoded.user.role !== USER_ROLES.ADMIN_ACADEMY) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const networkObj = await network.connectToNetwork(req.decoded.user);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    const func = req.params.report === 'results' ? 'GetResultsOfTerm' : 'GetEnrollmentsOfTerm';
+    const response = await network.query(networkObj, func, req.params.termId);
+
+    if (!response.success) {
+      return res.status(404).json({
+        msg: 'Query chaincode has failed'
+      });
+    }
+
+    return res.json({
+      [req.params.report]: JSON.parse(response.msg)
+    });
+  }
+);
+
+// Create term
+router.post('/', termValidators, async (req, res) => {
+  if (req.decoded.user.role !== USER_ROLES.ADMIN_ACADEMY) {
+    return res.status(403).json({
+      msg: 'Permission Denied'
+    });
+  }
+
+  const errors = validationResult(req);
+  if (!errors.isEmpty()) {
+    return res.status(400).json({ errors: errors.array() });
+  }
+
+  let term = {
+    termId: uuidv4(),
+    termName: req.body.termName,
+    registrationStart: req.body.registrationStart,
+    registrationEnd: req.body.registrationEnd,
+    teachingStart: req.body.teachingStart,
+    teachingEnd: req.body.teachingEnd,
+    gradingDeadline: req.body.gradingDeadline,
+    timezone: req.body.timezone
+  };
+
+  const msg = checkTermDates(term);
+  if (msg) {
+    return res.status(400).json({ msg });
+  }
+
+  const networkObj = await network.connectToNetwork(req.decoded.user);
+  if (!networkObj) {
+    return res.status(500).json({
+      msg: 'Failed connect to blockchain'
+    });
+  }
+
+  const response = await network.createAcademicTerm(networkObj, term);
+
+  if (!response.success) {
+    return res.status(500).json({
+      msg: 'Create term has failed'
+    });
+  }
+
+  return res.status(201).json({
+    msg: 'Create Successfully'
+  });
+});
+
+// Update term
+router.put(
+  '/:termId',
+  [
+    check('termId')
+      .trim()
+      .escape(),
+    ...termValidators
+  ],
+  async (req, res) => {
+    if (req.decoded.user.role !== USER_ROLES.ADMIN_ACADEMY) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const errors = validationResult(req);
+    if (!errors.isEmpty()) {
+      return res.status(400).json({ errors: errors.array() });
+    }
+
+    let term = {
+      termId: req.params.termId,
+      termName: req.body.termName,
+      registrationStart: req.body.registrationStart,
+      registrationEnd: req.body.registrationEnd,
+      teachingStart: req.body.teachingStart,
+      teachingEnd: req.body.teachingEnd,
+      gradingDeadline: req.body.gradingDeadline,
+      timezone: req.body.timezone
+    };
+
+    const msg = checkTermDates(term);
+    if (msg) {
+      return res.status(400).json({ msg });
+    }
+
+    const networkObj = await network.connectToNetwork(req.decoded.user);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    const response = await network.updateAcademicTerm(networkObj, term);
+
+    if (!response.success) {
+      return res.status(500).json({
+        msg: 'Update term has failed'
+      });
+    }
+
+    return res.status(200).json({
+      msg: 'Update Successfully'
+    });
+  }
+);
+
+// Activate or close term
+router.put(
+  '/:termId/status',
+  [
+    check('termId')
+      .trim()
+      .escape(),
+    body('status').isIn(TERM_STATUS)
+  ],
+  async (req, res) => {
+    if (req.decoded.user.role !== USER_ROLES.ADMIN_ACADEMY) {
+      return res.status(403).json({
+        msg: 'Permission Denied'
+      });
+    }
+
+    const errors = validationResult(req);
+    if (!errors.isEmpty()) {
+      return res.status(400).json({ errors: errors.array() });
+    }
+
+    const networkObj = await network.connectToNetwork(req.decoded.user);
+    if (!networkObj) {
+      return res.status(500).json({
+        msg: 'Failed connect to blockchain'
+      });
+    }
+
+    const response = await network.setAcademicTermStatus(
+      networkObj,
+      req.params.termId,
+      req.body.status
+    );
+
+    if (!response.success) {
+      return res.status(500).json({
+        msg: 'Change term status has failed'
+      });
+    }
+
+    return res.status(200).json({
+      msg: 'Update Successfully'
+    });
+  }
+);
+
+module.exports = router;
@@ -0,0 +1,275 @@
+process.env.NODE_ENV = 'test';
+
+const expect = require('chai').expect;
+const request = require('supertest');
+const sinon = require('sinon');
+const network = require('../fabric/network');
+const credential = require('../fabric/credential');
+const USER_ROLES = require('../configs/constant').USER_ROLES;
+const app = require('../app');
+
+require('dotenv').config();
+
+describe('# GET /badges/achievements/:achievementType/:id ', () => {
+  let connect;
+  let query;
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    query = sinon.stub(network, 'query');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    query.restore();
+  });
+
+  it('Achievement type is not supported', (done) => {
+    request(app)
+      .get('/badges/achievements/classes/K1')
+      .then((res) => {
+        expect(res.status).equal(404);
+        done();
+      });
+  });
+
+  it('Error chaincode when query achievement', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'guest', role: USER_ROLES.STUDENT }
+    });
+
+    query.returns({ success: false, msg: 'error' });
+
+    request(app)
+      .get('/badges/achievements/courses/C1')
+      .then((res) => {
+        expect(res.status).equal(404);
+        done();
+      });
+  });
+
+  it('should get achievement success', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'guest', role: USER_ROLES.STUDENT }
+    });
+
+    query.returns({ success: true, msg: JSON.stringify({ achievementType: 'Course' }) });
+
+    request(app)
+      .get('/badges/achievements/subjects/S1')
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(res.body.achievement.achievementType).equal('Course');
+        expect(query.firstCall.args[1]).equal('GetAchievement');
+        expect(query.firstCall.args[2]).deep.equal(['Subject', 'S1']);
+        done();
+      });
+  });
+});
+
+describe('# GET /badges/students/:username/:achievementType/:id ', () => {
+  let connect;
+  let queryAsVerifier;
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    queryAsVerifier = sinon.stub(network, 'queryAsVerifier');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    queryAsVerifier.restore();
+  });
+
+  it('Failed to connect blockchain', (done) => {
+    connect.returns(null);
+
+    request(app)
+      .get('/badges/students/hoangdd/courses/C1')
+      .then((res) => {
+        expect(res.status).equal(500);
+        done();
+      });
+  });
+
+  it('Error chaincode when query badge', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'guest', role: USER_ROLES.STUDENT }
+    });
+
+    queryAsVerifier.returns({ success: false, msg: 'error' });
+
+    request(app)
+      .get('/badges/students/hoangdd/courses/C1')
+      .then((res) => {
+        expect(res.status).equal(404);
+        done();
+      });
+  });
+
+  it('should get badge success', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'guest', role: USER_ROLES.STUDENT }
+    });
+
+    queryAsVerifier.returns({ success: true, msg: JSON.stringify({ id: 'urn:uuid:1' }) });
+
+    request(app)
+      .get('/badges/students/hoangdd/courses/C1')
+      .set('x-verifier-id', 'acme')
+      .set('x-verifier-token', 'token')
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(res.body.badge.id).equal('urn:uuid:1');
+        expect(queryAsVerifier.firstCall.args[1]).equal('GetOpenBadgeCredential');
+        expect(queryAsVerifier.firstCall.args[2]).deep.equal(['hoangdd', 'Course', 'C1']);
+        expect(queryAsVerifier.firstCall.args[3]).deep.equal({ id: 'acme', token: 'token' });
+        done();
+      });
+  });
+});
+
+describe('# POST /badges/students/:username/:achievementType/:id ', () => {
+  let connect;
+  let query;
+  let getIssuerKey;
+  let signCredential;
+  let anchorOpenBadgeProof;
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    query = sinon.stub(network, 'query');
+    getIssuerKey = sinon.stub(credential, 'getIssuerKey');
+    signCredential = sinon.stub(credential, 'signCredential');
+    anchorOpenBadgeProof = sinon.stub(network, 'anchorOpenBadgeProof');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    query.restore();
+    getIssuerKey.restore();
+    signCredential.restore();
+    anchorOpenBadgeProof.restore();
+  });
+
+  it('Permission Denined with student', (done) => {
+    request(app)
+      .post('/badges/students/hoangdd/subjects/S1')
+      .set('authorization', `${process.env.JWT_STUDENT_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(403);
+        done();
+      });
+  });
+
+  it('Issuer key is not configured', (done) => {
+    getIssuerKey.returns(null);
+
+    request(app)
+      .post('/badges/students/hoangdd/subjects/S1')
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(500);
+        expect(res.body.msg).equal('Issuer key is not configured');
+        done();
+      });
+  });
+
+  it('Error chaincode when query badge', (done) => {
+    getIssuerKey.returns('issuer key');
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    query.onFirstCall().returns({
+      success: true,
+      msg: JSON.stringify({ VerificationMethod: 'did:key:z1#z1' })
+    });
+
+    query.onSecondCall().returns({ success: false, msg: 'error' });
+
+    request(app)
+      .post('/badges/students/hoangdd/subjects/S1')
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(404);
+        done();
+      });
+  });
+
+  it('Can not anchor badge proof', (done) => {
+    getIssuerKey.returns('issuer key');
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    query.onFirstCall().returns({
+      success: true,
+      msg: JSON.stringify({ VerificationMethod: 'did:key:z1#z1' })
+    });
+
+    query.onSecondCall().returns({ success: true, msg: JSON.stringify({ id: 'urn:uuid:1' }) });
+    signCredential.returns({ proofValue: 'z1' });
+    anchorOpenBadgeProof.returns({ success: false, msg: 'error' });
+
+    request(app)
+      .post('/badges/students/hoangdd/subjects/S1')
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(500);
+        done();
+      });
+  });
+
+  it('Anchor badge proof successfully', (done) => {
+    getIssuerKey.returns('issuer key');
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    query.onFirstCall().returns({
+      success: true,
+      msg: JSON.stringify({ VerificationMethod: 'did:key:z1#z1' })
+    });
+
+    query.onSecondCall().returns({ success: true, msg: JSON.stringify({ id: 'urn:uuid:1' }) });
+    signCredential.returns({ proofValue: 'z1' });
+    anchorOpenBadgeProof.returns({ success: true, msg: 'Anchor Successfully!' });
+
+    request(app)
+      .post('/badges/students/hoangdd/subjects/S1')
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(201);
+        expect(query.secondCall.args[2]).deep.equal(['hoangdd', 'Subject', 'S1']);
+        expect(anchorOpenBadgeProof.firstCall.args[1]).deep.equal({
+          username: 'hoangdd',
+          achievementType: 'Subject',
+          id: 'S1'
+        });
+        expect(res.body.badge.proof.proofValue).equal('z1');
+        done();
+      });
+  });
+});
@@ -5,6 +5,7 @@ const request = require('supertest');
 const Cert = require('../models/Certificate');
 const sinon = require('sinon');
 const network = require('../fabric/network');
+const credential = require('../fabric/credential');
 const USER_ROLES = require('../configs/constant').USER_ROLES;
 const app = require('../app');
 
@@ -484,20 +485,95 @@ describe('#POST /certificates ', () => {
   });
 });
 
+describe('# GET /certificates/codes/:code ', () => {
+  let code = 'K7Q2-9XMB';
+  let connect;
+  let queryAsVerifier;
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    queryAsVerifier = sinon.stub(network, 'queryAsVerifier');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    queryAsVerifier.restore();
+  });
+
+  it('Failed to connect blockchain', (done) => {
+    connect.returns(null);
+
+    request(app)
+      .get(`/certificates/codes/${code}`)
+      .then((res) => {
+        expect(res.status).equal(500);
+        done();
+      });
+  });
+
+  it('Error chaincode when query certificate by code', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'guest', role: USER_ROLES.STUDENT }
+    });
+
+    queryAsVerifier.returns({ success: false, msg: 'error' });
+
+    request(app)
+      .get(`/certificates/codes/${code}`)
+      .then((res) => {
+        expect(res.status).equal(404);
+        done();
+      });
+  });
+
+  it('should get certificate by code success', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'guest', role: USER_ROLES.STUDENT }
+    });
+
+    queryAsVerifier.returns({
+      success: true,
+      msg: JSON.stringify({ CertificateID: 'cert1', VerificationCode: code })
+    });
+
+    request(app)
+      .get(`/certificates/codes/${code}`)
+      .set('x-verifier-id', 'acme')
+      .set('x-verifier-token', 'token')
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(res.body.cert.CertificateID).equal('cert1');
+        expect(queryAsVerifier.firstCall.args[1]).equal('GetCertificateByVerificationCode');
+        expect(queryAsVerifier.firstCall.args[2]).equal(code);
+        expect(queryAsVerifier.firstCall.args[3]).deep.equal({ id: 'acme', token: 'token' });
+        done();
+      });
+  });
+});
+
 describe('# GET /certificates/:certId ', () => {
   let certId = 'cdb63720-9628-5ef6-bbca-2e5ce6094f3c';
   let courseId = '9b1deb4d-3b7d-4bad-9bdd-2b0d7b3dcb6d';
   let connect;
   let query;
+  let queryAsVerifier;
 
   beforeEach(() => {
     connect = sinon.stub(network, 'connectToNetwork');
     query = sinon.stub(network, 'query');
+    queryAsVerifier = sinon.stub(network, 'queryAsVerifier');
   });
 
   afterEach(() => {
     connect.restore();
     query.restore();
+    queryAsVerifier.restore();
   });
 
   it('should get certificate success', (done) => {
@@ -527,25 +603,28 @@ describe('# GET /certificates/:certId ', () => {
       CourseCode: 'BC101'
     });
 
-    query.onFirstCall().returns({
+    queryAsVerifier.onFirstCall().returns({
       success: true,
       msg: cert
     });
 
-    query.onSecondCall().returns({
+    queryAsVerifier.onSecondCall().returns({
       success: true,
       msg: student
     });
 
-    query.onThirdCall().returns({
+    query.onFirstCall().returns({
       success: true,
       msg: course
     });
 
     request(app)
       .get(`/certificates/${certId}`)
+      .set('x-verifier-id', 'acme')
+      .set('x-verifier-token', 'token')
       .then((res) => {
         expect(res.status).equal(200);
+        expect(queryAsVerifier.firstCall.args[3]).deep.equal({ id: 'acme', token: 'token' });
         done();
       });
   });
@@ -568,7 +647,7 @@ describe('# GET /certificates/:certId ', () => {
       user: { username: 'hoangdd', role: USER_ROLES.ADMIN_STUDENT }
     });
 
-    query.onFirstCall().returns({
+    queryAsVerifier.onFirstCall().returns({
       success: false,
       msg: 'error'
     });
@@ -597,12 +676,12 @@ describe('# GET /certificates/:certId ', () => {
       }
     ]);
 
-    query.onFirstCall().returns({
+    queryAsVerifier.onFirstCall().returns({
       success: true,
       msg: cert
     });
 
-    query.onSecondCall().returns({
+    queryAsVerifier.onSecondCall().returns({
       success: false,
       msg: 'error'
     });
@@ -637,17 +716,17 @@ describe('# GET /certificates/:certId ', () => {
       Fullname: 'Do Hoang'
     });
 
-    query.onFirstCall().returns({
+    queryAsVerifier.onFirstCall().returns({
       success: true,
       msg: cert
     });
 
-    query.onSecondCall().returns({
+    queryAsVerifier.onSecondCall().returns({
       success: true,
       msg: student
     });
 
-    query.onThirdCall().returns({
+    query.onFirstCall().returns({
       success: false,
       msg: 'error'
     });
@@ -666,15 +745,18 @@ describe('# GET /certificates/:certId/verify ', () => {
   let courseId = '9b1deb4d-3b7d-4bad-9bdd-2b0d7b3dcb6d';
   let connect;
   let query;
+  let queryAsVerifier;
 
   beforeEach(() => {
     connect = sinon.stub(network, 'connectToNetwork');
     query = sinon.stub(network, 'query');
+    queryAsVerifier = sinon.stub(network, 'queryAsVerifier');
   });
 
   afterEach(() => {
     connect.restore();
     query.restore();
+    queryAsVerifier.restore();
   });
 
   it('Failed to connect blockchain', (done) => {
@@ -695,7 +777,7 @@ describe('# GET /certificates/:certId/verify ', () => {
       user: { username: 'hoangdd', role: USER_ROLES.ADMIN_STUDENT }
     });
 
-    query.returns({
+    queryAsVerifier.returns({
       success: false,
       msg: 'error'
     });
@@ -708,3 +790,728 @@ describe('# GET /certificates/:certId/verify ', () => {
       });
   });
 });
+
+describe('# GET /certificates/:certId/status ', () => {
+  let certId = 'cdb63720-9628-5ef6-bbca-2e5ce6094f3c';
+  let connect;
+  let queryAsVerifier;
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    queryAsVerifier = sinon.stub(network, 'queryAsVerifier');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    queryAsVerifier.restore();
+  });
+
+  it('Error chaincode when verify certificate', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'guest', role: USER_ROLES.STUDENT }
+    });
+
+    queryAsVerifier.returns({ success: false, msg: 'error' });
+
+    request(app)
+      .get(`/certificates/${certId}/status`)
+      .then((res) => {
+        expect(res.status).equal(404);
+        done();
+      });
+  });
+
+  it('should verify certificate success', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'guest', role: USER_ROLES.STUDENT }
+    });
+
+    queryAsVerifier.returns({
+      success: true,
+      msg: JSON.stringify({ CertificateID: certId, Status: 'Superseded', Chain: [certId, 'new'] })
+    });
+
+    request(app)
+      .get(`/certificates/${certId}/status`)
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(res.body.verification.Chain).deep.equal([certId, 'new']);
+        expect(queryAsVerifier.firstCall.args[1]).equal('VerifyCertificate');
+        done();
+      });
+  });
+});
+
+describe('# POST /certificates/:certId/reissue ', () => {
+  let certId = 'cdb63720-9628-5ef6-bbca-2e5ce6094f3c';
+  let connect;
+  let query;
+  let reissueCertificate;
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    query = sinon.stub(network, 'query');
+    reissueCertificate = sinon.stub(network, 'reissueCertificate');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    query.restore();
+    reissueCertificate.restore();
+  });
+
+  it('Permission Denined with student', (done) => {
+    request(app)
+      .post(`/certificates/${certId}/reissue`)
+      .set('authorization', `${process.env.JWT_STUDENT_EXAMPLE}`)
+      .send({ reason: 'Name was misspelled' })
+      .then((res) => {
+        expect(res.status).equal(403);
+        done();
+      });
+  });
+
+  it('Reason is missing', (done) => {
+    request(app)
+      .post(`/certificates/${certId}/reissue`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ reason: ' ' })
+      .then((res) => {
+        expect(res.status).equal(400);
+        done();
+      });
+  });
+
+  it('Error chaincode when query certificate', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    query.returns({ success: false, msg: 'error' });
+
+    request(app)
+      .post(`/certificates/${certId}/reissue`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ reason: 'Name was misspelled' })
+      .then((res) => {
+        expect(res.status).equal(404);
+        done();
+      });
+  });
+
+  it('Can not reissue certificate', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    query.returns({
+      success: true,
+      msg: JSON.stringify({ CertificateID: certId, StudentUsername: 'hoangdd' })
+    });
+    reissueCertificate.returns({ success: false, msg: 'error' });
+
+    request(app)
+      .post(`/certificates/${certId}/reissue`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ reason: 'Name was misspelled' })
+      .then((res) => {
+        expect(res.status).equal(500);
+        done();
+      });
+  });
+
+  it('Reissue certificate successfully', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    query.returns({
+      success: true,
+      msg: JSON.stringify({ CertificateID: certId, StudentUsername: 'hoangdd' })
+    });
+    reissueCertificate.returns({
+      success: true,
+      msg: JSON.stringify({ CertificateID: 'new', Supersedes: certId })
+    });
+
+    request(app)
+      .post(`/certificates/${certId}/reissue`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ reason: 'Name was misspelled' })
+      .then((res) => {
+        expect(res.status).equal(201);
+        expect(res.body.cert.Supersedes).equal(certId);
+        expect(reissueCertificate.firstCall.args[1].StudentUsername).equal('hoangdd');
+        expect(reissueCertificate.firstCall.args[2]).equal('Name was misspelled');
+        done();
+      });
+  });
+});
+
+describe('# GET /certificates/issuer ', () => {
+  let connect;
+  let query;
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    query = sinon.stub(network, 'query');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    query.restore();
+  });
+
+  it('Error chaincode when query issuer', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'guest', role: USER_ROLES.STUDENT }
+    });
+
+    query.returns({ success: false, msg: 'error' });
+
+    request(app)
+      .get('/certificates/issuer')
+      .then((res) => {
+        expect(res.status).equal(404);
+        done();
+      });
+  });
+
+  it('should get issuer success', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'guest', role: USER_ROLES.STUDENT }
+    });
+
+    query.returns({ success: true, msg: JSON.stringify({ DID: 'did:key:z1' }) });
+
+    request(app)
+      .get('/certificates/issuer')
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(res.body.issuer.DID).equal('did:key:z1');
+        expect(query.firstCall.args[1]).equal('GetCredentialIssuer');
+        done();
+      });
+  });
+});
+
+describe('# PUT /certificates/issuer ', () => {
+  let publicKey = '-----BEGIN PUBLIC KEY-----\nMFkw\n-----END PUBLIC KEY-----';
+  let connect;
+  let setCredentialIssuer;
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    setCredentialIssuer = sinon.stub(network, 'setCredentialIssuer');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    setCredentialIssuer.restore();
+  });
+
+  it('Permission Denined with student', (done) => {
+    request(app)
+      .put('/certificates/issuer')
+      .set('authorization', `${process.env.JWT_STUDENT_EXAMPLE}`)
+      .send({ publicKey })
+      .then((res) => {
+        expect(res.status).equal(403);
+        done();
+      });
+  });
+
+  it('Public key is missing', (done) => {
+    request(app)
+      .put('/certificates/issuer')
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ name: 'Academy' })
+      .then((res) => {
+        expect(res.status).equal(400);
+        done();
+      });
+  });
+
+  it('Chaincode rejects the public key', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    setCredentialIssuer.returns({ success: false, msg: 'error' });
+
+    request(app)
+      .put('/certificates/issuer')
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ publicKey })
+      .then((res) => {
+        expect(res.status).equal(500);
+        done();
+      });
+  });
+
+  it('Set credential issuer successfully', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    setCredentialIssuer.returns({ success: true, msg: 'Update Successfully!' });
+
+    request(app)
+      .put('/certificates/issuer')
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ publicKey, name: 'Academy' })
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(setCredentialIssuer.firstCall.args[1]).equal(publicKey);
+        expect(setCredentialIssuer.firstCall.args[2]).equal('Academy');
+        done();
+      });
+  });
+});
+
+describe('# POST /certificates/:certId/revoke ', () => {
+  let certId = 'cdb63720-9628-5ef6-bbca-2e5ce6094f3c';
+  let connect;
+  let revokeCertificate;
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    revokeCertificate = sinon.stub(network, 'revokeCertificate');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    revokeCertificate.restore();
+  });
+
+  it('Permission Denined with teacher', (done) => {
+    request(app)
+      .post(`/certificates/${certId}/revoke`)
+      .set('authorization', `${process.env.JWT_TEACHER_EXAMPLE}`)
+      .send({ reason: 'Issued by mistake' })
+      .then((res) => {
+        expect(res.status).equal(403);
+        done();
+      });
+  });
+
+  it('Reason is missing', (done) => {
+    request(app)
+      .post(`/certificates/${certId}/revoke`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(400);
+        done();
+      });
+  });
+
+  it('Can not revoke certificate', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    revokeCertificate.returns({ success: false, msg: 'This certificate was revoked!' });
+
+    request(app)
+      .post(`/certificates/${certId}/revoke`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ reason: 'Issued by mistake' })
+      .then((res) => {
+        expect(res.status).equal(500);
+        done();
+      });
+  });
+
+  it('Revoke certificate successfully', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    revokeCertificate.returns({ success: true, msg: 'Revoke certificate successfully!' });
+
+    request(app)
+      .post(`/certificates/${certId}/revoke`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ reason: 'Issued by mistake' })
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(revokeCertificate.firstCall.args.slice(1)).deep.equal([
+          certId,
+          'Issued by mistake'
+        ]);
+        done();
+      });
+  });
+});
+
+describe('# GET /certificates/:certId/credential ', () => {
+  let certId = 'cdb63720-9628-5ef6-bbca-2e5ce6094f3c';
+  let connect;
+  let query;
+  let queryAsVerifier;
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    query = sinon.stub(network, 'query');
+    queryAsVerifier = sinon.stub(network, 'queryAsVerifier');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    query.restore();
+    queryAsVerifier.restore();
+  });
+
+  it('Failed to connect blockchain', (done) => {
+    connect.returns(null);
+    request(app)
+      .get(`/certificates/${certId}/credential`)
+      .then((res) => {
+        expect(res.status).equal(500);
+        done();
+      });
+  });
+
+  it('Error chaincode when query credential', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'guest', role: USER_ROLES.STUDENT }
+    });
+
+    queryAsVerifier.returns({
+      success: false,
+      msg: 'error'
+    });
+
+    request(app)
+      .get(`/certificates/${certId}/credential`)
+      .then((res) => {
+        expect(res.status).equal(404);
+        done();
+      });
+  });
+
+  it('should get credential success', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'guest', role: USER_ROLES.STUDENT }
+    });
+
+    queryAsVerifier.returns({
+      success: true,
+      msg: JSON.stringify({ id: `urn:uuid:${certId}` })
+    });
+
+    request(app)
+      .get(`/certificates/${certId}/credential`)
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(res.body.credential.id).equal(`urn:uuid:${certId}`);
+        expect(queryAsVerifier.firstCall.args[1]).equal('GetCertificateAsVerifiableCredential');
+        done();
+      });
+  });
+});
+
+describe('# POST /certificates/:certId/credential ', () => {
+  let certId = 'cdb63720-9628-5ef6-bbca-2e5ce6094f3c';
+  let connect;
+  let query;
+  let getIssuerKey;
+  let signCredential;
+  let anchorCertificateProof;
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    query = sinon.stub(network, 'query');
+    getIssuerKey = sinon.stub(credential, 'getIssuerKey');
+    signCredential = sinon.stub(credential, 'signCredential');
+    anchorCertificateProof = sinon.stub(network, 'anchorCertificateProof');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    query.restore();
+    getIssuerKey.restore();
+    signCredential.restore();
+    anchorCertificateProof.restore();
+  });
+
+  it('Permission Denined with student', (done) => {
+    request(app)
+      .post(`/certificates/${certId}/credential`)
+      .set('authorization', `${process.env.JWT_STUDENT_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(403);
+        done();
+      });
+  });
+
+  it('Issuer key is not configured', (done) => {
+    getIssuerKey.returns(null);
+
+    request(app)
+      .post(`/certificates/${certId}/credential`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(500);
+        expect(res.body.msg).equal('Issuer key is not configured');
+        done();
+      });
+  });
+
+  it('Error chaincode when query credential', (done) => {
+    getIssuerKey.returns('issuer key');
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    query.onFirstCall().returns({
+      success: true,
+      msg: JSON.stringify({ VerificationMethod: 'did:key:z1#z1' })
+    });
+
+    query.onSecondCall().returns({
+      success: false,
+      msg: 'error'
+    });
+
+    request(app)
+      .post(`/certificates/${certId}/credential`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(404);
+        done();
+      });
+  });
+
+  it('Can not anchor credential proof', (done) => {
+    getIssuerKey.returns('issuer key');
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    query.onFirstCall().returns({
+      success: true,
+      msg: JSON.stringify({ VerificationMethod: 'did:key:z1#z1' })
+    });
+
+    query.onSecondCall().returns({
+      success: true,
+      msg: JSON.stringify({ id: `urn:uuid:${certId}` })
+    });
+
+    signCredential.returns({ proofValue: 'z1' });
+    anchorCertificateProof.returns({ success: false, msg: 'error' });
+
+    request(app)
+      .post(`/certificates/${certId}/credential`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(500);
+        done();
+      });
+  });
+
+  it('Anchor credential proof successfully', (done) => {
+    getIssuerKey.returns('issuer key');
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    query.onFirstCall().returns({
+      success: true,
+      msg: JSON.stringify({ VerificationMethod: 'did:key:z1#z1' })
+    });
+
+    query.onSecondCall().returns({
+      success: true,
+      msg: JSON.stringify({ id: `urn:uuid:${certId}` })
+    });
+
+    signCredential.returns({ proofValue: 'z1' });
+    anchorCertificateProof.returns({ success: true, msg: 'Anchor Successfully!' });
+
+    request(app)
+      .post(`/certificates/${certId}/credential`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(201);
+        expect(signCredential.firstCall.args[1]).equal('did:key:z1#z1');
+        expect(anchorCertificateProof.firstCall.args[1]).equal(certId);
+        expect(res.body.credential.proof.proofValue).equal('z1');
+        done();
+      });
+  });
+});
+
+describe('# GET /certificates/:rootId/commitment ', () => {
+  let rootId = 'cdb63720-9628-5ef6-bbca-2e5ce6094f3c';
+  let connect;
+  let query;
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    query = sinon.stub(network, 'query');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    query.restore();
+  });
+
+  it('Error chaincode when query commitment', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'guest', role: USER_ROLES.STUDENT }
+    });
+
+    query.returns({ success: false, msg: 'error' });
+
+    request(app)
+      .get(`/certificates/${rootId}/commitment`)
+      .then((res) => {
+        expect(res.status).equal(404);
+        done();
+      });
+  });
+
+  it('should get commitment success', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'guest', role: USER_ROLES.STUDENT }
+    });
+
+    query.returns({ success: true, msg: JSON.stringify({ RootID: rootId, Root: 'abcd' }) });
+
+    request(app)
+      .get(`/certificates/${rootId}/commitment`)
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(res.body.commitment.Root).equal('abcd');
+        done();
+      });
+  });
+});
+
+describe('# POST /certificates/:rootId/disclosure ', () => {
+  let rootId = 'cdb63720-9628-5ef6-bbca-2e5ce6094f3c';
+  let leaves = [{ SubjectID: 'S1', ScoreValue: 9, Salt: 'ab' }];
+  let proofs = [[{ Hash: 'cd', Left: false }]];
+  let connect;
+  let query;
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    query = sinon.stub(network, 'query');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    query.restore();
+  });
+
+  it('Request body is invalid', (done) => {
+    request(app)
+      .post(`/certificates/${rootId}/disclosure`)
+      .send({ leaves: [], proofs })
+      .then((res) => {
+        expect(res.status).equal(400);
+        done();
+      });
+  });
+
+  it('Error chaincode when verify disclosed scores', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'guest', role: USER_ROLES.STUDENT }
+    });
+
+    query.returns({ success: false, msg: 'error' });
+
+    request(app)
+      .post(`/certificates/${rootId}/disclosure`)
+      .send({ leaves, proofs })
+      .then((res) => {
+        expect(res.status).equal(404);
+        done();
+      });
+  });
+
+  it('should verify disclosed scores success', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'guest', role: USER_ROLES.STUDENT }
+    });
+
+    query.returns({ success: true, msg: JSON.stringify({ RootID: rootId, Valid: true }) });
+
+    request(app)
+      .post(`/certificates/${rootId}/disclosure`)
+      .send({ leaves, proofs })
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(res.body.result.Valid).equal(true);
+        expect(query.firstCall.args[2]).deep.equal([
+          rootId,
+          JSON.stringify(leaves),
+          JSON.stringify(proofs)
+        ]);
+        done();
+      });
+  });
+});
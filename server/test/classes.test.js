@@ -1106,7 +1106,7 @@ describe('#POST /classes', () => {
       });
   });
 
-  it('Start date must occur befor end date at least 1 week!', (done) => {
+  it('Start date must occur before end date!', (done) => {
     connect.returns(null);
 
     request(app)
@@ -1116,15 +1116,20 @@ describe('#POST /classes', () => {
         subjectId,
         classCode: 'CACLC1',
         room: 'Blockchain101',
-        time: '12122020',
-        startDate: '604800000',
-        endDate: '604800000',
-        repeat: 'Weekly Monday',
-        capacity: 10
+        schedule: {
+          daysOfWeek: ['Monday', 'Wednesday'],
+          startTime: '07:30',
+          endTime: '09:30',
+          startDate: '2020-03-02',
+          endDate: '2020-02-24',
+          timezone: 'Asia/Ho_Chi_Minh'
+        },
+        capacity: 10,
+        termId: 'F20'
       })
       .then((res) => {
         expect(res.status).equal(400);
-        expect(res.body.msg).equal('Start date must occur befor end date at least 1 week');
+        expect(res.body.msg).equal('Start date must occur before end date');
         done();
       });
   });
@@ -1139,11 +1144,16 @@ describe('#POST /classes', () => {
         subjectId,
         classCode: 'CACLC1',
         room: 'Blockchain101',
-        time: '12122020',
-        startDate: '604800000',
-        endDate: '1604800000',
-        repeat: 'Weekly Monday',
-        capacity: 10
+        schedule: {
+          daysOfWeek: ['Monday', 'Wednesday'],
+          startTime: '07:30',
+          endTime: '09:30',
+          startDate: '2020-02-24',
+          endDate: '2020-05-29',
+          timezone: 'Asia/Ho_Chi_Minh'
+        },
+        capacity: 10,
+        termId: 'F20'
       })
       .then((res) => {
         expect(res.status).equal(500);
@@ -1172,11 +1182,16 @@ describe('#POST /classes', () => {
         subjectId,
         classCode: 'CACLC1',
         room: 'Blockchain101',
-        time: '12122020',
-        startDate: '604800000',
-        endDate: '1604800000',
-        repeat: 'Weekly Monday',
-        capacity: 10
+        schedule: {
+          daysOfWeek: ['Monday', 'Wednesday'],
+          startTime: '07:30',
+          endTime: '09:30',
+          startDate: '2020-02-24',
+          endDate: '2020-05-29',
+          timezone: 'Asia/Ho_Chi_Minh'
+        },
+        capacity: 10,
+        termId: 'F20'
       })
       .then((res) => {
         expect(res.status).equal(500);
@@ -1203,11 +1218,16 @@ describe('#POST /classes', () => {
         subjectId,
         classCode: 'CACLC1',
         room: 'Blockchain101',
-        time: '12122020',
-        startDate: '604800000',
-        endDate: '1604800000',
-        repeat: 'Weekly Monday',
-        capacity: 10
+        schedule: {
+          daysOfWeek: ['Monday', 'Wednesday'],
+          startTime: '07:30',
+          endTime: '09:30',
+          startDate: '2020-02-24',
+          endDate: '2020-05-29',
+          timezone: 'Asia/Ho_Chi_Minh'
+        },
+        capacity: 10,
+        termId: 'F20'
       })
       .then((res) => {
         expect(res.status).equal(201);
@@ -1253,10 +1273,14 @@ describe('#PUT /classes/:classId', () => {
       .send({
         classCode: 'CACLC1',
         room: 'Blockchain101',
-        time: '12122020',
-        startDate: '24-02-2020',
-        endDate: '29-02-2020',
-        repeat: 'Weekly Monday',
+        schedule: {
+          daysOfWeek: ['Monday', 'Wednesday'],
+          startTime: '07:30',
+          endTime: '09:30',
+          startDate: '2020-02-24',
+          endDate: '2020-05-29',
+          timezone: 'Asia/Ho_Chi_Minh'
+        },
         subjectId: '123-456-a12b-1231',
         capacity: 112
       })
@@ -1288,10 +1312,14 @@ describe('#PUT /classes/:classId', () => {
       classId,
       classCode: 'CACLC2',
       room: 'Blockchain101',
-      time: '12122020',
-      startDate: '24-02-2020',
-      endDate: '29-02-2020',
-      repeat: 'Weekly Monday',
+      schedule: {
+        daysOfWeek: ['Monday', 'Wednesday'],
+        startTime: '07:30',
+        endTime: '09:30',
+        startDate: '2020-02-24',
+        endDate: '2020-05-29',
+        timezone: 'Asia/Ho_Chi_Minh'
+      },
       subjectId: '123-456-a12b-1231`',
       capacity: 112
     });
@@ -1312,10 +1340,14 @@ describe('#PUT /classes/:classId', () => {
       .send({
         classCode: 'CACLC1',
         room: 'Blockchain101',
-        time: '12122020',
-        startDate: '24-02-2020',
-        endDate: '29-02-2020',
-        repeat: 'Weekly Monday',
+        schedule: {
+          daysOfWeek: ['Monday', 'Wednesday'],
+          startTime: '07:30',
+          endTime: '09:30',
+          startDate: '2020-02-24',
+          endDate: '2020-05-29',
+          timezone: 'Asia/Ho_Chi_Minh'
+        },
         subjectId: '123-456-a12b-1231',
         capacity: 112
       })
@@ -1362,10 +1394,14 @@ describe('#PUT /classes/:classId', () => {
         classId: '123-123',
         classCode: 'CACLC1',
         room: 'Blockchain101',
-        time: '12122020',
-        startDate: '24-02-2020',
-        endDate: '29-02-2020',
-        repeat: 'Weekly Monday',
+        schedule: {
+          daysOfWeek: ['Monday', 'Wednesday'],
+          startTime: '07:30',
+          endTime: '09:30',
+          startDate: '2020-02-24',
+          endDate: '2020-05-29',
+          timezone: 'Asia/Ho_Chi_Minh'
+        },
         subjectId: 'sj',
         capacity: 100
       })
@@ -1886,7 +1922,9 @@ describe('#PUT /classes/:classId/:username/score', () => {
 
     let data = JSON.stringify({
       ClassID: classId,
-      TeacherUsername: 'abc'
+      TeacherUsername: 'abc',
+      Status: 'InProgress',
+      Students: [username]
     });
 
     query.returns({
@@ -1894,6 +1932,11 @@ describe('#PUT /classes/:classId/:username/score', () => {
       msg: data
     });
 
+    pickScore.returns({
+      success: false,
+      msg: 'Permission Denied!'
+    });
+
     request(app)
       .put(`/classes/${classId}/${username}/score`)
       .set('authorization', `${process.env.JWT_TEACHER_EXAMPLE}`)
@@ -1901,7 +1944,46 @@ describe('#PUT /classes/:classId/:username/score', () => {
         scoreValue: 9.5
       })
       .then((res) => {
-        expect(res.status).equal(403);
+        expect(res.status).equal(500);
+        done();
+      });
+  });
+
+  it('teaching assistant can not entry final score', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'hoangdd', role: USER_ROLES.TEACHER }
+    });
+
+    let data = JSON.stringify({
+      ClassID: classId,
+      TeacherUsername: 'abc',
+      Staff: [{ Username: 'hoangdd', Role: 'TA' }],
+      Status: 'InProgress',
+      Students: [username]
+    });
+
+    query.returns({
+      success: true,
+      msg: data
+    });
+
+    pickScore.returns({
+      success: false,
+      msg: 'Permission Denied!'
+    });
+
+    request(app)
+      .put(`/classes/${classId}/${username}/score`)
+      .set('authorization', `${process.env.JWT_TEACHER_EXAMPLE}`)
+      .send({
+        scoreValue: 9.5
+      })
+      .then((res) => {
+        expect(res.status).equal(500);
+        expect(pickScore.firstCall.args[1].teacher).equal('hoangdd');
         done();
       });
   });
@@ -2069,6 +2151,886 @@ describe('#PUT /classes/:classId/:username/score', () => {
   });
 });
 
+describe('#PUT /classes/:classId/sessions/:date/attendance', () => {
+  let connect;
+  let recordAttendance;
+  let classId = 'cdb63720-9628-5ef6-bbca-2e5ce6094f3c';
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    recordAttendance = sinon.stub(network, 'recordAttendance');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    recordAttendance.restore();
+  });
+
+  it('permission denied when access routes with admin', (done) => {
+    request(app)
+      .put(`/classes/${classId}/sessions/2020-09-07/attendance`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ attendance: { conglt: 'Present' } })
+      .then((res) => {
+        expect(res.status).equal(403);
+        done();
+      });
+  });
+
+  it('do not success because attendance status invalid', (done) => {
+    request(app)
+      .put(`/classes/${classId}/sessions/2020-09-07/attendance`)
+      .set('authorization', `${process.env.JWT_TEACHER_EXAMPLE}`)
+      .send({ attendance: { conglt: 'Sleeping' } })
+      .then((res) => {
+        expect(res.status).equal(400);
+        done();
+      });
+  });
+
+  it('do not success because teacher does not teach this class', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'hoangdd', role: USER_ROLES.TEACHER }
+    });
+
+    recordAttendance.returns({
+      success: false,
+      msg: 'Permission Denied!'
+    });
+
+    request(app)
+      .put(`/classes/${classId}/sessions/2020-09-07/attendance`)
+      .set('authorization', `${process.env.JWT_TEACHER_EXAMPLE}`)
+      .send({ attendance: { conglt: 'Present' } })
+      .then((res) => {
+        expect(res.status).equal(500);
+        expect(recordAttendance.firstCall.args[1].teacher).equal('hoangdd');
+        done();
+      });
+  });
+
+  it('success record attendance', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'hoangdd', role: USER_ROLES.TEACHER }
+    });
+
+    recordAttendance.returns({
+      success: true
+    });
+
+    request(app)
+      .put(`/classes/${classId}/sessions/2020-09-07/attendance`)
+      .set('authorization', `${process.env.JWT_TEACHER_EXAMPLE}`)
+      .send({ attendance: { conglt: 'Present', st01: 'Late' } })
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(recordAttendance.firstCall.args[1].date).equal('2020-09-07');
+        done();
+      });
+  });
+});
+
+describe('#GET /classes/:classId/attendance', () => {
+  let connect;
+  let query;
+  let classId = 'cdb63720-9628-5ef6-bbca-2e5ce6094f3c';
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    query = sinon.stub(network, 'query');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    query.restore();
+  });
+
+  it('permission denied when access routes with student', (done) => {
+    request(app)
+      .get(`/classes/${classId}/attendance`)
+      .set('authorization', `${process.env.JWT_STUDENT_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(403);
+        done();
+      });
+  });
+
+  it('should return attendance of class', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    query.returns({
+      success: true,
+      msg: JSON.stringify([
+        { ClassID: classId, StudentUsername: 'conglt', Sessions: 9, Recorded: 3, Percentage: 66.67 }
+      ])
+    });
+
+    request(app)
+      .get(`/classes/${classId}/attendance`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(res.body.attendance[0].Percentage).equal(66.67);
+        done();
+      });
+  });
+
+  it('permission denied because teacher does not take attendance of this class', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'hoangdd', role: USER_ROLES.TEACHER }
+    });
+
+    query.returns({
+      success: true,
+      msg: JSON.stringify([])
+    });
+
+    request(app)
+      .get(`/classes/${classId}/attendance`)
+      .set('authorization', `${process.env.JWT_TEACHER_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(403);
+        expect(query.firstCall.args[1]).equal('GetStaffPermissions');
+        expect(query.firstCall.args[2]).eql([classId, 'hoangdd']);
+        done();
+      });
+  });
+
+  it('should return attendance of class to teaching assistant', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'hoangdd', role: USER_ROLES.TEACHER }
+    });
+
+    query.onFirstCall().returns({
+      success: true,
+      msg: JSON.stringify(['Attendance', 'GradeSubmission'])
+    });
+
+    query.onSecondCall().returns({
+      success: true,
+      msg: JSON.stringify([{ ClassID: classId, StudentUsername: 'conglt', Percentage: 100 }])
+    });
+
+    request(app)
+      .get(`/classes/${classId}/attendance`)
+      .set('authorization', `${process.env.JWT_TEACHER_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(res.body.attendance[0].Percentage).equal(100);
+        done();
+      });
+  });
+});
+
+describe('#POST /classes/:classId/assessments', () => {
+  let connect;
+  let createAssessment;
+  let classId = 'cdb63720-9628-5ef6-bbca-2e5ce6094f3c';
+  let assessment = {
+    title: 'Homework 1',
+    type: 'Assignment',
+    dueDate: '2020-09-10T23:59:00+07:00',
+    maxPoints: 10
+  };
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    createAssessment = sinon.stub(network, 'createAssessment');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    createAssessment.restore();
+  });
+
+  it('permission denied when access routes with student', (done) => {
+    request(app)
+      .post(`/classes/${classId}/assessments`)
+      .set('authorization', `${process.env.JWT_STUDENT_EXAMPLE}`)
+      .send(assessment)
+      .then((res) => {
+        expect(res.status).equal(403);
+        done();
+      });
+  });
+
+  it('do not success because assessment type invalid', (done) => {
+    request(app)
+      .post(`/classes/${classId}/assessments`)
+      .set('authorization', `${process.env.JWT_TEACHER_EXAMPLE}`)
+      .send(Object.assign({}, assessment, { type: 'Essay' }))
+      .then((res) => {
+        expect(res.status).equal(400);
+        done();
+      });
+  });
+
+  it('do not success because teacher does not teach this class', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'hoangdd', role: USER_ROLES.TEACHER }
+    });
+
+    createAssessment.returns({
+      success: false,
+      msg: 'Permission Denied!'
+    });
+
+    request(app)
+      .post(`/classes/${classId}/assessments`)
+      .set('authorization', `${process.env.JWT_TEACHER_EXAMPLE}`)
+      .send(assessment)
+      .then((res) => {
+        expect(res.status).equal(500);
+        done();
+      });
+  });
+
+  it('success create assessment', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'hoangdd', role: USER_ROLES.TEACHER }
+    });
+
+    createAssessment.returns({
+      success: true
+    });
+
+    request(app)
+      .post(`/classes/${classId}/assessments`)
+      .set('authorization', `${process.env.JWT_TEACHER_EXAMPLE}`)
+      .send(assessment)
+      .then((res) => {
+        expect(res.status).equal(201);
+        expect(createAssessment.firstCall.args[1].maxPoints).equal('10');
+        done();
+      });
+  });
+});
+
+describe('#PUT /classes/:classId/assessments/:assessmentId/submission', () => {
+  let connect;
+  let submitAssessment;
+  let classId = 'cdb63720-9628-5ef6-bbca-2e5ce6094f3c';
+  let hash = '9F86D081884C7D659A2FEAA0C55AD015A3BF4F1B2B0B822CD15D6C15B0F00A08';
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    submitAssessment = sinon.stub(network, 'submitAssessment');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    submitAssessment.restore();
+  });
+
+  it('permission denied when access routes with teacher', (done) => {
+    request(app)
+      .put(`/classes/${classId}/assessments/A1/submission`)
+      .set('authorization', `${process.env.JWT_TEACHER_EXAMPLE}`)
+      .send({ hash })
+      .then((res) => {
+        expect(res.status).equal(403);
+        done();
+      });
+  });
+
+  it('do not success because hash is not SHA-256', (done) => {
+    request(app)
+      .put(`/classes/${classId}/assessments/A1/submission`)
+      .set('authorization', `${process.env.JWT_STUDENT_EXAMPLE}`)
+      .send({ hash: 'abc' })
+      .then((res) => {
+        expect(res.status).equal(400);
+        done();
+      });
+  });
+
+  it('do not success because deadline passed', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'hoangdd', role: USER_ROLES.STUDENT }
+    });
+
+    submitAssessment.returns({
+      success: false,
+      msg: 'Deadline of this assessment passed at 2020-09-10T23:59:00+07:00!'
+    });
+
+    request(app)
+      .put(`/classes/${classId}/assessments/A1/submission`)
+      .set('authorization', `${process.env.JWT_STUDENT_EXAMPLE}`)
+      .send({ hash })
+      .then((res) => {
+        expect(res.status).equal(500);
+        done();
+      });
+  });
+
+  it('success submit assessment', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'hoangdd', role: USER_ROLES.STUDENT }
+    });
+
+    submitAssessment.returns({
+      success: true,
+      msg: { AssessmentID: 'A1', Hash: hash.toLowerCase(), SubmittedAt: '2020-09-05T00:00:00Z' }
+    });
+
+    request(app)
+      .put(`/classes/${classId}/assessments/A1/submission`)
+      .set('authorization', `${process.env.JWT_STUDENT_EXAMPLE}`)
+      .send({ hash })
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(submitAssessment.firstCall.args[1].hash).equal(hash.toLowerCase());
+        expect(res.body.submission.SubmittedAt).equal('2020-09-05T00:00:00Z');
+        done();
+      });
+  });
+});
+
+describe('#PUT /classes/:classId/assessments/:assessmentId/submissions/:username/grade', () => {
+  let connect;
+  let gradeSubmission;
+  let classId = 'cdb63720-9628-5ef6-bbca-2e5ce6094f3c';
+  let hash = '9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08';
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    gradeSubmission = sinon.stub(network, 'gradeSubmission');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    gradeSubmission.restore();
+  });
+
+  it('do not success because teacher does not teach this class', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'hoangdd', role: USER_ROLES.TEACHER }
+    });
+
+    gradeSubmission.returns({
+      success: false,
+      msg: 'Permission Denied!'
+    });
+
+    request(app)
+      .put(`/classes/${classId}/assessments/A1/submissions/conglt/grade`)
+      .set('authorization', `${process.env.JWT_TEACHER_EXAMPLE}`)
+      .send({ hash, points: 8 })
+      .then((res) => {
+        expect(res.status).equal(500);
+        done();
+      });
+  });
+
+  it('success grade submission', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'hoangdd', role: USER_ROLES.TEACHER }
+    });
+
+    gradeSubmission.returns({
+      success: true
+    });
+
+    request(app)
+      .put(`/classes/${classId}/assessments/A1/submissions/conglt/grade`)
+      .set('authorization', `${process.env.JWT_TEACHER_EXAMPLE}`)
+      .send({ hash, points: 8 })
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(gradeSubmission.firstCall.args[1].studentUsername).equal('conglt');
+        done();
+      });
+  });
+});
+
+describe('#GET /classes/:classId/eligible-teachers', () => {
+  let connect;
+  let query;
+  let classId = 'cdb63720-9628-5ef6-bbca-2e5ce6094f3c';
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    query = sinon.stub(network, 'query');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    query.restore();
+  });
+
+  it('permission denied when access routes with teacher', (done) => {
+    request(app)
+      .get(`/classes/${classId}/eligible-teachers`)
+      .set('authorization', `${process.env.JWT_TEACHER_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(403);
+        done();
+      });
+  });
+
+  it('should return eligible teachers', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    query.returns({
+      success: true,
+      msg: JSON.stringify([
+        {
+          Username: 'hoangdd',
+          Fullname: 'Do Dinh Hoang',
+          Qualifications: [{ SubjectID: 'IT01', EffectiveFrom: '2020-01-01', EffectiveUntil: '' }]
+        }
+      ])
+    });
+
+    request(app)
+      .get(`/classes/${classId}/eligible-teachers`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(res.body.teachers.length).equal(1);
+        expect(query.firstCall.args[1]).equal('GetEligibleTeachersOfClass');
+        done();
+      });
+  });
+});
+
+describe('#PUT /classes/:classId/staff', () => {
+  let connect;
+  let addClassStaff;
+  let classId = 'cdb63720-9628-5ef6-bbca-2e5ce6094f3c';
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    addClassStaff = sinon.stub(network, 'addClassStaff');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    addClassStaff.restore();
+  });
+
+  it('permission denied when access routes with teacher', (done) => {
+    request(app)
+      .put(`/classes/${classId}/staff`)
+      .set('authorization', `${process.env.JWT_TEACHER_EXAMPLE}`)
+      .send({ username: 'tc02', role: 'TA' })
+      .then((res) => {
+        expect(res.status).equal(403);
+        done();
+      });
+  });
+
+  it('do not success because lead is assigned with /teacher', (done) => {
+    request(app)
+      .put(`/classes/${classId}/staff`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ username: 'tc02', role: 'Lead' })
+      .then((res) => {
+        expect(res.status).equal(400);
+        done();
+      });
+  });
+
+  it('success add teaching assistant', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    addClassStaff.returns({
+      success: true
+    });
+
+    request(app)
+      .put(`/classes/${classId}/staff`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ username: 'tc02', role: 'TA' })
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(addClassStaff.firstCall.args.slice(1)).deep.equal([classId, 'tc02', 'TA']);
+        done();
+      });
+  });
+});
+
+describe('#DELETE /classes/:classId/staff/:username', () => {
+  let connect;
+  let removeClassStaff;
+  let classId = 'cdb63720-9628-5ef6-bbca-2e5ce6094f3c';
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    removeClassStaff = sinon.stub(network, 'removeClassStaff');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    removeClassStaff.restore();
+  });
+
+  it('Can not invoke chaincode!', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    removeClassStaff.returns({
+      success: false,
+      msg: 'The teacher is not a staff of this class!'
+    });
+
+    request(app)
+      .delete(`/classes/${classId}/staff/tc02`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(500);
+        done();
+      });
+  });
+
+  it('success remove staff', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    removeClassStaff.returns({
+      success: true
+    });
+
+    request(app)
+      .delete(`/classes/${classId}/staff/tc02`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(200);
+        done();
+      });
+  });
+});
+
+describe('#PUT /classes/grading-window', () => {
+  let connect;
+  let setGradingWindow;
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    setGradingWindow = sinon.stub(network, 'setGradingWindow');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    setGradingWindow.restore();
+  });
+
+  it('permission denied when access routes with teacher', (done) => {
+    request(app)
+      .put('/classes/grading-window')
+      .set('authorization', `${process.env.JWT_TEACHER_EXAMPLE}`)
+      .send({ daysAfterEnd: 14 })
+      .then((res) => {
+        expect(res.status).equal(403);
+        done();
+      });
+  });
+
+  it('do not success because days after end invalid', (done) => {
+    request(app)
+      .put('/classes/grading-window')
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ daysAfterEnd: -1 })
+      .then((res) => {
+        expect(res.status).equal(400);
+        done();
+      });
+  });
+
+  it('success set grading window', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    setGradingWindow.returns({
+      success: true
+    });
+
+    request(app)
+      .put('/classes/grading-window')
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ daysAfterEnd: 14 })
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(setGradingWindow.firstCall.args[1]).equal('14');
+        done();
+      });
+  });
+});
+
+describe('#GET /classes/retake-policy', () => {
+  let connect;
+  let query;
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    query = sinon.stub(network, 'query');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    query.restore();
+  });
+
+  it('do not success because query chaincode has failed', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    query.returns({ success: false, msg: 'error' });
+
+    request(app)
+      .get('/classes/retake-policy')
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(404);
+        done();
+      });
+  });
+
+  it('success query retake policy', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'hoangdd', role: USER_ROLES.STUDENT }
+    });
+
+    query.returns({
+      success: true,
+      msg: JSON.stringify({ MaxAttempts: 3, RetakeOnlyAfterFail: true, CountedAttempt: 'Best' })
+    });
+
+    request(app)
+      .get('/classes/retake-policy')
+      .set('authorization', `${process.env.JWT_STUDENT_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(res.body.retakePolicy.MaxAttempts).equal(3);
+        expect(query.firstCall.args[1]).equal('GetRetakePolicy');
+        done();
+      });
+  });
+});
+
+describe('#PUT /classes/retake-policy', () => {
+  let connect;
+  let setRetakePolicy;
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    setRetakePolicy = sinon.stub(network, 'setRetakePolicy');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    setRetakePolicy.restore();
+  });
+
+  it('permission denied when access routes with teacher', (done) => {
+    request(app)
+      .put('/classes/retake-policy')
+      .set('authorization', `${process.env.JWT_TEACHER_EXAMPLE}`)
+      .send({ maxAttempts: 3, retakeOnlyAfterFail: true, countedAttempt: 'Best' })
+      .then((res) => {
+        expect(res.status).equal(403);
+        done();
+      });
+  });
+
+  it('do not success because counted attempt is invalid', (done) => {
+    request(app)
+      .put('/classes/retake-policy')
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ maxAttempts: 3, retakeOnlyAfterFail: true, countedAttempt: 'First' })
+      .then((res) => {
+        expect(res.status).equal(400);
+        done();
+      });
+  });
+
+  it('do not success because max attempts is zero', (done) => {
+    request(app)
+      .put('/classes/retake-policy')
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ maxAttempts: 0, retakeOnlyAfterFail: true, countedAttempt: 'Best' })
+      .then((res) => {
+        expect(res.status).equal(400);
+        done();
+      });
+  });
+
+  it('success set retake policy', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    setRetakePolicy.returns({ success: true });
+
+    request(app)
+      .put('/classes/retake-policy')
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ maxAttempts: 3, retakeOnlyAfterFail: 'false', countedAttempt: 'Latest' })
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(setRetakePolicy.firstCall.args[1]).deep.equal({
+          maxAttempts: 3,
+          retakeOnlyAfterFail: false,
+          countedAttempt: 'Latest'
+        });
+        done();
+      });
+  });
+});
+
+describe('#PUT /classes/:classId/:username/score/override', () => {
+  let connect;
+  let overrideScore;
+  let classId = 'cdb63720-9628-5ef6-bbca-2e5ce6094f3c';
+  let username = 'conglt';
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    overrideScore = sinon.stub(network, 'overrideScore');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    overrideScore.restore();
+  });
+
+  it('permission denied when access routes with teacher', (done) => {
+    request(app)
+      .put(`/classes/${classId}/${username}/score/override`)
+      .set('authorization', `${process.env.JWT_TEACHER_EXAMPLE}`)
+      .send({ scoreValue: 8, justification: 'Regrade after appeal' })
+      .then((res) => {
+        expect(res.status).equal(403);
+        done();
+      });
+  });
+
+  it('do not success because justification is missing', (done) => {
+    request(app)
+      .put(`/classes/${classId}/${username}/score/override`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ scoreValue: 8 })
+      .then((res) => {
+        expect(res.status).equal(400);
+        done();
+      });
+  });
+
+  it('Can not invoke chaincode!', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    overrideScore.returns({
+      success: false,
+      msg: 'The student does not study in this class!'
+    });
+
+    request(app)
+      .put(`/classes/${classId}/${username}/score/override`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ scoreValue: 8, justification: 'Regrade after appeal' })
+      .then((res) => {
+        expect(res.status).equal(500);
+        done();
+      });
+  });
+
+  it('success override score', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    overrideScore.returns({
+      success: true
+    });
+
+    request(app)
+      .put(`/classes/${classId}/${username}/score/override`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ scoreValue: 8, justification: 'Regrade after appeal' })
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(overrideScore.firstCall.args[1].justification).equal('Regrade after appeal');
+        done();
+      });
+  });
+});
+
 describe('#GET /classes/:classId', () => {
   let connect;
   let query;
@@ -788,6 +788,67 @@ describe('#PUT /courses/:courseId/status', () => {
   });
 });
 
+describe('#PUT /courses/:courseId/enrollment-window', () => {
+  let connect;
+  let setCourseEnrollmentWindow;
+  let courseId = 'cdb63720-9628-5ef6-bbca-2e5ce6094f3c';
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    setCourseEnrollmentWindow = sinon.stub(network, 'setCourseEnrollmentWindow');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    setCourseEnrollmentWindow.restore();
+  });
+
+  it('permission denied when access routes with teacher', (done) => {
+    request(app)
+      .put(`/courses/${courseId}/enrollment-window`)
+      .set('authorization', `${process.env.JWT_TEACHER_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(403);
+        done();
+      });
+  });
+
+  it('Enrollment start must occur before enrollment end', (done) => {
+    request(app)
+      .put(`/courses/${courseId}/enrollment-window`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ startDate: '2020-09-15', endDate: '2020-08-01', timezone: 'Asia/Ho_Chi_Minh' })
+      .then((res) => {
+        expect(res.status).equal(400);
+        expect(res.body.msg).equal('Enrollment start must occur before enrollment end');
+        done();
+      });
+  });
+
+  it('success set enrollment window', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    setCourseEnrollmentWindow.returns({
+      success: true
+    });
+
+    request(app)
+      .put(`/courses/${courseId}/enrollment-window`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ startDate: '2020-08-01', endDate: '2020-09-15', timezone: 'Asia/Ho_Chi_Minh' })
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(setCourseEnrollmentWindow.firstCall.args[1]).equal(courseId);
+        done();
+      });
+  });
+});
+
 describe('#POST /courses/:courseId/subjects', () => {
   let connect;
   let query;
@@ -1480,3 +1541,779 @@ describe('#GET /courses/:courseId', () => {
       });
   });
 });
+
+describe('#PUT /courses/batch-issuance-limit', () => {
+  let connect;
+  let setBatchIssuanceLimit;
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    setBatchIssuanceLimit = sinon.stub(network, 'setBatchIssuanceLimit');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    setBatchIssuanceLimit.restore();
+  });
+
+  it('permission denied when access routes with teacher', (done) => {
+    request(app)
+      .put('/courses/batch-issuance-limit')
+      .set('authorization', `${process.env.JWT_TEACHER_EXAMPLE}`)
+      .send({ maxWritesPerCall: 50 })
+      .then((res) => {
+        expect(res.status).equal(403);
+        done();
+      });
+  });
+
+  it('do not success because limit is invalid', (done) => {
+    request(app)
+      .put('/courses/batch-issuance-limit')
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ maxWritesPerCall: 0 })
+      .then((res) => {
+        expect(res.status).equal(400);
+        done();
+      });
+  });
+
+  it('do not success because chaincode rejects the limit', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    setBatchIssuanceLimit.returns({ success: false, msg: 'error' });
+
+    request(app)
+      .put('/courses/batch-issuance-limit')
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ maxWritesPerCall: 3 })
+      .then((res) => {
+        expect(res.status).equal(500);
+        done();
+      });
+  });
+
+  it('success set batch issuance limit', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    setBatchIssuanceLimit.returns({ success: true });
+
+    request(app)
+      .put('/courses/batch-issuance-limit')
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ maxWritesPerCall: 50 })
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(setBatchIssuanceLimit.firstCall.args[1]).equal('50');
+        done();
+      });
+  });
+});
+
+describe('#PUT /courses/:courseId/issuance-policy', () => {
+  let connect;
+  let setCourseIssuancePolicy;
+  let courseId = '9b1deb4d-3b7d-4bad-9bdd-2b0d7b3dcb6d';
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    setCourseIssuancePolicy = sinon.stub(network, 'setCourseIssuancePolicy');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    setCourseIssuancePolicy.restore();
+  });
+
+  it('permission denied when access routes with teacher', (done) => {
+    request(app)
+      .put(`/courses/${courseId}/issuance-policy`)
+      .set('authorization', `${process.env.JWT_TEACHER_EXAMPLE}`)
+      .send({ minAverageScore: 7 })
+      .then((res) => {
+        expect(res.status).equal(403);
+        done();
+      });
+  });
+
+  it('do not success because attendance is not a percentage', (done) => {
+    request(app)
+      .put(`/courses/${courseId}/issuance-policy`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ minAttendance: 120 })
+      .then((res) => {
+        expect(res.status).equal(400);
+        done();
+      });
+  });
+
+  it('do not success because chaincode rejects the policy', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    setCourseIssuancePolicy.returns({ success: false, msg: 'error' });
+
+    request(app)
+      .put(`/courses/${courseId}/issuance-policy`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ minElectives: 3 })
+      .then((res) => {
+        expect(res.status).equal(500);
+        done();
+      });
+  });
+
+  it('success set issuance policy', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    setCourseIssuancePolicy.returns({ success: true });
+
+    request(app)
+      .put(`/courses/${courseId}/issuance-policy`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ minAverageScore: 7, minAttendance: 80, subjectWeights: { S1: 2 } })
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(setCourseIssuancePolicy.firstCall.args[1]).equal(courseId);
+        expect(setCourseIssuancePolicy.firstCall.args[2]).deep.equal({
+          MinSubjectScore: 0,
+          MinAverageScore: 7,
+          MinAttendance: 80,
+          MaxCompletionDays: 0,
+          MinElectives: 0,
+          SubjectWeights: { S1: 2 }
+        });
+        done();
+      });
+  });
+});
+
+describe('#POST /courses/:courseId/electives', () => {
+  let connect;
+  let addElectiveToCourse;
+  let courseId = '9b1deb4d-3b7d-4bad-9bdd-2b0d7b3dcb6d';
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    addElectiveToCourse = sinon.stub(network, 'addElectiveToCourse');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    addElectiveToCourse.restore();
+  });
+
+  it('permission denied when access routes with student', (done) => {
+    request(app)
+      .post(`/courses/${courseId}/electives`)
+      .set('authorization', `${process.env.JWT_STUDENT_EXAMPLE}`)
+      .send({ subjectId: 'S1' })
+      .then((res) => {
+        expect(res.status).equal(403);
+        done();
+      });
+  });
+
+  it('do not success because subjectId is empty', (done) => {
+    request(app)
+      .post(`/courses/${courseId}/electives`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ subjectId: '' })
+      .then((res) => {
+        expect(res.status).equal(400);
+        done();
+      });
+  });
+
+  it('do not success because chaincode rejects the elective', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    addElectiveToCourse.returns({ success: false, msg: 'error' });
+
+    request(app)
+      .post(`/courses/${courseId}/electives`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ subjectId: 'S1' })
+      .then((res) => {
+        expect(res.status).equal(500);
+        done();
+      });
+  });
+
+  it('success add elective to course', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    addElectiveToCourse.returns({ success: true });
+
+    request(app)
+      .post(`/courses/${courseId}/electives`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ subjectId: 'S1' })
+      .then((res) => {
+        expect(res.status).equal(201);
+        expect(addElectiveToCourse.firstCall.args[2]).equal('S1');
+        done();
+      });
+  });
+});
+
+describe('#DELETE /courses/:courseId/electives/:subjectId', () => {
+  let connect;
+  let removeElectiveFromCourse;
+  let courseId = '9b1deb4d-3b7d-4bad-9bdd-2b0d7b3dcb6d';
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    removeElectiveFromCourse = sinon.stub(network, 'removeElectiveFromCourse');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    removeElectiveFromCourse.restore();
+  });
+
+  it('permission denied when access routes with student', (done) => {
+    request(app)
+      .delete(`/courses/${courseId}/electives/S1`)
+      .set('authorization', `${process.env.JWT_STUDENT_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(403);
+        done();
+      });
+  });
+
+  it('do not success because chaincode rejects the removal', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    removeElectiveFromCourse.returns({ success: false, msg: 'error' });
+
+    request(app)
+      .delete(`/courses/${courseId}/electives/S1`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(500);
+        done();
+      });
+  });
+
+  it('success remove elective from course', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    removeElectiveFromCourse.returns({ success: true });
+
+    request(app)
+      .delete(`/courses/${courseId}/electives/S1`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(removeElectiveFromCourse.firstCall.args[2]).equal('S1');
+        done();
+      });
+  });
+});
+
+describe('#POST /courses/:courseId/prerequisites', () => {
+  let connect;
+  let addPrerequisiteToCourse;
+  let courseId = '9b1deb4d-3b7d-4bad-9bdd-2b0d7b3dcb6d';
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    addPrerequisiteToCourse = sinon.stub(network, 'addPrerequisiteToCourse');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    addPrerequisiteToCourse.restore();
+  });
+
+  it('permission denied when access routes with student', (done) => {
+    request(app)
+      .post(`/courses/${courseId}/prerequisites`)
+      .set('authorization', `${process.env.JWT_STUDENT_EXAMPLE}`)
+      .send({ requiredCourseId: 'C0' })
+      .then((res) => {
+        expect(res.status).equal(403);
+        done();
+      });
+  });
+
+  it('do not success because requiredCourseId is empty', (done) => {
+    request(app)
+      .post(`/courses/${courseId}/prerequisites`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ requiredCourseId: '' })
+      .then((res) => {
+        expect(res.status).equal(400);
+        done();
+      });
+  });
+
+  it('do not success because chaincode rejects the prerequisite', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    addPrerequisiteToCourse.returns({ success: false, msg: 'error' });
+
+    request(app)
+      .post(`/courses/${courseId}/prerequisites`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ requiredCourseId: courseId })
+      .then((res) => {
+        expect(res.status).equal(500);
+        done();
+      });
+  });
+
+  it('success add prerequisite to course', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    addPrerequisiteToCourse.returns({ success: true });
+
+    request(app)
+      .post(`/courses/${courseId}/prerequisites`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ requiredCourseId: 'C0' })
+      .then((res) => {
+        expect(res.status).equal(201);
+        expect(addPrerequisiteToCourse.firstCall.args.slice(1)).deep.equal([courseId, 'C0']);
+        done();
+      });
+  });
+});
+
+describe('#DELETE /courses/:courseId/prerequisites/:requiredCourseId', () => {
+  let connect;
+  let removePrerequisiteFromCourse;
+  let courseId = '9b1deb4d-3b7d-4bad-9bdd-2b0d7b3dcb6d';
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    removePrerequisiteFromCourse = sinon.stub(network, 'removePrerequisiteFromCourse');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    removePrerequisiteFromCourse.restore();
+  });
+
+  it('permission denied when access routes with student', (done) => {
+    request(app)
+      .delete(`/courses/${courseId}/prerequisites/C0`)
+      .set('authorization', `${process.env.JWT_STUDENT_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(403);
+        done();
+      });
+  });
+
+  it('do not success because chaincode rejects the removal', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    removePrerequisiteFromCourse.returns({ success: false, msg: 'error' });
+
+    request(app)
+      .delete(`/courses/${courseId}/prerequisites/C0`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(500);
+        done();
+      });
+  });
+
+  it('success remove prerequisite from course', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    removePrerequisiteFromCourse.returns({ success: true });
+
+    request(app)
+      .delete(`/courses/${courseId}/prerequisites/C0`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(removePrerequisiteFromCourse.firstCall.args.slice(1)).deep.equal([courseId, 'C0']);
+        done();
+      });
+  });
+});
+
+describe('#GET /courses/:courseId/eligibility/:username', () => {
+  let connect;
+  let query;
+  let courseId = '9b1deb4d-3b7d-4bad-9bdd-2b0d7b3dcb6d';
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    query = sinon.stub(network, 'query');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    query.restore();
+  });
+
+  it('permission denied when access routes with student', (done) => {
+    request(app)
+      .get(`/courses/${courseId}/eligibility/hoangdd`)
+      .set('authorization', `${process.env.JWT_STUDENT_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(403);
+        done();
+      });
+  });
+
+  it('do not success because query chaincode has failed', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    query.returns({ success: false, msg: 'error' });
+
+    request(app)
+      .get(`/courses/${courseId}/eligibility/hoangdd`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(404);
+        done();
+      });
+  });
+
+  it('success query eligibility of student', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    query.returns({
+      success: true,
+      msg: JSON.stringify({ CourseID: courseId, StudentUsername: 'hoangdd', Eligible: true })
+    });
+
+    request(app)
+      .get(`/courses/${courseId}/eligibility/hoangdd`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(res.body.eligibility.Eligible).equal(true);
+        expect(query.firstCall.args[2]).deep.equal([courseId, 'hoangdd']);
+        done();
+      });
+  });
+});
+
+describe('#PUT /courses/:courseId/honours-bands', () => {
+  let connect;
+  let setCourseHonoursBands;
+  let courseId = '9b1deb4d-3b7d-4bad-9bdd-2b0d7b3dcb6d';
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    setCourseHonoursBands = sinon.stub(network, 'setCourseHonoursBands');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    setCourseHonoursBands.restore();
+  });
+
+  it('permission denied when access routes with teacher', (done) => {
+    request(app)
+      .put(`/courses/${courseId}/honours-bands`)
+      .set('authorization', `${process.env.JWT_TEACHER_EXAMPLE}`)
+      .send({ bands: [{ level: 'Distinction', minAverage: 9 }] })
+      .then((res) => {
+        expect(res.status).equal(403);
+        done();
+      });
+  });
+
+  it('do not success because a band has no level', (done) => {
+    request(app)
+      .put(`/courses/${courseId}/honours-bands`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ bands: [{ level: '', minAverage: 9 }] })
+      .then((res) => {
+        expect(res.status).equal(400);
+        done();
+      });
+  });
+
+  it('do not success because chaincode rejects the bands', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    setCourseHonoursBands.returns({ success: false, msg: 'error' });
+
+    request(app)
+      .put(`/courses/${courseId}/honours-bands`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ bands: [{ level: 'Merit', minAverage: 8 }] })
+      .then((res) => {
+        expect(res.status).equal(500);
+        done();
+      });
+  });
+
+  it('success set honours bands', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    setCourseHonoursBands.returns({ success: true });
+
+    request(app)
+      .put(`/courses/${courseId}/honours-bands`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({
+        bands: [
+          { level: 'Merit', minAverage: 8 },
+          { level: 'Distinction', minAverage: '9' }
+        ]
+      })
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(setCourseHonoursBands.firstCall.args[2]).deep.equal([
+          { Level: 'Merit', MinAverage: 8 },
+          { Level: 'Distinction', MinAverage: 9 }
+        ]);
+        done();
+      });
+  });
+});
+
+describe('#GET /courses/:courseId/honours/:level', () => {
+  let connect;
+  let query;
+  let courseId = '9b1deb4d-3b7d-4bad-9bdd-2b0d7b3dcb6d';
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    query = sinon.stub(network, 'query');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    query.restore();
+  });
+
+  it('permission denied when access routes with student', (done) => {
+    request(app)
+      .get(`/courses/${courseId}/honours/Distinction`)
+      .set('authorization', `${process.env.JWT_STUDENT_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(403);
+        done();
+      });
+  });
+
+  it('do not success because query chaincode has failed', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    query.returns({ success: false, msg: 'error' });
+
+    request(app)
+      .get(`/courses/${courseId}/honours/Distinction`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(404);
+        done();
+      });
+  });
+
+  it('success query certificates by honours level', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    query.returns({
+      success: true,
+      msg: JSON.stringify([{ CertificateID: 'cert1', HonoursLevel: 'Distinction' }])
+    });
+
+    request(app)
+      .get(`/courses/${courseId}/honours/Distinction`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(res.body.certificates.length).equal(1);
+        expect(query.firstCall.args[2]).deep.equal([courseId, 'Distinction']);
+        done();
+      });
+  });
+});
+
+describe('#POST /courses/:courseId/certificates', () => {
+  let connect;
+  let query;
+  let issueCertificatesForCourse;
+  let courseId = '9b1deb4d-3b7d-4bad-9bdd-2b0d7b3dcb6d';
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    query = sinon.stub(network, 'query');
+    issueCertificatesForCourse = sinon.stub(network, 'issueCertificatesForCourse');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    query.restore();
+    issueCertificatesForCourse.restore();
+  });
+
+  it('permission denied when access routes with student', (done) => {
+    request(app)
+      .post(`/courses/${courseId}/certificates`)
+      .set('authorization', `${process.env.JWT_STUDENT_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(403);
+        done();
+      });
+  });
+
+  it('do not success because query course has failed', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    query.returns({ success: false, msg: 'error' });
+
+    request(app)
+      .post(`/courses/${courseId}/certificates`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(404);
+        done();
+      });
+  });
+
+  it('do not success because chaincode rejects the batch', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    query.returns({ success: true, msg: JSON.stringify({ CourseID: courseId, Students: [] }) });
+    issueCertificatesForCourse.returns({ success: false, msg: 'error' });
+
+    request(app)
+      .post(`/courses/${courseId}/certificates`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(500);
+        done();
+      });
+  });
+
+  it('success issue certificates of the course', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    query.returns({
+      success: true,
+      msg: JSON.stringify({ CourseID: courseId, Students: ['hoangdd', 'conglt'] })
+    });
+    issueCertificatesForCourse.returns({
+      success: true,
+      msg: JSON.stringify({ CourseID: courseId, NextIndex: 2, Completed: true, Results: [] })
+    });
+
+    request(app)
+      .post(`/courses/${courseId}/certificates`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ restart: true })
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(res.body.report.Completed).equal(true);
+        expect(issueCertificatesForCourse.firstCall.args[2]).deep.equal(['hoangdd', 'conglt']);
+        expect(issueCertificatesForCourse.firstCall.args[3]).equal(true);
+        done();
+      });
+  });
+});
@@ -0,0 +1,124 @@
+process.env.NODE_ENV = 'test';
+
+const expect = require('chai').expect;
+const request = require('supertest');
+const sinon = require('sinon');
+const network = require('../fabric/network');
+const app = require('../app');
+const USER_ROLES = require('../configs/constant').USER_ROLES;
+
+describe('#POST /lifecycle/advance', () => {
+  let connect;
+  let advanceLifecycle;
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    advanceLifecycle = sinon.stub(network, 'advanceLifecycle');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    advanceLifecycle.restore();
+  });
+
+  it('permission denied when access routes with teacher', (done) => {
+    request(app)
+      .post('/lifecycle/advance')
+      .set('authorization', `${process.env.JWT_TEACHER_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(403);
+        done();
+      });
+  });
+
+  it('Failed connect to blockchain', (done) => {
+    connect.returns(null);
+
+    request(app)
+      .post('/lifecycle/advance')
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(500);
+        expect(res.body.msg).equal('Failed connect to blockchain');
+        done();
+      });
+  });
+
+  it('should return report of lifecycle', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    advanceLifecycle.returns({
+      success: true,
+      report: {
+        RunAt: '2020-10-02T00:00:00Z',
+        StartedClasses: [],
+        CompletedClasses: ['123'],
+        MissingScores: [
+          { ClassID: '456', ClassCode: 'ETH101', EndDate: '2020-10-01', Students: ['st01'] }
+        ],
+        SkippedClasses: [],
+        ClosedCourses: ['789']
+      }
+    });
+
+    request(app)
+      .post('/lifecycle/advance')
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(res.body.report.CompletedClasses).eql(['123']);
+        expect(res.body.report.MissingScores[0].Students).eql(['st01']);
+        done();
+      });
+  });
+
+  it('bad request when page size is not a positive integer', (done) => {
+    request(app)
+      .post('/lifecycle/advance')
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ pageSize: 0 })
+      .then((res) => {
+        expect(res.status).equal(400);
+        done();
+      });
+  });
+
+  it('should pass page size and bookmark to the chaincode', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    advanceLifecycle.returns({
+      success: true,
+      report: {
+        RunAt: '2020-10-02T00:00:00Z',
+        StartedClasses: ['123'],
+        CompletedClasses: [],
+        MissingScores: [],
+        SkippedClasses: [],
+        ClosedCourses: [],
+        Bookmark: 'Class-123'
+      }
+    });
+
+    request(app)
+      .post('/lifecycle/advance')
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ pageSize: 50, bookmark: 'Class-100' })
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(advanceLifecycle.firstCall.args[1]).equal(50);
+        expect(advanceLifecycle.firstCall.args[2]).equal('Class-100');
+        expect(res.body.report.Bookmark).equal('Class-123');
+        done();
+      });
+  });
+});
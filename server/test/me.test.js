@@ -878,6 +878,115 @@ describe('GET /me/classes', () => {
   });
 });
 
+describe('GET /me/timetable.ics', () => {
+  let connect;
+  let query;
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    query = sinon.stub(network, 'query');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    query.restore();
+  });
+
+  it('permission denied', (done) => {
+    request(app)
+      .get('/me/timetable.ics')
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(403);
+        done();
+      });
+  });
+
+  it('failed connect to blockchain', (done) => {
+    connect.returns(null);
+
+    request(app)
+      .get('/me/timetable.ics')
+      .set('authorization', `${process.env.JWT_STUDENT_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(500);
+        done();
+      });
+  });
+
+  it('success query timetable of teacher', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'GV00', role: USER_ROLES.TEACHER }
+    });
+
+    query.returns({
+      success: true,
+      msg: Buffer.from('BEGIN:VCALENDAR\r\nVERSION:2.0\r\nEND:VCALENDAR\r\n')
+    });
+
+    request(app)
+      .get('/me/timetable.ics')
+      .set('authorization', `${process.env.JWT_TEACHER_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(res.headers['content-type']).to.include('text/calendar');
+        expect(res.text).to.include('BEGIN:VCALENDAR');
+        done();
+      });
+  });
+});
+
+describe('GET /me/attendance', () => {
+  let connect;
+  let query;
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    query = sinon.stub(network, 'query');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    query.restore();
+  });
+
+  it('permission denied', (done) => {
+    request(app)
+      .get('/me/attendance')
+      .set('authorization', `${process.env.JWT_TEACHER_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(403);
+        done();
+      });
+  });
+
+  it('success query attendance of student', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'hoangdd', role: USER_ROLES.STUDENT }
+    });
+
+    query.returns({
+      success: true,
+      msg: JSON.stringify([{ ClassID: '123', StudentUsername: 'hoangdd', Percentage: 100 }])
+    });
+
+    request(app)
+      .get('/me/attendance')
+      .set('authorization', `${process.env.JWT_STUDENT_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(res.body.attendance.length).equal(1);
+        done();
+      });
+  });
+});
+
 describe('GET /me/courses', () => {
   let connect;
   let queryCourses;
@@ -1197,6 +1306,80 @@ describe('GET /me/courses/:courseId/certificate', () => {
   });
 });
 
+describe('GET /me/courses/:courseId/eligibility', () => {
+  let connect;
+  let query;
+  const courseId = '3611523c-876c-48f6-8c2a-d7685881d914';
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    query = sinon.stub(network, 'query');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    query.restore();
+  });
+
+  it('permission denied', (done) => {
+    request(app)
+      .get(`/me/courses/${courseId}/eligibility`)
+      .set('authorization', `${process.env.JWT_TEACHER_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(403);
+        done();
+      });
+  });
+
+  it('query chaincode error', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'hoangdd', role: USER_ROLES.STUDENT }
+    });
+
+    query.returns({ success: false, msg: 'err' });
+
+    request(app)
+      .get(`/me/courses/${courseId}/eligibility`)
+      .set('authorization', `${process.env.JWT_STUDENT_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(404);
+        done();
+      });
+  });
+
+  it('success query eligibility', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'hoangdd', role: USER_ROLES.STUDENT }
+    });
+
+    query.returns({
+      success: true,
+      msg: JSON.stringify({
+        CourseID: courseId,
+        Eligible: false,
+        FailedConditions: ['Average score 6.5 is below 7']
+      })
+    });
+
+    request(app)
+      .get(`/me/courses/${courseId}/eligibility`)
+      .set('authorization', `${process.env.JWT_STUDENT_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(res.body.eligibility.Eligible).equal(false);
+        expect(query.firstCall.args[1]).equal('CheckCertificateEligibility');
+        expect(query.firstCall.args[2][0]).equal(courseId);
+        done();
+      });
+  });
+});
+
 describe('PUT /me/password', () => {
   let findOneUserStub;
   let hashPass;
@@ -1704,3 +1887,767 @@ describe('#GET /me/subjects/:subjectId', () => {
       });
   });
 });
+
+describe('GET /me/transcript', () => {
+  let connect;
+  let query;
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    query = sinon.stub(network, 'query');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    query.restore();
+  });
+
+  it('permission denied', (done) => {
+    request(app)
+      .get('/me/transcript')
+      .set('authorization', `${process.env.JWT_TEACHER_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(403);
+        done();
+      });
+  });
+
+  it('query chaincode error', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'hoangdd', role: USER_ROLES.STUDENT }
+    });
+
+    query.returns({ success: false, msg: 'err' });
+
+    request(app)
+      .get('/me/transcript')
+      .set('authorization', `${process.env.JWT_STUDENT_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(404);
+        done();
+      });
+  });
+
+  it('success query transcript', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'hoangdd', role: USER_ROLES.STUDENT }
+    });
+
+    query.returns({
+      success: true,
+      msg: JSON.stringify({ StudentUsername: 'hoangdd', CountedAttempt: 'Latest' })
+    });
+
+    request(app)
+      .get('/me/transcript')
+      .set('authorization', `${process.env.JWT_STUDENT_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(res.body.transcript.CountedAttempt).equal('Latest');
+        expect(query.firstCall.args[1]).equal('GetTranscript');
+        done();
+      });
+  });
+});
+
+describe('POST /me/transcript-commitments', () => {
+  let connect;
+  let issueTranscriptCommitment;
+  let courseId = '9b1deb4d-3b7d-4bad-9bdd-2b0d7b3dcb6d';
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    issueTranscriptCommitment = sinon.stub(network, 'issueTranscriptCommitment');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    issueTranscriptCommitment.restore();
+  });
+
+  it('permission denied when access routes with teacher', (done) => {
+    request(app)
+      .post('/me/transcript-commitments')
+      .set('authorization', `${process.env.JWT_TEACHER_EXAMPLE}`)
+      .send({ courseId })
+      .then((res) => {
+        expect(res.status).equal(403);
+        done();
+      });
+  });
+
+  it('do not success because course id is missing', (done) => {
+    request(app)
+      .post('/me/transcript-commitments')
+      .set('authorization', `${process.env.JWT_STUDENT_EXAMPLE}`)
+      .send({ courseId: '' })
+      .then((res) => {
+        expect(res.status).equal(400);
+        done();
+      });
+  });
+
+  it('do not success because chaincode rejects the commitment', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'hoangdd', role: USER_ROLES.STUDENT }
+    });
+
+    issueTranscriptCommitment.returns({ success: false, msg: 'There is no score to commit!' });
+
+    request(app)
+      .post('/me/transcript-commitments')
+      .set('authorization', `${process.env.JWT_STUDENT_EXAMPLE}`)
+      .send({ courseId })
+      .then((res) => {
+        expect(res.status).equal(500);
+        done();
+      });
+  });
+
+  it('success commit transcript', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'hoangdd', role: USER_ROLES.STUDENT }
+    });
+
+    issueTranscriptCommitment.returns({
+      success: true,
+      msg: JSON.stringify({ RootID: 'root', CourseID: courseId, Root: 'abcd' })
+    });
+
+    request(app)
+      .post('/me/transcript-commitments')
+      .set('authorization', `${process.env.JWT_STUDENT_EXAMPLE}`)
+      .send({ courseId })
+      .then((res) => {
+        expect(res.status).equal(201);
+        expect(res.body.commitment.RootID).equal('root');
+        expect(issueTranscriptCommitment.firstCall.args[2]).equal(courseId);
+        done();
+      });
+  });
+});
+
+describe('GET /me/commitments/:rootId/subjects/:subjectId/proof', () => {
+  let connect;
+  let query;
+  let rootId = 'cdb63720-9628-5ef6-bbca-2e5ce6094f3c';
+  let subjectId = '0defc52c-6ebb-4373-8971-a36cf789e5d9';
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    query = sinon.stub(network, 'query');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    query.restore();
+  });
+
+  it('permission denied when access routes with admin', (done) => {
+    request(app)
+      .get(`/me/commitments/${rootId}/subjects/${subjectId}/proof`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(403);
+        done();
+      });
+  });
+
+  it('do not success because query chaincode has failed', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'hoangdd', role: USER_ROLES.STUDENT }
+    });
+
+    query.returns({ success: false, msg: 'Permission Denied!' });
+
+    request(app)
+      .get(`/me/commitments/${rootId}/subjects/${subjectId}/proof`)
+      .set('authorization', `${process.env.JWT_STUDENT_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(404);
+        done();
+      });
+  });
+
+  it('success get disclosure proof', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'hoangdd', role: USER_ROLES.STUDENT }
+    });
+
+    query.returns({
+      success: true,
+      msg: JSON.stringify({ RootID: rootId, Leaf: { SubjectID: subjectId }, Proof: [] })
+    });
+
+    request(app)
+      .get(`/me/commitments/${rootId}/subjects/${subjectId}/proof`)
+      .set('authorization', `${process.env.JWT_STUDENT_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(res.body.proof.Leaf.SubjectID).equal(subjectId);
+        expect(query.firstCall.args[2]).deep.equal([rootId, subjectId]);
+        done();
+      });
+  });
+});
+
+describe('POST /me/certificates/:certId/grants', () => {
+  let connect;
+  let grantCertificateAccess;
+  let certId = 'cdb63720-9628-5ef6-bbca-2e5ce6094f3c';
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    grantCertificateAccess = sinon.stub(network, 'grantCertificateAccess');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    grantCertificateAccess.restore();
+  });
+
+  it('permission denied when access routes with teacher', (done) => {
+    request(app)
+      .post(`/me/certificates/${certId}/grants`)
+      .set('authorization', `${process.env.JWT_TEACHER_EXAMPLE}`)
+      .send({ verifierId: 'acme', expiry: '2030-01-01T00:00:00Z' })
+      .then((res) => {
+        expect(res.status).equal(403);
+        done();
+      });
+  });
+
+  it('do not success because expiry is invalid', (done) => {
+    request(app)
+      .post(`/me/certificates/${certId}/grants`)
+      .set('authorization', `${process.env.JWT_STUDENT_EXAMPLE}`)
+      .send({ verifierId: 'acme', expiry: 'tomorrow' })
+      .then((res) => {
+        expect(res.status).equal(400);
+        done();
+      });
+  });
+
+  it('do not success because invoke chaincode has failed', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'hoangdd', role: USER_ROLES.STUDENT }
+    });
+
+    grantCertificateAccess.returns({ success: false, msg: 'Permission Denied!' });
+
+    request(app)
+      .post(`/me/certificates/${certId}/grants`)
+      .set('authorization', `${process.env.JWT_STUDENT_EXAMPLE}`)
+      .send({ verifierId: 'acme', expiry: '2030-01-01T00:00:00Z' })
+      .then((res) => {
+        expect(res.status).equal(500);
+        done();
+      });
+  });
+
+  it('success grant certificate access', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'hoangdd', role: USER_ROLES.STUDENT }
+    });
+
+    grantCertificateAccess.returns({ success: true, msg: 'token' });
+
+    request(app)
+      .post(`/me/certificates/${certId}/grants`)
+      .set('authorization', `${process.env.JWT_STUDENT_EXAMPLE}`)
+      .send({ verifierId: 'acme', expiry: '2030-01-01T00:00:00.000Z' })
+      .then((res) => {
+        expect(res.status).equal(201);
+        expect(res.body.token).equal('token');
+        expect(grantCertificateAccess.firstCall.args.slice(1)).deep.equal([
+          certId,
+          'acme',
+          '2030-01-01T00:00:00Z'
+        ]);
+        done();
+      });
+  });
+});
+
+describe('DELETE /me/certificates/:certId/grants/:verifierId', () => {
+  let connect;
+  let revokeCertificateAccess;
+  let certId = 'cdb63720-9628-5ef6-bbca-2e5ce6094f3c';
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    revokeCertificateAccess = sinon.stub(network, 'revokeCertificateAccess');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    revokeCertificateAccess.restore();
+  });
+
+  it('permission denied when access routes with admin', (done) => {
+    request(app)
+      .delete(`/me/certificates/${certId}/grants/acme`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(403);
+        done();
+      });
+  });
+
+  it('do not success because invoke chaincode has failed', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'hoangdd', role: USER_ROLES.STUDENT }
+    });
+
+    revokeCertificateAccess.returns({ success: false, msg: 'Grant does not exist!' });
+
+    request(app)
+      .delete(`/me/certificates/${certId}/grants/acme`)
+      .set('authorization', `${process.env.JWT_STUDENT_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(500);
+        done();
+      });
+  });
+
+  it('success revoke certificate access', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'hoangdd', role: USER_ROLES.STUDENT }
+    });
+
+    revokeCertificateAccess.returns({
+      success: true,
+      msg: 'Revoke access successfully!'
+    });
+
+    request(app)
+      .delete(`/me/certificates/${certId}/grants/acme`)
+      .set('authorization', `${process.env.JWT_STUDENT_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(revokeCertificateAccess.firstCall.args.slice(1)).deep.equal([certId, 'acme']);
+        done();
+      });
+  });
+});
+
+describe('GET /me/certificates/:certId/access-log', () => {
+  let connect;
+  let query;
+  let certId = 'cdb63720-9628-5ef6-bbca-2e5ce6094f3c';
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    query = sinon.stub(network, 'query');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    query.restore();
+  });
+
+  it('do not success because query chaincode has failed', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'hoangdd', role: USER_ROLES.STUDENT }
+    });
+
+    query.returns({ success: false, msg: 'Permission Denied!' });
+
+    request(app)
+      .get(`/me/certificates/${certId}/access-log`)
+      .set('authorization', `${process.env.JWT_STUDENT_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(404);
+        done();
+      });
+  });
+
+  it('success get access log', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'hoangdd', role: USER_ROLES.STUDENT }
+    });
+
+    query.returns({
+      success: true,
+      msg: JSON.stringify([{ CertificateID: certId, VerifierID: 'acme' }])
+    });
+
+    request(app)
+      .get(`/me/certificates/${certId}/access-log`)
+      .set('authorization', `${process.env.JWT_STUDENT_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(res.body.accessLog[0].VerifierID).equal('acme');
+        expect(query.firstCall.args[1]).equal('GetCertificateAccessLog');
+        done();
+      });
+  });
+});
+
+describe('GET /me/micro-credentials', () => {
+  let connect;
+  let query;
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    query = sinon.stub(network, 'query');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    query.restore();
+  });
+
+  it('permission denied when access routes with teacher', (done) => {
+    request(app)
+      .get('/me/micro-credentials')
+      .set('authorization', `${process.env.JWT_TEACHER_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(403);
+        done();
+      });
+  });
+
+  it('success get micro-credentials', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'hoangdd', role: USER_ROLES.STUDENT }
+    });
+
+    query.returns({
+      success: true,
+      msg: JSON.stringify([{ MicroCredentialID: 'mc1' }])
+    });
+
+    request(app)
+      .get('/me/micro-credentials')
+      .set('authorization', `${process.env.JWT_STUDENT_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(res.body.microCredentials[0].MicroCredentialID).equal('mc1');
+        expect(query.firstCall.args[1]).equal('GetMicroCredentialsOfStudent');
+        done();
+      });
+  });
+});
+
+describe('POST /me/micro-credentials/:microCredentialId/grants', () => {
+  let connect;
+  let grantMicroCredentialAccess;
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    grantMicroCredentialAccess = sinon.stub(network, 'grantMicroCredentialAccess');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    grantMicroCredentialAccess.restore();
+  });
+
+  it('do not success because verifier is missing', (done) => {
+    request(app)
+      .post('/me/micro-credentials/mc1/grants')
+      .set('authorization', `${process.env.JWT_STUDENT_EXAMPLE}`)
+      .send({ expiry: '2030-01-01T00:00:00Z' })
+      .then((res) => {
+        expect(res.status).equal(400);
+        done();
+      });
+  });
+
+  it('success grant micro-credential access', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'hoangdd', role: USER_ROLES.STUDENT }
+    });
+
+    grantMicroCredentialAccess.returns({ success: true, msg: 'token' });
+
+    request(app)
+      .post('/me/micro-credentials/mc1/grants')
+      .set('authorization', `${process.env.JWT_STUDENT_EXAMPLE}`)
+      .send({ verifierId: 'acme', expiry: '2030-01-01T00:00:00Z' })
+      .then((res) => {
+        expect(res.status).equal(201);
+        expect(res.body.token).equal('token');
+        expect(grantMicroCredentialAccess.firstCall.args.slice(1)).deep.equal([
+          'mc1',
+          'acme',
+          '2030-01-01T00:00:00Z'
+        ]);
+        done();
+      });
+  });
+});
+
+describe('DELETE /me/micro-credentials/:microCredentialId/grants/:verifierId', () => {
+  let connect;
+  let revokeMicroCredentialAccess;
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    revokeMicroCredentialAccess = sinon.stub(network, 'revokeMicroCredentialAccess');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    revokeMicroCredentialAccess.restore();
+  });
+
+  it('do not success because invoke chaincode has failed', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'hoangdd', role: USER_ROLES.STUDENT }
+    });
+
+    revokeMicroCredentialAccess.returns({ success: false, msg: 'Grant does not exist!' });
+
+    request(app)
+      .delete('/me/micro-credentials/mc1/grants/acme')
+      .set('authorization', `${process.env.JWT_STUDENT_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(500);
+        done();
+      });
+  });
+
+  it('success revoke micro-credential access', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'hoangdd', role: USER_ROLES.STUDENT }
+    });
+
+    revokeMicroCredentialAccess.returns({ success: true, msg: 'Revoke access successfully!' });
+
+    request(app)
+      .delete('/me/micro-credentials/mc1/grants/acme')
+      .set('authorization', `${process.env.JWT_STUDENT_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(revokeMicroCredentialAccess.firstCall.args.slice(1)).deep.equal(['mc1', 'acme']);
+        done();
+      });
+  });
+});
+
+describe('GET /me/micro-credentials/:microCredentialId/access-log', () => {
+  let connect;
+  let query;
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    query = sinon.stub(network, 'query');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    query.restore();
+  });
+
+  it('success get access log', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'hoangdd', role: USER_ROLES.STUDENT }
+    });
+
+    query.returns({
+      success: true,
+      msg: JSON.stringify([{ MicroCredentialID: 'mc1', VerifierID: 'acme' }])
+    });
+
+    request(app)
+      .get('/me/micro-credentials/mc1/access-log')
+      .set('authorization', `${process.env.JWT_STUDENT_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(res.body.accessLog[0].VerifierID).equal('acme');
+        expect(query.firstCall.args[1]).equal('GetMicroCredentialAccessLog');
+        done();
+      });
+  });
+});
+
+describe('GET /me/classes/:classId/waitlist', () => {
+  let connect;
+  let query;
+  let classId = 'CLS01';
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    query = sinon.stub(network, 'query');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    query.restore();
+  });
+
+  it('permission denied when access routes with teacher', (done) => {
+    request(app)
+      .get(`/me/classes/${classId}/waitlist`)
+      .set('authorization', `${process.env.JWT_TEACHER_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(403);
+        done();
+      });
+  });
+
+  it('not found when student is not waiting', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'hoangdd', role: USER_ROLES.STUDENT }
+    });
+
+    query.returns({ success: false, msg: 'You are not on the waitlist of this class!' });
+
+    request(app)
+      .get(`/me/classes/${classId}/waitlist`)
+      .set('authorization', `${process.env.JWT_STUDENT_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(404);
+        done();
+      });
+  });
+
+  it('success query waitlist position', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'hoangdd', role: USER_ROLES.STUDENT }
+    });
+
+    query.returns({
+      success: true,
+      msg: JSON.stringify({ ClassID: classId, Position: 2, Length: 3 })
+    });
+
+    request(app)
+      .get(`/me/classes/${classId}/waitlist`)
+      .set('authorization', `${process.env.JWT_STUDENT_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(res.body.waitlist.Position).equal(2);
+        expect(query.firstCall.args[1]).equal('GetWaitlistPosition');
+        expect(query.firstCall.args[2][1]).equal(classId);
+        done();
+      });
+  });
+});
+
+describe('DELETE /me/classes/:classId/waitlist', () => {
+  let connect;
+  let leaveClassWaitlist;
+  let classId = 'CLS01';
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    leaveClassWaitlist = sinon.stub(network, 'leaveClassWaitlist');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    leaveClassWaitlist.restore();
+  });
+
+  it('permission denied when access routes with admin', (done) => {
+    request(app)
+      .delete(`/me/classes/${classId}/waitlist`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(403);
+        done();
+      });
+  });
+
+  it('do not success because invoke chaincode has failed', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'hoangdd', role: USER_ROLES.STUDENT }
+    });
+
+    leaveClassWaitlist.returns({
+      success: false,
+      msg: 'You are not on the waitlist of this class!'
+    });
+
+    request(app)
+      .delete(`/me/classes/${classId}/waitlist`)
+      .set('authorization', `${process.env.JWT_STUDENT_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(500);
+        done();
+      });
+  });
+
+  it('success leave class waitlist', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'hoangdd', role: USER_ROLES.STUDENT }
+    });
+
+    leaveClassWaitlist.returns({ success: true, msg: 'Leave waitlist successfully!' });
+
+    request(app)
+      .delete(`/me/classes/${classId}/waitlist`)
+      .set('authorization', `${process.env.JWT_STUDENT_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(leaveClassWaitlist.firstCall.args[2]).equal(classId);
+        done();
+      });
+  });
+});
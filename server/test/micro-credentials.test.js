@@ -0,0 +1,326 @@
+process.env.NODE_ENV = 'test';
+
+const expect = require('chai').expect;
+const request = require('supertest');
+const sinon = require('sinon');
+const network = require('../fabric/network');
+const USER_ROLES = require('../configs/constant').USER_ROLES;
+const app = require('../app');
+
+require('dotenv').config();
+
+describe('# POST /micro-credentials ', () => {
+  let connect;
+  let issueMicroCredential;
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    issueMicroCredential = sinon.stub(network, 'issueMicroCredential');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    issueMicroCredential.restore();
+  });
+
+  it('Permission Denined with student', (done) => {
+    request(app)
+      .post('/micro-credentials')
+      .set('authorization', `${process.env.JWT_STUDENT_EXAMPLE}`)
+      .send({ classId: 'K1', username: 'hoangdd' })
+      .then((res) => {
+        expect(res.status).equal(403);
+        done();
+      });
+  });
+
+  it('Request body is invalid', (done) => {
+    request(app)
+      .post('/micro-credentials')
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ classId: 'K1' })
+      .then((res) => {
+        expect(res.status).equal(400);
+        done();
+      });
+  });
+
+  it('Can not issue micro-credential', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'admin', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    issueMicroCredential.returns({ success: false, msg: 'This class is not completed yet!' });
+
+    request(app)
+      .post('/micro-credentials')
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ classId: 'K1', username: 'hoangdd' })
+      .then((res) => {
+        expect(res.status).equal(500);
+        done();
+      });
+  });
+
+  it('Issue micro-credential successfully', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'admin', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    issueMicroCredential.returns({
+      success: true,
+      msg: JSON.stringify({ MicroCredentialID: 'mc1', ClassID: 'K1' })
+    });
+
+    request(app)
+      .post('/micro-credentials')
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ classId: 'K1', username: 'hoangdd' })
+      .then((res) => {
+        expect(res.status).equal(201);
+        expect(res.body.microCredential.MicroCredentialID).equal('mc1');
+        expect(issueMicroCredential.firstCall.args.slice(1)).deep.equal(['K1', 'hoangdd']);
+        done();
+      });
+  });
+});
+
+describe('# GET /micro-credentials/:microCredentialId ', () => {
+  let connect;
+  let queryAsVerifier;
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    queryAsVerifier = sinon.stub(network, 'queryAsVerifier');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    queryAsVerifier.restore();
+  });
+
+  it('Failed to connect blockchain', (done) => {
+    connect.returns(null);
+    request(app)
+      .get('/micro-credentials/mc1')
+      .then((res) => {
+        expect(res.status).equal(500);
+        done();
+      });
+  });
+
+  it('Error chaincode when verifier has no grant', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'guest', role: USER_ROLES.STUDENT }
+    });
+
+    queryAsVerifier.returns({ success: false, msg: 'Access denied' });
+
+    request(app)
+      .get('/micro-credentials/mc1')
+      .then((res) => {
+        expect(res.status).equal(404);
+        done();
+      });
+  });
+
+  it('should get micro-credential success', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'guest', role: USER_ROLES.STUDENT }
+    });
+
+    queryAsVerifier.returns({
+      success: true,
+      msg: JSON.stringify({ MicroCredentialID: 'mc1' })
+    });
+
+    request(app)
+      .get('/micro-credentials/mc1')
+      .set('x-verifier-id', 'acme')
+      .set('x-verifier-token', 'token')
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(res.body.microCredential.MicroCredentialID).equal('mc1');
+        expect(queryAsVerifier.firstCall.args[1]).equal('GetMicroCredential');
+        expect(queryAsVerifier.firstCall.args[3]).deep.equal({ id: 'acme', token: 'token' });
+        done();
+      });
+  });
+});
+
+describe('# GET /micro-credentials/:microCredentialId/verify ', () => {
+  let connect;
+  let queryAsVerifier;
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    queryAsVerifier = sinon.stub(network, 'queryAsVerifier');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    queryAsVerifier.restore();
+  });
+
+  it('should verify micro-credential success', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'guest', role: USER_ROLES.STUDENT }
+    });
+
+    queryAsVerifier.returns({
+      success: true,
+      msg: JSON.stringify({ MicroCredentialID: 'mc1', Valid: true })
+    });
+
+    request(app)
+      .get('/micro-credentials/mc1/verify')
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(res.body.verification.Valid).equal(true);
+        expect(queryAsVerifier.firstCall.args[1]).equal('VerifyMicroCredential');
+        done();
+      });
+  });
+});
+
+describe('# GET /micro-credentials/students/:username ', () => {
+  let connect;
+  let queryAsVerifier;
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    queryAsVerifier = sinon.stub(network, 'queryAsVerifier');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    queryAsVerifier.restore();
+  });
+
+  it('Error chaincode when verifier has no grant', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'guest', role: USER_ROLES.STUDENT }
+    });
+
+    queryAsVerifier.returns({ success: false, msg: 'Access denied' });
+
+    request(app)
+      .get('/micro-credentials/students/hoangdd')
+      .then((res) => {
+        expect(res.status).equal(404);
+        done();
+      });
+  });
+
+  it('should get micro-credentials of student success', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'guest', role: USER_ROLES.STUDENT }
+    });
+
+    queryAsVerifier.returns({
+      success: true,
+      msg: JSON.stringify([{ MicroCredentialID: 'mc1' }])
+    });
+
+    request(app)
+      .get('/micro-credentials/students/hoangdd')
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(res.body.microCredentials.length).equal(1);
+        expect(queryAsVerifier.firstCall.args[1]).equal('GetMicroCredentialsOfStudent');
+        expect(queryAsVerifier.firstCall.args[2]).equal('hoangdd');
+        done();
+      });
+  });
+});
+
+describe('# POST /micro-credentials/:microCredentialId/revoke ', () => {
+  let connect;
+  let revokeMicroCredential;
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    revokeMicroCredential = sinon.stub(network, 'revokeMicroCredential');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    revokeMicroCredential.restore();
+  });
+
+  it('Permission Denined with teacher', (done) => {
+    request(app)
+      .post('/micro-credentials/mc1/revoke')
+      .set('authorization', `${process.env.JWT_TEACHER_EXAMPLE}`)
+      .send({ reason: 'Score was wrong' })
+      .then((res) => {
+        expect(res.status).equal(403);
+        done();
+      });
+  });
+
+  it('Can not revoke micro-credential', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'admin', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    revokeMicroCredential.returns({ success: false, msg: 'This micro-credential was revoked!' });
+
+    request(app)
+      .post('/micro-credentials/mc1/revoke')
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ reason: 'Score was wrong' })
+      .then((res) => {
+        expect(res.status).equal(500);
+        done();
+      });
+  });
+
+  it('Revoke micro-credential successfully', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'admin', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    revokeMicroCredential.returns({ success: true, msg: 'Revoke micro-credential successfully!' });
+
+    request(app)
+      .post('/micro-credentials/mc1/revoke')
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ reason: 'Score was wrong' })
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(revokeMicroCredential.firstCall.args.slice(1)).deep.equal([
+          'mc1',
+          'Score was wrong'
+        ]);
+        done();
+      });
+  });
+});
@@ -0,0 +1,321 @@
+process.env.NODE_ENV = 'test';
+
+const expect = require('chai').expect;
+const request = require('supertest');
+const sinon = require('sinon');
+const network = require('../fabric/network');
+const app = require('../app');
+const USER_ROLES = require('../configs/constant').USER_ROLES;
+
+describe('#GET /rooms', () => {
+  let connect;
+  let query;
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    query = sinon.stub(network, 'query');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    query.restore();
+  });
+
+  it('should return all rooms', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    query.returns({
+      success: true,
+      msg: JSON.stringify([
+        { RoomID: 'F13', Building: 'F', Capacity: 80, Facilities: ['Projector'], Classes: null }
+      ])
+    });
+
+    request(app)
+      .get('/rooms')
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(res.body.rooms.length).equal(1);
+        done();
+      });
+  });
+
+  it('fail get all rooms because error call chaincode', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    query.returns({
+      success: false,
+      msg: 'Query chaincode has failed'
+    });
+
+    request(app)
+      .get('/rooms')
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(404);
+        done();
+      });
+  });
+});
+
+describe('#GET /rooms/:roomId/schedule', () => {
+  let connect;
+  let query;
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    query = sinon.stub(network, 'query');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    query.restore();
+  });
+
+  it('should return bookings of room', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    query.returns({
+      success: true,
+      msg: JSON.stringify({
+        RoomID: 'F13',
+        StartDate: '2020-02-24',
+        EndDate: '2020-03-01',
+        Bookings: [
+          {
+            ClassID: '123',
+            ClassCode: 'ETH101',
+            SubjectID: '456',
+            Start: '2020-02-24T11:00:00+07:00',
+            End: '2020-02-24T12:30:00+07:00'
+          }
+        ]
+      })
+    });
+
+    request(app)
+      .get('/rooms/F13/schedule?startDate=2020-02-24&endDate=2020-03-01')
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(res.body.schedule.Bookings.length).equal(1);
+        done();
+      });
+  });
+
+  it('Start date must occur before end date', (done) => {
+    request(app)
+      .get('/rooms/F13/schedule?startDate=2020-03-01&endDate=2020-02-24')
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(400);
+        expect(res.body.msg).equal('Start date must occur before end date');
+        done();
+      });
+  });
+
+  it('do not success because query invalid', (done) => {
+    request(app)
+      .get('/rooms/F13/schedule?startDate=24-02-2020')
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(400);
+        done();
+      });
+  });
+});
+
+describe('#POST /rooms', () => {
+  let connect;
+  let createRoom;
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    createRoom = sinon.stub(network, 'createRoom');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    createRoom.restore();
+  });
+
+  it('permission denied when access routes with teacher', (done) => {
+    request(app)
+      .post('/rooms')
+      .set('authorization', `${process.env.JWT_TEACHER_EXAMPLE}`)
+      .send({ roomId: 'F13', building: 'F', capacity: 80 })
+      .then((res) => {
+        expect(res.status).equal(403);
+        done();
+      });
+  });
+
+  it('do not success create room because req.body invalid', (done) => {
+    request(app)
+      .post('/rooms')
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ roomId: 'F13', building: 'F', capacity: 0 })
+      .then((res) => {
+        expect(res.status).equal(400);
+        done();
+      });
+  });
+
+  it('Can not invoke chaincode!', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    createRoom.returns({
+      success: false,
+      msg: 'Can not invoke chaincode!'
+    });
+
+    request(app)
+      .post('/rooms')
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ roomId: 'F13', building: 'F', capacity: 80, facilities: ['Projector'] })
+      .then((res) => {
+        expect(res.status).equal(500);
+        done();
+      });
+  });
+
+  it('Create Successfully!', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    createRoom.returns({
+      success: true
+    });
+
+    request(app)
+      .post('/rooms')
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ roomId: 'F13', building: 'F', capacity: 80, facilities: ['Projector'] })
+      .then((res) => {
+        expect(res.status).equal(201);
+        done();
+      });
+  });
+});
+
+describe('#PUT /rooms/:roomId', () => {
+  let connect;
+  let updateRoomInfo;
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    updateRoomInfo = sinon.stub(network, 'updateRoomInfo');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    updateRoomInfo.restore();
+  });
+
+  it('permission denied when access routes with student', (done) => {
+    request(app)
+      .put('/rooms/F13')
+      .set('authorization', `${process.env.JWT_STUDENT_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(403);
+        done();
+      });
+  });
+
+  it('success edit room', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    updateRoomInfo.returns({
+      success: true
+    });
+
+    request(app)
+      .put('/rooms/F13')
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ building: 'F', capacity: 100 })
+      .then((res) => {
+        expect(res.status).equal(200);
+        done();
+      });
+  });
+});
+
+describe('#DELETE /rooms/:roomId', () => {
+  let connect;
+  let deleteRoom;
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    deleteRoom = sinon.stub(network, 'deleteRoom');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    deleteRoom.restore();
+  });
+
+  it('Failed connect to blockchain', (done) => {
+    connect.returns(null);
+
+    request(app)
+      .delete('/rooms/F13')
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(500);
+        expect(res.body.msg).equal('Failed connect to blockchain');
+        done();
+      });
+  });
+
+  it('room still booked by classes', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    deleteRoom.returns({
+      success: false,
+      msg: 'Room is booked by 1 classes!'
+    });
+
+    request(app)
+      .delete('/rooms/F13')
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(500);
+        done();
+      });
+  });
+});
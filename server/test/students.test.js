@@ -298,6 +298,234 @@ describe('#GET /students/:username/courses', () => {
   });
 });
 
+describe('#GET /students/:username/transcript', () => {
+  let query;
+  let connect;
+  let username = 'quangnt';
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    query = sinon.stub(network, 'query');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    query.restore();
+  });
+
+  it('Permission denied with student', (done) => {
+    request(app)
+      .get(`/students/${username}/transcript`)
+      .set('authorization', `${process.env.JWT_STUDENT_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(403);
+        done();
+      });
+  });
+
+  it('Failed to query transcript in chaincode', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    query.returns({ success: false, msg: 'error' });
+
+    request(app)
+      .get(`/students/${username}/transcript`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(404);
+        done();
+      });
+  });
+
+  it('Query transcript successfully', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    query.returns({
+      success: true,
+      msg: JSON.stringify({ StudentUsername: username, CountedAttempt: 'Best', Subjects: [] })
+    });
+
+    request(app)
+      .get(`/students/${username}/transcript`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(res.body.transcript.StudentUsername).equal(username);
+        expect(query.firstCall.args.slice(1)).deep.equal(['GetTranscript', username]);
+        done();
+      });
+  });
+});
+
+describe('#PUT /students/:username/exemptions/:subjectId', () => {
+  let connect;
+  let exemptSubject;
+  let username = 'quangnt';
+  let evidenceHash = 'ab'.repeat(32);
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    exemptSubject = sinon.stub(network, 'exemptSubject');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    exemptSubject.restore();
+  });
+
+  it('Permission denied with teacher', (done) => {
+    request(app)
+      .put(`/students/${username}/exemptions/S1`)
+      .set('authorization', `${process.env.JWT_TEACHER_EXAMPLE}`)
+      .send({ evidenceHash, reason: 'Transfer credit' })
+      .then((res) => {
+        expect(res.status).equal(403);
+        done();
+      });
+  });
+
+  it('Evidence hash is not a SHA-256 digest', (done) => {
+    request(app)
+      .put(`/students/${username}/exemptions/S1`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ evidenceHash: 'abcd', reason: 'Transfer credit' })
+      .then((res) => {
+        expect(res.status).equal(400);
+        done();
+      });
+  });
+
+  it('Chaincode rejects the exemption', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    exemptSubject.returns({ success: false, msg: 'Student already has a score of subject S1' });
+
+    request(app)
+      .put(`/students/${username}/exemptions/S1`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ evidenceHash, reason: 'Transfer credit' })
+      .then((res) => {
+        expect(res.status).equal(500);
+        done();
+      });
+  });
+
+  it('Exempt subject successfully', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    exemptSubject.returns({
+      success: true,
+      msg: JSON.stringify({
+        SubjectID: 'S1',
+        StudentUsername: username,
+        EvidenceHash: evidenceHash
+      })
+    });
+
+    request(app)
+      .put(`/students/${username}/exemptions/S1`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ evidenceHash, reason: 'Transfer credit' })
+      .then((res) => {
+        expect(res.status).equal(201);
+        expect(res.body.exemption.SubjectID).equal('S1');
+        expect(exemptSubject.firstCall.args[1]).deep.equal({
+          username,
+          subjectId: 'S1',
+          evidenceHash,
+          reason: 'Transfer credit'
+        });
+        done();
+      });
+  });
+});
+
+describe('#GET /students/:username/exemptions/:subjectId', () => {
+  let connect;
+  let query;
+  let username = 'quangnt';
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    query = sinon.stub(network, 'query');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    query.restore();
+  });
+
+  it('Permission denied with student', (done) => {
+    request(app)
+      .get(`/students/${username}/exemptions/S1`)
+      .set('authorization', `${process.env.JWT_STUDENT_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(403);
+        done();
+      });
+  });
+
+  it('Failed to query exemption in chaincode', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    query.returns({ success: false, msg: 'error' });
+
+    request(app)
+      .get(`/students/${username}/exemptions/S1`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(404);
+        done();
+      });
+  });
+
+  it('Query exemption successfully', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    query.returns({ success: true, msg: JSON.stringify({ SubjectID: 'S1', Reason: 'Transfer' }) });
+
+    request(app)
+      .get(`/students/${username}/exemptions/S1`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(res.body.exemption.Reason).equal('Transfer');
+        expect(query.firstCall.args[2]).deep.equal([username, 'S1']);
+        done();
+      });
+  });
+});
+
 describe('#GET /students/:username/classes', () => {
   let queryClasses;
   let connect;
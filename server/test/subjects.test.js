@@ -582,6 +582,121 @@ describe('#PUT /subjects', () => {
   });
 });
 
+describe('#PUT /subjects/:subjectId/prerequisites', () => {
+  let connect;
+  let setSubjectPrerequisites;
+  let subjectId = 'S2';
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    setSubjectPrerequisites = sinon.stub(network, 'setSubjectPrerequisites');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    setSubjectPrerequisites.restore();
+  });
+
+  it('permission denied when access routes with teacher', (done) => {
+    request(app)
+      .put(`/subjects/${subjectId}/prerequisites`)
+      .set('authorization', `${process.env.JWT_TEACHER_EXAMPLE}`)
+      .send({ prerequisites: [{ SubjectID: 'S1', MinScore: 5 }] })
+      .then((res) => {
+        expect(res.status).equal(403);
+        done();
+      });
+  });
+
+  it('do not success because prerequisites are not a rule', (done) => {
+    request(app)
+      .put(`/subjects/${subjectId}/prerequisites`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ prerequisites: 'S1' })
+      .then((res) => {
+        expect(res.status).equal(400);
+        done();
+      });
+  });
+
+  it('do not success because chaincode rejects the rule', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    setSubjectPrerequisites.returns({ success: false, msg: 'Prerequisites can not form a cycle!' });
+
+    request(app)
+      .put(`/subjects/${subjectId}/prerequisites`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ prerequisites: [{ SubjectID: 'S2' }] })
+      .then((res) => {
+        expect(res.status).equal(500);
+        done();
+      });
+  });
+
+  it('success set prerequisites of subject', (done) => {
+    let rule = {
+      Operator: 'OR',
+      Rules: [
+        { Operator: 'SUBJECT', SubjectID: 'S1', MinScore: 5 },
+        { Operator: 'SUBJECT', SubjectID: 'S3' }
+      ]
+    };
+
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    setSubjectPrerequisites.returns({
+      success: true,
+      msg: JSON.stringify({ SubjectID: subjectId, Prerequisites: rule })
+    });
+
+    request(app)
+      .put(`/subjects/${subjectId}/prerequisites`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ prerequisites: rule })
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(res.body.subject.Prerequisites).deep.equal(rule);
+        expect(setSubjectPrerequisites.firstCall.args[2]).deep.equal(rule);
+        done();
+      });
+  });
+
+  it('success clear prerequisites of subject', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    setSubjectPrerequisites.returns({
+      success: true,
+      msg: JSON.stringify({ SubjectID: subjectId })
+    });
+
+    request(app)
+      .put(`/subjects/${subjectId}/prerequisites`)
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ prerequisites: null })
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(setSubjectPrerequisites.firstCall.args[2]).equal(null);
+        done();
+      });
+  });
+});
+
 describe('#DELETE /subjects/:subjectId', () => {
   let connect;
   let deleteSubjectStub;
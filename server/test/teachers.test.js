@@ -578,3 +578,122 @@ describe('#POST /teachers', () => {
       });
   });
 });
+
+describe('#PUT /teachers/:username/qualifications/:subjectId', () => {
+  let connect;
+  let grantTeacherQualification;
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    grantTeacherQualification = sinon.stub(network, 'grantTeacherQualification');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    grantTeacherQualification.restore();
+  });
+
+  it('permission denied when access routes with teacher', (done) => {
+    request(app)
+      .put('/teachers/hoangdd/qualifications/IT01')
+      .set('authorization', `${process.env.JWT_TEACHER_EXAMPLE}`)
+      .send({ effectiveFrom: '2020-01-01' })
+      .then((res) => {
+        expect(res.status).equal(403);
+        done();
+      });
+  });
+
+  it('do not success because effective until occurs before effective from', (done) => {
+    request(app)
+      .put('/teachers/hoangdd/qualifications/IT01')
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ effectiveFrom: '2020-06-01', effectiveUntil: '2020-01-01' })
+      .then((res) => {
+        expect(res.status).equal(400);
+        expect(res.body.msg).equal('Effective from must occur before effective until');
+        done();
+      });
+  });
+
+  it('success grant qualification without expiry', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    grantTeacherQualification.returns({
+      success: true
+    });
+
+    request(app)
+      .put('/teachers/hoangdd/qualifications/IT01')
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ effectiveFrom: '2020-01-01' })
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(grantTeacherQualification.firstCall.args[1].effectiveUntil).equal('');
+        done();
+      });
+  });
+});
+
+describe('#DELETE /teachers/:username/qualifications/:subjectId', () => {
+  let connect;
+  let revokeTeacherQualification;
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    revokeTeacherQualification = sinon.stub(network, 'revokeTeacherQualification');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    revokeTeacherQualification.restore();
+  });
+
+  it('Can not invoke chaincode!', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    revokeTeacherQualification.returns({
+      success: false,
+      msg: 'The teacher is not qualified for this subject!'
+    });
+
+    request(app)
+      .delete('/teachers/hoangdd/qualifications/IT01')
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(500);
+        done();
+      });
+  });
+
+  it('success revoke qualification', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    revokeTeacherQualification.returns({
+      success: true
+    });
+
+    request(app)
+      .delete('/teachers/hoangdd/qualifications/IT01')
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(200);
+        done();
+      });
+  });
+});
@@ -0,0 +1,261 @@
+process.env.NODE_ENV = 'test';
+
+const expect = require('chai').expect;
+const request = require('supertest');
+const sinon = require('sinon');
+const network = require('../fabric/network');
+const app = require('../app');
+const USER_ROLES = require('../configs/constant').USER_ROLES;
+
+const fallTerm = {
+  termName: 'Fall 2020',
+  registrationStart: '2020-08-01',
+  registrationEnd: '2020-08-31',
+  teachingStart: '2020-09-01',
+  teachingEnd: '2020-12-20',
+  gradingDeadline: '2021-01-10',
+  timezone: 'Asia/Ho_Chi_Minh'
+};
+
+describe('#GET /terms', () => {
+  let connect;
+  let query;
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    query = sinon.stub(network, 'query');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    query.restore();
+  });
+
+  it('should return all terms', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'hoangdd', role: USER_ROLES.STUDENT }
+    });
+
+    query.returns({
+      success: true,
+      msg: JSON.stringify([{ TermID: 'F20', TermName: 'Fall 2020', Status: 'Active' }])
+    });
+
+    request(app)
+      .get('/terms')
+      .set('authorization', `${process.env.JWT_STUDENT_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(res.body.terms.length).equal(1);
+        done();
+      });
+  });
+
+  it('hide students of classes from students', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'hoangdd', role: USER_ROLES.STUDENT }
+    });
+
+    query.returns({
+      success: true,
+      msg: JSON.stringify([{ ClassID: '123', TermID: 'F20', Students: ['st01'], Waitlist: [] }])
+    });
+
+    request(app)
+      .get('/terms/F20/classes')
+      .set('authorization', `${process.env.JWT_STUDENT_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(res.body.classes[0].Students).equal(undefined);
+        done();
+      });
+  });
+});
+
+describe('#GET /terms/:termId/results', () => {
+  let connect;
+  let query;
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    query = sinon.stub(network, 'query');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    query.restore();
+  });
+
+  it('permission denied when access routes with student', (done) => {
+    request(app)
+      .get('/terms/F20/results')
+      .set('authorization', `${process.env.JWT_STUDENT_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(403);
+        done();
+      });
+  });
+
+  it('should return results of term', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    query.returns({
+      success: true,
+      msg: JSON.stringify([
+        { ClassID: '123', StudentUsername: 'st01', Scored: true, ScoreValue: 8, Passed: true }
+      ])
+    });
+
+    request(app)
+      .get('/terms/F20/results')
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .then((res) => {
+        expect(res.status).equal(200);
+        expect(res.body.results.length).equal(1);
+        expect(query.firstCall.args[1]).equal('GetResultsOfTerm');
+        done();
+      });
+  });
+});
+
+describe('#POST /terms', () => {
+  let connect;
+  let createAcademicTerm;
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    createAcademicTerm = sinon.stub(network, 'createAcademicTerm');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    createAcademicTerm.restore();
+  });
+
+  it('permission denied when access routes with teacher', (done) => {
+    request(app)
+      .post('/terms')
+      .set('authorization', `${process.env.JWT_TEACHER_EXAMPLE}`)
+      .send(fallTerm)
+      .then((res) => {
+        expect(res.status).equal(403);
+        done();
+      });
+  });
+
+  it('Grading deadline must not occur before teaching end', (done) => {
+    request(app)
+      .post('/terms')
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send(Object.assign({}, fallTerm, { gradingDeadline: '2020-12-01' }))
+      .then((res) => {
+        expect(res.status).equal(400);
+        expect(res.body.msg).equal('Grading deadline must not occur before teaching end');
+        done();
+      });
+  });
+
+  it('Create Successfully!', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    createAcademicTerm.returns({
+      success: true
+    });
+
+    request(app)
+      .post('/terms')
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send(fallTerm)
+      .then((res) => {
+        expect(res.status).equal(201);
+        done();
+      });
+  });
+});
+
+describe('#PUT /terms/:termId/status', () => {
+  let connect;
+  let setAcademicTermStatus;
+
+  beforeEach(() => {
+    connect = sinon.stub(network, 'connectToNetwork');
+    setAcademicTermStatus = sinon.stub(network, 'setAcademicTermStatus');
+  });
+
+  afterEach(() => {
+    connect.restore();
+    setAcademicTermStatus.restore();
+  });
+
+  it('do not success because status invalid', (done) => {
+    request(app)
+      .put('/terms/F20/status')
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ status: 'Planned' })
+      .then((res) => {
+        expect(res.status).equal(400);
+        done();
+      });
+  });
+
+  it('Can not invoke chaincode!', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    setAcademicTermStatus.returns({
+      success: false,
+      msg: 'Can not change term status from Closed to Active!'
+    });
+
+    request(app)
+      .put('/terms/F20/status')
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ status: 'Active' })
+      .then((res) => {
+        expect(res.status).equal(500);
+        done();
+      });
+  });
+
+  it('success activate term', (done) => {
+    connect.returns({
+      contract: 'academy',
+      network: 'certificatechannel',
+      gateway: 'gateway',
+      user: { username: 'adminacademy', role: USER_ROLES.ADMIN_ACADEMY }
+    });
+
+    setAcademicTermStatus.returns({
+      success: true
+    });
+
+    request(app)
+      .put('/terms/F20/status')
+      .set('authorization', `${process.env.JWT_ADMIN_ACADEMY_EXAMPLE}`)
+      .send({ status: 'Active' })
+      .then((res) => {
+        expect(res.status).equal(200);
+        done();
+      });
+  });
+});